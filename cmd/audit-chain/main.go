@@ -0,0 +1,183 @@
+package main
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"cybesphere-backend/internal/config"
+	"cybesphere-backend/internal/repositories"
+	"cybesphere-backend/internal/services"
+	"cybesphere-backend/pkg/audit"
+	"cybesphere-backend/pkg/database"
+	"cybesphere-backend/pkg/logger"
+)
+
+func main() {
+	// Configurar flags de comandos
+	var (
+		export       = flag.String("export", "", "Exportar un tramo firmado a este fichero")
+		from         = flag.Int64("from", 0, "Primera posición del tramo exportado")
+		to           = flag.Int64("to", 0, "Última posición del tramo exportado")
+		verifyBundle = flag.String("verify-bundle", "", "Verificar un paquete exportado sin conectar a la base de datos")
+		publicKey    = flag.String("public-key", "", "Clave pública Ed25519 (base64) que debe haber firmado el paquete")
+		help         = flag.Bool("help", false, "Mostrar ayuda")
+	)
+	flag.Parse()
+
+	if *help {
+		printHelp()
+		return
+	}
+
+	// Los paquetes archivados se verifican sin configuración ni base de datos
+	if *verifyBundle != "" {
+		os.Exit(checkBundle(*verifyBundle, *publicKey))
+	}
+
+	// 1. Cargar configuración
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Printf("Error cargando configuración: %v\n", err)
+		os.Exit(1)
+	}
+
+	// 2. Inicializar logger
+	if err := logger.Init(&cfg.Logging); err != nil {
+		fmt.Printf("Error inicializando logger: %v\n", err)
+		os.Exit(1)
+	}
+
+	// 3. Clave de firma (solo necesaria para exportar)
+	var signingKey ed25519.PrivateKey
+	if cfg.Audit.SigningKey != "" {
+		if signingKey, err = audit.ParseSigningKey(cfg.Audit.SigningKey); err != nil {
+			logger.Fatalf("Error inicializando la firma de auditoría: %v", err)
+		}
+	}
+
+	// 4. Conectar a la base de datos
+	if err := database.Connect(&cfg.Database); err != nil {
+		logger.Fatalf("Error conectando a la base de datos: %v", err)
+	}
+	defer database.Close()
+
+	repoManager := repositories.NewRepositoryManager()
+	service := services.NewAuditService(repoManager.AuditLogs, signingKey)
+	ctx := context.Background()
+
+	// 5. Exportar o verificar la cadena
+	if *export != "" {
+		bundle, err := service.ExportBundle(ctx, *from, *to)
+		if err != nil {
+			logger.Fatalf("Error exportando la auditoría: %v", err)
+		}
+		if err := writeBundle(*export, bundle); err != nil {
+			logger.Fatalf("Error guardando el paquete: %v", err)
+		}
+		fmt.Printf("Exportados %d registros (%d-%d) en %s\n",
+			len(bundle.Links), bundle.FromSequence, bundle.ToSequence, *export)
+		fmt.Printf("Último hash: %s\n", bundle.LastHash)
+		return
+	}
+
+	report, err := service.VerifyChain(ctx)
+	if err != nil {
+		logger.Fatalf("Error verificando la auditoría: %v", err)
+	}
+
+	if report.PrunedThrough > 0 {
+		fmt.Printf("Verificación desde el punto de retención: %d\n", report.PrunedThrough)
+	}
+	fmt.Printf("Registros verificados: %d\n", report.Checked)
+	fmt.Printf("Último eslabón válido: %d %s\n", report.HeadSequence, report.HeadHash)
+	if report.Unchained > 0 {
+		fmt.Printf("Registros anteriores al encadenado (sin verificar): %d\n", report.Unchained)
+	}
+	if !report.Valid {
+		printBreak(report.Break, report.BrokenLogID)
+		os.Exit(2)
+	}
+	fmt.Println("✅ Cadena de auditoría íntegra")
+}
+
+// checkBundle verifica un paquete exportado; devuelve el código de salida
+func checkBundle(path, publicKey string) int {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		fmt.Printf("Error leyendo el paquete: %v\n", err)
+		return 1
+	}
+	var bundle audit.Bundle
+	if err := json.Unmarshal(raw, &bundle); err != nil {
+		fmt.Printf("Error leyendo el paquete: %v\n", err)
+		return 1
+	}
+
+	var trusted ed25519.PublicKey
+	if publicKey != "" {
+		trusted, err = base64.StdEncoding.DecodeString(publicKey)
+		if err != nil || len(trusted) != ed25519.PublicKeySize {
+			fmt.Println("-public-key debe ser una clave Ed25519 en base64")
+			return 1
+		}
+	}
+
+	brk, err := bundle.Verify(trusted)
+	if err != nil {
+		fmt.Printf("❌ Paquete no válido: %v\n", err)
+		return 2
+	}
+	if brk != nil {
+		printBreak(brk, "")
+		return 2
+	}
+
+	fmt.Printf("✅ Paquete íntegro: %d registros (%d-%d), último hash %s\n",
+		len(bundle.Links), bundle.FromSequence, bundle.ToSequence, bundle.LastHash)
+	if trusted == nil {
+		fmt.Println("Sin -public-key no se comprueba quién lo firmó")
+	}
+	return 0
+}
+
+// writeBundle guarda el paquete en formato legible
+func writeBundle(path string, bundle *audit.Bundle) error {
+	raw, err := json.MarshalIndent(bundle, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, raw, 0o600)
+}
+
+// printBreak muestra el primer eslabón roto
+func printBreak(brk *audit.Break, logID string) {
+	fmt.Printf("❌ Cadena rota en la posición %d: %s\n", brk.Sequence, brk.Reason)
+	if logID != "" {
+		fmt.Printf("   Registro: %s\n", logID)
+	}
+	fmt.Printf("   Esperado: %s\n   Obtenido: %s\n", brk.Expected, brk.Actual)
+}
+
+// printHelp muestra la ayuda del comando
+func printHelp() {
+	fmt.Println(`Cadena de auditoría de CybESphere
+
+Cada registro de auditoría guarda el hash del anterior. Sin opciones se
+recorre la cadena recalculando los hashes y se informa del primer eslabón
+roto (código de salida 2).
+
+Uso:
+  go run ./cmd/audit-chain [opciones]
+
+Opciones:
+  -export FICHERO         Exportar un tramo firmado (requiere AUDIT_SIGNING_KEY)
+  -from N / -to N         Tramo exportado (por defecto los últimos registros)
+  -verify-bundle FICHERO  Verificar un paquete exportado sin base de datos
+  -public-key CLAVE       Clave pública Ed25519 esperada al verificar un paquete
+  -help                   Mostrar esta ayuda`)
+}
@@ -43,6 +43,11 @@ func main() {
 	}
 	defer database.Close()
 
+	// Auditoría de cambios de eventos, organizaciones y usuarios
+	if err := database.GetDB().Use(models.NewAuditPlugin()); err != nil {
+		logger.Fatalf("Failed to register audit plugin: %v", err)
+	}
+
 	logger.Info("Database connected successfully")
 
 	// 4. Ejecutar auto-migraciones
@@ -88,8 +93,24 @@ func main() {
 	routes.SetupRoutes(r, cfg, authMiddleware, app)
 	logger.Info(" Routes configured successfully")
 
-	// 12. Iniciar servidor con graceful shutdown
-	startServerWithGracefulShutdown(r, cfg)
+	// 12. Tareas programadas de limpieza y conservación de datos
+	if cfg.Jobs.Enabled {
+		if err := app.Services.Jobs.Start(context.Background()); err != nil {
+			logger.Warnf("Failed to start scheduled jobs: %v", err)
+		} else {
+			logger.Info("Scheduled jobs started")
+		}
+	}
+
+	// 13. Workers de procesado de imágenes
+	if err := app.Services.MediaProcessing.Start(context.Background()); err != nil {
+		logger.Warnf("Failed to start image processing workers: %v", err)
+	} else if cfg.Upload.ImageWorkers > 0 {
+		logger.Infof("Image processing workers started (%d)", cfg.Upload.ImageWorkers)
+	}
+
+	// 14. Iniciar servidor con graceful shutdown
+	startServerWithGracefulShutdown(r, cfg, app)
 }
 
 // setupRouter configura el router de Gin con configuración básica
@@ -183,7 +204,7 @@ func seedDevelopmentData() error {
 }
 
 // startServerWithGracefulShutdown inicia el servidor con graceful shutdown
-func startServerWithGracefulShutdown(r *gin.Engine, cfg *config.Config) {
+func startServerWithGracefulShutdown(r *gin.Engine, cfg *config.Config, app *routes.Application) {
 	address := cfg.Server.GetAddress()
 
 	// Crear servidor HTTP
@@ -221,6 +242,10 @@ func startServerWithGracefulShutdown(r *gin.Engine, cfg *config.Config) {
 		logger.Errorf("Server forced to shutdown: %v", err)
 	}
 
+	// Esperar a las tareas en curso antes de cerrar la base de datos
+	app.Services.Jobs.Stop()
+	app.Services.MediaProcessing.Stop()
+
 	// Cerrar conexiones de base de datos
 	if err := database.Close(); err != nil {
 		logger.Errorf("Error cerrando la base de datos: %v", err)
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"cybesphere-backend/internal/config"
+	"cybesphere-backend/internal/repositories"
+	"cybesphere-backend/internal/services"
+	"cybesphere-backend/pkg/database"
+	"cybesphere-backend/pkg/geocoding"
+	"cybesphere-backend/pkg/logger"
+)
+
+func main() {
+	// Configurar flags de comandos
+	var (
+		events        = flag.Bool("events", true, "Geocodificar eventos sin coordenadas")
+		organizations = flag.Bool("organizations", true, "Geocodificar organizaciones sin coordenadas")
+		batchSize     = flag.Int("batch", 100, "Registros leídos por lote")
+		help          = flag.Bool("help", false, "Mostrar ayuda")
+	)
+	flag.Parse()
+
+	if *help {
+		printHelp()
+		return
+	}
+
+	// 1. Cargar configuración
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Printf("Error cargando configuración: %v\n", err)
+		os.Exit(1)
+	}
+
+	// 2. Inicializar logger
+	if err := logger.Init(&cfg.Logging); err != nil {
+		fmt.Printf("Error inicializando logger: %v\n", err)
+		os.Exit(1)
+	}
+
+	// 3. Crear geocodificador
+	geocoder, err := geocoding.New(geocoding.Config{
+		Provider:    cfg.Geocoding.Provider,
+		FixturePath: cfg.Geocoding.FixturePath,
+		BaseURL:     cfg.Geocoding.BaseURL,
+		UserAgent:   cfg.Geocoding.UserAgent,
+		Timeout:     cfg.Geocoding.Timeout,
+		CacheTTL:    cfg.Geocoding.CacheTTL,
+		CacheSize:   cfg.Geocoding.CacheSize,
+	})
+	if err != nil {
+		logger.Fatalf("Error inicializando el geocodificador: %v", err)
+	}
+	if geocoder == nil {
+		logger.Fatalf("La geocodificación está desactivada (GEOCODER_PROVIDER=%s)", cfg.Geocoding.Provider)
+	}
+
+	// 4. Conectar a la base de datos
+	if err := database.Connect(&cfg.Database); err != nil {
+		logger.Fatalf("Error conectando a la base de datos: %v", err)
+	}
+	defer database.Close()
+
+	// 5. Rellenar coordenadas; Ctrl+C detiene el proceso tras el registro en curso
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	repoManager := repositories.NewRepositoryManager()
+	service := services.NewGeocodingService(geocoder, repoManager.Events, repoManager.Organizations)
+
+	logger.Infof("🌍 Geocodificando direcciones con el proveedor %s", cfg.Geocoding.Provider)
+	result, err := service.Backfill(ctx, services.GeocodingBackfillOptions{
+		Events:        *events,
+		Organizations: *organizations,
+		BatchSize:     *batchSize,
+	})
+	if result != nil {
+		printStats("Eventos", result.Events)
+		printStats("Organizaciones", result.Organizations)
+	}
+	if err != nil {
+		logger.Fatalf("Error rellenando coordenadas: %v", err)
+	}
+
+	logger.Info("🎉 Geocodificación completada")
+}
+
+// printStats muestra el recuento de un tipo de registro
+func printStats(label string, stats services.GeocodingBackfillStats) {
+	logger.Infof("  📋 %s: %d procesados, %d actualizados, %d no encontrados, %d con error",
+		label, stats.Processed, stats.Updated, stats.NotFound, stats.Failed)
+}
+
+// printHelp muestra la ayuda del comando
+func printHelp() {
+	fmt.Println(`Geocodificación de direcciones de CybESphere
+
+Rellena las coordenadas de los eventos presenciales y organizaciones que
+tienen dirección pero no latitud/longitud. No modifica coordenadas manuales.
+
+Uso:
+  go run ./cmd/geocode [opciones]
+
+Opciones:
+  -events=false          No procesar eventos
+  -organizations=false   No procesar organizaciones
+  -batch N               Registros leídos por lote (por defecto 100)
+  -help                  Mostrar esta ayuda
+
+El proveedor se configura con GEOCODER_PROVIDER (fixture, nominatim); por defecto
+está desactivado (none).`)
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"cybesphere-backend/internal/config"
+	"cybesphere-backend/internal/repositories"
+	"cybesphere-backend/internal/services"
+	"cybesphere-backend/pkg/database"
+	"cybesphere-backend/pkg/logger"
+	"cybesphere-backend/pkg/recommend"
+)
+
+func main() {
+	// Configurar flags de comandos
+	var (
+		k    = flag.Int("k", 10, "Tamaño de la lista de recomendaciones evaluada")
+		help = flag.Bool("help", false, "Mostrar ayuda")
+	)
+	flag.Parse()
+
+	if *help {
+		printHelp()
+		return
+	}
+	if *k <= 0 {
+		fmt.Println("-k debe ser mayor que 0")
+		os.Exit(1)
+	}
+
+	// 1. Cargar configuración
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Printf("Error cargando configuración: %v\n", err)
+		os.Exit(1)
+	}
+
+	// 2. Inicializar logger
+	if err := logger.Init(&cfg.Logging); err != nil {
+		fmt.Printf("Error inicializando logger: %v\n", err)
+		os.Exit(1)
+	}
+
+	// 3. Conectar a la base de datos
+	if err := database.Connect(&cfg.Database); err != nil {
+		logger.Fatalf("Error conectando a la base de datos: %v", err)
+	}
+	defer database.Close()
+
+	// 4. Evaluar con los favoritos existentes
+	repoManager := repositories.NewRepositoryManager()
+	service := services.NewRecommendationService(repoManager.Events, repoManager.Organizations, repoManager.Users)
+
+	result, err := service.Evaluate(context.Background(), *k)
+	if err != nil {
+		logger.Fatalf("Error evaluando recomendaciones: %v", err)
+	}
+	if result.Model.Cases == 0 {
+		logger.Fatalf("No hay usuarios con al menos dos favoritos; ejecuta antes make db-seed")
+	}
+
+	fmt.Printf("Evaluación leave-one-out: %d usuarios, %d casos, top %d\n\n",
+		result.Model.Users, result.Model.Cases, result.Model.K)
+	fmt.Printf("%-14s %9s %9s %9s %9s\n", "", "HitRate", "MRR", "NDCG", "Cobertura")
+	printMetrics("Recomendador", result.Model)
+	printMetrics("Popularidad", result.Baseline)
+}
+
+// printMetrics muestra una fila de métricas
+func printMetrics(label string, m recommend.Metrics) {
+	fmt.Printf("%-14s %9.3f %9.3f %9.3f %9.3f\n", label, m.HitRate, m.MRR, m.NDCG, m.Coverage)
+}
+
+// printHelp muestra la ayuda del comando
+func printHelp() {
+	fmt.Println(`Evaluación offline de recomendaciones de CybESphere
+
+Oculta por turnos cada favorito de los usuarios con al menos dos, recomienda
+con el resto de señales y mide si el favorito oculto vuelve a aparecer entre
+los K primeros. Compara el recomendador con una ordenación por popularidad.
+
+Uso:
+  go run ./cmd/recommend-eval [opciones]
+
+Opciones:
+  -k N     Tamaño de la lista evaluada (por defecto 10)
+  -help    Mostrar esta ayuda
+
+Usa los datos de la base de datos configurada (por ejemplo, tras make db-seed).`)
+}
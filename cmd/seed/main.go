@@ -107,10 +107,33 @@ func recreateAllTables(db *gorm.DB) error {
 	// Lista de modelos a recrear (orden importante para relaciones)
 	models := []interface{}{
 		&models.RefreshToken{}, // Primero las tablas dependientes
+		&models.ModerationNote{},
+		&models.OrganizationVerification{},
+		&models.MediaReference{},
+		&models.Media{},
+		&models.ContentReport{},
+		&models.ErasureRequest{},
+		&models.DataExport{},
+		&models.Invoice{},
+		&models.InvoiceSequence{},
+		&models.Order{},
+		&models.EventRegistration{},
+		&models.PromoCode{},
+		&models.TicketType{},
+		&models.Notification{},
+		&models.SubmissionReview{},
+		&models.TalkSubmission{},
+		&models.EventSession{},
+		&models.Speaker{},
+		&models.CalendarFeed{},
 		&models.Event{},
+		&models.EventSeries{},
 		&models.User{},
 		&models.Organization{},
 		&models.AuditLog{},
+		&models.AuditCheckpoint{},
+		&models.EntityVersion{},
+		&models.ScheduledJob{},
 	}
 
 	// Eliminar tablas en orden reverso
@@ -121,8 +144,10 @@ func recreateAllTables(db *gorm.DB) error {
 	}
 
 	// Eliminar tabla de relaciones many-to-many
-	if err := db.Exec("DROP TABLE IF EXISTS user_favorite_events").Error; err != nil {
-		logger.Warnf("Error eliminando tabla user_favorite_events: %v", err)
+	for _, table := range []string{"user_favorite_events", "user_followed_organizations", "user_favorite_sessions", "event_session_speakers"} {
+		if err := db.Exec("DROP TABLE IF EXISTS " + table).Error; err != nil {
+			logger.Warnf("Error eliminando tabla %s: %v", table, err)
+		}
 	}
 
 	// Recrear tablas
@@ -64,6 +64,11 @@ func MapGormError(err error) error {
 
 // HTTPStatusFromError mapea errores a códigos HTTP
 func HTTPStatusFromError(err error) int {
+	var businessErr *BusinessError
+	if errors.As(err, &businessErr) && businessErr.Code == "validation_error" {
+		return http.StatusBadRequest
+	}
+
 	switch {
 	case errors.Is(err, ErrNotFound):
 		return http.StatusNotFound
@@ -1,5 +1,7 @@
 package common
 
+import "strings"
+
 // QueryOptions opciones genéricas para consultas
 type QueryOptions struct {
 	// Paginación
@@ -7,6 +9,10 @@ type QueryOptions struct {
 	Limit  int `form:"limit,default=20" binding:"min=1,max=100"`
 	Offset int `form:"-"` // Calculado automáticamente
 
+	// Paginación por cursor (keyset): sustituye a page y solo cuenta el total si se pide
+	Cursor    string `form:"cursor"`
+	WithTotal bool   `form:"with_total"`
+
 	// Ordenamiento
 	OrderBy  string `form:"order_by,default=created_at"`
 	OrderDir string `form:"order_dir,default=desc" binding:"omitempty,oneof=asc desc"`
@@ -14,6 +20,13 @@ type QueryOptions struct {
 	// Búsqueda
 	Search string `form:"search"`
 
+	// Expresión de filtrado, p. ej. type in (workshop, training) and is_free = true
+	Filter string `form:"filter"`
+
+	// Selección de campos de la respuesta y relaciones a incluir
+	Fields string `form:"fields"`
+	Expand string `form:"expand"`
+
 	// Filtros genéricos
 	Filters map[string]interface{} `form:"-"`
 
@@ -34,6 +47,12 @@ func (qo *QueryOptions) Validate() error {
 	}
 	qo.Offset = (qo.Page - 1) * qo.Limit
 
+	// Con cursor la posición la determina el propio cursor
+	if qo.Cursor != "" {
+		qo.Page = 1
+		qo.Offset = 0
+	}
+
 	if qo.OrderDir != "asc" && qo.OrderDir != "desc" {
 		qo.OrderDir = "desc"
 	}
@@ -45,6 +64,17 @@ func (qo *QueryOptions) Validate() error {
 	return nil
 }
 
+// ExpandList devuelve las relaciones pedidas con ?expand=, normalizadas
+func (qo *QueryOptions) ExpandList() []string {
+	var names []string
+	for _, name := range strings.Split(qo.Expand, ",") {
+		if name = strings.ToLower(strings.TrimSpace(name)); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
 // AddFilter agrega un filtro
 func (qo *QueryOptions) AddFilter(key string, value interface{}) {
 	if qo.Filters == nil {
@@ -72,6 +102,10 @@ type PaginationMeta struct {
 	HasPrev  bool  `json:"has_prev"`
 	NextPage *int  `json:"next_page,omitempty"`
 	PrevPage *int  `json:"prev_page,omitempty"`
+
+	// Cursores opacos para pedir la página siguiente o anterior con ?cursor=
+	NextCursor string `json:"next_cursor,omitempty"`
+	PrevCursor string `json:"prev_cursor,omitempty"`
 }
 
 // NewPaginationMeta crea metadatos de paginación
@@ -102,3 +136,22 @@ func NewPaginationMeta(page, limit int, total int64) *PaginationMeta {
 
 	return meta
 }
+
+// NewCursorPaginationMeta crea metadatos de paginación por cursor; page y
+// pages no aplican y total es -1 si no se ha calculado
+func NewCursorPaginationMeta(limit int, total int64, nextCursor, prevCursor string) *PaginationMeta {
+	pages := int64(0)
+	if total > 0 {
+		pages = (total + int64(limit) - 1) / int64(limit)
+	}
+
+	return &PaginationMeta{
+		Limit:      limit,
+		Total:      total,
+		Pages:      pages,
+		HasNext:    nextCursor != "",
+		HasPrev:    prevCursor != "",
+		NextCursor: nextCursor,
+		PrevCursor: prevCursor,
+	}
+}
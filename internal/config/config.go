@@ -21,7 +21,13 @@ type Config struct {
 	Email      EmailConfig      `json:"email"`
 	Upload     UploadConfig     `json:"upload"`
 	Geo        GeoConfig        `json:"geo"`
+	Geocoding  GeocodingConfig  `json:"geocoding"`
 	RateLimit  RateLimitConfig  `json:"rate_limit"`
+	Payments   PaymentsConfig   `json:"payments"`
+	Audit      AuditConfig      `json:"audit"`
+	Privacy    PrivacyConfig    `json:"privacy"`
+	Jobs       JobsConfig       `json:"jobs"`
+	Moderation ModerationConfig `json:"moderation"`
 }
 
 // ServerConfig configuración del servidor
@@ -96,9 +102,27 @@ type EmailConfig struct {
 
 // UploadConfig configuración de archivos
 type UploadConfig struct {
-	MaxSizeMB         int      `json:"max_size_mb"`
-	AllowedExtensions []string `json:"allowed_extensions"`
-	Path              string   `json:"path"`
+	MaxSizeMB         int            `json:"max_size_mb"`
+	AllowedExtensions []string       `json:"allowed_extensions"`
+	Path              string         `json:"path"`       // Directorio del backend local
+	Backend           string         `json:"backend"`    // local o s3
+	PublicURL         string         `json:"public_url"` // Base pública de los ficheros; vacía = se sirven desde la API
+	S3                UploadS3Config `json:"s3"`
+
+	// Procesado de imágenes
+	ImageWorkers   int `json:"image_workers"`    // Workers en segundo plano (0 = solo la tarea periódica)
+	ImageQuality   int `json:"image_quality"`    // Calidad WebP de las versiones (1-100)
+	ImageMaxPixels int `json:"image_max_pixels"` // Límite de píxeles para decodificar una imagen
+}
+
+// UploadS3Config almacenamiento compatible con S3 (AWS, MinIO, etc.)
+type UploadS3Config struct {
+	Endpoint  string `json:"endpoint"`
+	Bucket    string `json:"bucket"`
+	Region    string `json:"region"`
+	AccessKey string `json:"-"` // No exponer en JSON
+	SecretKey string `json:"-"` // No exponer en JSON
+	PathStyle bool   `json:"path_style"`
 }
 
 // GeoConfig configuración geoespacial
@@ -107,6 +131,17 @@ type GeoConfig struct {
 	MaxRadiusKM     int `json:"max_radius_km"`
 }
 
+// GeocodingConfig configuración del geocodificador de direcciones
+type GeocodingConfig struct {
+	Provider    string        `json:"provider"`     // none, fixture o nominatim
+	FixturePath string        `json:"fixture_path"` // Vacío = ciudades incluidas por defecto
+	BaseURL     string        `json:"base_url"`
+	UserAgent   string        `json:"user_agent"`
+	Timeout     time.Duration `json:"timeout"`
+	CacheTTL    time.Duration `json:"cache_ttl"`
+	CacheSize   int           `json:"cache_size"`
+}
+
 // RateLimitConfig configuración de rate limiting
 type RateLimitConfig struct {
 	Enabled           bool `json:"enabled"`
@@ -114,6 +149,38 @@ type RateLimitConfig struct {
 	Burst             int  `json:"burst"`
 }
 
+// PaymentsConfig configuración del proveedor de pagos
+type PaymentsConfig struct {
+	Provider      string `json:"provider"` // none (desactivado) o fake
+	WebhookSecret string `json:"-"`        // No exponer en JSON
+	CheckoutURL   string `json:"checkout_url"`
+}
+
+// AuditConfig configuración de la cadena de auditoría
+type AuditConfig struct {
+	SigningKey string `json:"-"` // Semilla Ed25519 en base64 para firmar las exportaciones
+}
+
+// PrivacyConfig plazos de conservación de datos personales (RGPD)
+type PrivacyConfig struct {
+	ExportRetention    time.Duration `json:"export_retention"`     // Tiempo que se puede descargar una exportación
+	ErasureGracePeriod time.Duration `json:"erasure_grace_period"` // Plazo hasta anonimizar una cuenta (0 = inmediato)
+}
+
+// JobsConfig tareas programadas de limpieza y conservación de datos
+type JobsConfig struct {
+	Enabled              bool          `json:"enabled"`                // Ejecutar las tareas en esta instancia
+	TokenRetention       time.Duration `json:"token_retention"`        // Conservación de los refresh tokens revocados
+	AuditLogRetention    time.Duration `json:"audit_log_retention"`    // Conservación de la auditoría (0 = indefinida)
+	SoftDeleteRetention  time.Duration `json:"soft_delete_retention"`  // Plazo hasta borrar definitivamente lo eliminado
+	EventCompletionDelay time.Duration `json:"event_completion_delay"` // Margen tras el fin de un evento para completarlo
+}
+
+// ModerationConfig moderación de contenidos
+type ModerationConfig struct {
+	FirstEventApproval bool `json:"first_event_approval"` // Aprobar los eventos de organizaciones que aún no han publicado ninguno
+}
+
 // Load carga la configuración desde variables de entorno
 func Load() (*Config, error) {
 	// Cargar .env si existe
@@ -181,18 +248,62 @@ func Load() (*Config, error) {
 		},
 		Upload: UploadConfig{
 			MaxSizeMB:         getEnvInt("UPLOAD_MAX_SIZE_MB", 10),
-			AllowedExtensions: getEnvStringSlice("UPLOAD_ALLOWED_EXTENSIONS", "jpg,jpeg,png,gif,pdf,doc,docx"),
+			AllowedExtensions: getEnvStringSlice("UPLOAD_ALLOWED_EXTENSIONS", "jpg,jpeg,png,gif,webp,pdf,doc,docx"),
 			Path:              getEnvString("UPLOAD_PATH", "uploads/"),
+			Backend:           getEnvString("UPLOAD_BACKEND", "local"),
+			PublicURL:         getEnvString("UPLOAD_PUBLIC_URL", ""),
+			S3: UploadS3Config{
+				Endpoint:  getEnvString("UPLOAD_S3_ENDPOINT", ""),
+				Bucket:    getEnvString("UPLOAD_S3_BUCKET", ""),
+				Region:    getEnvString("UPLOAD_S3_REGION", "us-east-1"),
+				AccessKey: getEnvString("UPLOAD_S3_ACCESS_KEY", ""),
+				SecretKey: getEnvString("UPLOAD_S3_SECRET_KEY", ""),
+				PathStyle: getEnvBool("UPLOAD_S3_PATH_STYLE", true),
+			},
+			ImageWorkers:   getEnvInt("UPLOAD_IMAGE_WORKERS", 2),
+			ImageQuality:   getEnvInt("UPLOAD_IMAGE_QUALITY", 82),
+			ImageMaxPixels: getEnvInt("UPLOAD_IMAGE_MAX_PIXELS", 40_000_000),
 		},
 		Geo: GeoConfig{
 			DefaultRadiusKM: getEnvInt("GEO_DEFAULT_RADIUS_KM", 50),
 			MaxRadiusKM:     getEnvInt("GEO_MAX_RADIUS_KM", 200),
 		},
+		Geocoding: GeocodingConfig{
+			Provider:    getEnvString("GEOCODER_PROVIDER", "none"),
+			FixturePath: getEnvString("GEOCODER_FIXTURE_PATH", ""),
+			BaseURL:     getEnvString("GEOCODER_BASE_URL", "https://nominatim.openstreetmap.org"),
+			UserAgent:   getEnvString("GEOCODER_USER_AGENT", "CybESphere-Backend"),
+			Timeout:     getEnvDuration("GEOCODER_TIMEOUT", "10s"),
+			CacheTTL:    getEnvDuration("GEOCODER_CACHE_TTL", "24h"),
+			CacheSize:   getEnvInt("GEOCODER_CACHE_SIZE", 1000),
+		},
 		RateLimit: RateLimitConfig{
 			Enabled:           getEnvBool("RATE_LIMIT_ENABLED", true),
 			RequestsPerMinute: getEnvInt("RATE_LIMIT_REQUESTS_PER_MINUTE", 100),
 			Burst:             getEnvInt("RATE_LIMIT_BURST", 20),
 		},
+		Payments: PaymentsConfig{
+			Provider:      getEnvString("PAYMENTS_PROVIDER", "none"),
+			WebhookSecret: getEnvString("PAYMENTS_WEBHOOK_SECRET", ""),
+			CheckoutURL:   getEnvString("PAYMENTS_CHECKOUT_URL", "http://localhost:8080/api/v1/public/payments/fake/checkout"),
+		},
+		Audit: AuditConfig{
+			SigningKey: getEnvString("AUDIT_SIGNING_KEY", ""),
+		},
+		Privacy: PrivacyConfig{
+			ExportRetention:    getEnvDuration("PRIVACY_EXPORT_RETENTION", "168h"),
+			ErasureGracePeriod: getEnvDuration("PRIVACY_ERASURE_GRACE_PERIOD", "720h"),
+		},
+		Jobs: JobsConfig{
+			Enabled:              getEnvBool("JOBS_ENABLED", true),
+			TokenRetention:       getEnvDuration("JOBS_TOKEN_RETENTION", "168h"),
+			AuditLogRetention:    getEnvDuration("JOBS_AUDIT_LOG_RETENTION", "17520h"),
+			SoftDeleteRetention:  getEnvDuration("JOBS_SOFT_DELETE_RETENTION", "720h"),
+			EventCompletionDelay: getEnvDuration("JOBS_EVENT_COMPLETION_DELAY", "24h"),
+		},
+		Moderation: ModerationConfig{
+			FirstEventApproval: getEnvBool("MODERATION_FIRST_EVENT_APPROVAL", false),
+		},
 	}
 
 	// Validaciones
@@ -234,6 +345,69 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("SERVER_MODE must be one of: debug, release, test")
 	}
 
+	// Validar proveedor de pagos (el secreto solo es necesario si hay proveedor)
+	if c.Payments.Provider != "none" && c.Payments.Provider != "" && c.Payments.WebhookSecret == "" {
+		return fmt.Errorf("PAYMENTS_WEBHOOK_SECRET is required when PAYMENTS_PROVIDER is set")
+	}
+
+	if c.Payments.Provider == "fake" && c.Monitoring.Environment == "production" {
+		return fmt.Errorf("PAYMENTS_PROVIDER fake cannot be used in production")
+	}
+
+	// Validar geocodificador
+	if c.Geocoding.Provider == "fixture" && c.Monitoring.Environment == "production" {
+		return fmt.Errorf("GEOCODER_PROVIDER fixture cannot be used in production")
+	}
+
+	// Validar firma de las exportaciones de auditoría
+	if c.Audit.SigningKey == "" && c.Monitoring.Environment == "production" {
+		return fmt.Errorf("AUDIT_SIGNING_KEY is required in production")
+	}
+
+	// Validar plazos de conservación
+	if c.Privacy.ExportRetention <= 0 {
+		return fmt.Errorf("PRIVACY_EXPORT_RETENTION must be positive")
+	}
+
+	if c.Privacy.ErasureGracePeriod < 0 {
+		return fmt.Errorf("PRIVACY_ERASURE_GRACE_PERIOD cannot be negative")
+	}
+
+	// Validar almacenamiento de ficheros
+	if c.Upload.MaxSizeMB <= 0 {
+		return fmt.Errorf("UPLOAD_MAX_SIZE_MB must be positive")
+	}
+
+	switch c.Upload.Backend {
+	case "local":
+		if c.Upload.Path == "" {
+			return fmt.Errorf("UPLOAD_PATH is required for the local backend")
+		}
+	case "s3":
+		if c.Upload.S3.Endpoint == "" || c.Upload.S3.Bucket == "" ||
+			c.Upload.S3.AccessKey == "" || c.Upload.S3.SecretKey == "" {
+			return fmt.Errorf("UPLOAD_S3_ENDPOINT, UPLOAD_S3_BUCKET, UPLOAD_S3_ACCESS_KEY and UPLOAD_S3_SECRET_KEY are required for the s3 backend")
+		}
+	default:
+		return fmt.Errorf("UPLOAD_BACKEND must be one of: local, s3")
+	}
+
+	if c.Upload.ImageWorkers < 0 {
+		return fmt.Errorf("UPLOAD_IMAGE_WORKERS cannot be negative")
+	}
+	if c.Upload.ImageQuality < 1 || c.Upload.ImageQuality > 100 {
+		return fmt.Errorf("UPLOAD_IMAGE_QUALITY must be between 1 and 100")
+	}
+	if c.Upload.ImageMaxPixels <= 0 {
+		return fmt.Errorf("UPLOAD_IMAGE_MAX_PIXELS must be positive")
+	}
+
+	// Validar tareas programadas
+	if c.Jobs.TokenRetention < 0 || c.Jobs.AuditLogRetention < 0 ||
+		c.Jobs.SoftDeleteRetention < 0 || c.Jobs.EventCompletionDelay < 0 {
+		return fmt.Errorf("JOBS_* durations cannot be negative")
+	}
+
 	return nil
 }
 
@@ -0,0 +1,62 @@
+package dto
+
+import "time"
+
+// CreateSpeakerRequest DTO para crear un perfil de ponente
+type CreateSpeakerRequest struct {
+	Name     string  `json:"name" binding:"required,min=2,max=200"`
+	Position string  `json:"position" binding:"max=200"`
+	Company  string  `json:"company" binding:"max=200"`
+	Bio      string  `json:"bio" binding:"max=5000"`
+	PhotoURL string  `json:"photo_url" binding:"omitempty,url,max=500"`
+	Website  string  `json:"website" binding:"omitempty,url,max=255"`
+	LinkedIn string  `json:"linkedin" binding:"omitempty,url,max=255"`
+	Twitter  string  `json:"twitter" binding:"max=255"`
+	GitHub   string  `json:"github" binding:"max=255"`
+	Email    string  `json:"email" binding:"omitempty,email,max=255"`
+	UserID   *string `json:"user_id" binding:"omitempty,uuid"` // Vincular con un usuario de la plataforma
+
+	// Admin fields (only for admin users)
+	OrganizationID string `json:"organization_id" binding:"omitempty,uuid"`
+}
+
+// UpdateSpeakerRequest DTO para actualizar un perfil de ponente
+type UpdateSpeakerRequest struct {
+	Name     *string `json:"name,omitempty" binding:"omitempty,min=2,max=200"`
+	Position *string `json:"position,omitempty" binding:"omitempty,max=200"`
+	Company  *string `json:"company,omitempty" binding:"omitempty,max=200"`
+	Bio      *string `json:"bio,omitempty" binding:"omitempty,max=5000"`
+	PhotoURL *string `json:"photo_url,omitempty" binding:"omitempty,url,max=500"`
+	Website  *string `json:"website,omitempty" binding:"omitempty,url,max=255"`
+	LinkedIn *string `json:"linkedin,omitempty" binding:"omitempty,url,max=255"`
+	Twitter  *string `json:"twitter,omitempty" binding:"omitempty,max=255"`
+	GitHub   *string `json:"github,omitempty" binding:"omitempty,max=255"`
+	Email    *string `json:"email,omitempty" binding:"omitempty,email,max=255"`
+	UserID   *string `json:"user_id,omitempty" binding:"omitempty,uuid"`
+}
+
+// CreateSessionRequest DTO para crear una sesión de agenda
+type CreateSessionRequest struct {
+	Title       string    `json:"title" binding:"required,min=3,max=300"`
+	Description string    `json:"description" binding:"max=5000"`
+	StartTime   time.Time `json:"start_time" binding:"required"`
+	EndTime     time.Time `json:"end_time" binding:"required,gtfield=StartTime"`
+	Room        string    `json:"room" binding:"max=100"`
+	Track       string    `json:"track" binding:"max=100"`
+	Level       string    `json:"level" binding:"omitempty,oneof=beginner intermediate advanced"`
+	Tags        []string  `json:"tags" binding:"max=10,dive,min=1,max=50"`
+	SpeakerIDs  []string  `json:"speaker_ids" binding:"max=20,dive,uuid"`
+}
+
+// UpdateSessionRequest DTO para actualizar una sesión de agenda
+type UpdateSessionRequest struct {
+	Title       *string    `json:"title,omitempty" binding:"omitempty,min=3,max=300"`
+	Description *string    `json:"description,omitempty" binding:"omitempty,max=5000"`
+	StartTime   *time.Time `json:"start_time,omitempty"`
+	EndTime     *time.Time `json:"end_time,omitempty"`
+	Room        *string    `json:"room,omitempty" binding:"omitempty,max=100"`
+	Track       *string    `json:"track,omitempty" binding:"omitempty,max=100"`
+	Level       *string    `json:"level,omitempty" binding:"omitempty,oneof=beginner intermediate advanced"`
+	Tags        []string   `json:"tags,omitempty" binding:"omitempty,max=10,dive,min=1,max=50"`
+	SpeakerIDs  *[]string  `json:"speaker_ids,omitempty" binding:"omitempty,max=20,dive,uuid"` // Lista vacía elimina los ponentes
+}
@@ -0,0 +1,85 @@
+package dto
+
+import (
+	"time"
+
+	"cybesphere-backend/internal/common"
+)
+
+// SpeakerResponse DTO de respuesta para un ponente
+type SpeakerResponse struct {
+	ID             string  `json:"id"`
+	OrganizationID string  `json:"organization_id"`
+	UserID         *string `json:"user_id,omitempty"`
+	Name           string  `json:"name"`
+	Position       string  `json:"position,omitempty"`
+	Company        string  `json:"company,omitempty"`
+	Bio            string  `json:"bio,omitempty"`
+	PhotoURL       string  `json:"photo_url,omitempty"`
+	Website        string  `json:"website,omitempty"`
+	LinkedIn       string  `json:"linkedin,omitempty"`
+	Twitter        string  `json:"twitter,omitempty"`
+	GitHub         string  `json:"github,omitempty"`
+	Email          string  `json:"email,omitempty"` // Solo para quien gestiona la organización
+}
+
+// SpeakerSummaryResponse DTO resumido de ponente para sesiones
+type SpeakerSummaryResponse struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Position string `json:"position,omitempty"`
+	Company  string `json:"company,omitempty"`
+	PhotoURL string `json:"photo_url,omitempty"`
+}
+
+// SpeakerDetailResponse DTO de ponente con sus sesiones
+type SpeakerDetailResponse struct {
+	SpeakerResponse
+	Sessions []EventSessionResponse `json:"sessions"`
+}
+
+// SpeakerListResponse DTO para lista de ponentes
+type SpeakerListResponse struct {
+	Speakers   []SpeakerResponse     `json:"speakers"`
+	Pagination common.PaginationMeta `json:"pagination"`
+}
+
+// EventSessionResponse DTO de respuesta para una sesión de agenda
+type EventSessionResponse struct {
+	ID          string                   `json:"id"`
+	EventID     string                   `json:"event_id"`
+	EventTitle  string                   `json:"event_title,omitempty"`
+	EventSlug   string                   `json:"event_slug,omitempty"`
+	Title       string                   `json:"title"`
+	Description string                   `json:"description,omitempty"`
+	StartTime   time.Time                `json:"start_time"`
+	EndTime     time.Time                `json:"end_time"`
+	Duration    int                      `json:"duration"` // En minutos
+	Room        string                   `json:"room,omitempty"`
+	Track       string                   `json:"track,omitempty"`
+	Level       string                   `json:"level,omitempty"`
+	Tags        []string                 `json:"tags,omitempty"`
+	Speakers    []SpeakerSummaryResponse `json:"speakers"`
+	IsFavorite  bool                     `json:"is_favorite,omitempty"`
+}
+
+// AgendaDayResponse sesiones de un día en la zona horaria solicitada
+type AgendaDayResponse struct {
+	Date     string                 `json:"date"` // YYYY-MM-DD
+	Sessions []EventSessionResponse `json:"sessions"`
+}
+
+// AgendaResponse DTO de agenda completa de un evento
+type AgendaResponse struct {
+	EventID  string              `json:"event_id"`
+	Timezone string              `json:"timezone"`
+	Tracks   []string            `json:"tracks"`
+	Rooms    []string            `json:"rooms"`
+	Days     []AgendaDayResponse `json:"days"`
+}
+
+// ScheduleResponse DTO de agenda personal del usuario
+type ScheduleResponse struct {
+	Timezone string                 `json:"timezone,omitempty"` // Vacío = zona horaria de cada evento
+	Sessions []EventSessionResponse `json:"sessions"`
+}
@@ -0,0 +1,8 @@
+package dto
+
+// AuditExportRequest tramo de la cadena de auditoría que se exporta firmado.
+// Sin límites se exportan los últimos registros
+type AuditExportRequest struct {
+	FromSequence int64 `form:"from_sequence" binding:"omitempty,min=1"`
+	ToSequence   int64 `form:"to_sequence" binding:"omitempty,min=1"`
+}
@@ -0,0 +1,25 @@
+package dto
+
+import "time"
+
+// AuditChainResponse resultado de verificar la cadena de auditoría
+type AuditChainResponse struct {
+	Valid         bool                     `json:"valid"`
+	Checked       int64                    `json:"checked"`
+	HeadSequence  int64                    `json:"head_sequence"`
+	HeadHash      string                   `json:"head_hash"`
+	Unchained     int64                    `json:"unchained"`      // Registros anteriores al encadenado
+	PrunedThrough int64                    `json:"pruned_through"` // Última posición borrada por la retención
+	Break         *AuditChainBreakResponse `json:"break,omitempty"`
+	PublicKey     string                   `json:"public_key,omitempty"` // Ed25519 en base64
+	VerifiedAt    time.Time                `json:"verified_at"`
+}
+
+// AuditChainBreakResponse primer eslabón roto de la cadena
+type AuditChainBreakResponse struct {
+	Sequence int64  `json:"sequence"`
+	LogID    string `json:"log_id,omitempty"`
+	Reason   string `json:"reason"` // sequence_gap, prev_hash_mismatch, hash_mismatch
+	Expected string `json:"expected"`
+	Actual   string `json:"actual"`
+}
@@ -0,0 +1,8 @@
+package dto
+
+// CreateCalendarFeedRequest DTO para crear un feed de calendario suscribible
+type CreateCalendarFeedRequest struct {
+	Name     string `json:"name" binding:"omitempty,max=100"`
+	Scope    string `json:"scope" binding:"required,oneof=organization tag user"`
+	TargetID string `json:"target_id" binding:"omitempty,max=100"` // ID de organización o tag; se ignora en feeds de usuario
+}
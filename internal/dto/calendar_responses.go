@@ -0,0 +1,14 @@
+package dto
+
+import "time"
+
+// CalendarFeedResponse respuesta de feed de calendario
+type CalendarFeedResponse struct {
+	ID             string     `json:"id"`
+	Name           string     `json:"name"`
+	Scope          string     `json:"scope"`
+	TargetID       string     `json:"target_id"`
+	FeedURL        string     `json:"feed_url,omitempty"` // Solo se devuelve al crear el feed
+	CreatedAt      time.Time  `json:"created_at"`
+	LastAccessedAt *time.Time `json:"last_accessed_at,omitempty"`
+}
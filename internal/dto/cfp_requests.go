@@ -0,0 +1,48 @@
+package dto
+
+import "time"
+
+// CreateSubmissionRequest DTO para enviar una propuesta al call for papers
+type CreateSubmissionRequest struct {
+	Title           string   `json:"title" binding:"required,min=5,max=300"`
+	Abstract        string   `json:"abstract" binding:"required,min=50,max=5000"`
+	Notes           string   `json:"notes" binding:"max=2000"` // Solo visibles para la organización
+	Level           string   `json:"level" binding:"omitempty,oneof=beginner intermediate advanced"`
+	Track           string   `json:"track" binding:"max=100"`
+	DurationMinutes int      `json:"duration_minutes" binding:"omitempty,min=5,max=480"`
+	Tags            []string `json:"tags" binding:"max=10,dive,min=1,max=50"`
+
+	// Datos del ponente (por defecto, los del perfil del usuario)
+	SpeakerName     string `json:"speaker_name" binding:"omitempty,min=2,max=200"`
+	SpeakerBio      string `json:"speaker_bio" binding:"max=5000"`
+	SpeakerCompany  string `json:"speaker_company" binding:"max=200"`
+	SpeakerPosition string `json:"speaker_position" binding:"max=200"`
+}
+
+// AssignReviewersRequest DTO para asignar revisores a una propuesta
+type AssignReviewersRequest struct {
+	ReviewerIDs []string `json:"reviewer_ids" binding:"required,min=1,max=10,dive,uuid"`
+}
+
+// SubmitReviewRequest DTO para puntuar una propuesta asignada
+type SubmitReviewRequest struct {
+	Score   int    `json:"score" binding:"required,min=1,max=5"`
+	Comment string `json:"comment" binding:"max=5000"`
+}
+
+// SubmissionDecisionRequest DTO para decidir sobre una propuesta
+type SubmissionDecisionRequest struct {
+	Status string `json:"status" binding:"required,oneof=accepted rejected waitlisted"`
+	Note   string `json:"note" binding:"max=2000"` // Se comunica al autor
+
+	// Sesión de agenda a crear (obligatoria al aceptar)
+	Session *SubmissionSessionRequest `json:"session"`
+}
+
+// SubmissionSessionRequest horario de la sesión generada al aceptar una propuesta
+type SubmissionSessionRequest struct {
+	StartTime time.Time `json:"start_time" binding:"required"`
+	EndTime   time.Time `json:"end_time" binding:"required,gtfield=StartTime"`
+	Room      string    `json:"room" binding:"max=100"`
+	Track     string    `json:"track" binding:"max=100"` // Por defecto, el de la propuesta
+}
@@ -0,0 +1,85 @@
+package dto
+
+import "time"
+
+// SubmissionResponse DTO de respuesta de una propuesta para su autor
+type SubmissionResponse struct {
+	ID              string     `json:"id"`
+	EventID         string     `json:"event_id"`
+	EventTitle      string     `json:"event_title,omitempty"`
+	Title           string     `json:"title"`
+	Abstract        string     `json:"abstract"`
+	Level           string     `json:"level,omitempty"`
+	Track           string     `json:"track,omitempty"`
+	DurationMinutes int        `json:"duration_minutes"`
+	Tags            []string   `json:"tags"`
+	Status          string     `json:"status"`
+	DecisionNote    string     `json:"decision_note,omitempty"`
+	DecidedAt       *time.Time `json:"decided_at,omitempty"`
+	SessionID       *string    `json:"session_id,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+}
+
+// SubmissionDetailResponse DTO de respuesta de una propuesta para la organización
+type SubmissionDetailResponse struct {
+	SubmissionResponse
+
+	// Autor y ponente
+	SubmitterID     string  `json:"submitter_id"`
+	SubmitterEmail  string  `json:"submitter_email,omitempty"`
+	SpeakerName     string  `json:"speaker_name"`
+	SpeakerBio      string  `json:"speaker_bio,omitempty"`
+	SpeakerCompany  string  `json:"speaker_company,omitempty"`
+	SpeakerPosition string  `json:"speaker_position,omitempty"`
+	SpeakerID       *string `json:"speaker_id,omitempty"`
+	Notes           string  `json:"notes,omitempty"`
+
+	// Revisión
+	AverageScore     float64                 `json:"average_score"`
+	CompletedReviews int                     `json:"completed_reviews"`
+	AssignedReviews  int                     `json:"assigned_reviews"`
+	Reviews          []ReviewSummaryResponse `json:"reviews"`
+}
+
+// ReviewSummaryResponse revisión de una propuesta vista por la organización
+type ReviewSummaryResponse struct {
+	ID           string     `json:"id"`
+	ReviewerID   string     `json:"reviewer_id"`
+	ReviewerName string     `json:"reviewer_name,omitempty"`
+	Score        *int       `json:"score"`
+	Comment      string     `json:"comment,omitempty"`
+	ReviewedAt   *time.Time `json:"reviewed_at,omitempty"`
+}
+
+// BlindSubmissionResponse propuesta anonimizada para revisión ciega
+type BlindSubmissionResponse struct {
+	ID              string   `json:"id"`
+	EventID         string   `json:"event_id"`
+	EventTitle      string   `json:"event_title,omitempty"`
+	Title           string   `json:"title"`
+	Abstract        string   `json:"abstract"`
+	Level           string   `json:"level,omitempty"`
+	Track           string   `json:"track,omitempty"`
+	DurationMinutes int      `json:"duration_minutes"`
+	Tags            []string `json:"tags"`
+	Status          string   `json:"status"`
+}
+
+// ReviewAssignmentResponse revisión asignada vista por el revisor
+type ReviewAssignmentResponse struct {
+	ID         string                  `json:"id"`
+	Submission BlindSubmissionResponse `json:"submission"`
+	Score      *int                    `json:"score"`
+	Comment    string                  `json:"comment,omitempty"`
+	ReviewedAt *time.Time              `json:"reviewed_at,omitempty"`
+	Completed  bool                    `json:"completed"`
+}
+
+// SubmissionListResponse propuestas de un evento con el resumen del CFP
+type SubmissionListResponse struct {
+	EventID     string                     `json:"event_id"`
+	CFPOpen     bool                       `json:"cfp_open"`
+	Total       int                        `json:"total"`
+	ByStatus    map[string]int             `json:"by_status"`
+	Submissions []SubmissionDetailResponse `json:"submissions"`
+}
@@ -1,6 +1,10 @@
 package dto
 
-import "time"
+import (
+	"time"
+
+	"cybesphere-backend/internal/common"
+)
 
 // AppliedFilters filtros aplicados (para mostrar al usuario)
 type AppliedFilters struct {
@@ -54,10 +58,15 @@ type BulkOperationResponse struct {
 type FileUploadResponse struct {
 	Success  bool   `json:"success"`
 	Message  string `json:"message"`
+	ID       string `json:"id"`
+	Purpose  string `json:"purpose"`
 	FileURL  string `json:"file_url"`
 	FileName string `json:"file_name"`
 	FileSize int64  `json:"file_size"`
 	MimeType string `json:"mime_type"`
+	Checksum string `json:"checksum"`
+	// pending si se van a generar versiones de la imagen en segundo plano
+	ProcessingStatus string `json:"processing_status"`
 }
 
 // HealthCheckResponse respuesta para health check
@@ -81,6 +90,9 @@ type SearchResponse struct {
 	Results interface{} `json:"results"`
 	Total   int         `json:"total"`
 	Took    int64       `json:"took_ms"` // tiempo de búsqueda en ms
+
+	Facets      interface{} `json:"facets,omitempty"`
+	Suggestions []string    `json:"suggestions,omitempty"` // alternativas cuando hay pocos resultados
 }
 
 // StatsResponse respuesta de estadísticas
@@ -131,6 +143,13 @@ type NotificationResponse struct {
 	Data      map[string]interface{} `json:"data,omitempty"`
 }
 
+// NotificationListResponse notificaciones paginadas del usuario
+type NotificationListResponse struct {
+	Notifications []NotificationResponse `json:"notifications"`
+	Unread        int64                  `json:"unread"`
+	Pagination    common.PaginationMeta  `json:"pagination"`
+}
+
 // WebSocketMessage mensaje para WebSocket
 type WebSocketMessage struct {
 	Type     string                 `json:"type"`
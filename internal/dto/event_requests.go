@@ -49,6 +49,8 @@ type CreateEventRequest struct {
 	// Fechas importantes
 	RegistrationStartDate *time.Time `json:"registration_start_date"`
 	RegistrationEndDate   *time.Time `json:"registration_end_date" binding:"omitempty,gtfield=RegistrationStartDate"`
+	CFPStartDate          *time.Time `json:"cfp_start_date"`
+	CFPEndDate            *time.Time `json:"cfp_end_date" binding:"omitempty,gtfield=CFPStartDate"`
 
 	// Información de contacto
 	ContactEmail string `json:"contact_email" binding:"omitempty,email,max=255"`
@@ -92,6 +94,9 @@ type UpdateEventRequest struct {
 	OnlineURL    *string  `json:"online_url,omitempty" binding:"omitempty,url,max=500"`
 	StreamingURL *string  `json:"streaming_url,omitempty" binding:"omitempty,url,max=500"`
 
+	// ResetLocation descarta las coordenadas manuales y las vuelve a calcular a partir de la dirección
+	ResetLocation bool `json:"reset_location,omitempty"`
+
 	// Capacidad y registro
 	MaxAttendees    *int    `json:"max_attendees,omitempty" binding:"omitempty,min=1"`
 	IsFree          *bool   `json:"is_free,omitempty"`
@@ -109,6 +114,8 @@ type UpdateEventRequest struct {
 	// Fechas importantes
 	RegistrationStartDate *time.Time `json:"registration_start_date,omitempty"`
 	RegistrationEndDate   *time.Time `json:"registration_end_date,omitempty"`
+	CFPStartDate          *time.Time `json:"cfp_start_date,omitempty"`
+	CFPEndDate            *time.Time `json:"cfp_end_date,omitempty"`
 
 	// Información de contacto
 	ContactEmail *string `json:"contact_email,omitempty" binding:"omitempty,email,max=255"`
@@ -119,7 +126,7 @@ type UpdateEventRequest struct {
 	MetaDescription *string `json:"meta_description,omitempty" binding:"omitempty,max=500"`
 
 	// Admin only fields
-	Status     *string `json:"status,omitempty" binding:"omitempty,oneof=draft published canceled completed"`
+	Status     *string `json:"status,omitempty" binding:"omitempty,oneof=draft pending_review published canceled completed"`
 	IsPublic   *bool   `json:"is_public,omitempty"`
 	IsFeatured *bool   `json:"is_featured,omitempty"`
 }
@@ -135,6 +142,11 @@ type CancelEventRequest struct {
 	SendNotifications bool   `json:"send_notifications"`
 }
 
+// SchedulePublicationRequest DTO para programar la publicación de un borrador
+type SchedulePublicationRequest struct {
+	PublishAt time.Time `json:"publish_at" binding:"required"`
+}
+
 // EventFilterRequest DTO para filtrar eventos
 type EventFilterRequest struct {
 	// Filtros básicos
@@ -162,7 +174,7 @@ type EventFilterRequest struct {
 	EndDateTo     *time.Time `form:"end_date_to" time_format:"2006-01-02"`
 
 	// Filtros de estado (admin only)
-	Status     string `form:"status" binding:"omitempty,oneof=draft published canceled completed"`
+	Status     string `form:"status" binding:"omitempty,oneof=draft pending_review published canceled completed"`
 	IsPublic   *bool  `form:"is_public"`
 	IsFeatured *bool  `form:"is_featured"`
 
@@ -194,3 +206,11 @@ type EventAttendeesFilterRequest struct {
 	Page     int    `form:"page" binding:"min=1"`
 	Limit    int    `form:"limit" binding:"min=1,max=100"`
 }
+
+// NearbyEventsRequest parámetros de búsqueda por cercanía; sin lat/lng se usa
+// la ubicación del perfil del usuario autenticado
+type NearbyEventsRequest struct {
+	Latitude  *float64 `form:"lat" binding:"omitempty,min=-90,max=90"`
+	Longitude *float64 `form:"lng" binding:"omitempty,min=-180,max=180"`
+	RadiusKm  int      `form:"radius_km" binding:"omitempty,min=1"`
+}
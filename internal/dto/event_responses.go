@@ -37,6 +37,8 @@ type EventResponse struct {
 	Longitude    *float64 `json:"longitude,omitempty"`
 	OnlineURL    string   `json:"online_url,omitempty"`
 	StreamingURL string   `json:"streaming_url,omitempty"`
+	// Origen de las coordenadas: manual o geocoded
+	CoordinatesSource string `json:"coordinates_source,omitempty"`
 
 	// Capacidad y registro
 	MaxAttendees     *int   `json:"max_attendees"`
@@ -53,22 +55,36 @@ type EventResponse struct {
 	IsFeatured bool   `json:"is_featured"`
 	ViewsCount int    `json:"views_count"`
 
-	// Contenido y recursos
-	ImageURL  string   `json:"image_url,omitempty"`
-	BannerURL string   `json:"banner_url,omitempty"`
-	Tags      []string `json:"tags,omitempty"`
+	// Contenido y recursos. Las versiones (thumbnail, card, banner) aparecen
+	// cuando la imagen se subió a la plataforma y ya está procesada
+	ImageURL       string            `json:"image_url,omitempty"`
+	ImageVariants  map[string]string `json:"image_variants,omitempty"`
+	BannerURL      string            `json:"banner_url,omitempty"`
+	BannerVariants map[string]string `json:"banner_variants,omitempty"`
+	Tags           []string          `json:"tags,omitempty"`
 
 	// Información de registro
 	RegistrationOpen      bool       `json:"registration_open"`
 	RegistrationStartDate *time.Time `json:"registration_start_date,omitempty"`
 	RegistrationEndDate   *time.Time `json:"registration_end_date,omitempty"`
 
+	// Call for papers
+	CFPOpen      bool       `json:"cfp_open"`
+	CFPStartDate *time.Time `json:"cfp_start_date,omitempty"`
+	CFPEndDate   *time.Time `json:"cfp_end_date,omitempty"`
+
 	// Metadatos
 	MetaTitle       string `json:"meta_title,omitempty"`
 	MetaDescription string `json:"meta_description,omitempty"`
 
-	// Organización
-	Organization OrganizationSummaryResponse `json:"organization"`
+	// Relaciones; solo se incluyen si están cargadas (?expand=organization,speakers)
+	Organization *OrganizationSummaryResponse `json:"organization,omitempty"`
+	Speakers     []SpeakerSummaryResponse     `json:"speakers,omitempty"`
+
+	// Serie recurrente
+	SeriesID          *string    `json:"series_id,omitempty"`
+	OccurrenceDate    *time.Time `json:"occurrence_date,omitempty"`
+	IsSeriesException bool       `json:"is_series_exception,omitempty"`
 
 	// Estados computados
 	IsUpcoming bool `json:"is_upcoming"`
@@ -82,11 +98,17 @@ type EventResponse struct {
 	CanManage    bool `json:"can_manage,omitempty"`
 
 	// Timestamps
-	CreatedAt   time.Time  `json:"created_at"`
-	UpdatedAt   time.Time  `json:"updated_at"`
-	PublishedAt *time.Time `json:"published_at,omitempty"`
-	CanceledAt  *time.Time `json:"canceled_at,omitempty"`
-	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	CreatedAt            time.Time  `json:"created_at"`
+	UpdatedAt            time.Time  `json:"updated_at"`
+	PublishedAt          *time.Time `json:"published_at,omitempty"`
+	ScheduledPublishAt   *time.Time `json:"scheduled_publish_at,omitempty"`
+	RegistrationClosedAt *time.Time `json:"registration_closed_at,omitempty"`
+	CanceledAt           *time.Time `json:"canceled_at,omitempty"`
+	CompletedAt          *time.Time `json:"completed_at,omitempty"`
+
+	// Moderación
+	HiddenAt     *time.Time `json:"hidden_at,omitempty"`
+	HiddenReason string     `json:"hidden_reason,omitempty"`
 }
 
 // EventDetailResponse DTO de respuesta detallada para un evento (incluye campos adicionales)
@@ -108,27 +130,30 @@ type EventListResponse struct {
 	Events     []EventResponse       `json:"events"`
 	Pagination common.PaginationMeta `json:"pagination"`
 	Filters    AppliedFilters        `json:"filters,omitempty"`
+
+	Facets map[string][]FacetValueResponse `json:"facets,omitempty"`
 }
 
 // EventSummaryResponse DTO resumido para listados
 type EventSummaryResponse struct {
-	ID               string    `json:"id"`
-	Slug             string    `json:"slug"`
-	Title            string    `json:"title"`
-	ShortDesc        string    `json:"short_desc"`
-	Type             string    `json:"type"`
-	StartDate        time.Time `json:"start_date"`
-	EndDate          time.Time `json:"end_date"`
-	IsOnline         bool      `json:"is_online"`
-	VenueCity        string    `json:"venue_city,omitempty"`
-	IsFree           bool      `json:"is_free"`
-	Price            *int      `json:"price,omitempty"`
-	ImageURL         string    `json:"image_url,omitempty"`
-	CurrentAttendees int       `json:"current_attendees"`
-	MaxAttendees     *int      `json:"max_attendees"`
-	IsFeatured       bool      `json:"is_featured"`
-	Organization     string    `json:"organization_name"`
-	Tags             []string  `json:"tags,omitempty"`
+	ID               string            `json:"id"`
+	Slug             string            `json:"slug"`
+	Title            string            `json:"title"`
+	ShortDesc        string            `json:"short_desc"`
+	Type             string            `json:"type"`
+	StartDate        time.Time         `json:"start_date"`
+	EndDate          time.Time         `json:"end_date"`
+	IsOnline         bool              `json:"is_online"`
+	VenueCity        string            `json:"venue_city,omitempty"`
+	IsFree           bool              `json:"is_free"`
+	Price            *int              `json:"price,omitempty"`
+	ImageURL         string            `json:"image_url,omitempty"`
+	ImageVariants    map[string]string `json:"image_variants,omitempty"`
+	CurrentAttendees int               `json:"current_attendees"`
+	MaxAttendees     *int              `json:"max_attendees"`
+	IsFeatured       bool              `json:"is_featured"`
+	Organization     string            `json:"organization_name"`
+	Tags             []string          `json:"tags,omitempty"`
 }
 
 // EventStatistics estadísticas del evento (para organizadores)
@@ -168,6 +193,8 @@ type EventAttendeeResponse struct {
 	Name             string     `json:"name"`
 	Email            string     `json:"email"`
 	Status           string     `json:"status"` // confirmed, pending, canceled
+	TicketType       string     `json:"ticket_type,omitempty"`
+	TotalCents       int        `json:"total_cents"`
 	RegistrationDate time.Time  `json:"registration_date"`
 	AttendedAt       *time.Time `json:"attended_at,omitempty"`
 	CheckedInBy      string     `json:"checked_in_by,omitempty"`
@@ -212,11 +239,13 @@ type UpcomingEventsResponse struct {
 
 // NearbyEventsResponse eventos cercanos
 type NearbyEventsResponse struct {
-	CenterLatitude  float64             `json:"center_latitude"`
-	CenterLongitude float64             `json:"center_longitude"`
-	RadiusKm        int                 `json:"radius_km"`
-	Events          []EventWithDistance `json:"events"`
-	TotalFound      int                 `json:"total_found"`
+	CenterLatitude  float64               `json:"center_latitude"`
+	CenterLongitude float64               `json:"center_longitude"`
+	RadiusKm        int                   `json:"radius_km"`
+	FromProfile     bool                  `json:"from_profile"` // Centro tomado de la ubicación del usuario
+	Events          []EventWithDistance   `json:"events"`
+	TotalFound      int                   `json:"total_found"`
+	Pagination      common.PaginationMeta `json:"pagination"`
 }
 
 // EventWithDistance evento con distancia
@@ -0,0 +1,18 @@
+package dto
+
+// CreateEventSeriesRequest DTO para crear una serie de eventos recurrentes
+type CreateEventSeriesRequest struct {
+	RRule string             `json:"rrule" binding:"required,max=500"` // Regla RFC 5545, ej: FREQ=MONTHLY;BYDAY=2TH
+	Event CreateEventRequest `json:"event" binding:"required"`         // Datos de la primera ocurrencia
+}
+
+// UpdateOccurrenceRequest DTO para modificar una ocurrencia de una serie
+type UpdateOccurrenceRequest struct {
+	Scope   string             `json:"scope" binding:"required,oneof=this following all"`
+	Changes UpdateEventRequest `json:"changes" binding:"required"`
+}
+
+// CancelOccurrenceRequest DTO para cancelar ocurrencias de una serie
+type CancelOccurrenceRequest struct {
+	Scope string `json:"scope" binding:"required,oneof=this following all"`
+}
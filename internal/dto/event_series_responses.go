@@ -0,0 +1,20 @@
+package dto
+
+import "time"
+
+// EventSeriesResponse respuesta de serie de eventos recurrentes
+type EventSeriesResponse struct {
+	ID              string                 `json:"id"`
+	OrganizationID  string                 `json:"organization_id"`
+	Title           string                 `json:"title"`
+	RRule           string                 `json:"rrule"`
+	Timezone        string                 `json:"timezone"`
+	StartDate       time.Time              `json:"start_date"`
+	DurationMinutes int                    `json:"duration_minutes"`
+	Status          string                 `json:"status"`
+	ExDates         []time.Time            `json:"ex_dates,omitempty"`
+	GeneratedUntil  *time.Time             `json:"generated_until,omitempty"`
+	Occurrences     []EventSummaryResponse `json:"occurrences"`
+	CreatedAt       time.Time              `json:"created_at"`
+	UpdatedAt       time.Time              `json:"updated_at"`
+}
@@ -0,0 +1,23 @@
+package dto
+
+import "time"
+
+// ScheduledJobResponse tarea programada con su última ejecución
+type ScheduledJobResponse struct {
+	Name           string     `json:"name"`
+	Schedule       string     `json:"schedule"` // Expresión cron o atajo (@daily, @every 10m)
+	NextRunAt      *time.Time `json:"next_run_at"`
+	LastStartedAt  *time.Time `json:"last_started_at"`
+	LastFinishedAt *time.Time `json:"last_finished_at"`
+	LastOutcome    string     `json:"last_outcome,omitempty"` // running, success, failed
+	LastResult     string     `json:"last_result,omitempty"`
+	LastError      string     `json:"last_error,omitempty"`
+	LastDurationMs int64      `json:"last_duration_ms"`
+	RunCount       int64      `json:"run_count"`
+	FailureCount   int64      `json:"failure_count"`
+}
+
+// ScheduledJobListResponse listado de tareas programadas
+type ScheduledJobListResponse struct {
+	Jobs []ScheduledJobResponse `json:"jobs"`
+}
@@ -0,0 +1,21 @@
+package dto
+
+// UploadMediaRequest campos del formulario multipart de subida; el fichero va
+// en el campo "file"
+type UploadMediaRequest struct {
+	Purpose        string `form:"purpose" binding:"required,oneof=event_image event_banner organization_logo organization_banner verification_document"`
+	OrganizationID string `form:"organization_id" binding:"required,uuid"`
+}
+
+// MediaQuery filtros del listado de ficheros. Sin organización se listan los
+// ficheros subidos por el usuario
+type MediaQuery struct {
+	Purpose        string `form:"purpose" binding:"omitempty,oneof=event_image event_banner organization_logo organization_banner verification_document"`
+	OrganizationID string `form:"organization_id" binding:"omitempty,uuid"`
+}
+
+// AttachMediaRequest asignación de un fichero subido a un campo de un evento
+// u organización
+type AttachMediaRequest struct {
+	MediaID string `json:"media_id" binding:"required,uuid"`
+}
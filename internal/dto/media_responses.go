@@ -0,0 +1,54 @@
+package dto
+
+import (
+	"time"
+
+	"cybesphere-backend/internal/common"
+)
+
+// MediaReferenceResponse recurso que usa un fichero
+type MediaReferenceResponse struct {
+	ResourceType string `json:"resource_type"` // event, organization, organization_verification
+	ResourceID   string `json:"resource_id"`
+	Field        string `json:"field"` // image, banner, logo, document
+}
+
+// MediaVariantResponse versión redimensionada de una imagen
+type MediaVariantResponse struct {
+	URL         string `json:"url"`
+	Width       int    `json:"width"`
+	Height      int    `json:"height"`
+	ContentType string `json:"content_type"`
+	Size        int64  `json:"size"`
+}
+
+// MediaResponse fichero subido
+type MediaResponse struct {
+	ID             string                   `json:"id"`
+	OwnerID        string                   `json:"owner_id"`
+	OrganizationID string                   `json:"organization_id"`
+	Purpose        string                   `json:"purpose"`
+	FileName       string                   `json:"file_name"`
+	ContentType    string                   `json:"content_type"`
+	Size           int64                    `json:"size"`
+	Checksum       string                   `json:"checksum"`
+	URL            string                   `json:"url"`
+	IsPublic       bool                     `json:"is_public"`
+	References     []MediaReferenceResponse `json:"references"`
+	CreatedAt      time.Time                `json:"created_at"`
+
+	// Procesado de imágenes: pending, processing, ready, failed o skipped
+	ProcessingStatus string                          `json:"processing_status"`
+	ProcessingError  string                          `json:"processing_error,omitempty"`
+	ProcessedAt      *time.Time                      `json:"processed_at,omitempty"`
+	Width            int                             `json:"width,omitempty"`
+	Height           int                             `json:"height,omitempty"`
+	DominantColor    string                          `json:"dominant_color,omitempty"`
+	Variants         map[string]MediaVariantResponse `json:"variants,omitempty"` // thumbnail, card, banner
+}
+
+// MediaListResponse ficheros con paginación
+type MediaListResponse struct {
+	Media      []MediaResponse       `json:"media"`
+	Pagination common.PaginationMeta `json:"pagination"`
+}
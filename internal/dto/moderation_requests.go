@@ -0,0 +1,35 @@
+package dto
+
+// ReportContentRequest denuncia de un evento o una organización
+type ReportContentRequest struct {
+	Reason  string `json:"reason" binding:"required,oneof=spam inappropriate misleading fraud copyright other"`
+	Details string `json:"details" binding:"omitempty,max=2000"` // Obligatorio con el motivo other
+}
+
+// ContentReportsQuery filtro de la cola de moderación (por defecto, abiertas y en revisión)
+type ContentReportsQuery struct {
+	Status       string `form:"status" binding:"omitempty,oneof=open in_review actioned dismissed"`
+	ResourceType string `form:"resource_type" binding:"omitempty,oneof=event organization"`
+	Reason       string `form:"reason" binding:"omitempty,oneof=spam inappropriate misleading fraud copyright other"`
+}
+
+// ModerationNoteRequest nota interna de un moderador sobre una denuncia
+type ModerationNoteRequest struct {
+	Note string `json:"note" binding:"required,max=2000"`
+}
+
+// ResolveReportRequest resolución de una denuncia con una acción sobre el contenido
+type ResolveReportRequest struct {
+	Action  string `json:"action" binding:"required,oneof=hide warn"`
+	Message string `json:"message" binding:"required,max=1000"` // Se envía al organizador
+}
+
+// DismissReportRequest descarte de una denuncia
+type DismissReportRequest struct {
+	Reason string `json:"reason" binding:"omitempty,max=1000"`
+}
+
+// RejectEventRequest rechazo de un evento pendiente de aprobación
+type RejectEventRequest struct {
+	Reason string `json:"reason" binding:"required,max=1000"` // Se envía al organizador
+}
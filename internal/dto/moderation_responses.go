@@ -0,0 +1,42 @@
+package dto
+
+import (
+	"time"
+
+	"cybesphere-backend/internal/common"
+)
+
+// ContentReportResponse denuncia de contenido. El autor, el moderador
+// asignado y las notas solo se incluyen en la cola de moderación
+type ContentReportResponse struct {
+	ID           string                   `json:"id"`
+	ResourceType string                   `json:"resource_type"` // event, organization
+	ResourceID   string                   `json:"resource_id"`
+	Reason       string                   `json:"reason"`
+	Details      string                   `json:"details,omitempty"`
+	Status       string                   `json:"status"`           // open, in_review, actioned, dismissed
+	Action       string                   `json:"action,omitempty"` // hide, warn
+	Resolution   string                   `json:"resolution,omitempty"`
+	ResolvedAt   *time.Time               `json:"resolved_at,omitempty"`
+	ReporterID   string                   `json:"reporter_id,omitempty"`
+	Reporter     *UserSummaryResponse     `json:"reporter,omitempty"`
+	AssignedTo   *string                  `json:"assigned_to,omitempty"`
+	ResolvedBy   *string                  `json:"resolved_by,omitempty"`
+	Notes        []ModerationNoteResponse `json:"notes,omitempty"`
+	CreatedAt    time.Time                `json:"created_at"`
+}
+
+// ModerationNoteResponse nota de moderación
+type ModerationNoteResponse struct {
+	ID        string               `json:"id"`
+	AuthorID  string               `json:"author_id"`
+	Author    *UserSummaryResponse `json:"author,omitempty"`
+	Note      string               `json:"note"`
+	CreatedAt time.Time            `json:"created_at"`
+}
+
+// ContentReportListResponse denuncias con paginación
+type ContentReportListResponse struct {
+	Reports    []ContentReportResponse `json:"reports"`
+	Pagination common.PaginationMeta   `json:"pagination"`
+}
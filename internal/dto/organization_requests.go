@@ -37,8 +37,11 @@ type CreateOrganizationRequest struct {
 	LegalName        string `json:"legal_name" binding:"max=300"`
 	RegistrationDocs string `json:"registration_docs" binding:"omitempty,url,max=500"`
 
+	// Facturación
+	VATRate *float64 `json:"vat_rate" binding:"omitempty,gte=0,lte=100"` // IVA en % (vacío = tipo general)
+
 	// Admin only
-	Status          string `json:"status" binding:"omitempty,oneof=pending active suspended inactive"`
+	Status          string `json:"status" binding:"omitempty,oneof=pending active suspended inactive rejected"`
 	IsVerified      *bool  `json:"is_verified"`
 	MaxEvents       *int   `json:"max_events" binding:"omitempty,min=0"`
 	CanCreateEvents *bool  `json:"can_create_events"`
@@ -62,6 +65,8 @@ type UpdateOrganizationRequest struct {
 	// Geolocalización
 	Latitude  *float64 `json:"latitude,omitempty" binding:"omitempty,min=-90,max=90"`
 	Longitude *float64 `json:"longitude,omitempty" binding:"omitempty,min=-180,max=180"`
+	// ResetLocation descarta las coordenadas manuales y las vuelve a calcular a partir de la dirección
+	ResetLocation bool `json:"reset_location,omitempty"`
 
 	// Branding y medios
 	LogoURL        *string `json:"logo_url,omitempty" binding:"omitempty,url,max=500"`
@@ -76,8 +81,13 @@ type UpdateOrganizationRequest struct {
 	Instagram *string `json:"instagram,omitempty" binding:"omitempty,url,max=255"`
 	YouTube   *string `json:"youtube,omitempty" binding:"omitempty,url,max=255"`
 
+	// Datos fiscales y facturación
+	TaxID     *string  `json:"tax_id,omitempty" binding:"omitempty,max=50"`
+	LegalName *string  `json:"legal_name,omitempty" binding:"omitempty,max=300"`
+	VATRate   *float64 `json:"vat_rate,omitempty" binding:"omitempty,gte=0,lte=100"`
+
 	// Admin only
-	Status          *string `json:"status,omitempty" binding:"omitempty,oneof=pending active suspended inactive"`
+	Status          *string `json:"status,omitempty" binding:"omitempty,oneof=pending active suspended inactive rejected"`
 	IsVerified      *bool   `json:"is_verified,omitempty"`
 	MaxEvents       *int    `json:"max_events,omitempty" binding:"omitempty,min=0"`
 	CanCreateEvents *bool   `json:"can_create_events,omitempty"`
@@ -91,7 +101,7 @@ type VerifyOrganizationRequest struct {
 // OrganizationFilterRequest DTO para filtrar organizaciones
 type OrganizationFilterRequest struct {
 	// Filtros básicos
-	Status     string `form:"status" binding:"omitempty,oneof=pending active suspended inactive"`
+	Status     string `form:"status" binding:"omitempty,oneof=pending active suspended inactive rejected"`
 	IsVerified *bool  `form:"is_verified"`
 
 	// Filtros de ubicación
@@ -27,12 +27,18 @@ type OrganizationResponse struct {
 	// Geolocalización
 	Latitude  *float64 `json:"latitude,omitempty"`
 	Longitude *float64 `json:"longitude,omitempty"`
+	// Origen de las coordenadas: manual o geocoded
+	CoordinatesSource string `json:"coordinates_source,omitempty"`
 
 	// Branding y medios
-	LogoURL        string `json:"logo_url,omitempty"`
-	BannerURL      string `json:"banner_url,omitempty"`
-	PrimaryColor   string `json:"primary_color,omitempty"`
-	SecondaryColor string `json:"secondary_color,omitempty"`
+	LogoURL        string            `json:"logo_url,omitempty"`
+	LogoVariants   map[string]string `json:"logo_variants,omitempty"`
+	BannerURL      string            `json:"banner_url,omitempty"`
+	BannerVariants map[string]string `json:"banner_variants,omitempty"`
+	PrimaryColor   string            `json:"primary_color,omitempty"`
+	SecondaryColor string            `json:"secondary_color,omitempty"`
+	// Color dominante del logo, como sugerencia si difiere del principal
+	SuggestedPrimaryColor string `json:"suggested_primary_color,omitempty"`
 
 	// Redes sociales
 	SocialMedia *SocialMediaLinks `json:"social_media,omitempty"`
@@ -71,6 +77,9 @@ type OrganizationDetailResponse struct {
 	RegistrationDocs string `json:"registration_docs,omitempty"`
 	VerifiedBy       string `json:"verified_by,omitempty"`
 
+	// IVA aplicado en las facturas en % (para miembros)
+	VATRate *float64 `json:"vat_rate,omitempty"`
+
 	// Estadísticas detalladas (para miembros)
 	Statistics *OrganizationStatistics `json:"statistics,omitempty"`
 
@@ -83,14 +92,15 @@ type OrganizationDetailResponse struct {
 
 // OrganizationSummaryResponse DTO resumido para listados
 type OrganizationSummaryResponse struct {
-	ID          string `json:"id"`
-	Slug        string `json:"slug"`
-	Name        string `json:"name"`
-	LogoURL     string `json:"logo_url,omitempty"`
-	IsVerified  bool   `json:"is_verified"`
-	EventsCount int    `json:"events_count"`
-	City        string `json:"city,omitempty"`
-	Country     string `json:"country,omitempty"`
+	ID           string            `json:"id"`
+	Slug         string            `json:"slug"`
+	Name         string            `json:"name"`
+	LogoURL      string            `json:"logo_url,omitempty"`
+	LogoVariants map[string]string `json:"logo_variants,omitempty"`
+	IsVerified   bool              `json:"is_verified"`
+	EventsCount  int               `json:"events_count"`
+	City         string            `json:"city,omitempty"`
+	Country      string            `json:"country,omitempty"`
 }
 
 // OrganizationListResponse DTO para lista de organizaciones
@@ -0,0 +1,12 @@
+package dto
+
+// AccountErasureRequest solicitud de supresión de la propia cuenta; se confirma con la contraseña
+type AccountErasureRequest struct {
+	Password string `json:"password" binding:"required"`
+	Reason   string `json:"reason" binding:"omitempty,max=1000"`
+}
+
+// ErasureRequestsQuery filtro del listado de solicitudes de supresión (por defecto, pendientes)
+type ErasureRequestsQuery struct {
+	Status string `form:"status" binding:"omitempty,oneof=pending completed canceled"`
+}
@@ -0,0 +1,45 @@
+package dto
+
+import (
+	"time"
+
+	"cybesphere-backend/internal/common"
+)
+
+// DataExportResponse exportación de datos personales
+type DataExportResponse struct {
+	ID            string     `json:"id"`
+	Status        string     `json:"status"` // pending, processing, ready, failed, expired
+	Downloadable  bool       `json:"downloadable"`
+	SizeBytes     int64      `json:"size_bytes,omitempty"`
+	Checksum      string     `json:"checksum,omitempty"` // SHA-256 del archivo
+	FailureReason string     `json:"failure_reason,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+	CompletedAt   *time.Time `json:"completed_at,omitempty"`
+	ExpiresAt     *time.Time `json:"expires_at,omitempty"`
+}
+
+// DataExportListResponse exportaciones del usuario
+type DataExportListResponse struct {
+	Exports []DataExportResponse `json:"exports"`
+}
+
+// ErasureRequestResponse solicitud de supresión de cuenta
+type ErasureRequestResponse struct {
+	ID           string               `json:"id"`
+	UserID       string               `json:"user_id"`
+	User         *UserSummaryResponse `json:"user,omitempty"` // Solo en el listado de administración
+	Status       string               `json:"status"`         // pending, completed, canceled
+	Reason       string               `json:"reason,omitempty"`
+	ScheduledFor time.Time            `json:"scheduled_for"`
+	CompletedAt  *time.Time           `json:"completed_at,omitempty"`
+	CanceledAt   *time.Time           `json:"canceled_at,omitempty"`
+	ProcessedBy  string               `json:"processed_by,omitempty"`
+	CreatedAt    time.Time            `json:"created_at"`
+}
+
+// ErasureRequestListResponse solicitudes de supresión con paginación
+type ErasureRequestListResponse struct {
+	Requests   []ErasureRequestResponse `json:"requests"`
+	Pagination common.PaginationMeta    `json:"pagination"`
+}
@@ -0,0 +1,26 @@
+package dto
+
+import "cybesphere-backend/internal/common"
+
+// RecommendationsResponse eventos recomendados para el usuario
+type RecommendationsResponse struct {
+	Recommendations []RecommendedEventResponse `json:"recommendations"`
+	Pagination      common.PaginationMeta      `json:"pagination"`
+}
+
+// RecommendedEventResponse evento recomendado con su afinidad y los motivos
+type RecommendedEventResponse struct {
+	EventSummaryResponse
+	Score      float64                        `json:"score"`                 // Entre 0 y 1
+	DistanceKm *float64                       `json:"distance_km,omitempty"` // Solo si el usuario tiene ubicación
+	Reasons    []RecommendationReasonResponse `json:"reasons"`
+}
+
+// RecommendationReasonResponse motivo de una recomendación
+type RecommendationReasonResponse struct {
+	Type           string   `json:"type"` // similar_to_favorite, category, level, nearby, followed_organization
+	Message        string   `json:"message"`
+	EventID        string   `json:"event_id,omitempty"`
+	OrganizationID string   `json:"organization_id,omitempty"`
+	Tags           []string `json:"tags,omitempty"`
+}
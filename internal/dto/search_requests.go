@@ -0,0 +1,16 @@
+package dto
+
+// SearchRequest parámetros de la búsqueda full-text pública
+type SearchRequest struct {
+	Query string `form:"q" binding:"required,min=2,max=200"`
+	Scope string `form:"scope" binding:"omitempty,oneof=all events organizations tags"`
+
+	// Filtros sobre eventos
+	Type  string `form:"type" binding:"omitempty,oneof=conference workshop meetup webinar training competition other"`
+	Level string `form:"level" binding:"omitempty,oneof=beginner intermediate advanced"`
+	City  string `form:"city" binding:"omitempty,max=100"`
+	Tag   string `form:"tag" binding:"omitempty,max=50"`
+
+	Page  int `form:"page" binding:"omitempty,min=1"`
+	Limit int `form:"limit" binding:"omitempty,min=1,max=50"`
+}
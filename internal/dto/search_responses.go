@@ -0,0 +1,41 @@
+package dto
+
+import "cybesphere-backend/internal/common"
+
+// SearchResultsResponse resultados de la búsqueda agrupados por tipo
+type SearchResultsResponse struct {
+	Events        []EventSearchResultResponse        `json:"events"`
+	Organizations []OrganizationSearchResultResponse `json:"organizations"`
+	Tags          []FacetValueResponse               `json:"tags"`
+	Pagination    common.PaginationMeta              `json:"pagination"`
+}
+
+// EventSearchResultResponse evento encontrado con fragmentos resaltados (<mark>)
+type EventSearchResultResponse struct {
+	EventSummaryResponse
+	Score          float64 `json:"score"`
+	TitleHighlight string  `json:"title_highlight"`
+	Snippet        string  `json:"snippet,omitempty"`
+}
+
+// OrganizationSearchResultResponse organización encontrada con fragmentos resaltados (<mark>)
+type OrganizationSearchResultResponse struct {
+	OrganizationSummaryResponse
+	Score         float64 `json:"score"`
+	NameHighlight string  `json:"name_highlight"`
+	Snippet       string  `json:"snippet,omitempty"`
+}
+
+// FacetValueResponse número de resultados para un valor
+type FacetValueResponse struct {
+	Value string `json:"value"`
+	Count int64  `json:"count"`
+}
+
+// SearchFacetsResponse recuentos de los eventos encontrados por dimensión
+type SearchFacetsResponse struct {
+	Types  []FacetValueResponse `json:"types"`
+	Levels []FacetValueResponse `json:"levels"`
+	Cities []FacetValueResponse `json:"cities"`
+	Tags   []FacetValueResponse `json:"tags"`
+}
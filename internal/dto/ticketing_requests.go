@@ -0,0 +1,75 @@
+package dto
+
+import "time"
+
+// CreateTicketTypeRequest DTO para crear un tipo de entrada
+// (la moneda es siempre la del evento)
+type CreateTicketTypeRequest struct {
+	Name           string     `json:"name" binding:"required,min=2,max=100"`
+	Description    string     `json:"description" binding:"max=2000"`
+	PriceCents     int        `json:"price_cents" binding:"min=0"`
+	Quantity       *int       `json:"quantity" binding:"omitempty,min=1"` // null = ilimitado
+	SalesStartDate *time.Time `json:"sales_start_date"`
+	SalesEndDate   *time.Time `json:"sales_end_date" binding:"omitempty,gtfield=SalesStartDate"`
+	IsActive       *bool      `json:"is_active"`
+	SortOrder      int        `json:"sort_order"`
+}
+
+// UpdateTicketTypeRequest DTO para actualizar un tipo de entrada
+type UpdateTicketTypeRequest struct {
+	Name           *string    `json:"name,omitempty" binding:"omitempty,min=2,max=100"`
+	Description    *string    `json:"description,omitempty" binding:"omitempty,max=2000"`
+	PriceCents     *int       `json:"price_cents,omitempty" binding:"omitempty,min=0"`
+	Quantity       *int       `json:"quantity,omitempty" binding:"omitempty,min=1"`
+	Unlimited      bool       `json:"unlimited,omitempty"` // Elimina el cupo
+	SalesStartDate *time.Time `json:"sales_start_date,omitempty"`
+	SalesEndDate   *time.Time `json:"sales_end_date,omitempty"`
+	IsActive       *bool      `json:"is_active,omitempty"`
+	SortOrder      *int       `json:"sort_order,omitempty"`
+}
+
+// CreatePromoCodeRequest DTO para crear un código de descuento.
+// Según el tipo se indica percent_off (admite dos decimales) o amount_off_cents.
+type CreatePromoCodeRequest struct {
+	Code           string     `json:"code" binding:"required,min=3,max=50"`
+	DiscountType   string     `json:"discount_type" binding:"required,oneof=percentage fixed"`
+	PercentOff     *float64   `json:"percent_off" binding:"omitempty,gt=0,lte=100"`
+	AmountOffCents *int       `json:"amount_off_cents" binding:"omitempty,min=1"`
+	MaxUses        *int       `json:"max_uses" binding:"omitempty,min=1"`
+	StartsAt       *time.Time `json:"starts_at"`
+	ExpiresAt      *time.Time `json:"expires_at" binding:"omitempty,gtfield=StartsAt"`
+	TicketTypeIDs  []string   `json:"ticket_type_ids" binding:"max=50,dive,uuid"` // Vacío = todas las entradas
+	IsActive       *bool      `json:"is_active"`
+}
+
+// UpdatePromoCodeRequest DTO para actualizar un código de descuento
+// (el código y el tipo de descuento no se pueden cambiar)
+type UpdatePromoCodeRequest struct {
+	PercentOff     *float64   `json:"percent_off,omitempty" binding:"omitempty,gt=0,lte=100"`
+	AmountOffCents *int       `json:"amount_off_cents,omitempty" binding:"omitempty,min=1"`
+	MaxUses        *int       `json:"max_uses,omitempty" binding:"omitempty,min=1"`
+	Unlimited      bool       `json:"unlimited,omitempty"` // Elimina el límite de usos
+	StartsAt       *time.Time `json:"starts_at,omitempty"`
+	ExpiresAt      *time.Time `json:"expires_at,omitempty"`
+	TicketTypeIDs  *[]string  `json:"ticket_type_ids,omitempty" binding:"omitempty,max=50,dive,uuid"`
+	IsActive       *bool      `json:"is_active,omitempty"`
+}
+
+// TicketSelectionRequest DTO para presupuestar o reservar una entrada
+type TicketSelectionRequest struct {
+	TicketTypeID string                 `json:"ticket_type_id" binding:"omitempty,uuid"`
+	PromoCode    string                 `json:"promo_code" binding:"omitempty,max=50"`
+	Billing      *BillingDetailsRequest `json:"billing,omitempty"` // Datos para la factura (empresas)
+}
+
+// BillingDetailsRequest datos fiscales del comprador para la factura
+type BillingDetailsRequest struct {
+	Name    string `json:"name" binding:"required_with=TaxID,max=300"`
+	TaxID   string `json:"tax_id" binding:"max=50"`
+	Address string `json:"address" binding:"max=500"`
+}
+
+// FakeCheckoutRequest DTO para completar un pago en el checkout de pruebas
+type FakeCheckoutRequest struct {
+	Result string `json:"result" binding:"required,oneof=succeeded failed"`
+}
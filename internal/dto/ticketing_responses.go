@@ -0,0 +1,149 @@
+package dto
+
+import (
+	"time"
+
+	"cybesphere-backend/internal/common"
+)
+
+// TicketTypeResponse DTO de respuesta de un tipo de entrada
+type TicketTypeResponse struct {
+	ID             string     `json:"id"`
+	EventID        string     `json:"event_id"`
+	Name           string     `json:"name"`
+	Description    string     `json:"description,omitempty"`
+	PriceCents     int        `json:"price_cents"`
+	Currency       string     `json:"currency"`
+	IsFree         bool       `json:"is_free"`
+	Quantity       *int       `json:"quantity"`
+	QuantitySold   int        `json:"quantity_sold"`
+	Remaining      *int       `json:"remaining"`
+	SalesStartDate *time.Time `json:"sales_start_date,omitempty"`
+	SalesEndDate   *time.Time `json:"sales_end_date,omitempty"`
+	IsActive       bool       `json:"is_active"`
+	OnSale         bool       `json:"on_sale"`
+	SoldOut        bool       `json:"sold_out"`
+	SortOrder      int        `json:"sort_order"`
+}
+
+// EventTicketsResponse entradas a la venta de un evento
+type EventTicketsResponse struct {
+	EventID          string               `json:"event_id"`
+	Currency         string               `json:"currency"`
+	RegistrationOpen bool                 `json:"registration_open"`
+	TicketTypes      []TicketTypeResponse `json:"ticket_types"`
+}
+
+// PromoCodeResponse DTO de respuesta de un código de descuento
+type PromoCodeResponse struct {
+	ID             string     `json:"id"`
+	EventID        string     `json:"event_id"`
+	Code           string     `json:"code"`
+	DiscountType   string     `json:"discount_type"`
+	PercentOff     *float64   `json:"percent_off,omitempty"`
+	AmountOffCents *int       `json:"amount_off_cents,omitempty"`
+	MaxUses        *int       `json:"max_uses"`
+	UsedCount      int        `json:"used_count"`
+	StartsAt       *time.Time `json:"starts_at,omitempty"`
+	ExpiresAt      *time.Time `json:"expires_at,omitempty"`
+	TicketTypeIDs  []string   `json:"ticket_type_ids"`
+	IsActive       bool       `json:"is_active"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+// PriceQuoteResponse desglose del precio de una entrada en céntimos
+type PriceQuoteResponse struct {
+	EventID        string `json:"event_id"`
+	TicketTypeID   string `json:"ticket_type_id,omitempty"`
+	TicketTypeName string `json:"ticket_type_name,omitempty"`
+	PromoCode      string `json:"promo_code,omitempty"`
+	PriceCents     int    `json:"price_cents"`
+	DiscountCents  int    `json:"discount_cents"`
+	TotalCents     int    `json:"total_cents"`
+	Currency       string `json:"currency"`
+}
+
+// RegistrationResponse DTO de respuesta de una inscripción
+type RegistrationResponse struct {
+	ID             string                `json:"id"`
+	EventID        string                `json:"event_id"`
+	Status         string                `json:"status"`
+	TicketTypeID   *string               `json:"ticket_type_id,omitempty"`
+	TicketTypeName string                `json:"ticket_type_name,omitempty"`
+	PriceCents     int                   `json:"price_cents"`
+	DiscountCents  int                   `json:"discount_cents"`
+	TotalCents     int                   `json:"total_cents"`
+	Currency       string                `json:"currency"`
+	ConfirmedAt    *time.Time            `json:"confirmed_at,omitempty"`
+	CanceledAt     *time.Time            `json:"canceled_at,omitempty"`
+	CreatedAt      time.Time             `json:"created_at"`
+	Event          *EventSummaryResponse `json:"event,omitempty"`
+	Order          *OrderResponse        `json:"order,omitempty"`
+}
+
+// RegistrationListResponse lista paginada de inscripciones
+type RegistrationListResponse struct {
+	Registrations []RegistrationResponse `json:"registrations"`
+	Pagination    common.PaginationMeta  `json:"pagination"`
+}
+
+// OrderResponse response de un pedido. checkout_url solo se incluye mientras
+// el pedido está pendiente de pago.
+type OrderResponse struct {
+	ID             string                `json:"id"`
+	RegistrationID string                `json:"registration_id"`
+	EventID        string                `json:"event_id"`
+	Status         string                `json:"status"`
+	AmountCents    int                   `json:"amount_cents"`
+	Currency       string                `json:"currency"`
+	Provider       string                `json:"provider"`
+	CheckoutURL    string                `json:"checkout_url,omitempty"`
+	FailureReason  string                `json:"failure_reason,omitempty"`
+	PaidAt         *time.Time            `json:"paid_at,omitempty"`
+	FailedAt       *time.Time            `json:"failed_at,omitempty"`
+	RefundedAt     *time.Time            `json:"refunded_at,omitempty"`
+	CreatedAt      time.Time             `json:"created_at"`
+	Event          *EventSummaryResponse `json:"event,omitempty"`
+}
+
+// OrderListResponse lista paginada de pedidos
+type OrderListResponse struct {
+	Orders     []OrderResponse       `json:"orders"`
+	Pagination common.PaginationMeta `json:"pagination"`
+}
+
+// InvoicePartyResponse datos fiscales del emisor o del cliente de una factura
+type InvoicePartyResponse struct {
+	Name    string `json:"name"`
+	TaxID   string `json:"tax_id,omitempty"`
+	Address string `json:"address,omitempty"`
+	Email   string `json:"email,omitempty"`
+}
+
+// InvoiceResponse representación estructurada de una factura o rectificativa
+type InvoiceResponse struct {
+	ID                  string               `json:"id"`
+	Number              string               `json:"number"`
+	Kind                string               `json:"kind"`
+	IssuedAt            time.Time            `json:"issued_at"`
+	OrderID             string               `json:"order_id"`
+	EventID             string               `json:"event_id"`
+	OrganizationID      string               `json:"organization_id"`
+	RectifiesID         *string              `json:"rectifies_id,omitempty"`
+	RectifiesNumber     string               `json:"rectifies_number,omitempty"`
+	RectificationReason string               `json:"rectification_reason,omitempty"`
+	Issuer              InvoicePartyResponse `json:"issuer"`
+	Customer            InvoicePartyResponse `json:"customer"`
+	Description         string               `json:"description"`
+	BaseCents           int                  `json:"base_cents"`
+	VATRate             float64              `json:"vat_rate"` // %
+	VATCents            int                  `json:"vat_cents"`
+	TotalCents          int                  `json:"total_cents"`
+	Currency            string               `json:"currency"`
+}
+
+// InvoiceListResponse lista paginada de facturas
+type InvoiceListResponse struct {
+	Invoices   []InvoiceResponse     `json:"invoices"`
+	Pagination common.PaginationMeta `json:"pagination"`
+}
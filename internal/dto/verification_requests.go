@@ -0,0 +1,40 @@
+package dto
+
+// VerificationDocumentRequest documento aportado para verificar una
+// organización: una URL externa o un fichero subido con el uso
+// verification_document (media_id)
+type VerificationDocumentRequest struct {
+	Kind    string `json:"kind" binding:"required,oneof=tax_id incorporation registry other"`
+	Name    string `json:"name" binding:"omitempty,max=200"`
+	URL     string `json:"url" binding:"required_without=MediaID,omitempty,url,max=500"`
+	MediaID string `json:"media_id" binding:"omitempty,uuid"`
+}
+
+// SubmitVerificationRequest solicitud de verificación de una organización. Debe
+// incluir al menos un documento tax_id que acredite el NIF/CIF
+type SubmitVerificationRequest struct {
+	TaxID     string                        `json:"tax_id" binding:"required,max=20"`
+	LegalName string                        `json:"legal_name" binding:"required,max=300"`
+	Documents []VerificationDocumentRequest `json:"documents" binding:"required,min=1,max=10,dive"`
+	Comments  string                        `json:"comments" binding:"omitempty,max=2000"`
+}
+
+// VerificationsQuery filtro de la cola de verificación (por defecto, enviadas y en revisión)
+type VerificationsQuery struct {
+	Status string `form:"status" binding:"omitempty,oneof=submitted in_review needs_info approved rejected"`
+}
+
+// ApproveVerificationRequest aprobación de una solicitud de verificación
+type ApproveVerificationRequest struct {
+	Message string `json:"message" binding:"omitempty,max=1000"`
+}
+
+// RejectVerificationRequest rechazo de una solicitud de verificación
+type RejectVerificationRequest struct {
+	Reason string `json:"reason" binding:"required,max=1000"` // Se envía a la organización
+}
+
+// RequestVerificationInfoRequest petición de más documentación a la organización
+type RequestVerificationInfoRequest struct {
+	Message string `json:"message" binding:"required,max=1000"` // Se envía a la organización
+}
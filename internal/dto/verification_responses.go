@@ -0,0 +1,58 @@
+package dto
+
+import (
+	"time"
+
+	"cybesphere-backend/internal/common"
+)
+
+// VerificationDocumentResponse documento de una solicitud de verificación
+type VerificationDocumentResponse struct {
+	Kind    string `json:"kind"` // tax_id, incorporation, registry, other
+	Name    string `json:"name,omitempty"`
+	URL     string `json:"url"`
+	MediaID string `json:"media_id,omitempty"`
+}
+
+// OrganizationVerificationResponse solicitud de verificación. El administrador
+// que la revisa solo se incluye en la vista de administración
+type OrganizationVerificationResponse struct {
+	ID             string                         `json:"id"`
+	OrganizationID string                         `json:"organization_id"`
+	Organization   *OrganizationSummaryResponse   `json:"organization,omitempty"`
+	Status         string                         `json:"status"` // submitted, in_review, needs_info, approved, rejected
+	TaxID          string                         `json:"tax_id"`
+	LegalName      string                         `json:"legal_name"`
+	Documents      []VerificationDocumentResponse `json:"documents"`
+	Comments       string                         `json:"comments,omitempty"`
+	ReviewMessage  string                         `json:"review_message,omitempty"`
+	ReviewedAt     *time.Time                     `json:"reviewed_at,omitempty"`
+	SubmittedBy    string                         `json:"submitted_by"`
+	Submitter      *UserSummaryResponse           `json:"submitter,omitempty"`
+	ReviewerID     *string                        `json:"reviewer_id,omitempty"`
+	CreatedAt      time.Time                      `json:"created_at"`
+}
+
+// OrganizationVerificationDetailResponse solicitud con el historial de
+// solicitudes de la organización
+type OrganizationVerificationDetailResponse struct {
+	OrganizationVerificationResponse
+	History []OrganizationVerificationResponse `json:"history"`
+}
+
+// OrganizationVerificationStatusResponse estado de verificación de una
+// organización con su historial, de la solicitud más reciente a la más antigua
+type OrganizationVerificationStatusResponse struct {
+	OrganizationID string                             `json:"organization_id"`
+	Status         string                             `json:"status"`
+	IsVerified     bool                               `json:"is_verified"`
+	VerifiedAt     *time.Time                         `json:"verified_at,omitempty"`
+	CanSubmit      bool                               `json:"can_submit"`
+	History        []OrganizationVerificationResponse `json:"history"`
+}
+
+// OrganizationVerificationListResponse solicitudes con paginación
+type OrganizationVerificationListResponse struct {
+	Verifications []OrganizationVerificationResponse `json:"verifications"`
+	Pagination    common.PaginationMeta              `json:"pagination"`
+}
@@ -0,0 +1,7 @@
+package dto
+
+// VersionDiffRequest versiones que se comparan
+type VersionDiffRequest struct {
+	From int `form:"from" binding:"required,min=1"`
+	To   int `form:"to" binding:"required,min=1"`
+}
@@ -0,0 +1,37 @@
+package dto
+
+import (
+	"time"
+
+	"cybesphere-backend/internal/common"
+)
+
+// EntityVersionResponse versión de un evento u organización
+type EntityVersionResponse struct {
+	Version       int            `json:"version"`
+	Action        string         `json:"action"` // initial, create, update, restore
+	UserID        string         `json:"user_id"`
+	RestoredFrom  *int           `json:"restored_from,omitempty"`
+	ChangedFields []string       `json:"changed_fields"`
+	Snapshot      map[string]any `json:"snapshot,omitempty"` // Solo en el detalle de una versión
+	CreatedAt     time.Time      `json:"created_at"`
+}
+
+// EntityVersionsResponse historial de versiones
+type EntityVersionsResponse struct {
+	Versions   []EntityVersionResponse `json:"versions"`
+	Pagination common.PaginationMeta   `json:"pagination"`
+}
+
+// VersionDiffResponse diferencias entre dos versiones
+type VersionDiffResponse struct {
+	From    EntityVersionResponse          `json:"from"`
+	To      EntityVersionResponse          `json:"to"`
+	Changes map[string]FieldChangeResponse `json:"changes"`
+}
+
+// FieldChangeResponse valor de un campo en cada versión
+type FieldChangeResponse struct {
+	From any `json:"from,omitempty"`
+	To   any `json:"to,omitempty"`
+}
@@ -0,0 +1,263 @@
+// internal/handlers/agenda_handler.go
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/dto"
+	"cybesphere-backend/internal/mappers"
+	"cybesphere-backend/internal/services"
+)
+
+// AgendaHandler handler para agendas de eventos, ponentes y agendas personales
+type AgendaHandler struct {
+	agendaService services.AgendaService
+	mapper        *mappers.UnifiedMapper
+}
+
+// NewAgendaHandler crea nueva instancia del handler
+func NewAgendaHandler(
+	agendaService services.AgendaService,
+	mapper *mappers.UnifiedMapper,
+) *AgendaHandler {
+	return &AgendaHandler{
+		agendaService: agendaService,
+		mapper:        mapper,
+	}
+}
+
+// =============================================================================
+// AGENDA
+// =============================================================================
+
+// GetAgenda GET /public/events/:id/agenda?tz=Europe/Madrid
+func (h *AgendaHandler) GetAgenda(c *gin.Context) {
+	userCtx := extractUserContext(c)
+
+	event, sessions, favorites, err := h.agendaService.GetAgenda(c.Request.Context(), c.Param("id"), userCtx)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	loc, err := parseTimezone(c, event.Timezone)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Agenda del evento",
+		h.mapper.SessionsToAgendaResponse(event.ID.String(), sessions, loc, favorites))
+}
+
+// CreateSession POST /events/:id/sessions
+func (h *AgendaHandler) CreateSession(c *gin.Context) {
+	userCtx := extractUserContext(c)
+
+	var req dto.CreateSessionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.ErrorResponse(c, common.NewValidationError("request", err.Error()))
+		return
+	}
+
+	session, err := h.agendaService.CreateSession(c.Request.Context(), c.Param("id"), req, userCtx)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusCreated, "Sesión creada",
+		h.mapper.EventSessionToResponse(session, nil, false))
+}
+
+// UpdateSession PUT /events/:id/sessions/:sessionId
+func (h *AgendaHandler) UpdateSession(c *gin.Context) {
+	userCtx := extractUserContext(c)
+
+	var req dto.UpdateSessionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.ErrorResponse(c, common.NewValidationError("request", err.Error()))
+		return
+	}
+
+	session, err := h.agendaService.UpdateSession(
+		c.Request.Context(), c.Param("id"), c.Param("sessionId"), req, userCtx)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Sesión actualizada",
+		h.mapper.EventSessionToResponse(session, nil, false))
+}
+
+// DeleteSession DELETE /events/:id/sessions/:sessionId
+func (h *AgendaHandler) DeleteSession(c *gin.Context) {
+	userCtx := extractUserContext(c)
+
+	if err := h.agendaService.DeleteSession(c.Request.Context(), c.Param("id"), c.Param("sessionId"), userCtx); err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Sesión eliminada", nil)
+}
+
+// =============================================================================
+// AGENDA PERSONAL
+// =============================================================================
+
+// AddSessionFavorite POST /events/:id/sessions/:sessionId/favorite
+func (h *AgendaHandler) AddSessionFavorite(c *gin.Context) {
+	userCtx := extractUserContext(c)
+
+	if err := h.agendaService.AddSessionFavorite(c.Request.Context(), c.Param("id"), c.Param("sessionId"), userCtx); err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Sesión añadida a tu agenda", nil)
+}
+
+// RemoveSessionFavorite DELETE /events/:id/sessions/:sessionId/favorite
+func (h *AgendaHandler) RemoveSessionFavorite(c *gin.Context) {
+	userCtx := extractUserContext(c)
+
+	if err := h.agendaService.RemoveSessionFavorite(c.Request.Context(), c.Param("id"), c.Param("sessionId"), userCtx); err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Sesión eliminada de tu agenda", nil)
+}
+
+// GetSchedule GET /user/schedule?tz=Europe/Madrid
+// Sin tz cada sesión se expresa en la zona horaria de su evento.
+func (h *AgendaHandler) GetSchedule(c *gin.Context) {
+	userCtx := extractUserContext(c)
+
+	var loc *time.Location
+	if c.Query("tz") != "" {
+		parsed, err := parseTimezone(c, "")
+		if err != nil {
+			common.ErrorResponse(c, err)
+			return
+		}
+		loc = parsed
+	}
+
+	sessions, err := h.agendaService.GetSchedule(c.Request.Context(), userCtx)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Tu agenda",
+		h.mapper.SessionsToScheduleResponse(sessions, loc))
+}
+
+// =============================================================================
+// PONENTES
+// =============================================================================
+
+// ListSpeakers GET /speakers
+func (h *AgendaHandler) ListSpeakers(c *gin.Context) {
+	userCtx := extractUserContext(c)
+	opts := extractQueryOptions(c)
+
+	speakers, pagination, err := h.agendaService.ListSpeakers(c.Request.Context(), *opts, userCtx)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Ponentes",
+		h.mapper.SpeakersToListResponse(speakers, pagination, userCtx))
+}
+
+// GetSpeaker GET /public/speakers/:id
+func (h *AgendaHandler) GetSpeaker(c *gin.Context) {
+	userCtx := extractUserContext(c)
+
+	speaker, err := h.agendaService.GetSpeaker(c.Request.Context(), c.Param("id"), userCtx)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Ponente",
+		h.mapper.SpeakerToDetailResponse(speaker, userCtx))
+}
+
+// CreateSpeaker POST /speakers
+func (h *AgendaHandler) CreateSpeaker(c *gin.Context) {
+	userCtx := extractUserContext(c)
+
+	var req dto.CreateSpeakerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.ErrorResponse(c, common.NewValidationError("request", err.Error()))
+		return
+	}
+
+	speaker, err := h.agendaService.CreateSpeaker(c.Request.Context(), req, userCtx)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusCreated, "Ponente creado",
+		h.mapper.SpeakerToResponse(speaker, userCtx))
+}
+
+// UpdateSpeaker PUT /speakers/:id
+func (h *AgendaHandler) UpdateSpeaker(c *gin.Context) {
+	userCtx := extractUserContext(c)
+
+	var req dto.UpdateSpeakerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.ErrorResponse(c, common.NewValidationError("request", err.Error()))
+		return
+	}
+
+	speaker, err := h.agendaService.UpdateSpeaker(c.Request.Context(), c.Param("id"), req, userCtx)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Ponente actualizado",
+		h.mapper.SpeakerToResponse(speaker, userCtx))
+}
+
+// DeleteSpeaker DELETE /speakers/:id
+func (h *AgendaHandler) DeleteSpeaker(c *gin.Context) {
+	userCtx := extractUserContext(c)
+
+	if err := h.agendaService.DeleteSpeaker(c.Request.Context(), c.Param("id"), userCtx); err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Ponente eliminado", nil)
+}
+
+// parseTimezone obtiene la zona horaria del parámetro tz (o la indicada por defecto)
+func parseTimezone(c *gin.Context, fallback string) (*time.Location, error) {
+	name := c.Query("tz")
+	if name == "" {
+		name = fallback
+	}
+	if name == "" {
+		return time.UTC, nil
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, common.NewValidationError("tz", "Zona horaria inválida")
+	}
+	return loc, nil
+}
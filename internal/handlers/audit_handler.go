@@ -0,0 +1,77 @@
+// internal/handlers/audit_handler.go
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/dto"
+	"cybesphere-backend/internal/services"
+)
+
+// AuditHandler handler para la verificación y exportación de la cadena de auditoría
+type AuditHandler struct {
+	auditService services.AuditService
+}
+
+// NewAuditHandler crea nueva instancia del handler
+func NewAuditHandler(auditService services.AuditService) *AuditHandler {
+	return &AuditHandler{auditService: auditService}
+}
+
+// VerifyChain GET /admin/audit-logs/verify
+func (h *AuditHandler) VerifyChain(c *gin.Context) {
+	report, err := h.auditService.VerifyChain(c.Request.Context())
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	response := dto.AuditChainResponse{
+		Valid:         report.Valid,
+		Checked:       report.Checked,
+		HeadSequence:  report.HeadSequence,
+		HeadHash:      report.HeadHash,
+		Unchained:     report.Unchained,
+		PrunedThrough: report.PrunedThrough,
+		PublicKey:     report.PublicKey,
+		VerifiedAt:    report.VerifiedAt,
+	}
+	if brk := report.Break; brk != nil {
+		response.Break = &dto.AuditChainBreakResponse{
+			Sequence: brk.Sequence,
+			LogID:    report.BrokenLogID,
+			Reason:   brk.Reason,
+			Expected: brk.Expected,
+			Actual:   brk.Actual,
+		}
+	}
+
+	message := "Cadena de auditoría íntegra"
+	if !report.Valid {
+		message = "La cadena de auditoría está rota"
+	}
+	common.SuccessResponse(c, http.StatusOK, message, response)
+}
+
+// ExportBundle GET /admin/audit-logs/export
+func (h *AuditHandler) ExportBundle(c *gin.Context) {
+	var req dto.AuditExportRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		common.ErrorResponse(c, common.NewValidationError("request", err.Error()))
+		return
+	}
+
+	bundle, err := h.auditService.ExportBundle(c.Request.Context(), req.FromSequence, req.ToSequence)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="audit-%d-%d.json"`, bundle.FromSequence, bundle.ToSequence))
+	c.Header("Cache-Control", "private, no-store")
+	c.JSON(http.StatusOK, bundle)
+}
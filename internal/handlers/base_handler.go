@@ -49,6 +49,13 @@ func (h *BaseHandler[T, CreateDTO, UpdateDTO, ResponseDTO]) GetAll(c *gin.Contex
 	// Extraer contexto de usuario
 	userCtx := extractUserContext(c)
 
+	// Validar la selección de campos antes de consultar
+	fields, err := fieldSelection[ResponseDTO](queryOptions)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
 	// Llamar al servicio
 	entities, pagination, err := h.service.GetAll(c.Request.Context(), *queryOptions, userCtx)
 	if err != nil {
@@ -67,8 +74,14 @@ func (h *BaseHandler[T, CreateDTO, UpdateDTO, ResponseDTO]) GetAll(c *gin.Contex
 		responses = append(responses, response.(ResponseDTO))
 	}
 
+	data, err := selectFields(responses, fields)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
 	// Respuesta con paginación
-	common.SuccessWithPagination(c, "Recursos obtenidos exitosamente", responses, pagination)
+	common.SuccessWithPagination(c, "Recursos obtenidos exitosamente", data, pagination)
 }
 
 // GetByID maneja GET /resource/:id
@@ -0,0 +1,120 @@
+// internal/handlers/calendar_handler.go
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/dto"
+	"cybesphere-backend/internal/mappers"
+	"cybesphere-backend/internal/services"
+)
+
+// calendarContentType tipo MIME de los documentos iCalendar
+const calendarContentType = "text/calendar; charset=utf-8"
+
+// CalendarHandler handler para exportación iCalendar y feeds suscribibles
+type CalendarHandler struct {
+	calendarService services.CalendarService
+	mapper          *mappers.UnifiedMapper
+}
+
+// NewCalendarHandler crea nueva instancia del handler
+func NewCalendarHandler(
+	calendarService services.CalendarService,
+	mapper *mappers.UnifiedMapper,
+) *CalendarHandler {
+	return &CalendarHandler{
+		calendarService: calendarService,
+		mapper:          mapper,
+	}
+}
+
+// ExportEvent GET /public/events/:id/ical
+func (h *CalendarHandler) ExportEvent(c *gin.Context) {
+	eventID := c.Param("id")
+	userCtx := extractUserContext(c)
+
+	event, body, err := h.calendarService.ExportEvent(c.Request.Context(), eventID, userCtx)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.ics"`, event.Slug))
+	c.Data(http.StatusOK, calendarContentType, body)
+}
+
+// GetFeed GET /public/calendars/:token
+func (h *CalendarHandler) GetFeed(c *gin.Context) {
+	body, err := h.calendarService.RenderFeed(c.Request.Context(), c.Param("token"))
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", "private, max-age=900")
+	c.Data(http.StatusOK, calendarContentType, body)
+}
+
+// CreateFeed POST /user/calendar-feeds
+func (h *CalendarHandler) CreateFeed(c *gin.Context) {
+	userCtx := extractUserContext(c)
+
+	var req dto.CreateCalendarFeedRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.ErrorResponse(c, common.NewValidationError("request", err.Error()))
+		return
+	}
+
+	feed, token, err := h.calendarService.CreateFeed(c.Request.Context(), req, userCtx)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	response := h.mapper.CalendarFeedToResponse(feed, feedURL(c, token))
+	common.SuccessResponse(c, http.StatusCreated, "Feed de calendario creado", response)
+}
+
+// ListFeeds GET /user/calendar-feeds
+func (h *CalendarHandler) ListFeeds(c *gin.Context) {
+	userCtx := extractUserContext(c)
+
+	feeds, err := h.calendarService.ListFeeds(c.Request.Context(), userCtx)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Feeds de calendario", h.mapper.CalendarFeedsToResponse(feeds))
+}
+
+// RevokeFeed DELETE /user/calendar-feeds/:feedId
+func (h *CalendarHandler) RevokeFeed(c *gin.Context) {
+	userCtx := extractUserContext(c)
+
+	if err := h.calendarService.RevokeFeed(c.Request.Context(), c.Param("feedId"), userCtx); err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Feed de calendario revocado", nil)
+}
+
+// feedURL construye la URL absoluta de suscripción de un feed
+func feedURL(c *gin.Context, token string) string {
+	scheme := "http"
+	if c.Request.TLS != nil || c.GetHeader("X-Forwarded-Proto") == "https" {
+		scheme = "https"
+	}
+
+	// Reemplazar el sufijo de la ruta actual (/user/calendar-feeds) por la ruta pública
+	prefix := strings.TrimSuffix(c.FullPath(), "/user/calendar-feeds")
+
+	return fmt.Sprintf("%s://%s%s/public/calendars/%s.ics", scheme, c.Request.Host, prefix, token)
+}
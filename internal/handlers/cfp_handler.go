@@ -0,0 +1,200 @@
+// internal/handlers/cfp_handler.go
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/dto"
+	"cybesphere-backend/internal/mappers"
+	"cybesphere-backend/internal/models"
+	"cybesphere-backend/internal/services"
+)
+
+// csvContentType tipo de contenido de las exportaciones CSV
+const csvContentType = "text/csv; charset=utf-8"
+
+// CFPHandler handler para el call for papers y la revisión de propuestas
+type CFPHandler struct {
+	cfpService services.CFPService
+	mapper     *mappers.UnifiedMapper
+}
+
+// NewCFPHandler crea nueva instancia del handler
+func NewCFPHandler(
+	cfpService services.CFPService,
+	mapper *mappers.UnifiedMapper,
+) *CFPHandler {
+	return &CFPHandler{
+		cfpService: cfpService,
+		mapper:     mapper,
+	}
+}
+
+// =============================================================================
+// AUTORES
+// =============================================================================
+
+// SubmitTalk POST /events/:id/submissions
+func (h *CFPHandler) SubmitTalk(c *gin.Context) {
+	userCtx := extractUserContext(c)
+
+	var req dto.CreateSubmissionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.ErrorResponse(c, common.NewValidationError("request", err.Error()))
+		return
+	}
+
+	submission, err := h.cfpService.SubmitTalk(c.Request.Context(), c.Param("id"), req, userCtx)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusCreated, "Propuesta enviada",
+		h.mapper.SubmissionToResponse(submission))
+}
+
+// ListMySubmissions GET /user/submissions
+func (h *CFPHandler) ListMySubmissions(c *gin.Context) {
+	userCtx := extractUserContext(c)
+
+	submissions, err := h.cfpService.ListMySubmissions(c.Request.Context(), userCtx)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Tus propuestas",
+		h.mapper.SubmissionsToResponse(submissions))
+}
+
+// =============================================================================
+// ORGANIZACIÓN
+// =============================================================================
+
+// ListSubmissions GET /events/:id/submissions?status=submitted
+func (h *CFPHandler) ListSubmissions(c *gin.Context) {
+	userCtx := extractUserContext(c)
+	status := models.SubmissionStatus(c.Query("status"))
+
+	event, submissions, err := h.cfpService.ListSubmissions(c.Request.Context(), c.Param("id"), status, userCtx)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Propuestas del evento",
+		h.mapper.SubmissionsToListResponse(event, submissions))
+}
+
+// GetSubmission GET /events/:id/submissions/:submissionId
+func (h *CFPHandler) GetSubmission(c *gin.Context) {
+	userCtx := extractUserContext(c)
+
+	submission, err := h.cfpService.GetSubmission(c.Request.Context(), c.Param("id"), c.Param("submissionId"), userCtx)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Propuesta",
+		h.mapper.SubmissionToDetailResponse(submission))
+}
+
+// AssignReviewers POST /events/:id/submissions/:submissionId/reviewers
+func (h *CFPHandler) AssignReviewers(c *gin.Context) {
+	userCtx := extractUserContext(c)
+
+	var req dto.AssignReviewersRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.ErrorResponse(c, common.NewValidationError("request", err.Error()))
+		return
+	}
+
+	submission, err := h.cfpService.AssignReviewers(
+		c.Request.Context(), c.Param("id"), c.Param("submissionId"), req, userCtx)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Revisores asignados",
+		h.mapper.SubmissionToDetailResponse(submission))
+}
+
+// DecideSubmission POST /events/:id/submissions/:submissionId/decision
+func (h *CFPHandler) DecideSubmission(c *gin.Context) {
+	userCtx := extractUserContext(c)
+
+	var req dto.SubmissionDecisionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.ErrorResponse(c, common.NewValidationError("request", err.Error()))
+		return
+	}
+
+	submission, err := h.cfpService.DecideSubmission(
+		c.Request.Context(), c.Param("id"), c.Param("submissionId"), req, userCtx)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Decisión registrada",
+		h.mapper.SubmissionToDetailResponse(submission))
+}
+
+// ExportReviewSummary GET /events/:id/submissions/export
+func (h *CFPHandler) ExportReviewSummary(c *gin.Context) {
+	userCtx := extractUserContext(c)
+
+	event, body, err := h.cfpService.ExportReviewSummary(c.Request.Context(), c.Param("id"), userCtx)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s-cfp.csv"`, event.Slug))
+	c.Data(http.StatusOK, csvContentType, body)
+}
+
+// =============================================================================
+// REVISORES
+// =============================================================================
+
+// ListMyReviews GET /user/reviews
+func (h *CFPHandler) ListMyReviews(c *gin.Context) {
+	userCtx := extractUserContext(c)
+
+	reviews, err := h.cfpService.ListMyReviews(c.Request.Context(), userCtx)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Revisiones asignadas",
+		h.mapper.ReviewsToAssignmentResponse(reviews))
+}
+
+// SubmitReview PUT /user/reviews/:reviewId
+func (h *CFPHandler) SubmitReview(c *gin.Context) {
+	userCtx := extractUserContext(c)
+
+	var req dto.SubmitReviewRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.ErrorResponse(c, common.NewValidationError("request", err.Error()))
+		return
+	}
+
+	review, err := h.cfpService.SubmitReview(c.Request.Context(), c.Param("reviewId"), req, userCtx)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Revisión registrada",
+		h.mapper.ReviewToAssignmentResponse(review))
+}
@@ -11,6 +11,7 @@ import (
 	"cybesphere-backend/internal/mappers"
 	"cybesphere-backend/internal/models"
 	"cybesphere-backend/internal/services"
+	"cybesphere-backend/pkg/fieldset"
 )
 
 type EventHandler struct {
@@ -45,7 +46,7 @@ func NewEventHandler(
 
 // PublishEvent método específico de eventos
 func (h *EventHandler) PublishEvent(c *gin.Context) {
-	eventID := c.Param("eventId")
+	eventID := c.Param("id")
 	userCtx := extractUserContext(c)
 
 	event, err := h.eventService.PublishEvent(c.Request.Context(), eventID, userCtx)
@@ -54,13 +55,17 @@ func (h *EventHandler) PublishEvent(c *gin.Context) {
 		return
 	}
 
+	message := "Evento publicado exitosamente"
+	if event.Status == models.EventStatusPendingReview {
+		message = "Evento enviado a revisión; se publicará cuando lo apruebe la moderación"
+	}
 	response := h.mapper.EventToResponse(event, userCtx)
-	common.SuccessResponse(c, http.StatusOK, "Evento publicado exitosamente", response)
+	common.SuccessResponse(c, http.StatusOK, message, response)
 }
 
 // CancelEvent método específico
 func (h *EventHandler) CancelEvent(c *gin.Context) {
-	eventID := c.Param("eventId")
+	eventID := c.Param("id")
 	userCtx := extractUserContext(c)
 
 	var req dto.CancelEventRequest
@@ -79,11 +84,94 @@ func (h *EventHandler) CancelEvent(c *gin.Context) {
 	common.SuccessResponse(c, http.StatusOK, "Evento cancelado", response)
 }
 
+// SchedulePublication POST /events/:id/schedule-publish
+func (h *EventHandler) SchedulePublication(c *gin.Context) {
+	userCtx := extractUserContext(c)
+
+	var req dto.SchedulePublicationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.ErrorResponse(c, common.NewValidationError("request", err.Error()))
+		return
+	}
+
+	event, err := h.eventService.SchedulePublication(c.Request.Context(), c.Param("id"), req.PublishAt, userCtx)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	response := h.mapper.EventToResponse(event, userCtx)
+	common.SuccessResponse(c, http.StatusOK, "Publicación programada", response)
+}
+
+// UnschedulePublication DELETE /events/:id/schedule-publish
+func (h *EventHandler) UnschedulePublication(c *gin.Context) {
+	userCtx := extractUserContext(c)
+
+	event, err := h.eventService.UnschedulePublication(c.Request.Context(), c.Param("id"), userCtx)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	response := h.mapper.EventToResponse(event, userCtx)
+	common.SuccessResponse(c, http.StatusOK, "Publicación programada anulada", response)
+}
+
+// ListPublicEvents catálogo público de eventos con filtros y facetas
+func (h *EventHandler) ListPublicEvents(c *gin.Context) {
+	opts := extractQueryOptions(c)
+	userCtx := extractUserContext(c)
+
+	// Filtros solicitados, antes de añadir los de seguridad
+	applied := buildAppliedFilters(opts)
+
+	fields, err := fieldSelection[dto.EventResponse](opts)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	events, pagination, facets, err := h.eventService.GetEventCatalog(
+		c.Request.Context(),
+		*opts,
+		userCtx,
+	)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	response := h.mapper.EventsToListResponse(events, pagination, userCtx)
+	response.Filters = applied
+	response.Facets = h.mapper.FacetsToResponse(facets)
+
+	// La selección de campos solo afecta a los eventos
+	var data interface{} = response
+	if fields != nil {
+		data, err = selectFields(response, fieldset.Set{
+			"events": fields, "pagination": nil, "filters": nil, "facets": nil,
+		})
+		if err != nil {
+			common.ErrorResponse(c, err)
+			return
+		}
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Eventos obtenidos exitosamente", data)
+}
+
 // GetUpcomingEvents eventos futuros
 func (h *EventHandler) GetUpcomingEvents(c *gin.Context) {
 	opts := extractQueryOptions(c)
 	userCtx := extractUserContext(c)
 
+	fields, err := fieldSelection[dto.EventResponse](opts)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
 	events, pagination, err := h.eventService.GetUpcomingEvents(
 		c.Request.Context(),
 		*opts,
@@ -95,7 +183,12 @@ func (h *EventHandler) GetUpcomingEvents(c *gin.Context) {
 	}
 
 	response := h.mapper.EventsToListResponse(events, pagination, userCtx)
-	common.SuccessWithPagination(c, "Próximos eventos", response.Events, pagination)
+	data, err := selectFields(response.Events, fields)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+	common.SuccessWithPagination(c, "Próximos eventos", data, pagination)
 }
 
 // GetEventsByOrganization eventos de una organización
@@ -104,6 +197,12 @@ func (h *EventHandler) GetEventsByOrganization(c *gin.Context) {
 	opts := extractQueryOptions(c)
 	userCtx := extractUserContext(c)
 
+	fields, err := fieldSelection[dto.EventResponse](opts)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
 	events, pagination, err := h.eventService.GetEventsByOrganization(
 		c.Request.Context(),
 		orgID,
@@ -116,12 +215,17 @@ func (h *EventHandler) GetEventsByOrganization(c *gin.Context) {
 	}
 
 	response := h.mapper.EventsToListResponse(events, pagination, userCtx)
-	common.SuccessWithPagination(c, "Eventos de la organización", response.Events, pagination)
+	data, err := selectFields(response.Events, fields)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+	common.SuccessWithPagination(c, "Eventos de la organización", data, pagination)
 }
 
 // AddToFavorites agregar a favoritos
 func (h *EventHandler) AddToFavorites(c *gin.Context) {
-	eventID := c.Param("eventId")
+	eventID := c.Param("id")
 	userCtx := extractUserContext(c)
 
 	err := h.eventService.AddToFavorites(c.Request.Context(), eventID, userCtx)
@@ -135,7 +239,7 @@ func (h *EventHandler) AddToFavorites(c *gin.Context) {
 
 // RemoveFromFavorites remover de favoritos
 func (h *EventHandler) RemoveFromFavorites(c *gin.Context) {
-	eventID := c.Param("eventId")
+	eventID := c.Param("id")
 	userCtx := extractUserContext(c)
 
 	err := h.eventService.RemoveFromFavorites(c.Request.Context(), eventID, userCtx)
@@ -147,6 +251,36 @@ func (h *EventHandler) RemoveFromFavorites(c *gin.Context) {
 	common.SuccessResponse(c, http.StatusOK, "Removido de favoritos", nil)
 }
 
+// GetNearbyEvents eventos presenciales cercanos a un punto o a la ubicación del usuario
+func (h *EventHandler) GetNearbyEvents(c *gin.Context) {
+	var req dto.NearbyEventsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		common.ErrorResponse(c, common.NewValidationError("request", err.Error()))
+		return
+	}
+
+	opts := extractQueryOptions(c)
+	userCtx := extractUserContext(c)
+
+	result, err := h.eventService.GetNearbyEvents(c.Request.Context(), req, *opts, userCtx)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	response := dto.NearbyEventsResponse{
+		CenterLatitude:  result.Center.Latitude,
+		CenterLongitude: result.Center.Longitude,
+		RadiusKm:        result.RadiusKm,
+		FromProfile:     result.FromProfile,
+		Events:          h.mapper.EventDistancesToResponse(result.Events),
+		TotalFound:      int(result.Pagination.Total),
+		Pagination:      *result.Pagination,
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Eventos cercanos", response)
+}
+
 // GetFeaturedEvents eventos destacados
 func (h *EventHandler) GetFeaturedEvents(c *gin.Context) {
 	events, err := h.eventService.GetFeaturedEvents(c.Request.Context(), 10)
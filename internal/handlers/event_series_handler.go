@@ -0,0 +1,107 @@
+// internal/handlers/event_series_handler.go
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/dto"
+	"cybesphere-backend/internal/mappers"
+	"cybesphere-backend/internal/models"
+	"cybesphere-backend/internal/services"
+)
+
+// EventSeriesHandler handler para series de eventos recurrentes
+type EventSeriesHandler struct {
+	seriesService services.EventSeriesService
+	mapper        *mappers.UnifiedMapper
+}
+
+// NewEventSeriesHandler crea nueva instancia del handler
+func NewEventSeriesHandler(
+	seriesService services.EventSeriesService,
+	mapper *mappers.UnifiedMapper,
+) *EventSeriesHandler {
+	return &EventSeriesHandler{
+		seriesService: seriesService,
+		mapper:        mapper,
+	}
+}
+
+// CreateSeries POST /events/series
+func (h *EventSeriesHandler) CreateSeries(c *gin.Context) {
+	userCtx := extractUserContext(c)
+
+	var req dto.CreateEventSeriesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.ErrorResponse(c, common.NewValidationError("request", err.Error()))
+		return
+	}
+
+	series, occurrences, err := h.seriesService.CreateSeries(c.Request.Context(), req, userCtx)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusCreated, "Serie de eventos creada",
+		h.mapper.EventSeriesToResponse(series, occurrences))
+}
+
+// GetSeries GET /events/series/:seriesId
+func (h *EventSeriesHandler) GetSeries(c *gin.Context) {
+	userCtx := extractUserContext(c)
+
+	series, occurrences, err := h.seriesService.GetSeries(c.Request.Context(), c.Param("seriesId"), userCtx)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Serie de eventos",
+		h.mapper.EventSeriesToResponse(series, occurrences))
+}
+
+// UpdateOccurrence PUT /events/series/:seriesId/occurrences/:occurrenceId
+func (h *EventSeriesHandler) UpdateOccurrence(c *gin.Context) {
+	userCtx := extractUserContext(c)
+
+	var req dto.UpdateOccurrenceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.ErrorResponse(c, common.NewValidationError("request", err.Error()))
+		return
+	}
+
+	series, occurrences, err := h.seriesService.UpdateOccurrence(
+		c.Request.Context(), c.Param("seriesId"), c.Param("occurrenceId"), req, userCtx)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Ocurrencia actualizada",
+		h.mapper.EventSeriesToResponse(series, occurrences))
+}
+
+// CancelOccurrence POST /events/series/:seriesId/occurrences/:occurrenceId/cancel
+func (h *EventSeriesHandler) CancelOccurrence(c *gin.Context) {
+	userCtx := extractUserContext(c)
+
+	var req dto.CancelOccurrenceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.ErrorResponse(c, common.NewValidationError("request", err.Error()))
+		return
+	}
+
+	series, occurrences, err := h.seriesService.CancelOccurrence(
+		c.Request.Context(), c.Param("seriesId"), c.Param("occurrenceId"), models.EditScope(req.Scope), userCtx)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Ocurrencias canceladas",
+		h.mapper.EventSeriesToResponse(series, occurrences))
+}
@@ -2,9 +2,16 @@
 package handlers
 
 import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
 	"github.com/gin-gonic/gin"
 
 	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/dto"
+	"cybesphere-backend/pkg/fieldset"
 	"cybesphere-backend/pkg/logger"
 )
 
@@ -12,6 +19,67 @@ func GetUserContext(c *gin.Context) *common.UserContext {
 	return extractUserContext(c)
 }
 
+// buildAppliedFilters copia los filtros de la consulta para devolverlos en la respuesta
+func buildAppliedFilters(opts *common.QueryOptions) dto.AppliedFilters {
+	filters := make(map[string]interface{}, len(opts.Filters))
+	for key, value := range opts.Filters {
+		filters[key] = value
+	}
+
+	return dto.AppliedFilters{
+		Search:   opts.Search,
+		OrderBy:  opts.OrderBy,
+		OrderDir: opts.OrderDir,
+		Filters:  filters,
+	}
+}
+
+// fieldSelection valida ?fields= contra los campos de la respuesta T; las
+// relaciones pedidas con ?expand= se incluyen aunque no se nombren. Devuelve
+// nil si no se pidió selección
+func fieldSelection[T any](opts *common.QueryOptions) (fieldset.Set, error) {
+	if strings.TrimSpace(opts.Fields) == "" {
+		return nil, nil
+	}
+
+	set, err := fieldset.Parse(opts.Fields)
+	if err != nil {
+		var fieldErr *fieldset.FieldError
+		if errors.As(err, &fieldErr) {
+			return nil, common.NewValidationError("fields."+fieldErr.Path, "Campo anidado demasiado profundo")
+		}
+		validationErr := common.NewValidationError("fields", "Selección de campos inválida")
+		validationErr.Details = err.Error()
+		return nil, validationErr
+	}
+
+	if err := set.Validate(reflect.TypeOf((*T)(nil)).Elem()); err != nil {
+		var fieldErr *fieldset.FieldError
+		if errors.As(err, &fieldErr) {
+			return nil, common.NewValidationError("fields."+fieldErr.Path, fmt.Sprintf("El campo '%s' no existe", fieldErr.Path))
+		}
+		return nil, err
+	}
+
+	for _, name := range opts.ExpandList() {
+		relation := strings.Split(name, ".")[0]
+		if _, selected := set[relation]; !selected {
+			set[relation] = nil
+		}
+	}
+
+	return set, nil
+}
+
+// selectFields reduce la respuesta a los campos seleccionados; sin selección
+// la devuelve sin cambios
+func selectFields(response interface{}, set fieldset.Set) (interface{}, error) {
+	if set == nil {
+		return response, nil
+	}
+	return fieldset.Project(response, set)
+}
+
 // extractQueryOptions extrae opciones de query del contexto o las construye
 func extractQueryOptions(c *gin.Context) *common.QueryOptions {
 	// Primero intentar obtener las opciones ya parseadas
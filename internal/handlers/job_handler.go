@@ -0,0 +1,70 @@
+// internal/handlers/job_handler.go
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/dto"
+	"cybesphere-backend/internal/models"
+	"cybesphere-backend/internal/services"
+)
+
+// JobHandler handler para consultar y lanzar las tareas programadas
+type JobHandler struct {
+	jobService services.JobService
+}
+
+// NewJobHandler crea nueva instancia del handler
+func NewJobHandler(jobService services.JobService) *JobHandler {
+	return &JobHandler{jobService: jobService}
+}
+
+// ListJobs GET /admin/jobs
+func (h *JobHandler) ListJobs(c *gin.Context) {
+	jobs, err := h.jobService.ListJobs(c.Request.Context(), extractUserContext(c))
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	response := dto.ScheduledJobListResponse{Jobs: make([]dto.ScheduledJobResponse, 0, len(jobs))}
+	for _, job := range jobs {
+		response.Jobs = append(response.Jobs, jobToResponse(job))
+	}
+	common.SuccessResponse(c, http.StatusOK, "Tareas programadas", response)
+}
+
+// RunJob POST /admin/jobs/:name/run
+func (h *JobHandler) RunJob(c *gin.Context) {
+	job, err := h.jobService.RunJob(c.Request.Context(), c.Param("name"), extractUserContext(c))
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	message := "Tarea ejecutada"
+	if job.LastOutcome == models.JobOutcomeFailed {
+		message = "La tarea ha terminado con errores"
+	}
+	common.SuccessResponse(c, http.StatusOK, message, jobToResponse(job))
+}
+
+// jobToResponse convierte una tarea a su respuesta
+func jobToResponse(job *models.ScheduledJob) dto.ScheduledJobResponse {
+	return dto.ScheduledJobResponse{
+		Name:           job.Name,
+		Schedule:       job.Schedule,
+		NextRunAt:      job.NextRunAt,
+		LastStartedAt:  job.LastStartedAt,
+		LastFinishedAt: job.LastFinishedAt,
+		LastOutcome:    string(job.LastOutcome),
+		LastResult:     job.LastResult,
+		LastError:      job.LastError,
+		LastDurationMs: job.LastDurationMs,
+		RunCount:       job.RunCount,
+		FailureCount:   job.FailureCount,
+	}
+}
@@ -0,0 +1,251 @@
+// internal/handlers/media_handler.go
+package handlers
+
+import (
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/dto"
+	"cybesphere-backend/internal/mappers"
+	"cybesphere-backend/internal/models"
+	"cybesphere-backend/internal/services"
+	"cybesphere-backend/pkg/storage"
+)
+
+// multipartOverhead margen para los campos y cabeceras del formulario multipart
+const multipartOverhead = 1 << 20
+
+// MediaHandler handler para la subida y descarga de ficheros
+type MediaHandler struct {
+	mediaService services.MediaService
+	mapper       *mappers.UnifiedMapper
+	maxBodySize  int64
+}
+
+// NewMediaHandler crea nueva instancia del handler. maxFileSize limita el
+// cuerpo de las subidas antes de leerlo
+func NewMediaHandler(
+	mediaService services.MediaService,
+	mapper *mappers.UnifiedMapper,
+	maxFileSize int64,
+) *MediaHandler {
+	return &MediaHandler{
+		mediaService: mediaService,
+		mapper:       mapper,
+		maxBodySize:  maxFileSize + multipartOverhead,
+	}
+}
+
+// Upload POST /media
+func (h *MediaHandler) Upload(c *gin.Context) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.maxBodySize)
+
+	var req dto.UploadMediaRequest
+	if err := c.ShouldBind(&req); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			common.ErrorResponse(c, common.NewValidationError("file", "El fichero supera el tamaño máximo permitido"))
+			return
+		}
+		common.ErrorResponse(c, common.NewValidationError("request", err.Error()))
+		return
+	}
+
+	header, err := c.FormFile("file")
+	if err != nil {
+		common.ErrorResponse(c, common.NewValidationError("file", "Falta el fichero (campo file)"))
+		return
+	}
+	file, err := header.Open()
+	if err != nil {
+		common.ErrorResponse(c, common.NewValidationError("file", "No se pudo leer el fichero"))
+		return
+	}
+	defer file.Close()
+
+	media, err := h.mediaService.Upload(c.Request.Context(), req, services.UploadedFile{
+		Name: header.Filename,
+		Size: header.Size,
+		Body: file,
+	}, extractUserContext(c))
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusCreated, "Fichero subido", h.mapper.MediaToUploadResponse(media))
+}
+
+// ListMedia GET /media
+func (h *MediaHandler) ListMedia(c *gin.Context) {
+	var query dto.MediaQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		common.ErrorResponse(c, common.NewValidationError("query", err.Error()))
+		return
+	}
+
+	opts := extractQueryOptions(c)
+	media, pagination, err := h.mediaService.ListMedia(c.Request.Context(), query, *opts, extractUserContext(c))
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Ficheros", h.mapper.MediaToListResponse(media, pagination))
+}
+
+// GetMedia GET /media/:id
+func (h *MediaHandler) GetMedia(c *gin.Context) {
+	media, err := h.mediaService.GetMedia(c.Request.Context(), c.Param("id"), extractUserContext(c))
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Fichero", h.mapper.MediaToResponse(media))
+}
+
+// DownloadMedia GET /media/:id/file
+func (h *MediaHandler) DownloadMedia(c *gin.Context) {
+	media, body, err := h.mediaService.OpenContent(c.Request.Context(), c.Param("id"), extractUserContext(c))
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+	defer body.Close()
+
+	serveMedia(c, media, body)
+}
+
+// GetPublicMedia GET /public/media/:id
+func (h *MediaHandler) GetPublicMedia(c *gin.Context) {
+	media, body, err := h.mediaService.OpenPublicContent(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+	defer body.Close()
+
+	serveMedia(c, media, body)
+}
+
+// GetPublicMediaVariant GET /public/media/:id/variants/:name
+func (h *MediaHandler) GetPublicMediaVariant(c *gin.Context) {
+	media, variant, body, err := h.mediaService.OpenPublicVariant(c.Request.Context(), c.Param("id"), c.Param("name"))
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+	defer body.Close()
+
+	serveContent(c, mediaContent{
+		fileName:    strings.TrimSuffix(media.FileName, path.Ext(media.FileName)) + "_" + variant.Name + path.Ext(variant.StorageKey),
+		contentType: variant.ContentType,
+		size:        variant.Size,
+		checksum:    variant.Checksum,
+		public:      true,
+	}, body)
+}
+
+// ReprocessMedia POST /media/:id/process
+func (h *MediaHandler) ReprocessMedia(c *gin.Context) {
+	media, err := h.mediaService.ReprocessMedia(c.Request.Context(), c.Param("id"), extractUserContext(c))
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusAccepted, "Imagen en cola para procesar", h.mapper.MediaToResponse(media))
+}
+
+// DeleteMedia DELETE /media/:id
+func (h *MediaHandler) DeleteMedia(c *gin.Context) {
+	if err := h.mediaService.DeleteMedia(c.Request.Context(), c.Param("id"), extractUserContext(c)); err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Fichero borrado", nil)
+}
+
+// AttachEventMedia PUT /events/:id/media/:field
+func (h *MediaHandler) AttachEventMedia(c *gin.Context) {
+	var req dto.AttachMediaRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.ErrorResponse(c, common.NewValidationError("request", err.Error()))
+		return
+	}
+
+	media, err := h.mediaService.AttachEventMedia(c.Request.Context(), c.Param("id"), c.Param("field"), req, extractUserContext(c))
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Imagen del evento actualizada", h.mapper.MediaToResponse(media))
+}
+
+// AttachOrganizationMedia PUT /organizations/:id/media/:field
+func (h *MediaHandler) AttachOrganizationMedia(c *gin.Context) {
+	var req dto.AttachMediaRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.ErrorResponse(c, common.NewValidationError("request", err.Error()))
+		return
+	}
+
+	media, err := h.mediaService.AttachOrganizationMedia(c.Request.Context(), c.Param("id"), c.Param("field"), req, extractUserContext(c))
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Imagen de la organización actualizada", h.mapper.MediaToResponse(media))
+}
+
+// mediaContent datos con los que se sirve un fichero o una de sus versiones
+type mediaContent struct {
+	fileName    string
+	contentType string
+	size        int64
+	checksum    string
+	public      bool
+}
+
+// serveMedia envía el fichero con el tipo detectado al subirlo
+func serveMedia(c *gin.Context, media *models.Media, body io.Reader) {
+	serveContent(c, mediaContent{
+		fileName:    media.FileName,
+		contentType: media.ContentType,
+		size:        media.Size,
+		checksum:    media.Checksum,
+		public:      media.IsPublic(),
+	}, body)
+}
+
+// serveContent envía el contenido. Las imágenes se muestran en línea; el
+// resto se descarga. nosniff impide que el navegador interprete el fichero
+// como otro tipo
+func serveContent(c *gin.Context, content mediaContent, body io.Reader) {
+	disposition := "attachment"
+	if storage.IsImage(content.contentType) {
+		disposition = "inline"
+	}
+	cacheControl := "private, no-store"
+	if content.public {
+		cacheControl = "public, max-age=86400"
+	}
+
+	c.DataFromReader(http.StatusOK, content.size, content.contentType, body, map[string]string{
+		"Content-Disposition":    mime.FormatMediaType(disposition, map[string]string{"filename": content.fileName}),
+		"Cache-Control":          cacheControl,
+		"X-Content-Type-Options": "nosniff",
+		"ETag":                   `"` + content.checksum + `"`,
+	})
+}
@@ -0,0 +1,232 @@
+// internal/handlers/moderation_handler.go
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/dto"
+	"cybesphere-backend/internal/mappers"
+	"cybesphere-backend/internal/models"
+	"cybesphere-backend/internal/services"
+)
+
+// ModerationHandler handler para las denuncias de contenido y la moderación
+type ModerationHandler struct {
+	moderationService services.ModerationService
+	mapper            *mappers.UnifiedMapper
+}
+
+// NewModerationHandler crea nueva instancia del handler
+func NewModerationHandler(
+	moderationService services.ModerationService,
+	mapper *mappers.UnifiedMapper,
+) *ModerationHandler {
+	return &ModerationHandler{
+		moderationService: moderationService,
+		mapper:            mapper,
+	}
+}
+
+// ReportEvent POST /events/:id/report
+func (h *ModerationHandler) ReportEvent(c *gin.Context) {
+	h.report(c, models.ReportResourceEvent)
+}
+
+// ReportOrganization POST /organizations/:id/report
+func (h *ModerationHandler) ReportOrganization(c *gin.Context) {
+	h.report(c, models.ReportResourceOrganization)
+}
+
+// report registra la denuncia del contenido de la ruta
+func (h *ModerationHandler) report(c *gin.Context, resourceType models.ReportResourceType) {
+	var req dto.ReportContentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.ErrorResponse(c, common.NewValidationError("request", err.Error()))
+		return
+	}
+
+	report, err := h.moderationService.ReportContent(c.Request.Context(), resourceType, c.Param("id"), req, extractUserContext(c))
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusCreated, "Denuncia registrada; la revisará el equipo de moderación",
+		h.mapper.ContentReportToResponse(report, false))
+}
+
+// ListOwnReports GET /user/reports
+func (h *ModerationHandler) ListOwnReports(c *gin.Context) {
+	opts := extractQueryOptions(c)
+	reports, pagination, err := h.moderationService.ListOwnReports(c.Request.Context(), *opts, extractUserContext(c))
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Mis denuncias", h.mapper.ContentReportsToListResponse(reports, pagination, false))
+}
+
+// ListReports GET /admin/moderation/reports
+func (h *ModerationHandler) ListReports(c *gin.Context) {
+	var query dto.ContentReportsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		common.ErrorResponse(c, common.NewValidationError("query", err.Error()))
+		return
+	}
+
+	opts := extractQueryOptions(c)
+	reports, pagination, err := h.moderationService.ListReports(c.Request.Context(), query, *opts, extractUserContext(c))
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Cola de moderación", h.mapper.ContentReportsToListResponse(reports, pagination, true))
+}
+
+// GetReport GET /admin/moderation/reports/:reportId
+func (h *ModerationHandler) GetReport(c *gin.Context) {
+	report, err := h.moderationService.GetReport(c.Request.Context(), c.Param("reportId"), extractUserContext(c))
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Denuncia", h.mapper.ContentReportToResponse(report, true))
+}
+
+// StartReview POST /admin/moderation/reports/:reportId/review
+func (h *ModerationHandler) StartReview(c *gin.Context) {
+	report, err := h.moderationService.StartReview(c.Request.Context(), c.Param("reportId"), extractUserContext(c))
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Denuncia en revisión", h.mapper.ContentReportToResponse(report, true))
+}
+
+// AddNote POST /admin/moderation/reports/:reportId/notes
+func (h *ModerationHandler) AddNote(c *gin.Context) {
+	var req dto.ModerationNoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.ErrorResponse(c, common.NewValidationError("request", err.Error()))
+		return
+	}
+
+	report, err := h.moderationService.AddNote(c.Request.Context(), c.Param("reportId"), req, extractUserContext(c))
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusCreated, "Nota añadida", h.mapper.ContentReportToResponse(report, true))
+}
+
+// ResolveReport POST /admin/moderation/reports/:reportId/resolve
+func (h *ModerationHandler) ResolveReport(c *gin.Context) {
+	var req dto.ResolveReportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.ErrorResponse(c, common.NewValidationError("request", err.Error()))
+		return
+	}
+
+	report, err := h.moderationService.ResolveReport(c.Request.Context(), c.Param("reportId"), req, extractUserContext(c))
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Denuncia resuelta", h.mapper.ContentReportToResponse(report, true))
+}
+
+// DismissReport POST /admin/moderation/reports/:reportId/dismiss
+func (h *ModerationHandler) DismissReport(c *gin.Context) {
+	var req dto.DismissReportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.ErrorResponse(c, common.NewValidationError("request", err.Error()))
+		return
+	}
+
+	report, err := h.moderationService.DismissReport(c.Request.Context(), c.Param("reportId"), req, extractUserContext(c))
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Denuncia descartada", h.mapper.ContentReportToResponse(report, true))
+}
+
+// RestoreEvent POST /admin/moderation/events/:id/restore
+func (h *ModerationHandler) RestoreEvent(c *gin.Context) {
+	h.restore(c, models.ReportResourceEvent)
+}
+
+// RestoreOrganization POST /admin/moderation/organizations/:id/restore
+func (h *ModerationHandler) RestoreOrganization(c *gin.Context) {
+	h.restore(c, models.ReportResourceOrganization)
+}
+
+// restore vuelve a mostrar el contenido de la ruta
+func (h *ModerationHandler) restore(c *gin.Context, resourceType models.ReportResourceType) {
+	err := h.moderationService.RestoreContent(c.Request.Context(), resourceType, c.Param("id"), extractUserContext(c))
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Contenido restaurado", gin.H{
+		"resource_type": resourceType,
+		"resource_id":   c.Param("id"),
+	})
+}
+
+// ListPendingEvents GET /admin/moderation/events
+func (h *ModerationHandler) ListPendingEvents(c *gin.Context) {
+	userCtx := extractUserContext(c)
+	opts := extractQueryOptions(c)
+
+	events, pagination, err := h.moderationService.ListPendingEvents(c.Request.Context(), *opts, userCtx)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Eventos pendientes de aprobación", h.mapper.EventsToListResponse(events, pagination, userCtx))
+}
+
+// ApproveEvent POST /admin/moderation/events/:id/approve
+func (h *ModerationHandler) ApproveEvent(c *gin.Context) {
+	userCtx := extractUserContext(c)
+
+	event, err := h.moderationService.ApproveEvent(c.Request.Context(), c.Param("id"), userCtx)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Evento aprobado y publicado", h.mapper.EventToResponse(event, userCtx))
+}
+
+// RejectEvent POST /admin/moderation/events/:id/reject
+func (h *ModerationHandler) RejectEvent(c *gin.Context) {
+	userCtx := extractUserContext(c)
+
+	var req dto.RejectEventRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.ErrorResponse(c, common.NewValidationError("request", err.Error()))
+		return
+	}
+
+	event, err := h.moderationService.RejectEvent(c.Request.Context(), c.Param("id"), req, userCtx)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Evento rechazado", h.mapper.EventToResponse(event, userCtx))
+}
@@ -0,0 +1,68 @@
+// internal/handlers/notification_handler.go
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/mappers"
+	"cybesphere-backend/internal/services"
+)
+
+// NotificationHandler handler para notificaciones in-app
+type NotificationHandler struct {
+	notificationService services.NotificationService
+	mapper              *mappers.UnifiedMapper
+}
+
+// NewNotificationHandler crea nueva instancia del handler
+func NewNotificationHandler(
+	notificationService services.NotificationService,
+	mapper *mappers.UnifiedMapper,
+) *NotificationHandler {
+	return &NotificationHandler{
+		notificationService: notificationService,
+		mapper:              mapper,
+	}
+}
+
+// ListNotifications GET /user/notifications
+func (h *NotificationHandler) ListNotifications(c *gin.Context) {
+	userCtx := extractUserContext(c)
+	opts := extractQueryOptions(c)
+
+	notifications, pagination, unread, err := h.notificationService.ListNotifications(c.Request.Context(), *opts, userCtx)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Notificaciones",
+		h.mapper.NotificationsToListResponse(notifications, unread, pagination))
+}
+
+// MarkAsRead POST /user/notifications/:notificationId/read
+func (h *NotificationHandler) MarkAsRead(c *gin.Context) {
+	userCtx := extractUserContext(c)
+
+	if err := h.notificationService.MarkAsRead(c.Request.Context(), c.Param("notificationId"), userCtx); err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Notificación marcada como leída", nil)
+}
+
+// MarkAllAsRead POST /user/notifications/read-all
+func (h *NotificationHandler) MarkAllAsRead(c *gin.Context) {
+	userCtx := extractUserContext(c)
+
+	if err := h.notificationService.MarkAllAsRead(c.Request.Context(), userCtx); err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Notificaciones marcadas como leídas", nil)
+}
@@ -64,6 +64,12 @@ func (h *OrganizationHandler) GetMembers(c *gin.Context) {
 	opts := extractQueryOptions(c)
 	userCtx := extractUserContext(c)
 
+	fields, err := fieldSelection[dto.UserResponse](opts)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
 	users, pagination, err := h.orgService.GetMembers(c.Request.Context(), orgID, *opts, userCtx)
 	if err != nil {
 		common.ErrorResponse(c, err)
@@ -71,13 +77,50 @@ func (h *OrganizationHandler) GetMembers(c *gin.Context) {
 	}
 
 	response := h.mapper.UsersToListResponse(users, pagination, userCtx)
-	common.SuccessWithPagination(c, "Miembros de la organización", response.Users, pagination)
+	data, err := selectFields(response.Users, fields)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+	common.SuccessWithPagination(c, "Miembros de la organización", data, pagination)
+}
+
+// Follow seguir una organización
+func (h *OrganizationHandler) Follow(c *gin.Context) {
+	orgID := c.Param("id")
+	userCtx := extractUserContext(c)
+
+	if err := h.orgService.FollowOrganization(c.Request.Context(), orgID, userCtx); err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Ahora sigues a la organización", nil)
+}
+
+// Unfollow dejar de seguir una organización
+func (h *OrganizationHandler) Unfollow(c *gin.Context) {
+	orgID := c.Param("id")
+	userCtx := extractUserContext(c)
+
+	if err := h.orgService.UnfollowOrganization(c.Request.Context(), orgID, userCtx); err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Has dejado de seguir a la organización", nil)
 }
 
 // GetActiveOrganizations obtiene organizaciones activas
 func (h *OrganizationHandler) GetActiveOrganizations(c *gin.Context) {
 	opts := extractQueryOptions(c)
 
+	fields, err := fieldSelection[dto.OrganizationResponse](opts)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
 	orgs, pagination, err := h.orgService.GetActiveOrganizations(c.Request.Context(), *opts)
 	if err != nil {
 		common.ErrorResponse(c, err)
@@ -86,5 +129,10 @@ func (h *OrganizationHandler) GetActiveOrganizations(c *gin.Context) {
 
 	// No hay userCtx porque es público
 	response := h.mapper.OrganizationsToListResponse(orgs, pagination, nil)
-	common.SuccessWithPagination(c, "Organizaciones activas", response.Organizations, pagination)
+	data, err := selectFields(response.Organizations, fields)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+	common.SuccessWithPagination(c, "Organizaciones activas", data, pagination)
 }
@@ -0,0 +1,170 @@
+// internal/handlers/payment_handler.go
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/dto"
+	"cybesphere-backend/internal/mappers"
+	"cybesphere-backend/internal/services"
+	"cybesphere-backend/pkg/payments"
+)
+
+// pdfContentType tipo de contenido de las facturas descargables
+const pdfContentType = "application/pdf"
+
+// PaymentHandler handler para pedidos, facturas y notificaciones de los proveedores de pago
+type PaymentHandler struct {
+	paymentService services.PaymentService
+	invoiceService services.InvoiceService
+	mapper         *mappers.UnifiedMapper
+}
+
+// NewPaymentHandler crea nueva instancia del handler
+func NewPaymentHandler(
+	paymentService services.PaymentService,
+	invoiceService services.InvoiceService,
+	mapper *mappers.UnifiedMapper,
+) *PaymentHandler {
+	return &PaymentHandler{
+		paymentService: paymentService,
+		invoiceService: invoiceService,
+		mapper:         mapper,
+	}
+}
+
+// =============================================================================
+// WEBHOOKS
+// =============================================================================
+
+// HandleWebhook POST /public/payments/:provider/webhook
+// La firma se verifica sobre el cuerpo sin procesar de la petición.
+func (h *PaymentHandler) HandleWebhook(c *gin.Context) {
+	payload, err := c.GetRawData()
+	if err != nil {
+		common.ErrorResponse(c, common.NewValidationError("payload", "No se pudo leer la notificación"))
+		return
+	}
+
+	if err := h.paymentService.HandleWebhook(
+		c.Request.Context(), c.Param("provider"), payload, c.GetHeader(payments.SignatureHeader)); err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Notificación procesada", nil)
+}
+
+// FakeCheckout POST /public/payments/fake/checkout/:paymentId
+// Solo disponible con el proveedor fake fuera de producción.
+func (h *PaymentHandler) FakeCheckout(c *gin.Context) {
+	var req dto.FakeCheckoutRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.ErrorResponse(c, common.NewValidationError("request", err.Error()))
+		return
+	}
+
+	succeed := req.Result == "succeeded"
+	if err := h.paymentService.SimulateCheckout(c.Request.Context(), c.Param("paymentId"), succeed); err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Pago simulado", nil)
+}
+
+// =============================================================================
+// PEDIDOS
+// =============================================================================
+
+// ListMyOrders GET /user/orders
+func (h *PaymentHandler) ListMyOrders(c *gin.Context) {
+	userCtx := extractUserContext(c)
+	opts := extractQueryOptions(c)
+
+	orders, pagination, err := h.paymentService.ListMyOrders(c.Request.Context(), *opts, userCtx)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Tus pedidos",
+		h.mapper.OrdersToListResponse(orders, pagination))
+}
+
+// GetOrder GET /user/orders/:orderId
+func (h *PaymentHandler) GetOrder(c *gin.Context) {
+	userCtx := extractUserContext(c)
+
+	order, err := h.paymentService.GetOrder(c.Request.Context(), c.Param("orderId"), userCtx)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Pedido", h.mapper.OrderToResponse(order))
+}
+
+// =============================================================================
+// FACTURAS
+// =============================================================================
+
+// ListOrderInvoices GET /user/orders/:orderId/invoices
+func (h *PaymentHandler) ListOrderInvoices(c *gin.Context) {
+	userCtx := extractUserContext(c)
+
+	invoices, err := h.invoiceService.ListOrderInvoices(c.Request.Context(), c.Param("orderId"), userCtx)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Facturas del pedido", h.mapper.InvoicesToResponse(invoices))
+}
+
+// ListOrganizationInvoices GET /organizations/:id/invoices
+func (h *PaymentHandler) ListOrganizationInvoices(c *gin.Context) {
+	userCtx := extractUserContext(c)
+	opts := extractQueryOptions(c)
+
+	invoices, pagination, err := h.invoiceService.ListOrganizationInvoices(c.Request.Context(), c.Param("id"), *opts, userCtx)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Facturas de la organización",
+		h.mapper.InvoicesToListResponse(invoices, pagination))
+}
+
+// GetInvoice GET /invoices/:invoiceId
+func (h *PaymentHandler) GetInvoice(c *gin.Context) {
+	userCtx := extractUserContext(c)
+
+	invoice, err := h.invoiceService.GetInvoice(c.Request.Context(), c.Param("invoiceId"), userCtx)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Factura", h.mapper.InvoiceToResponse(invoice))
+}
+
+// DownloadInvoice GET /invoices/:invoiceId/pdf
+func (h *PaymentHandler) DownloadInvoice(c *gin.Context) {
+	userCtx := extractUserContext(c)
+
+	invoice, body, err := h.invoiceService.RenderInvoicePDF(c.Request.Context(), c.Param("invoiceId"), userCtx)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="factura-%s.pdf"`, invoice.Number))
+	c.Header("Cache-Control", "private, no-store")
+	c.Data(http.StatusOK, pdfContentType, body)
+}
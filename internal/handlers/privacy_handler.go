@@ -0,0 +1,166 @@
+// internal/handlers/privacy_handler.go
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/dto"
+	"cybesphere-backend/internal/mappers"
+	"cybesphere-backend/internal/models"
+	"cybesphere-backend/internal/services"
+)
+
+// zipContentType tipo MIME de los archivos de exportación
+const zipContentType = "application/zip"
+
+// PrivacyHandler handler para la exportación de datos personales y la supresión de cuentas
+type PrivacyHandler struct {
+	privacyService services.PrivacyService
+	mapper         *mappers.UnifiedMapper
+}
+
+// NewPrivacyHandler crea nueva instancia del handler
+func NewPrivacyHandler(
+	privacyService services.PrivacyService,
+	mapper *mappers.UnifiedMapper,
+) *PrivacyHandler {
+	return &PrivacyHandler{
+		privacyService: privacyService,
+		mapper:         mapper,
+	}
+}
+
+// RequestDataExport POST /user/data-export
+func (h *PrivacyHandler) RequestDataExport(c *gin.Context) {
+	export, err := h.privacyService.RequestDataExport(c.Request.Context(), extractUserContext(c))
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusAccepted, "Estamos preparando el archivo con tus datos", h.mapper.DataExportToResponse(export))
+}
+
+// ListDataExports GET /user/data-export
+func (h *PrivacyHandler) ListDataExports(c *gin.Context) {
+	exports, err := h.privacyService.ListDataExports(c.Request.Context(), extractUserContext(c))
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Exportaciones de tus datos", h.mapper.DataExportsToListResponse(exports))
+}
+
+// GetDataExport GET /user/data-export/:exportId
+func (h *PrivacyHandler) GetDataExport(c *gin.Context) {
+	export, err := h.privacyService.GetDataExport(c.Request.Context(), c.Param("exportId"), extractUserContext(c))
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Exportación obtenida", h.mapper.DataExportToResponse(export))
+}
+
+// DownloadDataExport GET /user/data-export/:exportId/download
+func (h *PrivacyHandler) DownloadDataExport(c *gin.Context) {
+	export, err := h.privacyService.DownloadDataExport(c.Request.Context(), c.Param("exportId"), extractUserContext(c))
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="cybesphere-datos-%s.zip"`, export.CompletedAt.Format("20060102")))
+	c.Header("Cache-Control", "private, no-store")
+	c.Header("Digest", "sha-256="+export.Checksum)
+	c.Data(http.StatusOK, zipContentType, export.Archive)
+}
+
+// RequestErasure DELETE /user/account
+func (h *PrivacyHandler) RequestErasure(c *gin.Context) {
+	var req dto.AccountErasureRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.ErrorResponse(c, common.NewValidationError("request", err.Error()))
+		return
+	}
+
+	request, err := h.privacyService.RequestErasure(c.Request.Context(), req, extractUserContext(c))
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	message := "Solicitud de supresión registrada; puedes retirarla antes de la fecha prevista"
+	if request.Status == models.ErasureStatusCompleted {
+		message = "Cuenta suprimida"
+	}
+	common.SuccessResponse(c, http.StatusAccepted, message, h.mapper.ErasureRequestToResponse(request))
+}
+
+// GetErasureRequest GET /user/account/erasure
+func (h *PrivacyHandler) GetErasureRequest(c *gin.Context) {
+	request, err := h.privacyService.GetErasureRequest(c.Request.Context(), extractUserContext(c))
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Solicitud de supresión", h.mapper.ErasureRequestToResponse(request))
+}
+
+// CancelOwnErasure DELETE /user/account/erasure
+func (h *PrivacyHandler) CancelOwnErasure(c *gin.Context) {
+	request, err := h.privacyService.CancelOwnErasure(c.Request.Context(), extractUserContext(c))
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Solicitud de supresión retirada", h.mapper.ErasureRequestToResponse(request))
+}
+
+// ListErasureRequests GET /admin/erasure-requests
+func (h *PrivacyHandler) ListErasureRequests(c *gin.Context) {
+	var query dto.ErasureRequestsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		common.ErrorResponse(c, common.NewValidationError("status", err.Error()))
+		return
+	}
+
+	opts := extractQueryOptions(c)
+	requests, pagination, err := h.privacyService.ListErasureRequests(
+		c.Request.Context(), models.ErasureStatus(query.Status), *opts, extractUserContext(c))
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Solicitudes de supresión", h.mapper.ErasureRequestsToListResponse(requests, pagination))
+}
+
+// ExecuteErasure POST /admin/erasure-requests/:requestId/execute
+func (h *PrivacyHandler) ExecuteErasure(c *gin.Context) {
+	request, err := h.privacyService.ExecuteErasure(c.Request.Context(), c.Param("requestId"), extractUserContext(c))
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Cuenta suprimida", h.mapper.ErasureRequestToResponse(request))
+}
+
+// CancelErasure POST /admin/erasure-requests/:requestId/cancel
+func (h *PrivacyHandler) CancelErasure(c *gin.Context) {
+	request, err := h.privacyService.CancelErasure(c.Request.Context(), c.Param("requestId"), extractUserContext(c))
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Solicitud de supresión retirada", h.mapper.ErasureRequestToResponse(request))
+}
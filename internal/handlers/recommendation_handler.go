@@ -0,0 +1,52 @@
+// internal/handlers/recommendation_handler.go
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/dto"
+	"cybesphere-backend/internal/mappers"
+	"cybesphere-backend/internal/services"
+)
+
+// RecommendationHandler handler para las recomendaciones personalizadas
+type RecommendationHandler struct {
+	recommendationService services.RecommendationService
+	mapper                *mappers.UnifiedMapper
+}
+
+// NewRecommendationHandler crea nueva instancia del handler
+func NewRecommendationHandler(
+	recommendationService services.RecommendationService,
+	mapper *mappers.UnifiedMapper,
+) *RecommendationHandler {
+	return &RecommendationHandler{
+		recommendationService: recommendationService,
+		mapper:                mapper,
+	}
+}
+
+// GetRecommendations GET /user/recommendations
+func (h *RecommendationHandler) GetRecommendations(c *gin.Context) {
+	opts := extractQueryOptions(c)
+	userCtx := extractUserContext(c)
+
+	result, err := h.recommendationService.GetRecommendations(c.Request.Context(), *opts, userCtx)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	response := dto.RecommendationsResponse{
+		Recommendations: make([]dto.RecommendedEventResponse, 0, len(result.Events)),
+		Pagination:      *result.Pagination,
+	}
+	for _, rec := range result.Events {
+		response.Recommendations = append(response.Recommendations, h.mapper.RecommendationToResponse(rec.Event, rec.Recommendation))
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Eventos recomendados para ti", response)
+}
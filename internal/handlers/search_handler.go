@@ -0,0 +1,67 @@
+// internal/handlers/search_handler.go
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/dto"
+	"cybesphere-backend/internal/mappers"
+	"cybesphere-backend/internal/services"
+)
+
+// SearchHandler handler para la búsqueda full-text
+type SearchHandler struct {
+	searchService services.SearchService
+	mapper        *mappers.UnifiedMapper
+}
+
+// NewSearchHandler crea nueva instancia del handler
+func NewSearchHandler(
+	searchService services.SearchService,
+	mapper *mappers.UnifiedMapper,
+) *SearchHandler {
+	return &SearchHandler{
+		searchService: searchService,
+		mapper:        mapper,
+	}
+}
+
+// Search GET /public/search
+func (h *SearchHandler) Search(c *gin.Context) {
+	started := time.Now()
+
+	var req dto.SearchRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		common.ErrorResponse(c, common.NewValidationError("request", err.Error()))
+		return
+	}
+
+	result, err := h.searchService.Search(c.Request.Context(), req)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	response := dto.SearchResponse{
+		Query: result.Query,
+		Results: dto.SearchResultsResponse{
+			Events:        h.mapper.EventSearchHitsToResponse(result.Events),
+			Organizations: h.mapper.OrganizationSearchHitsToResponse(result.Organizations),
+			Tags:          h.mapper.FacetCountsToResponse(result.Tags),
+			Pagination:    *result.Pagination,
+		},
+		Total:       int(result.Total()),
+		Suggestions: result.Suggestions,
+		Took:        time.Since(started).Milliseconds(),
+	}
+	// Evitar un objeto con valor nil tipado que no omitiría omitempty
+	if facets := h.mapper.EventFacetsToResponse(result.Facets); facets != nil {
+		response.Facets = facets
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Resultados de búsqueda", response)
+}
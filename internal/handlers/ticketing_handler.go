@@ -0,0 +1,261 @@
+// internal/handlers/ticketing_handler.go
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/dto"
+	"cybesphere-backend/internal/mappers"
+	"cybesphere-backend/internal/services"
+)
+
+// TicketingHandler handler para tipos de entrada, códigos de descuento e inscripciones
+type TicketingHandler struct {
+	ticketingService services.TicketingService
+	mapper           *mappers.UnifiedMapper
+}
+
+// NewTicketingHandler crea nueva instancia del handler
+func NewTicketingHandler(
+	ticketingService services.TicketingService,
+	mapper *mappers.UnifiedMapper,
+) *TicketingHandler {
+	return &TicketingHandler{
+		ticketingService: ticketingService,
+		mapper:           mapper,
+	}
+}
+
+// =============================================================================
+// TIPOS DE ENTRADA
+// =============================================================================
+
+// ListTicketTypes GET /public/events/:id/tickets
+func (h *TicketingHandler) ListTicketTypes(c *gin.Context) {
+	userCtx := extractUserContext(c)
+
+	event, ticketTypes, err := h.ticketingService.ListTicketTypes(c.Request.Context(), c.Param("id"), userCtx)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Entradas del evento",
+		h.mapper.TicketTypesToEventResponse(event, ticketTypes))
+}
+
+// CreateTicketType POST /events/:id/ticket-types
+func (h *TicketingHandler) CreateTicketType(c *gin.Context) {
+	userCtx := extractUserContext(c)
+
+	var req dto.CreateTicketTypeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.ErrorResponse(c, common.NewValidationError("request", err.Error()))
+		return
+	}
+
+	ticketType, err := h.ticketingService.CreateTicketType(c.Request.Context(), c.Param("id"), req, userCtx)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusCreated, "Tipo de entrada creado",
+		h.mapper.TicketTypeToResponse(ticketType))
+}
+
+// UpdateTicketType PUT /events/:id/ticket-types/:ticketTypeId
+func (h *TicketingHandler) UpdateTicketType(c *gin.Context) {
+	userCtx := extractUserContext(c)
+
+	var req dto.UpdateTicketTypeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.ErrorResponse(c, common.NewValidationError("request", err.Error()))
+		return
+	}
+
+	ticketType, err := h.ticketingService.UpdateTicketType(
+		c.Request.Context(), c.Param("id"), c.Param("ticketTypeId"), req, userCtx)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Tipo de entrada actualizado",
+		h.mapper.TicketTypeToResponse(ticketType))
+}
+
+// DeleteTicketType DELETE /events/:id/ticket-types/:ticketTypeId
+func (h *TicketingHandler) DeleteTicketType(c *gin.Context) {
+	userCtx := extractUserContext(c)
+
+	if err := h.ticketingService.DeleteTicketType(c.Request.Context(), c.Param("id"), c.Param("ticketTypeId"), userCtx); err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Tipo de entrada eliminado", nil)
+}
+
+// =============================================================================
+// CÓDIGOS DE DESCUENTO
+// =============================================================================
+
+// ListPromoCodes GET /events/:id/promo-codes
+func (h *TicketingHandler) ListPromoCodes(c *gin.Context) {
+	userCtx := extractUserContext(c)
+
+	promos, err := h.ticketingService.ListPromoCodes(c.Request.Context(), c.Param("id"), userCtx)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Códigos de descuento",
+		h.mapper.PromoCodesToResponse(promos))
+}
+
+// CreatePromoCode POST /events/:id/promo-codes
+func (h *TicketingHandler) CreatePromoCode(c *gin.Context) {
+	userCtx := extractUserContext(c)
+
+	var req dto.CreatePromoCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.ErrorResponse(c, common.NewValidationError("request", err.Error()))
+		return
+	}
+
+	promo, err := h.ticketingService.CreatePromoCode(c.Request.Context(), c.Param("id"), req, userCtx)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusCreated, "Código de descuento creado",
+		h.mapper.PromoCodeToResponse(promo))
+}
+
+// UpdatePromoCode PUT /events/:id/promo-codes/:promoCodeId
+func (h *TicketingHandler) UpdatePromoCode(c *gin.Context) {
+	userCtx := extractUserContext(c)
+
+	var req dto.UpdatePromoCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.ErrorResponse(c, common.NewValidationError("request", err.Error()))
+		return
+	}
+
+	promo, err := h.ticketingService.UpdatePromoCode(
+		c.Request.Context(), c.Param("id"), c.Param("promoCodeId"), req, userCtx)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Código de descuento actualizado",
+		h.mapper.PromoCodeToResponse(promo))
+}
+
+// DeletePromoCode DELETE /events/:id/promo-codes/:promoCodeId
+func (h *TicketingHandler) DeletePromoCode(c *gin.Context) {
+	userCtx := extractUserContext(c)
+
+	if err := h.ticketingService.DeletePromoCode(c.Request.Context(), c.Param("id"), c.Param("promoCodeId"), userCtx); err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Código de descuento eliminado", nil)
+}
+
+// =============================================================================
+// INSCRIPCIONES
+// =============================================================================
+
+// QuotePrice POST /events/:id/price-quote
+func (h *TicketingHandler) QuotePrice(c *gin.Context) {
+	userCtx := extractUserContext(c)
+
+	var req dto.TicketSelectionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.ErrorResponse(c, common.NewValidationError("request", err.Error()))
+		return
+	}
+
+	selection, err := h.ticketingService.QuotePrice(c.Request.Context(), c.Param("id"), req, userCtx)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Precio calculado",
+		h.mapper.PriceQuoteToResponse(selection.Event.ID.String(), selection.TicketType, selection.PromoCode, selection.Quote))
+}
+
+// Register POST /events/:id/register
+func (h *TicketingHandler) Register(c *gin.Context) {
+	userCtx := extractUserContext(c)
+
+	var req dto.TicketSelectionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.ErrorResponse(c, common.NewValidationError("request", err.Error()))
+		return
+	}
+
+	registration, err := h.ticketingService.Register(c.Request.Context(), c.Param("id"), req, userCtx)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusCreated, "Inscripción realizada",
+		h.mapper.RegistrationToResponse(registration))
+}
+
+// CancelRegistration DELETE /events/:id/register
+func (h *TicketingHandler) CancelRegistration(c *gin.Context) {
+	userCtx := extractUserContext(c)
+
+	registration, err := h.ticketingService.CancelRegistration(c.Request.Context(), c.Param("id"), userCtx)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Inscripción cancelada",
+		h.mapper.RegistrationToResponse(registration))
+}
+
+// ListMyRegistrations GET /user/registrations
+func (h *TicketingHandler) ListMyRegistrations(c *gin.Context) {
+	userCtx := extractUserContext(c)
+	opts := extractQueryOptions(c)
+
+	registrations, pagination, err := h.ticketingService.ListMyRegistrations(c.Request.Context(), *opts, userCtx)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Tus inscripciones",
+		h.mapper.RegistrationsToListResponse(registrations, pagination))
+}
+
+// ListAttendees GET /events/:id/attendees
+func (h *TicketingHandler) ListAttendees(c *gin.Context) {
+	userCtx := extractUserContext(c)
+	opts := extractQueryOptions(c)
+
+	event, registrations, pagination, counts, err := h.ticketingService.ListRegistrations(
+		c.Request.Context(), c.Param("id"), *opts, userCtx)
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Asistentes del evento",
+		h.mapper.RegistrationsToAttendeesResponse(event, registrations, counts, pagination))
+}
@@ -0,0 +1,150 @@
+// internal/handlers/verification_handler.go
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/dto"
+	"cybesphere-backend/internal/mappers"
+	"cybesphere-backend/internal/services"
+)
+
+// VerificationHandler handler para las solicitudes de verificación de organizaciones
+type VerificationHandler struct {
+	verificationService services.VerificationService
+	mapper              *mappers.UnifiedMapper
+}
+
+// NewVerificationHandler crea nueva instancia del handler
+func NewVerificationHandler(
+	verificationService services.VerificationService,
+	mapper *mappers.UnifiedMapper,
+) *VerificationHandler {
+	return &VerificationHandler{
+		verificationService: verificationService,
+		mapper:              mapper,
+	}
+}
+
+// SubmitVerification POST /organizations/:id/verification
+func (h *VerificationHandler) SubmitVerification(c *gin.Context) {
+	var req dto.SubmitVerificationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.ErrorResponse(c, common.NewValidationError("request", err.Error()))
+		return
+	}
+
+	verification, err := h.verificationService.SubmitVerification(c.Request.Context(), c.Param("id"), req, extractUserContext(c))
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusCreated, "Solicitud de verificación enviada; la revisará un administrador",
+		h.mapper.VerificationToResponse(verification, false))
+}
+
+// GetVerificationStatus GET /organizations/:id/verification
+func (h *VerificationHandler) GetVerificationStatus(c *gin.Context) {
+	org, history, err := h.verificationService.GetVerificationStatus(c.Request.Context(), c.Param("id"), extractUserContext(c))
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Estado de verificación", h.mapper.VerificationStatusToResponse(org, history))
+}
+
+// ListVerifications GET /admin/verifications
+func (h *VerificationHandler) ListVerifications(c *gin.Context) {
+	var query dto.VerificationsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		common.ErrorResponse(c, common.NewValidationError("query", err.Error()))
+		return
+	}
+
+	opts := extractQueryOptions(c)
+	verifications, pagination, err := h.verificationService.ListVerifications(c.Request.Context(), query, *opts, extractUserContext(c))
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Cola de verificación", h.mapper.VerificationsToListResponse(verifications, pagination))
+}
+
+// GetVerification GET /admin/verifications/:verificationId
+func (h *VerificationHandler) GetVerification(c *gin.Context) {
+	verification, history, err := h.verificationService.GetVerification(c.Request.Context(), c.Param("verificationId"), extractUserContext(c))
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Solicitud de verificación", h.mapper.VerificationToDetailResponse(verification, history))
+}
+
+// StartReview POST /admin/verifications/:verificationId/review
+func (h *VerificationHandler) StartReview(c *gin.Context) {
+	verification, err := h.verificationService.StartReview(c.Request.Context(), c.Param("verificationId"), extractUserContext(c))
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Solicitud en revisión", h.mapper.VerificationToResponse(verification, true))
+}
+
+// ApproveVerification POST /admin/verifications/:verificationId/approve
+func (h *VerificationHandler) ApproveVerification(c *gin.Context) {
+	var req dto.ApproveVerificationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.ErrorResponse(c, common.NewValidationError("request", err.Error()))
+		return
+	}
+
+	verification, err := h.verificationService.ApproveVerification(c.Request.Context(), c.Param("verificationId"), req, extractUserContext(c))
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Organización verificada", h.mapper.VerificationToResponse(verification, true))
+}
+
+// RejectVerification POST /admin/verifications/:verificationId/reject
+func (h *VerificationHandler) RejectVerification(c *gin.Context) {
+	var req dto.RejectVerificationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.ErrorResponse(c, common.NewValidationError("request", err.Error()))
+		return
+	}
+
+	verification, err := h.verificationService.RejectVerification(c.Request.Context(), c.Param("verificationId"), req, extractUserContext(c))
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Solicitud de verificación rechazada", h.mapper.VerificationToResponse(verification, true))
+}
+
+// RequestInfo POST /admin/verifications/:verificationId/request-info
+func (h *VerificationHandler) RequestInfo(c *gin.Context) {
+	var req dto.RequestVerificationInfoRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.ErrorResponse(c, common.NewValidationError("request", err.Error()))
+		return
+	}
+
+	verification, err := h.verificationService.RequestInfo(c.Request.Context(), c.Param("verificationId"), req, extractUserContext(c))
+	if err != nil {
+		common.ErrorResponse(c, err)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, "Se ha pedido más documentación a la organización", h.mapper.VerificationToResponse(verification, true))
+}
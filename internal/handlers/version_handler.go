@@ -0,0 +1,142 @@
+// internal/handlers/version_handler.go
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/dto"
+	"cybesphere-backend/internal/models"
+	"cybesphere-backend/internal/services"
+)
+
+// VersionHandler handler para el historial de versiones. Cada método recibe
+// el recurso (event u organization) y devuelve el handler de sus rutas
+type VersionHandler struct {
+	versionService services.VersionService
+}
+
+// NewVersionHandler crea nueva instancia del handler
+func NewVersionHandler(versionService services.VersionService) *VersionHandler {
+	return &VersionHandler{versionService: versionService}
+}
+
+// List GET /events/:id/versions y /organizations/:id/versions
+func (h *VersionHandler) List(resource string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		opts := extractQueryOptions(c)
+		userCtx := extractUserContext(c)
+
+		versions, pagination, err := h.versionService.ListVersions(c.Request.Context(), resource, c.Param("id"), *opts, userCtx)
+		if err != nil {
+			common.ErrorResponse(c, err)
+			return
+		}
+
+		response := dto.EntityVersionsResponse{
+			Versions:   make([]dto.EntityVersionResponse, 0, len(versions)),
+			Pagination: *pagination,
+		}
+		for _, version := range versions {
+			response.Versions = append(response.Versions, versionToResponse(version, false))
+		}
+
+		common.SuccessResponse(c, http.StatusOK, "Historial de versiones", response)
+	}
+}
+
+// Get GET /events/:id/versions/:version y /organizations/:id/versions/:version
+func (h *VersionHandler) Get(resource string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		number, ok := versionParam(c)
+		if !ok {
+			return
+		}
+
+		version, err := h.versionService.GetVersion(c.Request.Context(), resource, c.Param("id"), number, extractUserContext(c))
+		if err != nil {
+			common.ErrorResponse(c, err)
+			return
+		}
+
+		common.SuccessResponse(c, http.StatusOK, "Versión obtenida", versionToResponse(version, true))
+	}
+}
+
+// Diff GET /events/:id/versions/diff y /organizations/:id/versions/diff
+func (h *VersionHandler) Diff(resource string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req dto.VersionDiffRequest
+		if err := c.ShouldBindQuery(&req); err != nil {
+			common.ErrorResponse(c, common.NewValidationError("request", err.Error()))
+			return
+		}
+
+		diff, err := h.versionService.DiffVersions(c.Request.Context(), resource, c.Param("id"), req.From, req.To, extractUserContext(c))
+		if err != nil {
+			common.ErrorResponse(c, err)
+			return
+		}
+
+		response := dto.VersionDiffResponse{
+			From:    versionToResponse(diff.From, false),
+			To:      versionToResponse(diff.To, false),
+			Changes: make(map[string]dto.FieldChangeResponse, len(diff.Changes)),
+		}
+		for column, change := range diff.Changes {
+			response.Changes[column] = dto.FieldChangeResponse{From: change.From, To: change.To}
+		}
+
+		common.SuccessResponse(c, http.StatusOK, "Diferencias entre versiones", response)
+	}
+}
+
+// Restore POST /events/:id/versions/:version/restore y /organizations/:id/versions/:version/restore
+func (h *VersionHandler) Restore(resource string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		number, ok := versionParam(c)
+		if !ok {
+			return
+		}
+
+		version, err := h.versionService.RestoreVersion(c.Request.Context(), resource, c.Param("id"), number, extractUserContext(c))
+		if err != nil {
+			common.ErrorResponse(c, err)
+			return
+		}
+
+		common.SuccessResponse(c, http.StatusOK, "Versión restaurada", versionToResponse(version, false))
+	}
+}
+
+// versionParam número de versión de la ruta; responde con error si no es válido
+func versionParam(c *gin.Context) (int, bool) {
+	number, err := strconv.Atoi(c.Param("version"))
+	if err != nil || number < 1 {
+		common.ErrorResponse(c, common.NewValidationError("version", "Número de versión inválido"))
+		return 0, false
+	}
+	return number, true
+}
+
+// versionToResponse convierte una versión; el estado completo solo en el detalle
+func versionToResponse(version *models.EntityVersion, withSnapshot bool) dto.EntityVersionResponse {
+	response := dto.EntityVersionResponse{
+		Version:       version.Version,
+		Action:        version.Action,
+		UserID:        version.UserID,
+		RestoredFrom:  version.RestoredFrom,
+		ChangedFields: version.ChangedFields,
+		CreatedAt:     version.CreatedAt,
+	}
+	if response.ChangedFields == nil {
+		response.ChangedFields = []string{}
+	}
+	if withSnapshot {
+		response.Snapshot = version.Snapshot
+	}
+	return response
+}
@@ -0,0 +1,175 @@
+package mappers
+
+import (
+	"sort"
+	"time"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/dto"
+	"cybesphere-backend/internal/models"
+)
+
+// AgendaMapperImpl implementación del mapper de agenda y ponentes
+type AgendaMapperImpl struct{}
+
+// NewAgendaMapper crea nueva instancia del mapper
+func NewAgendaMapper() AgendaMapperImpl {
+	return AgendaMapperImpl{}
+}
+
+// SpeakerToResponse convierte un ponente a su respuesta; el email solo se muestra a quien gestiona la organización
+func (m AgendaMapperImpl) SpeakerToResponse(speaker *models.Speaker, userCtx *common.UserContext) dto.SpeakerResponse {
+	response := dto.SpeakerResponse{
+		ID:             speaker.ID.String(),
+		OrganizationID: speaker.OrganizationID,
+		UserID:         speaker.UserID,
+		Name:           speaker.Name,
+		Position:       speaker.Position,
+		Company:        speaker.Company,
+		Bio:            speaker.Bio,
+		PhotoURL:       speaker.PhotoURL,
+		Website:        speaker.Website,
+		LinkedIn:       speaker.LinkedIn,
+		Twitter:        speaker.Twitter,
+		GitHub:         speaker.GitHub,
+	}
+
+	if userCtx != nil && userCtx.CanManageOrganization(speaker.OrganizationID) {
+		response.Email = speaker.Email
+	}
+
+	return response
+}
+
+// SpeakerToDetailResponse convierte un ponente con sus sesiones; las fechas se expresan en la zona de cada evento
+func (m AgendaMapperImpl) SpeakerToDetailResponse(speaker *models.Speaker, userCtx *common.UserContext) dto.SpeakerDetailResponse {
+	response := dto.SpeakerDetailResponse{
+		SpeakerResponse: m.SpeakerToResponse(speaker, userCtx),
+		Sessions:        make([]dto.EventSessionResponse, 0, len(speaker.Sessions)),
+	}
+
+	for i := range speaker.Sessions {
+		response.Sessions = append(response.Sessions, m.EventSessionToResponse(&speaker.Sessions[i], nil, false))
+	}
+
+	return response
+}
+
+// SpeakersToListResponse convierte una lista paginada de ponentes
+func (m AgendaMapperImpl) SpeakersToListResponse(speakers []*models.Speaker, pagination *common.PaginationMeta, userCtx *common.UserContext) dto.SpeakerListResponse {
+	responses := make([]dto.SpeakerResponse, 0, len(speakers))
+	for _, speaker := range speakers {
+		responses = append(responses, m.SpeakerToResponse(speaker, userCtx))
+	}
+
+	return dto.SpeakerListResponse{
+		Speakers:   responses,
+		Pagination: *pagination,
+	}
+}
+
+// EventSessionToResponse convierte una sesión a su respuesta en la zona horaria indicada
+// (nil = zona del evento si está precargado, UTC en otro caso)
+func (m AgendaMapperImpl) EventSessionToResponse(session *models.EventSession, loc *time.Location, isFavorite bool) dto.EventSessionResponse {
+	if loc == nil {
+		loc = sessionLocation(session)
+	}
+
+	response := dto.EventSessionResponse{
+		ID:          session.ID.String(),
+		EventID:     session.EventID,
+		Title:       session.Title,
+		Description: session.Description,
+		StartTime:   session.StartTime.In(loc),
+		EndTime:     session.EndTime.In(loc),
+		Duration:    int(session.Duration().Minutes()),
+		Room:        session.Room,
+		Track:       session.Track,
+		Level:       string(session.Level),
+		Tags:        session.GetTags(),
+		Speakers:    make([]dto.SpeakerSummaryResponse, 0, len(session.Speakers)),
+		IsFavorite:  isFavorite,
+	}
+
+	if session.Event != nil {
+		response.EventTitle = session.Event.Title
+		response.EventSlug = session.Event.Slug
+	}
+
+	for _, speaker := range session.Speakers {
+		response.Speakers = append(response.Speakers, dto.SpeakerSummaryResponse{
+			ID:       speaker.ID.String(),
+			Name:     speaker.Name,
+			Position: speaker.Position,
+			Company:  speaker.Company,
+			PhotoURL: speaker.PhotoURL,
+		})
+	}
+
+	return response
+}
+
+// SessionsToAgendaResponse agrupa las sesiones por día en la zona horaria indicada
+func (m AgendaMapperImpl) SessionsToAgendaResponse(eventID string, sessions []*models.EventSession, loc *time.Location, favorites map[string]bool) dto.AgendaResponse {
+	response := dto.AgendaResponse{
+		EventID:  eventID,
+		Timezone: loc.String(),
+		Tracks:   []string{},
+		Rooms:    []string{},
+		Days:     []dto.AgendaDayResponse{},
+	}
+
+	tracks := make(map[string]bool)
+	rooms := make(map[string]bool)
+
+	for _, session := range sessions {
+		item := m.EventSessionToResponse(session, loc, favorites[session.ID.String()])
+
+		date := item.StartTime.Format("2006-01-02")
+		if n := len(response.Days); n == 0 || response.Days[n-1].Date != date {
+			response.Days = append(response.Days, dto.AgendaDayResponse{Date: date})
+		}
+		day := &response.Days[len(response.Days)-1]
+		day.Sessions = append(day.Sessions, item)
+
+		if session.Track != "" && !tracks[session.Track] {
+			tracks[session.Track] = true
+			response.Tracks = append(response.Tracks, session.Track)
+		}
+		if session.Room != "" && !rooms[session.Room] {
+			rooms[session.Room] = true
+			response.Rooms = append(response.Rooms, session.Room)
+		}
+	}
+
+	sort.Strings(response.Tracks)
+	sort.Strings(response.Rooms)
+
+	return response
+}
+
+// SessionsToScheduleResponse convierte la agenda personal del usuario
+func (m AgendaMapperImpl) SessionsToScheduleResponse(sessions []*models.EventSession, loc *time.Location) dto.ScheduleResponse {
+	response := dto.ScheduleResponse{
+		Sessions: make([]dto.EventSessionResponse, 0, len(sessions)),
+	}
+	if loc != nil {
+		response.Timezone = loc.String()
+	}
+
+	for _, session := range sessions {
+		response.Sessions = append(response.Sessions, m.EventSessionToResponse(session, loc, true))
+	}
+
+	return response
+}
+
+// sessionLocation obtiene la zona horaria del evento de la sesión
+func sessionLocation(session *models.EventSession) *time.Location {
+	if session.Event != nil && session.Event.Timezone != "" {
+		if loc, err := time.LoadLocation(session.Event.Timezone); err == nil {
+			return loc
+		}
+	}
+	return time.UTC
+}
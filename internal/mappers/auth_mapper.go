@@ -198,14 +198,15 @@ func (m AuthMapperImpl) userToResponseForAuth(user *models.User) dto.UserRespons
 	// Incluir organización si existe
 	if user.Organization != nil {
 		response.Organization = &dto.OrganizationSummaryResponse{
-			ID:          user.Organization.ID.String(),
-			Slug:        user.Organization.Slug,
-			Name:        user.Organization.Name,
-			LogoURL:     user.Organization.LogoURL,
-			IsVerified:  user.Organization.IsVerified,
-			EventsCount: user.Organization.EventsCount,
-			City:        user.Organization.City,
-			Country:     user.Organization.Country,
+			ID:           user.Organization.ID.String(),
+			Slug:         user.Organization.Slug,
+			Name:         user.Organization.Name,
+			LogoURL:      user.Organization.LogoURL,
+			LogoVariants: user.Organization.LogoVariants(),
+			IsVerified:   user.Organization.IsVerified,
+			EventsCount:  user.Organization.EventsCount,
+			City:         user.Organization.City,
+			Country:      user.Organization.Country,
 		}
 	}
 
@@ -0,0 +1,36 @@
+package mappers
+
+import (
+	"cybesphere-backend/internal/dto"
+	"cybesphere-backend/internal/models"
+)
+
+// CalendarMapperImpl implementación del mapper de feeds de calendario
+type CalendarMapperImpl struct{}
+
+// NewCalendarMapper crea nueva instancia del mapper
+func NewCalendarMapper() CalendarMapperImpl {
+	return CalendarMapperImpl{}
+}
+
+// CalendarFeedToResponse convierte un feed a su respuesta; feedURL solo se informa al crearlo
+func (m CalendarMapperImpl) CalendarFeedToResponse(feed *models.CalendarFeed, feedURL string) dto.CalendarFeedResponse {
+	return dto.CalendarFeedResponse{
+		ID:             feed.ID.String(),
+		Name:           feed.Name,
+		Scope:          string(feed.Scope),
+		TargetID:       feed.TargetID,
+		FeedURL:        feedURL,
+		CreatedAt:      feed.CreatedAt,
+		LastAccessedAt: feed.LastAccessedAt,
+	}
+}
+
+// CalendarFeedsToResponse convierte una lista de feeds
+func (m CalendarMapperImpl) CalendarFeedsToResponse(feeds []*models.CalendarFeed) []dto.CalendarFeedResponse {
+	responses := make([]dto.CalendarFeedResponse, 0, len(feeds))
+	for _, feed := range feeds {
+		responses = append(responses, m.CalendarFeedToResponse(feed, ""))
+	}
+	return responses
+}
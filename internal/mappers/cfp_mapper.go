@@ -0,0 +1,153 @@
+package mappers
+
+import (
+	"cybesphere-backend/internal/dto"
+	"cybesphere-backend/internal/models"
+)
+
+// CFPMapperImpl implementación del mapper del call for papers
+type CFPMapperImpl struct{}
+
+// NewCFPMapper crea nueva instancia del mapper
+func NewCFPMapper() CFPMapperImpl {
+	return CFPMapperImpl{}
+}
+
+// SubmissionToResponse convierte una propuesta a la respuesta para su autor
+func (m CFPMapperImpl) SubmissionToResponse(submission *models.TalkSubmission) dto.SubmissionResponse {
+	response := dto.SubmissionResponse{
+		ID:              submission.ID.String(),
+		EventID:         submission.EventID,
+		Title:           submission.Title,
+		Abstract:        submission.Abstract,
+		Level:           string(submission.Level),
+		Track:           submission.Track,
+		DurationMinutes: submission.DurationMinutes,
+		Tags:            submission.GetTags(),
+		Status:          string(submission.Status),
+		DecisionNote:    submission.DecisionNote,
+		DecidedAt:       submission.DecidedAt,
+		SessionID:       submission.SessionID,
+		CreatedAt:       submission.CreatedAt,
+	}
+
+	if submission.Event != nil {
+		response.EventTitle = submission.Event.Title
+	}
+
+	return response
+}
+
+// SubmissionsToResponse convierte las propuestas de un autor
+func (m CFPMapperImpl) SubmissionsToResponse(submissions []*models.TalkSubmission) []dto.SubmissionResponse {
+	responses := make([]dto.SubmissionResponse, 0, len(submissions))
+	for _, submission := range submissions {
+		responses = append(responses, m.SubmissionToResponse(submission))
+	}
+	return responses
+}
+
+// SubmissionToDetailResponse convierte una propuesta a la respuesta completa para la organización
+func (m CFPMapperImpl) SubmissionToDetailResponse(submission *models.TalkSubmission) dto.SubmissionDetailResponse {
+	average, completed := submission.ScoreSummary()
+
+	response := dto.SubmissionDetailResponse{
+		SubmissionResponse: m.SubmissionToResponse(submission),
+		SubmitterID:        submission.SubmitterID,
+		SpeakerName:        submission.SpeakerName,
+		SpeakerBio:         submission.SpeakerBio,
+		SpeakerCompany:     submission.SpeakerCompany,
+		SpeakerPosition:    submission.SpeakerPosition,
+		SpeakerID:          submission.SpeakerID,
+		Notes:              submission.Notes,
+		AverageScore:       average,
+		CompletedReviews:   completed,
+		AssignedReviews:    len(submission.Reviews),
+		Reviews:            make([]dto.ReviewSummaryResponse, 0, len(submission.Reviews)),
+	}
+
+	if submission.Submitter != nil {
+		response.SubmitterEmail = submission.Submitter.Email
+	}
+
+	for _, review := range submission.Reviews {
+		summary := dto.ReviewSummaryResponse{
+			ID:         review.ID.String(),
+			ReviewerID: review.ReviewerID,
+			Score:      review.Score,
+			Comment:    review.Comment,
+			ReviewedAt: review.ReviewedAt,
+		}
+		if review.Reviewer != nil {
+			summary.ReviewerName = review.Reviewer.GetFullName()
+		}
+		response.Reviews = append(response.Reviews, summary)
+	}
+
+	return response
+}
+
+// SubmissionsToListResponse convierte las propuestas de un evento con el recuento por estado
+func (m CFPMapperImpl) SubmissionsToListResponse(event *models.Event, submissions []*models.TalkSubmission) dto.SubmissionListResponse {
+	response := dto.SubmissionListResponse{
+		EventID:     event.ID.String(),
+		CFPOpen:     event.IsCFPOpen(),
+		Total:       len(submissions),
+		ByStatus:    make(map[string]int),
+		Submissions: make([]dto.SubmissionDetailResponse, 0, len(submissions)),
+	}
+
+	for _, submission := range submissions {
+		response.ByStatus[string(submission.Status)]++
+		response.Submissions = append(response.Submissions, m.SubmissionToDetailResponse(submission))
+	}
+
+	return response
+}
+
+// SubmissionToBlindResponse convierte una propuesta eliminando los datos que identifican al autor
+func (m CFPMapperImpl) SubmissionToBlindResponse(submission *models.TalkSubmission) dto.BlindSubmissionResponse {
+	response := dto.BlindSubmissionResponse{
+		ID:              submission.ID.String(),
+		EventID:         submission.EventID,
+		Title:           submission.Title,
+		Abstract:        submission.Abstract,
+		Level:           string(submission.Level),
+		Track:           submission.Track,
+		DurationMinutes: submission.DurationMinutes,
+		Tags:            submission.GetTags(),
+		Status:          string(submission.Status),
+	}
+
+	if submission.Event != nil {
+		response.EventTitle = submission.Event.Title
+	}
+
+	return response
+}
+
+// ReviewToAssignmentResponse convierte una revisión a la respuesta para su revisor
+func (m CFPMapperImpl) ReviewToAssignmentResponse(review *models.SubmissionReview) dto.ReviewAssignmentResponse {
+	response := dto.ReviewAssignmentResponse{
+		ID:         review.ID.String(),
+		Score:      review.Score,
+		Comment:    review.Comment,
+		ReviewedAt: review.ReviewedAt,
+		Completed:  review.IsCompleted(),
+	}
+
+	if review.Submission != nil {
+		response.Submission = m.SubmissionToBlindResponse(review.Submission)
+	}
+
+	return response
+}
+
+// ReviewsToAssignmentResponse convierte las revisiones asignadas a un revisor
+func (m CFPMapperImpl) ReviewsToAssignmentResponse(reviews []*models.SubmissionReview) []dto.ReviewAssignmentResponse {
+	responses := make([]dto.ReviewAssignmentResponse, 0, len(reviews))
+	for _, review := range reviews {
+		responses = append(responses, m.ReviewToAssignmentResponse(review))
+	}
+	return responses
+}
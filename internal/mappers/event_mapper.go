@@ -1,12 +1,16 @@
 package mappers
 
 import (
+	"math"
+	"sort"
 	"strings"
 	"time"
 
 	"cybesphere-backend/internal/common"
 	"cybesphere-backend/internal/dto"
 	"cybesphere-backend/internal/models"
+	"cybesphere-backend/internal/repositories"
+	"cybesphere-backend/pkg/recommend"
 )
 
 // EventMapperImpl implementación del mapper de eventos
@@ -71,6 +75,8 @@ func (m EventMapperImpl) CreateEventRequestToModel(req *dto.CreateEventRequest,
 		// Fechas importantes
 		RegistrationStartDate: req.RegistrationStartDate,
 		RegistrationEndDate:   req.RegistrationEndDate,
+		CFPStartDate:          req.CFPStartDate,
+		CFPEndDate:            req.CFPEndDate,
 
 		// Información de contacto
 		ContactEmail: strings.ToLower(strings.TrimSpace(req.ContactEmail)),
@@ -96,6 +102,9 @@ func (m EventMapperImpl) CreateEventRequestToModel(req *dto.CreateEventRequest,
 	if event.Currency == "" {
 		event.Currency = "EUR"
 	}
+	if event.HasLocation() {
+		event.CoordinatesSource = models.CoordinatesSourceManual
+	}
 
 	// Solo admin puede establecer ciertos campos en creación
 	if userCtx.IsAdmin() {
@@ -151,6 +160,7 @@ func (m EventMapperImpl) UpdateEventRequestToModel(event *models.Event, req *dto
 	}
 
 	// Ubicación
+	previousAddress := [3]string{event.VenueAddress, event.VenueCity, event.VenueCountry}
 	if req.IsOnline != nil {
 		event.IsOnline = *req.IsOnline
 	}
@@ -166,11 +176,22 @@ func (m EventMapperImpl) UpdateEventRequestToModel(event *models.Event, req *dto
 	if req.VenueCountry != nil {
 		event.VenueCountry = strings.TrimSpace(*req.VenueCountry)
 	}
-	if req.Latitude != nil {
-		event.Latitude = req.Latitude
-	}
-	if req.Longitude != nil {
-		event.Longitude = req.Longitude
+	switch {
+	case req.Latitude != nil || req.Longitude != nil:
+		// Las coordenadas indicadas por el organizador prevalecen sobre las geocodificadas
+		if req.Latitude != nil {
+			event.Latitude = req.Latitude
+		}
+		if req.Longitude != nil {
+			event.Longitude = req.Longitude
+		}
+		event.CoordinatesSource = models.CoordinatesSourceManual
+	case req.ResetLocation:
+		event.ClearLocation()
+	case event.CoordinatesSource == models.CoordinatesSourceGeocoded &&
+		previousAddress != [3]string{event.VenueAddress, event.VenueCity, event.VenueCountry}:
+		// Las coordenadas calculadas corresponden a la dirección anterior
+		event.ClearLocation()
 	}
 	if req.OnlineURL != nil {
 		event.OnlineURL = strings.TrimSpace(*req.OnlineURL)
@@ -217,6 +238,12 @@ func (m EventMapperImpl) UpdateEventRequestToModel(event *models.Event, req *dto
 	if req.RegistrationEndDate != nil {
 		event.RegistrationEndDate = req.RegistrationEndDate
 	}
+	if req.CFPStartDate != nil {
+		event.CFPStartDate = req.CFPStartDate
+	}
+	if req.CFPEndDate != nil {
+		event.CFPEndDate = req.CFPEndDate
+	}
 
 	// Información de contacto
 	if req.ContactEmail != nil {
@@ -289,6 +316,8 @@ func (m EventMapperImpl) EventToResponse(event *models.Event, userCtx *common.Us
 		OnlineURL:    event.OnlineURL,
 		StreamingURL: event.StreamingURL,
 
+		CoordinatesSource: string(event.CoordinatesSource),
+
 		// Capacidad y registro
 		MaxAttendees:     event.MaxAttendees,
 		CurrentAttendees: event.CurrentAttendees,
@@ -305,21 +334,34 @@ func (m EventMapperImpl) EventToResponse(event *models.Event, userCtx *common.Us
 		ViewsCount: event.ViewsCount,
 
 		// Contenido
-		ImageURL:  event.ImageURL,
-		BannerURL: event.BannerURL,
-		Tags:      event.GetTags(),
+		ImageURL:       event.ImageURL,
+		ImageVariants:  event.ImageVariants(),
+		BannerURL:      event.BannerURL,
+		BannerVariants: event.BannerVariants(),
+		Tags:           event.GetTags(),
 
 		// Información de registro
 		RegistrationOpen:      event.IsRegistrationOpen(),
 		RegistrationStartDate: event.RegistrationStartDate,
 		RegistrationEndDate:   event.RegistrationEndDate,
 
+		// Call for papers
+		CFPOpen:      event.IsCFPOpen(),
+		CFPStartDate: event.CFPStartDate,
+		CFPEndDate:   event.CFPEndDate,
+
 		// Metadatos SEO (filtrados según permisos)
 		MetaTitle:       m.filterMetadata(event.MetaTitle, userCtx),
 		MetaDescription: m.filterMetadata(event.MetaDescription, userCtx),
 
 		// Organización
 		Organization: m.mapOrganizationSummary(event.Organization),
+		Speakers:     m.mapEventSpeakers(event.Sessions),
+
+		// Serie recurrente
+		SeriesID:          event.SeriesID,
+		OccurrenceDate:    event.OccurrenceDate,
+		IsSeriesException: event.IsSeriesException,
 
 		// Estados computados
 		IsUpcoming: event.IsUpcoming(),
@@ -333,11 +375,17 @@ func (m EventMapperImpl) EventToResponse(event *models.Event, userCtx *common.Us
 		CanManage:    m.canManage(event, userCtx),
 
 		// Timestamps
-		CreatedAt:   event.CreatedAt,
-		UpdatedAt:   event.UpdatedAt,
-		PublishedAt: event.PublishedAt,
-		CanceledAt:  event.CanceledAt,
-		CompletedAt: event.CompletedAt,
+		CreatedAt:            event.CreatedAt,
+		UpdatedAt:            event.UpdatedAt,
+		PublishedAt:          event.PublishedAt,
+		ScheduledPublishAt:   event.ScheduledPublishAt,
+		RegistrationClosedAt: event.RegistrationClosedAt,
+		CanceledAt:           event.CanceledAt,
+		CompletedAt:          event.CompletedAt,
+
+		// Moderación
+		HiddenAt:     event.HiddenAt,
+		HiddenReason: event.HiddenReason,
 	}
 
 	return response
@@ -379,6 +427,7 @@ func (m EventMapperImpl) EventToSummaryResponse(event *models.Event) dto.EventSu
 		IsFree:           event.IsFree,
 		Price:            event.Price,
 		ImageURL:         event.ImageURL,
+		ImageVariants:    event.ImageVariants(),
 		CurrentAttendees: event.CurrentAttendees,
 		MaxAttendees:     event.MaxAttendees,
 		IsFeatured:       event.IsFeatured,
@@ -402,28 +451,103 @@ func (m EventMapperImpl) EventsToListResponse(events []*models.Event, pagination
 	}
 }
 
+// EventDistancesToResponse convierte eventos cercanos a resúmenes con su
+// distancia, redondeada a decenas de metros
+func (m EventMapperImpl) EventDistancesToResponse(hits []*repositories.EventDistance) []dto.EventWithDistance {
+	responses := make([]dto.EventWithDistance, 0, len(hits))
+	for _, hit := range hits {
+		summary := m.EventToSummaryResponse(&hit.Event)
+		summary.Organization = hit.OrganizationName
+		responses = append(responses, dto.EventWithDistance{
+			EventSummaryResponse: summary,
+			DistanceKm:           math.Round(hit.DistanceKm*100) / 100,
+		})
+	}
+	return responses
+}
+
+// RecommendationToResponse convierte un evento recomendado a resumen con su
+// puntuación y motivos, redondeando puntuación y distancia
+func (m EventMapperImpl) RecommendationToResponse(event *models.Event, rec recommend.Recommendation) dto.RecommendedEventResponse {
+	response := dto.RecommendedEventResponse{
+		EventSummaryResponse: m.EventToSummaryResponse(event),
+		Score:                math.Round(rec.Score*1000) / 1000,
+		Reasons:              make([]dto.RecommendationReasonResponse, 0, len(rec.Reasons)),
+	}
+	if rec.DistanceKm != nil {
+		distance := math.Round(*rec.DistanceKm*100) / 100
+		response.DistanceKm = &distance
+	}
+	for _, reason := range rec.Reasons {
+		response.Reasons = append(response.Reasons, dto.RecommendationReasonResponse{
+			Type:           reason.Type,
+			Message:        reason.Message,
+			EventID:        reason.EventID,
+			OrganizationID: reason.OrganizationID,
+			Tags:           reason.Tags,
+		})
+	}
+	return response
+}
+
 // =============================================================================
 // MÉTODOS HELPER PRIVADOS
 // =============================================================================
 
-// mapOrganizationSummary convierte Organization a OrganizationSummaryResponse
-func (m EventMapperImpl) mapOrganizationSummary(org *models.Organization) dto.OrganizationSummaryResponse {
+// mapOrganizationSummary convierte Organization a OrganizationSummaryResponse (nil si no está cargada)
+func (m EventMapperImpl) mapOrganizationSummary(org *models.Organization) *dto.OrganizationSummaryResponse {
 	if org == nil {
-		return dto.OrganizationSummaryResponse{}
+		return nil
 	}
 
-	return dto.OrganizationSummaryResponse{
-		ID:          org.ID.String(),
-		Slug:        org.Slug,
-		Name:        org.Name,
-		LogoURL:     org.LogoURL,
-		IsVerified:  org.IsVerified,
-		EventsCount: org.EventsCount,
-		City:        org.City,
-		Country:     org.Country,
+	return &dto.OrganizationSummaryResponse{
+		ID:           org.ID.String(),
+		Slug:         org.Slug,
+		Name:         org.Name,
+		LogoURL:      org.LogoURL,
+		LogoVariants: org.LogoVariants(),
+		IsVerified:   org.IsVerified,
+		EventsCount:  org.EventsCount,
+		City:         org.City,
+		Country:      org.Country,
 	}
 }
 
+// mapEventSpeakers ponentes de las sesiones precargadas, sin repetir y por orden
+// de primera intervención (nil si las sesiones no están cargadas)
+func (m EventMapperImpl) mapEventSpeakers(sessions []models.EventSession) []dto.SpeakerSummaryResponse {
+	if sessions == nil {
+		return nil
+	}
+
+	ordered := make([]models.EventSession, len(sessions))
+	copy(ordered, sessions)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].StartTime.Before(ordered[j].StartTime)
+	})
+
+	speakers := make([]dto.SpeakerSummaryResponse, 0)
+	seen := make(map[string]bool)
+	for _, session := range ordered {
+		for _, speaker := range session.Speakers {
+			id := speaker.ID.String()
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+			speakers = append(speakers, dto.SpeakerSummaryResponse{
+				ID:       id,
+				Name:     speaker.Name,
+				Position: speaker.Position,
+				Company:  speaker.Company,
+				PhotoURL: speaker.PhotoURL,
+			})
+		}
+	}
+
+	return speakers
+}
+
 // isOngoing determina si un evento está en curso
 func (m EventMapperImpl) isOngoing(event *models.Event) bool {
 	now := time.Now()
@@ -0,0 +1,41 @@
+package mappers
+
+import (
+	"cybesphere-backend/internal/dto"
+	"cybesphere-backend/internal/models"
+)
+
+// EventSeriesMapperImpl implementación del mapper de series de eventos
+type EventSeriesMapperImpl struct {
+	eventMapper EventMapperImpl
+}
+
+// NewEventSeriesMapper crea nueva instancia del mapper
+func NewEventSeriesMapper() EventSeriesMapperImpl {
+	return EventSeriesMapperImpl{eventMapper: NewEventMapper()}
+}
+
+// EventSeriesToResponse convierte una serie y sus ocurrencias a su respuesta
+func (m EventSeriesMapperImpl) EventSeriesToResponse(series *models.EventSeries, occurrences []*models.Event) dto.EventSeriesResponse {
+	response := dto.EventSeriesResponse{
+		ID:              series.ID.String(),
+		OrganizationID:  series.OrganizationID,
+		Title:           series.Title,
+		RRule:           series.RRule,
+		Timezone:        series.Timezone,
+		StartDate:       series.StartDate,
+		DurationMinutes: series.DurationMinutes,
+		Status:          string(series.Status),
+		ExDates:         series.GetExDates(),
+		GeneratedUntil:  series.GeneratedUntil,
+		Occurrences:     make([]dto.EventSummaryResponse, 0, len(occurrences)),
+		CreatedAt:       series.CreatedAt,
+		UpdatedAt:       series.UpdatedAt,
+	}
+
+	for _, event := range occurrences {
+		response.Occurrences = append(response.Occurrences, m.eventMapper.EventToSummaryResponse(event))
+	}
+
+	return response
+}
@@ -6,6 +6,9 @@ import (
 	"cybesphere-backend/internal/common"
 	"cybesphere-backend/internal/dto"
 	"cybesphere-backend/internal/models"
+	"cybesphere-backend/internal/query"
+	"cybesphere-backend/internal/repositories"
+	"cybesphere-backend/pkg/recommend"
 )
 
 // ResponseMapper interfaz para mapeo de DTOs a models y viceversa
@@ -24,6 +27,8 @@ type EventMapper interface {
 	EventToDetailResponse(event *models.Event, userCtx *common.UserContext) dto.EventDetailResponse
 	EventToSummaryResponse(event *models.Event) dto.EventSummaryResponse
 	EventsToListResponse(events []*models.Event, pagination *common.PaginationMeta, userCtx *common.UserContext) dto.EventListResponse
+	EventDistancesToResponse(hits []*repositories.EventDistance) []dto.EventWithDistance
+	RecommendationToResponse(event *models.Event, rec recommend.Recommendation) dto.RecommendedEventResponse
 }
 
 // OrganizationMapper interfaz específica para mapeo de organizaciones
@@ -59,22 +64,137 @@ type AuthMapper interface {
 	RefreshTokensToSessionList(tokens []*models.RefreshToken, currentTokenID string) dto.SessionListResponse
 }
 
+// CalendarMapper interfaz específica para mapeo de feeds de calendario
+type CalendarMapper interface {
+	CalendarFeedToResponse(feed *models.CalendarFeed, feedURL string) dto.CalendarFeedResponse
+	CalendarFeedsToResponse(feeds []*models.CalendarFeed) []dto.CalendarFeedResponse
+}
+
+// EventSeriesMapper interfaz específica para mapeo de series de eventos
+type EventSeriesMapper interface {
+	EventSeriesToResponse(series *models.EventSeries, occurrences []*models.Event) dto.EventSeriesResponse
+}
+
+// AgendaMapper interfaz específica para mapeo de agenda y ponentes
+type AgendaMapper interface {
+	SpeakerToResponse(speaker *models.Speaker, userCtx *common.UserContext) dto.SpeakerResponse
+	SpeakerToDetailResponse(speaker *models.Speaker, userCtx *common.UserContext) dto.SpeakerDetailResponse
+	SpeakersToListResponse(speakers []*models.Speaker, pagination *common.PaginationMeta, userCtx *common.UserContext) dto.SpeakerListResponse
+	EventSessionToResponse(session *models.EventSession, loc *time.Location, isFavorite bool) dto.EventSessionResponse
+	SessionsToAgendaResponse(eventID string, sessions []*models.EventSession, loc *time.Location, favorites map[string]bool) dto.AgendaResponse
+	SessionsToScheduleResponse(sessions []*models.EventSession, loc *time.Location) dto.ScheduleResponse
+}
+
+// CFPMapper interfaz específica para mapeo del call for papers
+type CFPMapper interface {
+	SubmissionToResponse(submission *models.TalkSubmission) dto.SubmissionResponse
+	SubmissionsToResponse(submissions []*models.TalkSubmission) []dto.SubmissionResponse
+	SubmissionToDetailResponse(submission *models.TalkSubmission) dto.SubmissionDetailResponse
+	SubmissionsToListResponse(event *models.Event, submissions []*models.TalkSubmission) dto.SubmissionListResponse
+	ReviewToAssignmentResponse(review *models.SubmissionReview) dto.ReviewAssignmentResponse
+	ReviewsToAssignmentResponse(reviews []*models.SubmissionReview) []dto.ReviewAssignmentResponse
+}
+
+// NotificationMapper interfaz específica para mapeo de notificaciones
+type NotificationMapper interface {
+	NotificationToResponse(notification *models.Notification) dto.NotificationResponse
+	NotificationsToListResponse(notifications []*models.Notification, unread int64, pagination *common.PaginationMeta) dto.NotificationListResponse
+}
+
+// TicketingMapper interfaz específica para mapeo de entradas e inscripciones
+type TicketingMapper interface {
+	TicketTypeToResponse(ticketType *models.TicketType) dto.TicketTypeResponse
+	TicketTypesToEventResponse(event *models.Event, ticketTypes []*models.TicketType) dto.EventTicketsResponse
+	PromoCodeToResponse(promo *models.PromoCode) dto.PromoCodeResponse
+	PromoCodesToResponse(promos []*models.PromoCode) []dto.PromoCodeResponse
+	PriceQuoteToResponse(eventID string, ticketType *models.TicketType, promo *models.PromoCode, quote models.PriceQuote) dto.PriceQuoteResponse
+	RegistrationToResponse(registration *models.EventRegistration) dto.RegistrationResponse
+	RegistrationsToListResponse(registrations []*models.EventRegistration, pagination *common.PaginationMeta) dto.RegistrationListResponse
+	RegistrationsToAttendeesResponse(event *models.Event, registrations []*models.EventRegistration, counts map[models.RegistrationStatus]int, pagination *common.PaginationMeta) dto.EventAttendeesListResponse
+	OrderToResponse(order *models.Order) dto.OrderResponse
+	OrdersToListResponse(orders []*models.Order, pagination *common.PaginationMeta) dto.OrderListResponse
+	InvoiceToResponse(invoice *models.Invoice) dto.InvoiceResponse
+	InvoicesToResponse(invoices []*models.Invoice) []dto.InvoiceResponse
+	InvoicesToListResponse(invoices []*models.Invoice, pagination *common.PaginationMeta) dto.InvoiceListResponse
+}
+
+// SearchMapper interfaz específica para mapeo de resultados de búsqueda
+type SearchMapper interface {
+	EventSearchHitsToResponse(hits []*repositories.EventSearchHit) []dto.EventSearchResultResponse
+	OrganizationSearchHitsToResponse(hits []*repositories.OrganizationSearchHit) []dto.OrganizationSearchResultResponse
+	FacetCountsToResponse(counts []query.FacetCount) []dto.FacetValueResponse
+	FacetsToResponse(facets map[string][]query.FacetCount) map[string][]dto.FacetValueResponse
+	EventFacetsToResponse(facets *repositories.EventFacets) *dto.SearchFacetsResponse
+}
+
+// PrivacyMapper interfaz específica para mapeo de exportaciones y supresiones de cuenta
+type PrivacyMapper interface {
+	DataExportToResponse(export *models.DataExport) dto.DataExportResponse
+	DataExportsToListResponse(exports []*models.DataExport) dto.DataExportListResponse
+	ErasureRequestToResponse(request *models.ErasureRequest) dto.ErasureRequestResponse
+	ErasureRequestsToListResponse(requests []*models.ErasureRequest, pagination *common.PaginationMeta) dto.ErasureRequestListResponse
+}
+
+// ModerationMapper interfaz específica para mapeo de denuncias y moderación
+type ModerationMapper interface {
+	ContentReportToResponse(report *models.ContentReport, internal bool) dto.ContentReportResponse
+	ModerationNoteToResponse(note *models.ModerationNote) dto.ModerationNoteResponse
+	ContentReportsToListResponse(reports []*models.ContentReport, pagination *common.PaginationMeta, internal bool) dto.ContentReportListResponse
+}
+
+// VerificationMapper interfaz específica para mapeo de solicitudes de verificación
+type VerificationMapper interface {
+	VerificationToResponse(verification *models.OrganizationVerification, internal bool) dto.OrganizationVerificationResponse
+	VerificationToDetailResponse(verification *models.OrganizationVerification, history []*models.OrganizationVerification) dto.OrganizationVerificationDetailResponse
+	VerificationStatusToResponse(org *models.Organization, history []*models.OrganizationVerification) dto.OrganizationVerificationStatusResponse
+	VerificationsToListResponse(verifications []*models.OrganizationVerification, pagination *common.PaginationMeta) dto.OrganizationVerificationListResponse
+}
+
+// MediaMapper interfaz específica para mapeo de ficheros subidos
+type MediaMapper interface {
+	MediaToResponse(media *models.Media) dto.MediaResponse
+	MediaToUploadResponse(media *models.Media) dto.FileUploadResponse
+	MediaToListResponse(media []*models.Media, pagination *common.PaginationMeta) dto.MediaListResponse
+}
+
 // UnifiedMapper estructura que implementa todas las interfaces
 type UnifiedMapper struct {
 	// Usar implementaciones concretas en lugar de interfaces
-	eventMapper EventMapperImpl
-	orgMapper   OrganizationMapperImpl
-	userMapper  UserMapperImpl
-	authMapper  AuthMapperImpl
+	eventMapper  EventMapperImpl
+	orgMapper    OrganizationMapperImpl
+	userMapper   UserMapperImpl
+	authMapper   AuthMapperImpl
+	calMapper    CalendarMapperImpl
+	seriesMapper EventSeriesMapperImpl
+	agendaMapper AgendaMapperImpl
+	cfpMapper    CFPMapperImpl
+	notifMapper  NotificationMapperImpl
+	ticketMapper TicketingMapperImpl
+	searchMapper SearchMapperImpl
+	privMapper   PrivacyMapperImpl
+	modMapper    ModerationMapperImpl
+	verifMapper  VerificationMapperImpl
+	mediaMapper  MediaMapperImpl
 }
 
 // NewUnifiedMapper crea una nueva instancia del mapper unificado
 func NewUnifiedMapper() *UnifiedMapper {
 	return &UnifiedMapper{
-		eventMapper: NewEventMapper(),
-		orgMapper:   NewOrganizationMapper(),
-		userMapper:  NewUserMapper(),
-		authMapper:  NewAuthMapper(),
+		eventMapper:  NewEventMapper(),
+		orgMapper:    NewOrganizationMapper(),
+		userMapper:   NewUserMapper(),
+		authMapper:   NewAuthMapper(),
+		calMapper:    NewCalendarMapper(),
+		seriesMapper: NewEventSeriesMapper(),
+		agendaMapper: NewAgendaMapper(),
+		cfpMapper:    NewCFPMapper(),
+		notifMapper:  NewNotificationMapper(),
+		ticketMapper: NewTicketingMapper(),
+		searchMapper: NewSearchMapper(),
+		privMapper:   NewPrivacyMapper(),
+		modMapper:    NewModerationMapper(),
+		verifMapper:  NewVerificationMapper(),
+		mediaMapper:  NewMediaMapper(),
 	}
 }
 
@@ -169,6 +289,14 @@ func (m *UnifiedMapper) EventsToListResponse(events []*models.Event, pagination
 	return m.eventMapper.EventsToListResponse(events, pagination, userCtx)
 }
 
+func (m *UnifiedMapper) EventDistancesToResponse(hits []*repositories.EventDistance) []dto.EventWithDistance {
+	return m.eventMapper.EventDistancesToResponse(hits)
+}
+
+func (m *UnifiedMapper) RecommendationToResponse(event *models.Event, rec recommend.Recommendation) dto.RecommendedEventResponse {
+	return m.eventMapper.RecommendationToResponse(event, rec)
+}
+
 // =============================================================================
 // IMPLEMENTACIÓN DE OrganizationMapper
 // =============================================================================
@@ -264,3 +392,243 @@ func (m *UnifiedMapper) BuildTokenResponse(accessToken, refreshToken string, exp
 func (m *UnifiedMapper) RefreshTokensToSessionList(tokens []*models.RefreshToken, currentTokenID string) dto.SessionListResponse {
 	return m.authMapper.RefreshTokensToSessionList(tokens, currentTokenID)
 }
+
+// =============================================================================
+// IMPLEMENTACIÓN DE CalendarMapper
+// =============================================================================
+
+func (m *UnifiedMapper) CalendarFeedToResponse(feed *models.CalendarFeed, feedURL string) dto.CalendarFeedResponse {
+	return m.calMapper.CalendarFeedToResponse(feed, feedURL)
+}
+
+func (m *UnifiedMapper) CalendarFeedsToResponse(feeds []*models.CalendarFeed) []dto.CalendarFeedResponse {
+	return m.calMapper.CalendarFeedsToResponse(feeds)
+}
+
+// =============================================================================
+// IMPLEMENTACIÓN DE EventSeriesMapper
+// =============================================================================
+
+func (m *UnifiedMapper) EventSeriesToResponse(series *models.EventSeries, occurrences []*models.Event) dto.EventSeriesResponse {
+	return m.seriesMapper.EventSeriesToResponse(series, occurrences)
+}
+
+// =============================================================================
+// IMPLEMENTACIÓN DE AgendaMapper
+// =============================================================================
+
+func (m *UnifiedMapper) SpeakerToResponse(speaker *models.Speaker, userCtx *common.UserContext) dto.SpeakerResponse {
+	return m.agendaMapper.SpeakerToResponse(speaker, userCtx)
+}
+
+func (m *UnifiedMapper) SpeakerToDetailResponse(speaker *models.Speaker, userCtx *common.UserContext) dto.SpeakerDetailResponse {
+	return m.agendaMapper.SpeakerToDetailResponse(speaker, userCtx)
+}
+
+func (m *UnifiedMapper) SpeakersToListResponse(speakers []*models.Speaker, pagination *common.PaginationMeta, userCtx *common.UserContext) dto.SpeakerListResponse {
+	return m.agendaMapper.SpeakersToListResponse(speakers, pagination, userCtx)
+}
+
+func (m *UnifiedMapper) EventSessionToResponse(session *models.EventSession, loc *time.Location, isFavorite bool) dto.EventSessionResponse {
+	return m.agendaMapper.EventSessionToResponse(session, loc, isFavorite)
+}
+
+func (m *UnifiedMapper) SessionsToAgendaResponse(eventID string, sessions []*models.EventSession, loc *time.Location, favorites map[string]bool) dto.AgendaResponse {
+	return m.agendaMapper.SessionsToAgendaResponse(eventID, sessions, loc, favorites)
+}
+
+func (m *UnifiedMapper) SessionsToScheduleResponse(sessions []*models.EventSession, loc *time.Location) dto.ScheduleResponse {
+	return m.agendaMapper.SessionsToScheduleResponse(sessions, loc)
+}
+
+// =============================================================================
+// IMPLEMENTACIÓN DE CFPMapper
+// =============================================================================
+
+func (m *UnifiedMapper) SubmissionToResponse(submission *models.TalkSubmission) dto.SubmissionResponse {
+	return m.cfpMapper.SubmissionToResponse(submission)
+}
+
+func (m *UnifiedMapper) SubmissionsToResponse(submissions []*models.TalkSubmission) []dto.SubmissionResponse {
+	return m.cfpMapper.SubmissionsToResponse(submissions)
+}
+
+func (m *UnifiedMapper) SubmissionToDetailResponse(submission *models.TalkSubmission) dto.SubmissionDetailResponse {
+	return m.cfpMapper.SubmissionToDetailResponse(submission)
+}
+
+func (m *UnifiedMapper) SubmissionsToListResponse(event *models.Event, submissions []*models.TalkSubmission) dto.SubmissionListResponse {
+	return m.cfpMapper.SubmissionsToListResponse(event, submissions)
+}
+
+func (m *UnifiedMapper) ReviewToAssignmentResponse(review *models.SubmissionReview) dto.ReviewAssignmentResponse {
+	return m.cfpMapper.ReviewToAssignmentResponse(review)
+}
+
+func (m *UnifiedMapper) ReviewsToAssignmentResponse(reviews []*models.SubmissionReview) []dto.ReviewAssignmentResponse {
+	return m.cfpMapper.ReviewsToAssignmentResponse(reviews)
+}
+
+// =============================================================================
+// IMPLEMENTACIÓN DE NotificationMapper
+// =============================================================================
+
+func (m *UnifiedMapper) NotificationToResponse(notification *models.Notification) dto.NotificationResponse {
+	return m.notifMapper.NotificationToResponse(notification)
+}
+
+func (m *UnifiedMapper) NotificationsToListResponse(notifications []*models.Notification, unread int64, pagination *common.PaginationMeta) dto.NotificationListResponse {
+	return m.notifMapper.NotificationsToListResponse(notifications, unread, pagination)
+}
+
+// =============================================================================
+// IMPLEMENTACIÓN DE TicketingMapper
+// =============================================================================
+
+func (m *UnifiedMapper) TicketTypeToResponse(ticketType *models.TicketType) dto.TicketTypeResponse {
+	return m.ticketMapper.TicketTypeToResponse(ticketType)
+}
+
+func (m *UnifiedMapper) TicketTypesToEventResponse(event *models.Event, ticketTypes []*models.TicketType) dto.EventTicketsResponse {
+	return m.ticketMapper.TicketTypesToEventResponse(event, ticketTypes)
+}
+
+func (m *UnifiedMapper) PromoCodeToResponse(promo *models.PromoCode) dto.PromoCodeResponse {
+	return m.ticketMapper.PromoCodeToResponse(promo)
+}
+
+func (m *UnifiedMapper) PromoCodesToResponse(promos []*models.PromoCode) []dto.PromoCodeResponse {
+	return m.ticketMapper.PromoCodesToResponse(promos)
+}
+
+func (m *UnifiedMapper) PriceQuoteToResponse(eventID string, ticketType *models.TicketType, promo *models.PromoCode, quote models.PriceQuote) dto.PriceQuoteResponse {
+	return m.ticketMapper.PriceQuoteToResponse(eventID, ticketType, promo, quote)
+}
+
+func (m *UnifiedMapper) RegistrationToResponse(registration *models.EventRegistration) dto.RegistrationResponse {
+	return m.ticketMapper.RegistrationToResponse(registration)
+}
+
+func (m *UnifiedMapper) RegistrationsToListResponse(registrations []*models.EventRegistration, pagination *common.PaginationMeta) dto.RegistrationListResponse {
+	return m.ticketMapper.RegistrationsToListResponse(registrations, pagination)
+}
+
+func (m *UnifiedMapper) RegistrationsToAttendeesResponse(event *models.Event, registrations []*models.EventRegistration, counts map[models.RegistrationStatus]int, pagination *common.PaginationMeta) dto.EventAttendeesListResponse {
+	return m.ticketMapper.RegistrationsToAttendeesResponse(event, registrations, counts, pagination)
+}
+
+func (m *UnifiedMapper) OrderToResponse(order *models.Order) dto.OrderResponse {
+	return m.ticketMapper.OrderToResponse(order)
+}
+
+func (m *UnifiedMapper) OrdersToListResponse(orders []*models.Order, pagination *common.PaginationMeta) dto.OrderListResponse {
+	return m.ticketMapper.OrdersToListResponse(orders, pagination)
+}
+
+func (m *UnifiedMapper) InvoiceToResponse(invoice *models.Invoice) dto.InvoiceResponse {
+	return m.ticketMapper.InvoiceToResponse(invoice)
+}
+
+func (m *UnifiedMapper) InvoicesToResponse(invoices []*models.Invoice) []dto.InvoiceResponse {
+	return m.ticketMapper.InvoicesToResponse(invoices)
+}
+
+func (m *UnifiedMapper) InvoicesToListResponse(invoices []*models.Invoice, pagination *common.PaginationMeta) dto.InvoiceListResponse {
+	return m.ticketMapper.InvoicesToListResponse(invoices, pagination)
+}
+
+// =============================================================================
+// IMPLEMENTACIÓN DE SearchMapper
+// =============================================================================
+
+func (m *UnifiedMapper) EventSearchHitsToResponse(hits []*repositories.EventSearchHit) []dto.EventSearchResultResponse {
+	return m.searchMapper.EventSearchHitsToResponse(hits)
+}
+
+func (m *UnifiedMapper) OrganizationSearchHitsToResponse(hits []*repositories.OrganizationSearchHit) []dto.OrganizationSearchResultResponse {
+	return m.searchMapper.OrganizationSearchHitsToResponse(hits)
+}
+
+func (m *UnifiedMapper) FacetCountsToResponse(counts []query.FacetCount) []dto.FacetValueResponse {
+	return m.searchMapper.FacetCountsToResponse(counts)
+}
+
+func (m *UnifiedMapper) FacetsToResponse(facets map[string][]query.FacetCount) map[string][]dto.FacetValueResponse {
+	return m.searchMapper.FacetsToResponse(facets)
+}
+
+func (m *UnifiedMapper) EventFacetsToResponse(facets *repositories.EventFacets) *dto.SearchFacetsResponse {
+	return m.searchMapper.EventFacetsToResponse(facets)
+}
+
+// =============================================================================
+// IMPLEMENTACIÓN DE PrivacyMapper
+// =============================================================================
+
+func (m *UnifiedMapper) DataExportToResponse(export *models.DataExport) dto.DataExportResponse {
+	return m.privMapper.DataExportToResponse(export)
+}
+
+func (m *UnifiedMapper) DataExportsToListResponse(exports []*models.DataExport) dto.DataExportListResponse {
+	return m.privMapper.DataExportsToListResponse(exports)
+}
+
+func (m *UnifiedMapper) ErasureRequestToResponse(request *models.ErasureRequest) dto.ErasureRequestResponse {
+	return m.privMapper.ErasureRequestToResponse(request)
+}
+
+func (m *UnifiedMapper) ErasureRequestsToListResponse(requests []*models.ErasureRequest, pagination *common.PaginationMeta) dto.ErasureRequestListResponse {
+	return m.privMapper.ErasureRequestsToListResponse(requests, pagination)
+}
+
+// =============================================================================
+// IMPLEMENTACIÓN DE ModerationMapper
+// =============================================================================
+
+func (m *UnifiedMapper) ContentReportToResponse(report *models.ContentReport, internal bool) dto.ContentReportResponse {
+	return m.modMapper.ContentReportToResponse(report, internal)
+}
+
+func (m *UnifiedMapper) ModerationNoteToResponse(note *models.ModerationNote) dto.ModerationNoteResponse {
+	return m.modMapper.ModerationNoteToResponse(note)
+}
+
+func (m *UnifiedMapper) ContentReportsToListResponse(reports []*models.ContentReport, pagination *common.PaginationMeta, internal bool) dto.ContentReportListResponse {
+	return m.modMapper.ContentReportsToListResponse(reports, pagination, internal)
+}
+
+// =============================================================================
+// IMPLEMENTACIÓN DE VerificationMapper
+// =============================================================================
+
+func (m *UnifiedMapper) VerificationToResponse(verification *models.OrganizationVerification, internal bool) dto.OrganizationVerificationResponse {
+	return m.verifMapper.VerificationToResponse(verification, internal)
+}
+
+func (m *UnifiedMapper) VerificationToDetailResponse(verification *models.OrganizationVerification, history []*models.OrganizationVerification) dto.OrganizationVerificationDetailResponse {
+	return m.verifMapper.VerificationToDetailResponse(verification, history)
+}
+
+func (m *UnifiedMapper) VerificationStatusToResponse(org *models.Organization, history []*models.OrganizationVerification) dto.OrganizationVerificationStatusResponse {
+	return m.verifMapper.VerificationStatusToResponse(org, history)
+}
+
+func (m *UnifiedMapper) VerificationsToListResponse(verifications []*models.OrganizationVerification, pagination *common.PaginationMeta) dto.OrganizationVerificationListResponse {
+	return m.verifMapper.VerificationsToListResponse(verifications, pagination)
+}
+
+// =============================================================================
+// IMPLEMENTACIÓN DE MediaMapper
+// =============================================================================
+
+func (m *UnifiedMapper) MediaToResponse(media *models.Media) dto.MediaResponse {
+	return m.mediaMapper.MediaToResponse(media)
+}
+
+func (m *UnifiedMapper) MediaToUploadResponse(media *models.Media) dto.FileUploadResponse {
+	return m.mediaMapper.MediaToUploadResponse(media)
+}
+
+func (m *UnifiedMapper) MediaToListResponse(media []*models.Media, pagination *common.PaginationMeta) dto.MediaListResponse {
+	return m.mediaMapper.MediaToListResponse(media, pagination)
+}
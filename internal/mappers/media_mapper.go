@@ -0,0 +1,95 @@
+package mappers
+
+import (
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/dto"
+	"cybesphere-backend/internal/models"
+)
+
+// MediaMapperImpl implementación del mapper de ficheros subidos
+type MediaMapperImpl struct{}
+
+// NewMediaMapper crea nueva instancia del mapper
+func NewMediaMapper() MediaMapperImpl {
+	return MediaMapperImpl{}
+}
+
+// MediaToResponse convierte un fichero con sus referencias
+func (m MediaMapperImpl) MediaToResponse(media *models.Media) dto.MediaResponse {
+	references := make([]dto.MediaReferenceResponse, 0, len(media.References))
+	for _, ref := range media.References {
+		references = append(references, dto.MediaReferenceResponse{
+			ResourceType: ref.ResourceType,
+			ResourceID:   ref.ResourceID,
+			Field:        ref.Field,
+		})
+	}
+
+	var variants map[string]dto.MediaVariantResponse
+	for _, variant := range media.GetVariants() {
+		if variants == nil {
+			variants = make(map[string]dto.MediaVariantResponse)
+		}
+		variants[variant.Name] = dto.MediaVariantResponse{
+			URL:         variant.URL,
+			Width:       variant.Width,
+			Height:      variant.Height,
+			ContentType: variant.ContentType,
+			Size:        variant.Size,
+		}
+	}
+
+	return dto.MediaResponse{
+		ID:             media.ID.String(),
+		OwnerID:        media.OwnerID,
+		OrganizationID: media.OrganizationID,
+		Purpose:        string(media.Purpose),
+		FileName:       media.FileName,
+		ContentType:    media.ContentType,
+		Size:           media.Size,
+		Checksum:       media.Checksum,
+		URL:            media.URL,
+		IsPublic:       media.IsPublic(),
+		References:     references,
+		CreatedAt:      media.CreatedAt,
+
+		ProcessingStatus: string(media.ProcessingStatus),
+		ProcessingError:  media.ProcessingError,
+		ProcessedAt:      media.ProcessedAt,
+		Width:            media.Width,
+		Height:           media.Height,
+		DominantColor:    media.DominantColor,
+		Variants:         variants,
+	}
+}
+
+// MediaToUploadResponse convierte un fichero recién subido
+func (m MediaMapperImpl) MediaToUploadResponse(media *models.Media) dto.FileUploadResponse {
+	return dto.FileUploadResponse{
+		Success:  true,
+		Message:  "Fichero subido",
+		ID:       media.ID.String(),
+		Purpose:  string(media.Purpose),
+		FileURL:  media.URL,
+		FileName: media.FileName,
+		FileSize: media.Size,
+		MimeType: media.ContentType,
+		Checksum: media.Checksum,
+
+		ProcessingStatus: string(media.ProcessingStatus),
+	}
+}
+
+// MediaToListResponse convierte una lista de ficheros con paginación
+func (m MediaMapperImpl) MediaToListResponse(media []*models.Media, pagination *common.PaginationMeta) dto.MediaListResponse {
+	responses := make([]dto.MediaResponse, 0, len(media))
+	for _, item := range media {
+		responses = append(responses, m.MediaToResponse(item))
+	}
+
+	response := dto.MediaListResponse{Media: responses}
+	if pagination != nil {
+		response.Pagination = *pagination
+	}
+	return response
+}
@@ -0,0 +1,78 @@
+package mappers
+
+import (
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/dto"
+	"cybesphere-backend/internal/models"
+)
+
+// ModerationMapperImpl implementación del mapper de denuncias y moderación
+type ModerationMapperImpl struct {
+	userMapper UserMapperImpl
+}
+
+// NewModerationMapper crea nueva instancia del mapper
+func NewModerationMapper() ModerationMapperImpl {
+	return ModerationMapperImpl{userMapper: NewUserMapper()}
+}
+
+// ContentReportToResponse convierte una denuncia. Con internal incluye los
+// datos que solo ven los moderadores: autor, asignación y notas
+func (m ModerationMapperImpl) ContentReportToResponse(report *models.ContentReport, internal bool) dto.ContentReportResponse {
+	response := dto.ContentReportResponse{
+		ID:           report.ID.String(),
+		ResourceType: string(report.ResourceType),
+		ResourceID:   report.ResourceID,
+		Reason:       string(report.Reason),
+		Details:      report.Details,
+		Status:       string(report.Status),
+		Action:       string(report.Action),
+		Resolution:   report.Resolution,
+		ResolvedAt:   report.ResolvedAt,
+		CreatedAt:    report.CreatedAt,
+	}
+	if !internal {
+		return response
+	}
+
+	response.ReporterID = report.ReporterID
+	response.AssignedTo = report.AssignedTo
+	response.ResolvedBy = report.ResolvedBy
+	if report.Reporter != nil {
+		summary := m.userMapper.UserToSummaryResponse(report.Reporter)
+		response.Reporter = &summary
+	}
+	for i := range report.Notes {
+		response.Notes = append(response.Notes, m.ModerationNoteToResponse(&report.Notes[i]))
+	}
+	return response
+}
+
+// ModerationNoteToResponse convierte una nota de moderación
+func (m ModerationMapperImpl) ModerationNoteToResponse(note *models.ModerationNote) dto.ModerationNoteResponse {
+	response := dto.ModerationNoteResponse{
+		ID:        note.ID.String(),
+		AuthorID:  note.AuthorID,
+		Note:      note.Note,
+		CreatedAt: note.CreatedAt,
+	}
+	if note.Author != nil {
+		summary := m.userMapper.UserToSummaryResponse(note.Author)
+		response.Author = &summary
+	}
+	return response
+}
+
+// ContentReportsToListResponse convierte una lista de denuncias con paginación
+func (m ModerationMapperImpl) ContentReportsToListResponse(reports []*models.ContentReport, pagination *common.PaginationMeta, internal bool) dto.ContentReportListResponse {
+	responses := make([]dto.ContentReportResponse, 0, len(reports))
+	for _, report := range reports {
+		responses = append(responses, m.ContentReportToResponse(report, internal))
+	}
+
+	response := dto.ContentReportListResponse{Reports: responses}
+	if pagination != nil {
+		response.Pagination = *pagination
+	}
+	return response
+}
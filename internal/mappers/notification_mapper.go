@@ -0,0 +1,42 @@
+package mappers
+
+import (
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/dto"
+	"cybesphere-backend/internal/models"
+)
+
+// NotificationMapperImpl implementación del mapper de notificaciones
+type NotificationMapperImpl struct{}
+
+// NewNotificationMapper crea nueva instancia del mapper
+func NewNotificationMapper() NotificationMapperImpl {
+	return NotificationMapperImpl{}
+}
+
+// NotificationToResponse convierte una notificación a su respuesta
+func (m NotificationMapperImpl) NotificationToResponse(notification *models.Notification) dto.NotificationResponse {
+	return dto.NotificationResponse{
+		ID:        notification.ID.String(),
+		Type:      string(notification.Type),
+		Title:     notification.Title,
+		Message:   notification.Message,
+		Read:      notification.IsRead(),
+		CreatedAt: notification.CreatedAt,
+		Data:      notification.GetData(),
+	}
+}
+
+// NotificationsToListResponse convierte una lista paginada de notificaciones
+func (m NotificationMapperImpl) NotificationsToListResponse(notifications []*models.Notification, unread int64, pagination *common.PaginationMeta) dto.NotificationListResponse {
+	responses := make([]dto.NotificationResponse, 0, len(notifications))
+	for _, notification := range notifications {
+		responses = append(responses, m.NotificationToResponse(notification))
+	}
+
+	return dto.NotificationListResponse{
+		Notifications: responses,
+		Unread:        unread,
+		Pagination:    *pagination,
+	}
+}
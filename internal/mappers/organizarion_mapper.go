@@ -1,12 +1,21 @@
 package mappers
 
 import (
+	"math"
 	"strings"
 	"time"
 
 	"cybesphere-backend/internal/common"
 	"cybesphere-backend/internal/dto"
 	"cybesphere-backend/internal/models"
+	"cybesphere-backend/pkg/taxid"
+)
+
+// Errores de los datos fiscales de una organización
+var (
+	errInvalidTaxID           = common.NewValidationError("tax_id", "El NIF/CIF no es válido")
+	errVerifiedFiscalIdentity = common.NewBusinessError("verified_fiscal_identity",
+		"El NIF/CIF y la razón social de una organización verificada solo los puede cambiar un administrador")
 )
 
 // OrganizationMapperImpl implementación del mapper de organizaciones
@@ -51,15 +60,19 @@ func (m OrganizationMapperImpl) CreateOrganizationRequestToModel(req *dto.Create
 		YouTube:   strings.TrimSpace(req.YouTube),
 
 		// Documentación para verificación
-		TaxID:            strings.TrimSpace(req.TaxID),
+		TaxID:            taxid.Normalize(req.TaxID),
 		LegalName:        strings.TrimSpace(req.LegalName),
 		RegistrationDocs: strings.TrimSpace(req.RegistrationDocs),
+		VATRate:          vatRateBasisPoints(req.VATRate),
 
 		// Estado inicial
 		Status:          models.OrgStatusPending, // Requiere verificación por defecto
 		IsVerified:      false,
 		CanCreateEvents: true, // Habilitado por defecto
 	}
+	if organization.HasLocation() {
+		organization.CoordinatesSource = models.CoordinatesSourceManual
+	}
 
 	// Solo admin puede establecer ciertos campos en creación
 	if userCtx != nil && userCtx.IsAdmin() {
@@ -77,6 +90,10 @@ func (m OrganizationMapperImpl) CreateOrganizationRequestToModel(req *dto.Create
 		}
 	}
 
+	if organization.TaxID != "" && !taxid.IsValid(organization.TaxID) {
+		return nil, errInvalidTaxID
+	}
+
 	// Validar colores si están presentes
 	if err := organization.SetBranding(organization.PrimaryColor, organization.SecondaryColor); err != nil {
 		return nil, common.NewBusinessError("invalid_branding", "Formato de color inválido")
@@ -99,6 +116,7 @@ func (m OrganizationMapperImpl) UpdateOrganizationRequestToModel(org *models.Org
 	}
 
 	// Información de contacto
+	previousAddress := [3]string{org.Address, org.City, org.Country}
 	if req.Email != nil {
 		org.Email = strings.ToLower(strings.TrimSpace(*req.Email))
 	}
@@ -119,11 +137,22 @@ func (m OrganizationMapperImpl) UpdateOrganizationRequestToModel(org *models.Org
 	}
 
 	// Geolocalización
-	if req.Latitude != nil {
-		org.Latitude = req.Latitude
-	}
-	if req.Longitude != nil {
-		org.Longitude = req.Longitude
+	switch {
+	case req.Latitude != nil || req.Longitude != nil:
+		// Las coordenadas indicadas por la organización prevalecen sobre las geocodificadas
+		if req.Latitude != nil {
+			org.Latitude = req.Latitude
+		}
+		if req.Longitude != nil {
+			org.Longitude = req.Longitude
+		}
+		org.CoordinatesSource = models.CoordinatesSourceManual
+	case req.ResetLocation:
+		org.ClearLocation()
+	case org.CoordinatesSource == models.CoordinatesSourceGeocoded &&
+		previousAddress != [3]string{org.Address, org.City, org.Country}:
+		// Las coordenadas calculadas corresponden a la dirección anterior
+		org.ClearLocation()
 	}
 
 	// Branding
@@ -168,8 +197,32 @@ func (m OrganizationMapperImpl) UpdateOrganizationRequestToModel(org *models.Org
 		org.YouTube = strings.TrimSpace(*req.YouTube)
 	}
 
+	// Datos fiscales y facturación. Una vez verificada la organización, su
+	// identidad fiscal (la que figura en las facturas) solo la cambia un admin
+	isAdmin := userCtx != nil && userCtx.IsAdmin()
+	if req.TaxID != nil {
+		taxID := taxid.Normalize(*req.TaxID)
+		if taxID != "" && !taxid.IsValid(taxID) {
+			return errInvalidTaxID
+		}
+		if taxID != org.TaxID && org.IsVerified && !isAdmin {
+			return errVerifiedFiscalIdentity
+		}
+		org.TaxID = taxID
+	}
+	if req.LegalName != nil {
+		legalName := strings.TrimSpace(*req.LegalName)
+		if legalName != org.LegalName && org.IsVerified && !isAdmin {
+			return errVerifiedFiscalIdentity
+		}
+		org.LegalName = legalName
+	}
+	if req.VATRate != nil {
+		org.VATRate = vatRateBasisPoints(req.VATRate)
+	}
+
 	// Campos que solo admin puede cambiar
-	if userCtx != nil && userCtx.IsAdmin() {
+	if isAdmin {
 		if req.Status != nil {
 			org.Status = models.OrganizationStatus(*req.Status)
 		}
@@ -177,7 +230,7 @@ func (m OrganizationMapperImpl) UpdateOrganizationRequestToModel(org *models.Org
 			org.IsVerified = *req.IsVerified
 			if *req.IsVerified {
 				// Si se está verificando, actualizar campos relacionados
-				now := time.Now().Format(time.RFC3339)
+				now := time.Now()
 				org.VerifiedAt = &now
 				verifierID := userCtx.ID
 				org.VerifiedBy = &verifierID
@@ -210,14 +263,18 @@ func (m OrganizationMapperImpl) OrganizationToResponse(org *models.Organization,
 
 		// Branding
 		LogoURL:        org.LogoURL,
+		LogoVariants:   org.LogoVariants(),
 		BannerURL:      org.BannerURL,
+		BannerVariants: org.BannerVariants(),
 		PrimaryColor:   org.PrimaryColor,
 		SecondaryColor: org.SecondaryColor,
 
+		SuggestedPrimaryColor: org.SuggestedPrimaryColor(),
+
 		// Estado y verificación
 		Status:     string(org.Status),
 		IsVerified: org.IsVerified,
-		VerifiedAt: m.formatVerifiedAt(org.VerifiedAt),
+		VerifiedAt: org.VerifiedAt,
 
 		// Estadísticas públicas
 		EventsCount: org.EventsCount,
@@ -244,6 +301,7 @@ func (m OrganizationMapperImpl) OrganizationToResponse(org *models.Organization,
 	if m.canViewLocation(org, userCtx) {
 		response.Latitude = org.Latitude
 		response.Longitude = org.Longitude
+		response.CoordinatesSource = string(org.CoordinatesSource)
 	}
 
 	// Redes sociales si están configuradas
@@ -285,6 +343,9 @@ func (m OrganizationMapperImpl) OrganizationToDetailResponse(org *models.Organiz
 	// Estadísticas detalladas - para miembros y admin
 	if m.canViewStatistics(org, userCtx) {
 		detailResponse.Statistics = m.buildOrganizationStatistics(org)
+
+		vatRate := float64(org.InvoiceVATRate()) / 100
+		detailResponse.VATRate = &vatRate
 	}
 
 	// Eventos recientes - para miembros y admin
@@ -301,14 +362,15 @@ func (m OrganizationMapperImpl) OrganizationToDetailResponse(org *models.Organiz
 // OrganizationToSummaryResponse convierte a respuesta resumida
 func (m OrganizationMapperImpl) OrganizationToSummaryResponse(org *models.Organization) dto.OrganizationSummaryResponse {
 	return dto.OrganizationSummaryResponse{
-		ID:          org.ID.String(),
-		Slug:        org.Slug,
-		Name:        org.Name,
-		LogoURL:     org.LogoURL,
-		IsVerified:  org.IsVerified,
-		EventsCount: org.EventsCount,
-		City:        org.City,
-		Country:     org.Country,
+		ID:           org.ID.String(),
+		Slug:         org.Slug,
+		Name:         org.Name,
+		LogoURL:      org.LogoURL,
+		LogoVariants: org.LogoVariants(),
+		IsVerified:   org.IsVerified,
+		EventsCount:  org.EventsCount,
+		City:         org.City,
+		Country:      org.Country,
 	}
 }
 
@@ -385,19 +447,6 @@ func (m OrganizationMapperImpl) hasSocialMedia(org *models.Organization) bool {
 		org.Instagram != "" || org.YouTube != ""
 }
 
-// formatVerifiedAt formatea fecha de verificación
-func (m OrganizationMapperImpl) formatVerifiedAt(verifiedAt *string) *time.Time {
-	if verifiedAt == nil || *verifiedAt == "" {
-		return nil
-	}
-
-	if t, err := time.Parse(time.RFC3339, *verifiedAt); err == nil {
-		return &t
-	}
-
-	return nil
-}
-
 // getVerifiedByName obtiene nombre del verificador (placeholder)
 func (m OrganizationMapperImpl) getVerifiedByName(verifiedBy *string) string {
 	if verifiedBy == nil {
@@ -434,3 +483,12 @@ func (m OrganizationMapperImpl) getFeaturedMembers(org *models.Organization) []d
 	// Placeholder - en implementación real harías query de usuarios públicos
 	return []dto.UserSummaryResponse{}
 }
+
+// vatRateBasisPoints convierte un IVA en % a puntos básicos
+func vatRateBasisPoints(percent *float64) *int {
+	if percent == nil {
+		return nil
+	}
+	basisPoints := int(math.Round(*percent * 100))
+	return &basisPoints
+}
@@ -0,0 +1,80 @@
+package mappers
+
+import (
+	"time"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/dto"
+	"cybesphere-backend/internal/models"
+)
+
+// PrivacyMapperImpl implementación del mapper de exportaciones y supresiones de cuenta
+type PrivacyMapperImpl struct {
+	userMapper UserMapperImpl
+}
+
+// NewPrivacyMapper crea nueva instancia del mapper
+func NewPrivacyMapper() PrivacyMapperImpl {
+	return PrivacyMapperImpl{userMapper: NewUserMapper()}
+}
+
+// DataExportToResponse convierte una exportación a su respuesta
+func (m PrivacyMapperImpl) DataExportToResponse(export *models.DataExport) dto.DataExportResponse {
+	return dto.DataExportResponse{
+		ID:            export.ID.String(),
+		Status:        string(export.Status),
+		Downloadable:  export.IsDownloadable(time.Now()),
+		SizeBytes:     export.SizeBytes,
+		Checksum:      export.Checksum,
+		FailureReason: export.FailureReason,
+		CreatedAt:     export.CreatedAt,
+		CompletedAt:   export.CompletedAt,
+		ExpiresAt:     export.ExpiresAt,
+	}
+}
+
+// DataExportsToListResponse convierte las exportaciones de un usuario
+func (m PrivacyMapperImpl) DataExportsToListResponse(exports []*models.DataExport) dto.DataExportListResponse {
+	responses := make([]dto.DataExportResponse, 0, len(exports))
+	for _, export := range exports {
+		responses = append(responses, m.DataExportToResponse(export))
+	}
+	return dto.DataExportListResponse{Exports: responses}
+}
+
+// ErasureRequestToResponse convierte una solicitud de supresión; incluye el
+// usuario solo si se ha cargado
+func (m PrivacyMapperImpl) ErasureRequestToResponse(request *models.ErasureRequest) dto.ErasureRequestResponse {
+	response := dto.ErasureRequestResponse{
+		ID:           request.ID.String(),
+		UserID:       request.UserID,
+		Status:       string(request.Status),
+		Reason:       request.Reason,
+		ScheduledFor: request.ScheduledFor,
+		CompletedAt:  request.CompletedAt,
+		CanceledAt:   request.CanceledAt,
+		ProcessedBy:  request.ProcessedBy,
+		CreatedAt:    request.CreatedAt,
+	}
+
+	if request.User != nil {
+		summary := m.userMapper.UserToSummaryResponse(request.User)
+		response.User = &summary
+	}
+
+	return response
+}
+
+// ErasureRequestsToListResponse convierte una lista de solicitudes con paginación
+func (m PrivacyMapperImpl) ErasureRequestsToListResponse(requests []*models.ErasureRequest, pagination *common.PaginationMeta) dto.ErasureRequestListResponse {
+	responses := make([]dto.ErasureRequestResponse, 0, len(requests))
+	for _, request := range requests {
+		responses = append(responses, m.ErasureRequestToResponse(request))
+	}
+
+	response := dto.ErasureRequestListResponse{Requests: responses}
+	if pagination != nil {
+		response.Pagination = *pagination
+	}
+	return response
+}
@@ -0,0 +1,96 @@
+package mappers
+
+import (
+	"html"
+	"strings"
+
+	"cybesphere-backend/internal/dto"
+	"cybesphere-backend/internal/query"
+	"cybesphere-backend/internal/repositories"
+)
+
+// highlightReplacer convierte los delimitadores de ts_headline en etiquetas <mark>
+var highlightReplacer = strings.NewReplacer(
+	repositories.HighlightStart, "<mark>",
+	repositories.HighlightStop, "</mark>",
+)
+
+// SearchMapperImpl implementación del mapper de resultados de búsqueda
+type SearchMapperImpl struct {
+	eventMapper EventMapperImpl
+	orgMapper   OrganizationMapperImpl
+}
+
+// NewSearchMapper crea nueva instancia del mapper
+func NewSearchMapper() SearchMapperImpl {
+	return SearchMapperImpl{
+		eventMapper: NewEventMapper(),
+		orgMapper:   NewOrganizationMapper(),
+	}
+}
+
+// EventSearchHitsToResponse convierte los eventos encontrados
+func (m SearchMapperImpl) EventSearchHitsToResponse(hits []*repositories.EventSearchHit) []dto.EventSearchResultResponse {
+	responses := make([]dto.EventSearchResultResponse, 0, len(hits))
+	for _, hit := range hits {
+		summary := m.eventMapper.EventToSummaryResponse(&hit.Event)
+		summary.Organization = hit.OrganizationName
+		responses = append(responses, dto.EventSearchResultResponse{
+			EventSummaryResponse: summary,
+			Score:                hit.Rank,
+			TitleHighlight:       highlight(hit.TitleHighlight),
+			Snippet:              highlight(hit.Snippet),
+		})
+	}
+	return responses
+}
+
+// OrganizationSearchHitsToResponse convierte las organizaciones encontradas
+func (m SearchMapperImpl) OrganizationSearchHitsToResponse(hits []*repositories.OrganizationSearchHit) []dto.OrganizationSearchResultResponse {
+	responses := make([]dto.OrganizationSearchResultResponse, 0, len(hits))
+	for _, hit := range hits {
+		responses = append(responses, dto.OrganizationSearchResultResponse{
+			OrganizationSummaryResponse: m.orgMapper.OrganizationToSummaryResponse(&hit.Organization),
+			Score:                       hit.Rank,
+			NameHighlight:               highlight(hit.NameHighlight),
+			Snippet:                     highlight(hit.Snippet),
+		})
+	}
+	return responses
+}
+
+// FacetCountsToResponse convierte los recuentos de una faceta
+func (m SearchMapperImpl) FacetCountsToResponse(counts []query.FacetCount) []dto.FacetValueResponse {
+	responses := make([]dto.FacetValueResponse, 0, len(counts))
+	for _, count := range counts {
+		responses = append(responses, dto.FacetValueResponse{Value: count.Value, Count: count.Count})
+	}
+	return responses
+}
+
+// FacetsToResponse convierte los recuentos de varias facetas
+func (m SearchMapperImpl) FacetsToResponse(facets map[string][]query.FacetCount) map[string][]dto.FacetValueResponse {
+	responses := make(map[string][]dto.FacetValueResponse, len(facets))
+	for name, counts := range facets {
+		responses[name] = m.FacetCountsToResponse(counts)
+	}
+	return responses
+}
+
+// EventFacetsToResponse convierte las facetas de eventos; nil si no se calcularon
+func (m SearchMapperImpl) EventFacetsToResponse(facets *repositories.EventFacets) *dto.SearchFacetsResponse {
+	if facets == nil {
+		return nil
+	}
+	return &dto.SearchFacetsResponse{
+		Types:  m.FacetCountsToResponse(facets.Types),
+		Levels: m.FacetCountsToResponse(facets.Levels),
+		Cities: m.FacetCountsToResponse(facets.Cities),
+		Tags:   m.FacetCountsToResponse(facets.Tags),
+	}
+}
+
+// highlight escapa el fragmento como HTML y marca los términos encontrados
+func highlight(fragment string) string {
+	return highlightReplacer.Replace(html.EscapeString(fragment))
+}
@@ -0,0 +1,296 @@
+package mappers
+
+import (
+	"time"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/dto"
+	"cybesphere-backend/internal/models"
+)
+
+// TicketingMapperImpl implementación del mapper de entradas, descuentos e inscripciones
+type TicketingMapperImpl struct {
+	eventMapper EventMapperImpl
+}
+
+// NewTicketingMapper crea nueva instancia del mapper
+func NewTicketingMapper() TicketingMapperImpl {
+	return TicketingMapperImpl{eventMapper: NewEventMapper()}
+}
+
+// TicketTypeToResponse convierte un tipo de entrada a su respuesta
+func (m TicketingMapperImpl) TicketTypeToResponse(ticketType *models.TicketType) dto.TicketTypeResponse {
+	return dto.TicketTypeResponse{
+		ID:             ticketType.ID.String(),
+		EventID:        ticketType.EventID,
+		Name:           ticketType.Name,
+		Description:    ticketType.Description,
+		PriceCents:     ticketType.PriceCents,
+		Currency:       ticketType.Currency,
+		IsFree:         ticketType.IsFree(),
+		Quantity:       ticketType.Quantity,
+		QuantitySold:   ticketType.QuantitySold,
+		Remaining:      ticketType.Remaining(),
+		SalesStartDate: ticketType.SalesStartDate,
+		SalesEndDate:   ticketType.SalesEndDate,
+		IsActive:       ticketType.IsActive,
+		OnSale:         ticketType.IsOnSale(time.Now()),
+		SoldOut:        ticketType.IsSoldOut(),
+		SortOrder:      ticketType.SortOrder,
+	}
+}
+
+// TicketTypesToEventResponse convierte las entradas de un evento a su respuesta
+func (m TicketingMapperImpl) TicketTypesToEventResponse(event *models.Event, ticketTypes []*models.TicketType) dto.EventTicketsResponse {
+	response := dto.EventTicketsResponse{
+		EventID:          event.ID.String(),
+		Currency:         event.Currency,
+		RegistrationOpen: event.IsRegistrationOpen(),
+		TicketTypes:      make([]dto.TicketTypeResponse, 0, len(ticketTypes)),
+	}
+
+	for _, ticketType := range ticketTypes {
+		response.TicketTypes = append(response.TicketTypes, m.TicketTypeToResponse(ticketType))
+	}
+
+	return response
+}
+
+// PromoCodeToResponse convierte un código de descuento a su respuesta
+func (m TicketingMapperImpl) PromoCodeToResponse(promo *models.PromoCode) dto.PromoCodeResponse {
+	response := dto.PromoCodeResponse{
+		ID:            promo.ID.String(),
+		EventID:       promo.EventID,
+		Code:          promo.Code,
+		DiscountType:  string(promo.DiscountType),
+		MaxUses:       promo.MaxUses,
+		UsedCount:     promo.UsedCount,
+		StartsAt:      promo.StartsAt,
+		ExpiresAt:     promo.ExpiresAt,
+		TicketTypeIDs: promo.GetTicketTypeIDs(),
+		IsActive:      promo.IsActive,
+		CreatedAt:     promo.CreatedAt,
+	}
+
+	switch promo.DiscountType {
+	case models.DiscountTypePercentage:
+		percent := float64(promo.DiscountValue) / 100
+		response.PercentOff = &percent
+	case models.DiscountTypeFixed:
+		amount := promo.DiscountValue
+		response.AmountOffCents = &amount
+	}
+
+	return response
+}
+
+// PromoCodesToResponse convierte una lista de códigos de descuento
+func (m TicketingMapperImpl) PromoCodesToResponse(promos []*models.PromoCode) []dto.PromoCodeResponse {
+	responses := make([]dto.PromoCodeResponse, 0, len(promos))
+	for _, promo := range promos {
+		responses = append(responses, m.PromoCodeToResponse(promo))
+	}
+	return responses
+}
+
+// PriceQuoteToResponse convierte un desglose de precio a su respuesta
+func (m TicketingMapperImpl) PriceQuoteToResponse(eventID string, ticketType *models.TicketType, promo *models.PromoCode, quote models.PriceQuote) dto.PriceQuoteResponse {
+	response := dto.PriceQuoteResponse{
+		EventID:       eventID,
+		PriceCents:    quote.PriceCents,
+		DiscountCents: quote.DiscountCents,
+		TotalCents:    quote.TotalCents,
+		Currency:      quote.Currency,
+	}
+
+	if ticketType != nil {
+		response.TicketTypeID = ticketType.ID.String()
+		response.TicketTypeName = ticketType.Name
+	}
+
+	if promo != nil {
+		response.PromoCode = promo.Code
+	}
+
+	return response
+}
+
+// RegistrationToResponse convierte una inscripción a su respuesta
+func (m TicketingMapperImpl) RegistrationToResponse(registration *models.EventRegistration) dto.RegistrationResponse {
+	response := dto.RegistrationResponse{
+		ID:            registration.ID.String(),
+		EventID:       registration.EventID,
+		Status:        string(registration.Status),
+		TicketTypeID:  registration.TicketTypeID,
+		PriceCents:    registration.PriceCents,
+		DiscountCents: registration.DiscountCents,
+		TotalCents:    registration.TotalCents,
+		Currency:      registration.Currency,
+		ConfirmedAt:   registration.ConfirmedAt,
+		CanceledAt:    registration.CanceledAt,
+		CreatedAt:     registration.CreatedAt,
+	}
+
+	if registration.TicketType != nil {
+		response.TicketTypeName = registration.TicketType.Name
+	}
+
+	if registration.Event != nil {
+		summary := m.eventMapper.EventToSummaryResponse(registration.Event)
+		response.Event = &summary
+	}
+
+	if registration.Order != nil {
+		order := m.OrderToResponse(registration.Order)
+		response.Order = &order
+	}
+
+	return response
+}
+
+// RegistrationsToListResponse convierte una lista paginada de inscripciones
+func (m TicketingMapperImpl) RegistrationsToListResponse(registrations []*models.EventRegistration, pagination *common.PaginationMeta) dto.RegistrationListResponse {
+	responses := make([]dto.RegistrationResponse, 0, len(registrations))
+	for _, registration := range registrations {
+		responses = append(responses, m.RegistrationToResponse(registration))
+	}
+
+	return dto.RegistrationListResponse{
+		Registrations: responses,
+		Pagination:    *pagination,
+	}
+}
+
+// RegistrationsToAttendeesResponse convierte las inscripciones de un evento en su lista de asistentes
+func (m TicketingMapperImpl) RegistrationsToAttendeesResponse(event *models.Event, registrations []*models.EventRegistration, counts map[models.RegistrationStatus]int, pagination *common.PaginationMeta) dto.EventAttendeesListResponse {
+	attendees := make([]dto.EventAttendeeResponse, 0, len(registrations))
+	for _, registration := range registrations {
+		attendee := dto.EventAttendeeResponse{
+			ID:               registration.ID.String(),
+			UserID:           registration.UserID,
+			Status:           string(registration.Status),
+			TotalCents:       registration.TotalCents,
+			RegistrationDate: registration.CreatedAt,
+		}
+		if registration.User != nil {
+			attendee.Name = registration.User.GetFullName()
+			attendee.Email = registration.User.Email
+		}
+		if registration.TicketType != nil {
+			attendee.TicketType = registration.TicketType.Name
+		}
+		attendees = append(attendees, attendee)
+	}
+
+	statistics := dto.AttendeeStatistics{
+		Confirmed: counts[models.RegistrationStatusConfirmed],
+		Pending:   counts[models.RegistrationStatusPending],
+		Canceled:  counts[models.RegistrationStatusCanceled],
+	}
+	statistics.Total = statistics.Confirmed + statistics.Pending + statistics.Canceled
+
+	return dto.EventAttendeesListResponse{
+		EventID:    event.ID.String(),
+		EventTitle: event.Title,
+		Attendees:  attendees,
+		Statistics: statistics,
+		Pagination: *pagination,
+	}
+}
+
+// OrderToResponse convierte un pedido a response
+func (m TicketingMapperImpl) OrderToResponse(order *models.Order) dto.OrderResponse {
+	response := dto.OrderResponse{
+		ID:             order.ID.String(),
+		RegistrationID: order.RegistrationID,
+		EventID:        order.EventID,
+		Status:         string(order.Status),
+		AmountCents:    order.AmountCents,
+		Currency:       order.Currency,
+		Provider:       order.Provider,
+		FailureReason:  order.FailureReason,
+		PaidAt:         order.PaidAt,
+		FailedAt:       order.FailedAt,
+		RefundedAt:     order.RefundedAt,
+		CreatedAt:      order.CreatedAt,
+	}
+
+	if order.Status == models.OrderStatusPending {
+		response.CheckoutURL = order.CheckoutURL
+	}
+
+	if order.Event != nil {
+		summary := m.eventMapper.EventToSummaryResponse(order.Event)
+		response.Event = &summary
+	}
+
+	return response
+}
+
+// OrdersToListResponse convierte una lista de pedidos a response paginada
+func (m TicketingMapperImpl) OrdersToListResponse(orders []*models.Order, pagination *common.PaginationMeta) dto.OrderListResponse {
+	responses := make([]dto.OrderResponse, 0, len(orders))
+	for _, order := range orders {
+		responses = append(responses, m.OrderToResponse(order))
+	}
+
+	return dto.OrderListResponse{
+		Orders:     responses,
+		Pagination: *pagination,
+	}
+}
+
+// InvoiceToResponse convierte una factura a su representación estructurada
+func (m TicketingMapperImpl) InvoiceToResponse(invoice *models.Invoice) dto.InvoiceResponse {
+	response := dto.InvoiceResponse{
+		ID:                  invoice.ID.String(),
+		Number:              invoice.Number,
+		Kind:                string(invoice.Kind),
+		IssuedAt:            invoice.IssuedAt,
+		OrderID:             invoice.OrderID,
+		EventID:             invoice.EventID,
+		OrganizationID:      invoice.OrganizationID,
+		RectifiesID:         invoice.RectifiesID,
+		RectificationReason: invoice.RectificationReason,
+		Issuer: dto.InvoicePartyResponse{
+			Name:    invoice.IssuerName,
+			TaxID:   invoice.IssuerTaxID,
+			Address: invoice.IssuerAddress,
+		},
+		Customer: dto.InvoicePartyResponse{
+			Name:    invoice.Customer.Name,
+			TaxID:   invoice.Customer.TaxID,
+			Address: invoice.Customer.Address,
+			Email:   invoice.CustomerEmail,
+		},
+		Description: invoice.Description,
+		BaseCents:   invoice.BaseCents,
+		VATRate:     float64(invoice.VATRate) / 100,
+		VATCents:    invoice.VATCents,
+		TotalCents:  invoice.TotalCents,
+		Currency:    invoice.Currency,
+	}
+
+	if invoice.Rectifies != nil {
+		response.RectifiesNumber = invoice.Rectifies.Number
+	}
+
+	return response
+}
+
+// InvoicesToResponse convierte una lista de facturas
+func (m TicketingMapperImpl) InvoicesToResponse(invoices []*models.Invoice) []dto.InvoiceResponse {
+	responses := make([]dto.InvoiceResponse, 0, len(invoices))
+	for _, invoice := range invoices {
+		responses = append(responses, m.InvoiceToResponse(invoice))
+	}
+	return responses
+}
+
+// InvoicesToListResponse convierte una lista paginada de facturas
+func (m TicketingMapperImpl) InvoicesToListResponse(invoices []*models.Invoice, pagination *common.PaginationMeta) dto.InvoiceListResponse {
+	return dto.InvoiceListResponse{
+		Invoices:   m.InvoicesToResponse(invoices),
+		Pagination: *pagination,
+	}
+}
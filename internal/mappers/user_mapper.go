@@ -205,14 +205,15 @@ func (m UserMapperImpl) UserToResponse(user *models.User, userCtx *common.UserCo
 	// Organización si está asignada
 	if user.Organization != nil {
 		response.Organization = &dto.OrganizationSummaryResponse{
-			ID:          user.Organization.ID.String(),
-			Slug:        user.Organization.Slug,
-			Name:        user.Organization.Name,
-			LogoURL:     user.Organization.LogoURL,
-			IsVerified:  user.Organization.IsVerified,
-			EventsCount: user.Organization.EventsCount,
-			City:        user.Organization.City,
-			Country:     user.Organization.Country,
+			ID:           user.Organization.ID.String(),
+			Slug:         user.Organization.Slug,
+			Name:         user.Organization.Name,
+			LogoURL:      user.Organization.LogoURL,
+			LogoVariants: user.Organization.LogoVariants(),
+			IsVerified:   user.Organization.IsVerified,
+			EventsCount:  user.Organization.EventsCount,
+			City:         user.Organization.City,
+			Country:      user.Organization.Country,
 		}
 	}
 
@@ -413,6 +414,7 @@ func (m UserMapperImpl) getFavoriteEvents(user *models.User) []dto.EventSummaryR
 			IsFree:           event.IsFree,
 			Price:            event.Price,
 			ImageURL:         event.ImageURL,
+			ImageVariants:    event.ImageVariants(),
 			CurrentAttendees: event.CurrentAttendees,
 			MaxAttendees:     event.MaxAttendees,
 			IsFeatured:       event.IsFeatured,
@@ -504,6 +506,7 @@ func (m UserMapperImpl) getPublicEvents(user *models.User) []dto.EventSummaryRes
 				IsFree:           event.IsFree,
 				Price:            event.Price,
 				ImageURL:         event.ImageURL,
+				ImageVariants:    event.ImageVariants(),
 				CurrentAttendees: event.CurrentAttendees,
 				MaxAttendees:     event.MaxAttendees,
 				IsFeatured:       event.IsFeatured,
@@ -0,0 +1,105 @@
+package mappers
+
+import (
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/dto"
+	"cybesphere-backend/internal/models"
+)
+
+// VerificationMapperImpl implementación del mapper de solicitudes de verificación
+type VerificationMapperImpl struct {
+	userMapper UserMapperImpl
+	orgMapper  OrganizationMapperImpl
+}
+
+// NewVerificationMapper crea nueva instancia del mapper
+func NewVerificationMapper() VerificationMapperImpl {
+	return VerificationMapperImpl{userMapper: NewUserMapper(), orgMapper: NewOrganizationMapper()}
+}
+
+// VerificationToResponse convierte una solicitud de verificación. Con internal
+// incluye el administrador que la revisa
+func (m VerificationMapperImpl) VerificationToResponse(verification *models.OrganizationVerification, internal bool) dto.OrganizationVerificationResponse {
+	docs := verification.GetDocuments()
+	documents := make([]dto.VerificationDocumentResponse, 0, len(docs))
+	for _, doc := range docs {
+		documents = append(documents, dto.VerificationDocumentResponse{
+			Kind:    string(doc.Kind),
+			Name:    doc.Name,
+			URL:     doc.URL,
+			MediaID: doc.MediaID,
+		})
+	}
+
+	response := dto.OrganizationVerificationResponse{
+		ID:             verification.ID.String(),
+		OrganizationID: verification.OrganizationID,
+		Status:         string(verification.Status),
+		TaxID:          verification.TaxID,
+		LegalName:      verification.LegalName,
+		Documents:      documents,
+		Comments:       verification.Comments,
+		ReviewMessage:  verification.ReviewMessage,
+		ReviewedAt:     verification.ReviewedAt,
+		SubmittedBy:    verification.SubmittedBy,
+		CreatedAt:      verification.CreatedAt,
+	}
+	if verification.Organization != nil {
+		summary := m.orgMapper.OrganizationToSummaryResponse(verification.Organization)
+		response.Organization = &summary
+	}
+	if verification.Submitter != nil {
+		summary := m.userMapper.UserToSummaryResponse(verification.Submitter)
+		response.Submitter = &summary
+	}
+	if internal {
+		response.ReviewerID = verification.ReviewerID
+	}
+	return response
+}
+
+// VerificationToDetailResponse convierte una solicitud con el historial de la organización
+func (m VerificationMapperImpl) VerificationToDetailResponse(verification *models.OrganizationVerification, history []*models.OrganizationVerification) dto.OrganizationVerificationDetailResponse {
+	return dto.OrganizationVerificationDetailResponse{
+		OrganizationVerificationResponse: m.VerificationToResponse(verification, true),
+		History:                          m.verificationsToResponses(history, true),
+	}
+}
+
+// VerificationStatusToResponse convierte el estado de verificación de una organización
+func (m VerificationMapperImpl) VerificationStatusToResponse(org *models.Organization, history []*models.OrganizationVerification) dto.OrganizationVerificationStatusResponse {
+	canSubmit := org.CanRequestVerification()
+	for _, verification := range history {
+		if verification.IsOpen() {
+			canSubmit = false
+			break
+		}
+	}
+
+	return dto.OrganizationVerificationStatusResponse{
+		OrganizationID: org.ID.String(),
+		Status:         string(org.Status),
+		IsVerified:     org.IsVerified,
+		VerifiedAt:     org.VerifiedAt,
+		CanSubmit:      canSubmit,
+		History:        m.verificationsToResponses(history, false),
+	}
+}
+
+// VerificationsToListResponse convierte una lista de solicitudes con paginación
+func (m VerificationMapperImpl) VerificationsToListResponse(verifications []*models.OrganizationVerification, pagination *common.PaginationMeta) dto.OrganizationVerificationListResponse {
+	response := dto.OrganizationVerificationListResponse{Verifications: m.verificationsToResponses(verifications, true)}
+	if pagination != nil {
+		response.Pagination = *pagination
+	}
+	return response
+}
+
+// verificationsToResponses convierte una lista de solicitudes
+func (m VerificationMapperImpl) verificationsToResponses(verifications []*models.OrganizationVerification, internal bool) []dto.OrganizationVerificationResponse {
+	responses := make([]dto.OrganizationVerificationResponse, 0, len(verifications))
+	for _, verification := range verifications {
+		responses = append(responses, m.VerificationToResponse(verification, internal))
+	}
+	return responses
+}
@@ -12,6 +12,7 @@ import (
 	"cybesphere-backend/internal/common"
 	"cybesphere-backend/internal/models"
 	"cybesphere-backend/internal/permissions"
+	"cybesphere-backend/pkg/audit"
 	"cybesphere-backend/pkg/auth"
 	"cybesphere-backend/pkg/database"
 	"cybesphere-backend/pkg/logger"
@@ -487,6 +488,12 @@ func (m *AuthMiddleware) setUserContext(c *gin.Context, claims *auth.Claims, use
 	if user.OrganizationID != nil {
 		c.Set("organization_id", *user.OrganizationID)
 	}
+
+	// Usuario y petición en el contexto de la request para los hooks de
+	// BaseModel y la auditoría de cambios
+	ctx := models.ContextWithUserID(c.Request.Context(), claims.UserID)
+	ctx = audit.ContextWithRequest(ctx, audit.Request{IPAddress: c.ClientIP(), UserAgent: c.Request.UserAgent()})
+	c.Request = c.Request.WithContext(ctx)
 }
 
 // extractUserContext convierte contexto de Gin a UserContext unificado
@@ -727,7 +734,7 @@ func (m *AuthMiddleware) logCriticalAction(userID, method, path string, statusCo
 		Status:    statusCode,
 	}
 
-	if err := m.db.Create(&auditLog).Error; err != nil {
+	if err := models.AppendAuditLogs(m.db, []models.AuditLog{auditLog}); err != nil {
 		logger.Errorf("Failed to log critical action: %v", err)
 	}
 }
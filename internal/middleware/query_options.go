@@ -183,13 +183,16 @@ func buildBasicUserContext(c *gin.Context) *common.UserContext {
 func parseFiltersFromQuery(c *gin.Context, opts *common.QueryOptions) {
 	// Lista de parámetros reservados que NO son filtros
 	reservedParams := map[string]bool{
-		"page":      true,
-		"limit":     true,
-		"order_by":  true,
-		"order_dir": true,
-		"search":    true,
-		"expand":    true,
-		"fields":    true,
+		"page":       true,
+		"limit":      true,
+		"order_by":   true,
+		"order_dir":  true,
+		"search":     true,
+		"filter":     true,
+		"cursor":     true,
+		"with_total": true,
+		"expand":     true,
+		"fields":     true,
 	}
 
 	queryParams := c.Request.URL.Query()
@@ -0,0 +1,232 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"cybesphere-backend/pkg/audit"
+)
+
+// SystemActor usuario registrado en la auditoría para cambios sin usuario
+// autenticado (tareas en segundo plano, comandos)
+const SystemActor = "system"
+
+// auditChainLockID clave del bloqueo consultivo de PostgreSQL que serializa
+// las escrituras en la cadena de auditoría
+const auditChainLockID int64 = 0x61756469745f6c
+
+// Recursos auditados campo a campo
+const (
+	AuditResourceEvent        = "event"
+	AuditResourceOrganization = "organization"
+	AuditResourceUser         = "user"
+	AuditResourceReport       = "content_report"
+	AuditResourceVerification = "organization_verification"
+	AuditResourceMedia        = "media"
+)
+
+// mediaProcessingColumns columnas de los ficheros que rellena el worker de imágenes
+var mediaProcessingColumns = []string{
+	"processing_status", "processing_attempts", "processing_error", "processing_started_at",
+	"processed_at", "width", "height", "dominant_color", "variants",
+}
+
+// userPersonalColumns datos personales de los usuarios. La auditoría registra
+// qué columnas cambian pero no sus valores, de modo que tras la supresión de la
+// cuenta no quedan en la cadena datos que identifiquen al usuario
+var userPersonalColumns = []string{
+	"email", "first_name", "last_name", "company", "position", "bio", "website",
+	"linkedin", "twitter", "latitude", "longitude", "city",
+}
+
+// auditedTables tablas cuyos cambios se registran con sus diferencias. Se
+// ignoran contadores y marcas que cambian sin intervención de un usuario. De
+// eventos y organizaciones se guarda además el historial de versiones
+var auditedTables = map[string]audit.Table{
+	"events":                     {Resource: AuditResourceEvent, Ignore: []string{"views_count", "current_attendees", "media_variants"}, Versioned: true},
+	"organizations":              {Resource: AuditResourceOrganization, Ignore: []string{"events_count", "media_variants"}, Versioned: true},
+	"users":                      {Resource: AuditResourceUser, Ignore: []string{"last_login_at"}, Redact: userPersonalColumns},
+	"content_reports":            {Resource: AuditResourceReport},
+	"organization_verifications": {Resource: AuditResourceVerification},
+	"media":                      {Resource: AuditResourceMedia, Ignore: mediaProcessingColumns},
+}
+
+// NewAuditPlugin plugin de GORM que registra en audit_logs las altas, cambios y
+// bajas de eventos, organizaciones, usuarios, denuncias y solicitudes de
+// verificación con los valores anteriores y nuevos, y guarda en entity_versions
+// las versiones de eventos y organizaciones
+func NewAuditPlugin() gorm.Plugin {
+	return audit.New(audit.Config{
+		Tables:  auditedTables,
+		Record:  recordAuditEntries,
+		Version: recordEntityVersions,
+	})
+}
+
+// recordAuditEntries guarda las entradas con el usuario y la petición del contexto
+func recordAuditEntries(tx *gorm.DB, entries []audit.Entry) error {
+	ctx := tx.Statement.Context
+	userID := UserIDFromContext(ctx)
+	if userID == "" {
+		userID = SystemActor
+	}
+	request := audit.RequestFromContext(ctx)
+	restored, isRestore := RestoredVersionFromContext(ctx)
+	isErasure := IsErasureContext(ctx)
+	transition, isTransition := EventTransitionFromContext(ctx)
+	now := time.Now()
+
+	logs := make([]AuditLog, 0, len(entries))
+	for _, entry := range entries {
+		changes := make(map[string]any, len(entry.Changes))
+		for column, change := range entry.Changes {
+			changes[column] = change
+		}
+
+		action := entry.Action
+		if isRestore && action == audit.ActionUpdate {
+			action = VersionActionRestore
+			changes["restored_version"] = audit.Change{To: restored}
+		}
+		// Las transiciones del ciclo de vida se registran con su nombre
+		if isTransition && action == audit.ActionUpdate && entry.Resource == AuditResourceEvent {
+			action = string(transition)
+		}
+		// La supresión no puede dejar en la cadena los datos que elimina
+		if isErasure && action == audit.ActionUpdate {
+			action = AuditActionErase
+			for column := range changes {
+				changes[column] = audit.Change{From: audit.Redacted, To: audit.Redacted}
+			}
+		}
+
+		logs = append(logs, AuditLog{
+			UserID:     userID,
+			Action:     action,
+			Resource:   entry.Resource,
+			ResourceID: entry.ResourceID,
+			Changes:    changes,
+			IPAddress:  request.IPAddress,
+			UserAgent:  request.UserAgent,
+			Timestamp:  now,
+		})
+	}
+
+	return AppendAuditLogs(tx, logs)
+}
+
+// AppendAuditLogs añade registros al final de la cadena de auditoría. Cada
+// registro guarda el hash del anterior y el suyo propio; el bloqueo consultivo
+// se mantiene hasta el final de la transacción para que dos escrituras
+// concurrentes no enlacen con el mismo registro
+func AppendAuditLogs(db *gorm.DB, logs []AuditLog) error {
+	if len(logs) == 0 {
+		return nil
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", auditChainLockID).Error; err != nil {
+			return err
+		}
+
+		// Sin ámbito: un registro borrado también ocupa su posición
+		var head AuditLog
+		err := tx.Unscoped().Select("sequence", "hash").
+			Where("sequence IS NOT NULL").
+			Order("sequence DESC").
+			Limit(1).
+			Find(&head).Error
+		if err != nil {
+			return err
+		}
+
+		var sequence int64
+		if head.Sequence != nil {
+			sequence = *head.Sequence
+		}
+		prevHash := head.Hash
+
+		for i := range logs {
+			sequence++
+			if err := logs[i].seal(sequence, prevHash); err != nil {
+				return err
+			}
+			prevHash = logs[i].Hash
+		}
+
+		return tx.Create(&logs).Error
+	})
+}
+
+// seal asigna la posición en la cadena y calcula el hash del registro
+func (a *AuditLog) seal(sequence int64, prevHash string) error {
+	if a.ID == uuid.Nil {
+		a.ID = uuid.New()
+	}
+	if a.Timestamp.IsZero() {
+		a.Timestamp = time.Now()
+	}
+	// PostgreSQL guarda microsegundos; el hash debe poder recalcularse al leerlo
+	a.Timestamp = a.Timestamp.UTC().Truncate(time.Microsecond)
+
+	payload, err := a.chainPayload()
+	if err != nil {
+		return err
+	}
+	link := audit.NewLink(sequence, prevHash, payload)
+	a.Sequence = &link.Sequence
+	a.PrevHash = link.PrevHash
+	a.Hash = link.Hash
+	return nil
+}
+
+// ChainLink eslabón del registro tal y como está almacenado; false si es
+// anterior al encadenado
+func (a *AuditLog) ChainLink() (audit.Link, bool, error) {
+	if a.Sequence == nil {
+		return audit.Link{}, false, nil
+	}
+	payload, err := a.chainPayload()
+	if err != nil {
+		return audit.Link{}, false, err
+	}
+	return audit.Link{Sequence: *a.Sequence, PrevHash: a.PrevHash, Hash: a.Hash, Payload: payload}, true, nil
+}
+
+// IsAbout indica si el registro trata sobre la cuenta del usuario
+func (a *AuditLog) IsAbout(userID string) bool {
+	return a.Resource == AuditResourceUser && a.ResourceID == userID
+}
+
+// AuditCheckpoint último eslabón borrado al aplicar la retención de la
+// auditoría. La verificación de la cadena continúa desde él
+type AuditCheckpoint struct {
+	BaseModel
+	Sequence     int64     `json:"sequence" gorm:"not null;uniqueIndex"`
+	Hash         string    `json:"hash" gorm:"not null;size:64"`
+	PrunedBefore time.Time `json:"pruned_before" gorm:"not null"` // Fecha de corte de la retención
+	PrunedCount  int64     `json:"pruned_count" gorm:"not null;default:0"`
+}
+
+// TableName especifica el nombre de tabla
+func (AuditCheckpoint) TableName() string {
+	return "audit_checkpoints"
+}
+
+// chainPayload contenido canónico del registro que protege el hash
+func (a *AuditLog) chainPayload() ([]byte, error) {
+	return audit.CanonicalJSON(map[string]any{
+		"id":          a.ID.String(),
+		"user_id":     a.UserID,
+		"action":      a.Action,
+		"resource":    a.Resource,
+		"resource_id": a.ResourceID,
+		"changes":     a.Changes,
+		"ip_address":  a.IPAddress,
+		"user_agent":  a.UserAgent,
+		"timestamp":   a.Timestamp.UTC().Format(time.RFC3339Nano),
+		"status":      a.Status,
+	})
+}
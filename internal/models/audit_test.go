@@ -0,0 +1,88 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"cybesphere-backend/pkg/audit"
+)
+
+// reloadAuditLog simula la lectura del registro desde PostgreSQL: cambios
+// deserializados desde JSON y fecha en otra zona horaria
+func reloadAuditLog(t *testing.T, log AuditLog) AuditLog {
+	t.Helper()
+	raw, err := json.Marshal(log.Changes)
+	require.NoError(t, err)
+	log.Changes = nil
+	require.NoError(t, json.Unmarshal(raw, &log.Changes))
+
+	madrid, err := time.LoadLocation("Europe/Madrid")
+	require.NoError(t, err)
+	log.Timestamp = log.Timestamp.In(madrid)
+	return log
+}
+
+// TestAuditLog_ChainLink tests para el encadenado de los registros de auditoría
+func TestAuditLog_ChainLink(t *testing.T) {
+	first := AuditLog{
+		UserID:     "user-1",
+		Action:     audit.ActionUpdate,
+		Resource:   AuditResourceEvent,
+		ResourceID: "event-1",
+		Changes: map[string]any{
+			"title":    audit.Change{From: "Antes", To: "Después"},
+			"latitude": audit.Change{To: 40.4168},
+		},
+		Timestamp: time.Date(2025, 6, 1, 9, 0, 0, 123456789, time.UTC),
+	}
+	second := AuditLog{UserID: SystemActor, Action: "POST", Resource: "/api/v1/admin/dashboard", Status: 200}
+
+	require.NoError(t, first.seal(1, ""))
+	require.NoError(t, second.seal(2, first.Hash))
+
+	t.Run("registros previos al encadenado", func(t *testing.T) {
+		_, chained, err := (&AuditLog{UserID: "user-1"}).ChainLink()
+		require.NoError(t, err)
+		assert.False(t, chained)
+	})
+
+	t.Run("fecha truncada a microsegundos", func(t *testing.T) {
+		assert.Equal(t, 123456000, first.Timestamp.Nanosecond())
+		assert.Equal(t, first.Hash, second.PrevHash)
+	})
+
+	t.Run("la cadena verifica tras leerla de la base de datos", func(t *testing.T) {
+		verifier := audit.NewVerifier()
+		for _, log := range []AuditLog{first, second} {
+			stored := reloadAuditLog(t, log)
+			link, chained, err := stored.ChainLink()
+			require.NoError(t, err)
+			require.True(t, chained)
+			assert.Nil(t, verifier.Check(link))
+		}
+	})
+
+	t.Run("un cambio manipulado rompe la cadena", func(t *testing.T) {
+		stored := reloadAuditLog(t, first)
+		stored.UserID = "user-2"
+		link, _, err := stored.ChainLink()
+		require.NoError(t, err)
+
+		brk := audit.NewVerifier().Check(link)
+		require.NotNil(t, brk)
+		assert.Equal(t, audit.BreakHash, brk.Reason)
+	})
+}
+
+// TestAuditLog_IsAbout tests para los registros sobre la cuenta de un usuario
+func TestAuditLog_IsAbout(t *testing.T) {
+	userID := "uuid-user"
+
+	assert.True(t, (&AuditLog{Resource: AuditResourceUser, ResourceID: userID, UserID: "uuid-admin"}).IsAbout(userID))
+	assert.False(t, (&AuditLog{Resource: AuditResourceUser, ResourceID: "uuid-other", UserID: userID}).IsAbout(userID))
+	assert.False(t, (&AuditLog{Resource: AuditResourceEvent, ResourceID: userID, UserID: userID}).IsAbout(userID))
+}
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"context"
 	"time"
 
 	"github.com/google/uuid"
@@ -47,7 +48,21 @@ func (b *BaseModel) BeforeUpdate(tx *gorm.DB) (err error) {
 
 // getUserFromContext extrae el ID del usuario del contexto de la transacción
 func getUserFromContext(tx *gorm.DB) string {
-	if userCtx := tx.Statement.Context.Value("user_id"); userCtx != nil {
+	return UserIDFromContext(tx.Statement.Context)
+}
+
+// ContextWithUserID añade al contexto el usuario que origina los cambios. Usa
+// la clave "user_id", la misma que leen los hooks de BaseModel y la auditoría
+func ContextWithUserID(ctx context.Context, userID string) context.Context {
+	return context.WithValue(ctx, "user_id", userID)
+}
+
+// UserIDFromContext ID del usuario que origina los cambios ("" si no hay)
+func UserIDFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	if userCtx := ctx.Value("user_id"); userCtx != nil {
 		if userID, ok := userCtx.(string); ok {
 			return userID
 		}
@@ -68,11 +83,15 @@ type AuditLog struct {
 	Action     string         `json:"action" gorm:"not null;size:50;index"`
 	Resource   string         `json:"resource" gorm:"not null;size:100;index"`
 	ResourceID string         `json:"resource_id" gorm:"not null;size:100;index"`
-	Changes    map[string]any `json:"changes" gorm:"type:jsonb"`
+	Changes    map[string]any `json:"changes" gorm:"type:jsonb;serializer:json"`
 	IPAddress  string         `json:"ip_address" gorm:"size:45"`
 	UserAgent  string         `json:"user_agent" gorm:"size:500"`
 	Timestamp  time.Time      `json:"timestamp" gorm:"not null;index"`
 	Status     int            `json:"status" gorm:"not null;default:0"`
+	// Encadenado: posición, hash del registro anterior y hash propio
+	Sequence *int64 `json:"sequence,omitempty" gorm:"uniqueIndex"`
+	PrevHash string `json:"prev_hash,omitempty" gorm:"size:64"`
+	Hash     string `json:"hash,omitempty" gorm:"size:64"`
 }
 
 // TableName especifica el nombre de tabla para AuditLog
@@ -93,7 +112,7 @@ func LogAuditEvent(db *gorm.DB, userID, action, resource, resourceID string, cha
 		Timestamp:  time.Now(),
 	}
 
-	return db.Create(&auditLog).Error
+	return AppendAuditLogs(db, []AuditLog{auditLog})
 }
 
 // GetID implementa BaseEntity
@@ -0,0 +1,120 @@
+package models
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// CalendarFeedScope define el alcance de un feed de calendario
+type CalendarFeedScope string
+
+const (
+	CalendarFeedScopeOrganization CalendarFeedScope = "organization" // Eventos de una organización
+	CalendarFeedScopeTag          CalendarFeedScope = "tag"          // Eventos con un tag
+	CalendarFeedScopeUser         CalendarFeedScope = "user"         // Favoritos y registros del usuario
+)
+
+// CalendarFeed feed iCalendar suscribible protegido por un token secreto
+type CalendarFeed struct {
+	BaseModel
+
+	// Propietario del feed
+	UserID string `json:"user_id" gorm:"not null;size:36;index"`
+
+	// Configuración del feed
+	Name     string            `json:"name" gorm:"size:100"`
+	Scope    CalendarFeedScope `json:"scope" gorm:"not null;size:20;index"`
+	TargetID string            `json:"target_id" gorm:"not null;size:100;index"` // ID de organización, tag o usuario
+
+	// Token secreto (solo se guarda el hash)
+	TokenHash string `json:"-" gorm:"not null;size:64;uniqueIndex"`
+
+	// Estado y uso
+	IsRevoked      bool       `json:"is_revoked" gorm:"not null;default:false;index"`
+	RevokedAt      *time.Time `json:"revoked_at,omitempty"`
+	LastAccessedAt *time.Time `json:"last_accessed_at,omitempty"`
+}
+
+// TableName especifica el nombre de tabla
+func (CalendarFeed) TableName() string {
+	return "calendar_feeds"
+}
+
+// BeforeCreate hook de GORM para validación
+func (f *CalendarFeed) BeforeCreate(tx *gorm.DB) error {
+	if err := f.BaseModel.BeforeCreate(tx); err != nil {
+		return err
+	}
+
+	f.normalizeFields()
+	return f.ValidateCalendarFeed()
+}
+
+// ValidateCalendarFeed valida los datos del feed
+func (f *CalendarFeed) ValidateCalendarFeed() error {
+	if f.UserID == "" {
+		return errors.New("user ID is required")
+	}
+
+	if !f.IsValidScope() {
+		return errors.New("invalid calendar feed scope")
+	}
+
+	if f.TargetID == "" {
+		return errors.New("calendar feed target is required")
+	}
+
+	if f.TokenHash == "" {
+		return errors.New("token hash is required")
+	}
+
+	return nil
+}
+
+// IsValidScope verifica si el alcance es válido
+func (f *CalendarFeed) IsValidScope() bool {
+	return f.Scope == CalendarFeedScopeOrganization ||
+		f.Scope == CalendarFeedScopeTag ||
+		f.Scope == CalendarFeedScopeUser
+}
+
+// normalizeFields normaliza campos de texto
+func (f *CalendarFeed) normalizeFields() {
+	f.Name = strings.TrimSpace(f.Name)
+	f.TargetID = strings.TrimSpace(f.TargetID)
+	if f.Scope == CalendarFeedScopeTag {
+		f.TargetID = strings.ToLower(f.TargetID)
+	}
+}
+
+// Revoke revoca el feed
+func (f *CalendarFeed) Revoke() {
+	if !f.IsRevoked {
+		now := time.Now()
+		f.IsRevoked = true
+		f.RevokedAt = &now
+	}
+}
+
+// IsActive verifica si el feed puede usarse
+func (f *CalendarFeed) IsActive() bool {
+	return !f.IsRevoked
+}
+
+// GetAuditData implementa AuditableModel
+func (f *CalendarFeed) GetAuditData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":         f.ID,
+		"user_id":    f.UserID,
+		"scope":      f.Scope,
+		"target_id":  f.TargetID,
+		"is_revoked": f.IsRevoked,
+	}
+}
+
+func (f CalendarFeed) GetID() string           { return f.ID.String() }
+func (f CalendarFeed) GetCreatedAt() time.Time { return f.CreatedAt }
+func (f CalendarFeed) GetUpdatedAt() time.Time { return f.UpdatedAt }
@@ -0,0 +1,112 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// createTestCalendarFeed crea un feed de calendario válido para testing
+func createTestCalendarFeed() *CalendarFeed {
+	return &CalendarFeed{
+		UserID:    uuid.New().String(),
+		Name:      "Eventos de mi organización",
+		Scope:     CalendarFeedScopeOrganization,
+		TargetID:  uuid.New().String(),
+		TokenHash: "hashed-feed-token",
+	}
+}
+
+// TestCalendarFeed_ValidateCalendarFeed tests unitarios para validación
+func TestCalendarFeed_ValidateCalendarFeed(t *testing.T) {
+	tests := []struct {
+		name    string
+		feed    *CalendarFeed
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:    "feed válido",
+			feed:    createTestCalendarFeed(),
+			wantErr: false,
+		},
+		{
+			name: "user ID vacío",
+			feed: func() *CalendarFeed {
+				f := createTestCalendarFeed()
+				f.UserID = ""
+				return f
+			}(),
+			wantErr: true,
+			errMsg:  "user ID is required",
+		},
+		{
+			name: "alcance inválido",
+			feed: func() *CalendarFeed {
+				f := createTestCalendarFeed()
+				f.Scope = "everything"
+				return f
+			}(),
+			wantErr: true,
+			errMsg:  "invalid calendar feed scope",
+		},
+		{
+			name: "sin destino",
+			feed: func() *CalendarFeed {
+				f := createTestCalendarFeed()
+				f.TargetID = ""
+				return f
+			}(),
+			wantErr: true,
+			errMsg:  "calendar feed target is required",
+		},
+		{
+			name: "sin hash de token",
+			feed: func() *CalendarFeed {
+				f := createTestCalendarFeed()
+				f.TokenHash = ""
+				return f
+			}(),
+			wantErr: true,
+			errMsg:  "token hash is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.feed.ValidateCalendarFeed()
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Equal(t, tt.errMsg, err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// TestCalendarFeed_Revoke tests para revocación de feeds
+func TestCalendarFeed_Revoke(t *testing.T) {
+	feed := createTestCalendarFeed()
+	assert.True(t, feed.IsActive())
+
+	feed.Revoke()
+	assert.False(t, feed.IsActive())
+	assert.NotNil(t, feed.RevokedAt)
+
+	// Revocar de nuevo no cambia la fecha
+	revokedAt := *feed.RevokedAt
+	feed.Revoke()
+	assert.Equal(t, revokedAt, *feed.RevokedAt)
+}
+
+// TestCalendarFeed_normalizeFields tests para normalización de tags
+func TestCalendarFeed_normalizeFields(t *testing.T) {
+	feed := createTestCalendarFeed()
+	feed.Scope = CalendarFeedScopeTag
+	feed.TargetID = "  CTF "
+
+	feed.normalizeFields()
+	assert.Equal(t, "ctf", feed.TargetID)
+}
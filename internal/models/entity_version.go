@@ -0,0 +1,182 @@
+package models
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"cybesphere-backend/pkg/audit"
+)
+
+// Acciones que generan una versión
+const (
+	VersionActionInitial = "initial"          // Estado previo a la primera modificación registrada
+	VersionActionCreate  = audit.ActionCreate // Alta del registro
+	VersionActionUpdate  = audit.ActionUpdate // Modificación
+	VersionActionRestore = "restore"          // Restauración de una versión anterior
+)
+
+// RestorableColumns columnas que se recuperan al restaurar una versión: las que
+// el organizador puede editar. El estado, la moderación, la verificación, la
+// identidad fiscal verificada (NIF/CIF y razón social) y los contadores no se
+// restauran, ni el aforo y el precio de los eventos, que dependen de las
+// inscripciones y de los tipos de entrada actuales
+var RestorableColumns = map[string][]string{
+	AuditResourceEvent: {
+		"title", "description", "short_desc", "category", "level",
+		"start_date", "end_date", "timezone",
+		"is_online", "venue_address", "venue_name", "venue_city", "venue_country",
+		"latitude", "longitude", "coordinates_source", "online_url", "streaming_url",
+		"registration_url",
+		"image_url", "banner_url", "tags", "requirements", "agenda",
+		"registration_start_date", "registration_end_date", "cfp_start_date", "cfp_end_date",
+		"contact_email", "contact_phone", "meta_title", "meta_description",
+	},
+	AuditResourceOrganization: {
+		"name", "description", "website",
+		"email", "phone", "address", "city", "country", "postal_code",
+		"latitude", "longitude", "coordinates_source",
+		"logo_url", "banner_url", "primary_color", "secondary_color",
+		"linked_in", "twitter", "facebook", "instagram", "you_tube",
+		"vat_rate",
+	},
+}
+
+// EntityVersion estado completo de un evento u organización tras cada alta o
+// modificación, para consultar el historial y restaurar versiones anteriores
+type EntityVersion struct {
+	BaseModel
+
+	// Registro versionado
+	Resource   string `json:"resource" gorm:"not null;size:50;uniqueIndex:idx_entity_versions_resource_version"`
+	ResourceID string `json:"resource_id" gorm:"not null;size:36;uniqueIndex:idx_entity_versions_resource_version"`
+	Version    int    `json:"version" gorm:"not null;uniqueIndex:idx_entity_versions_resource_version"`
+
+	// Origen del cambio
+	Action       string `json:"action" gorm:"not null;size:20"`
+	UserID       string `json:"user_id" gorm:"not null;size:100;index"`
+	RestoredFrom *int   `json:"restored_from,omitempty"` // Versión restaurada
+
+	// Estado completo (columna → valor) y columnas modificadas respecto a la anterior
+	Snapshot      map[string]any `json:"snapshot" gorm:"type:jsonb;serializer:json;not null"`
+	ChangedFields []string       `json:"changed_fields" gorm:"type:jsonb;serializer:json"`
+}
+
+// TableName especifica el nombre de tabla
+func (EntityVersion) TableName() string {
+	return "entity_versions"
+}
+
+// BeforeCreate hook de GORM para validación
+func (v *EntityVersion) BeforeCreate(tx *gorm.DB) error {
+	if err := v.BaseModel.BeforeCreate(tx); err != nil {
+		return err
+	}
+	return v.ValidateEntityVersion()
+}
+
+// ValidateEntityVersion valida los datos de la versión
+func (v *EntityVersion) ValidateEntityVersion() error {
+	if _, ok := RestorableColumns[v.Resource]; !ok {
+		return errors.New("invalid versioned resource")
+	}
+
+	if strings.TrimSpace(v.ResourceID) == "" {
+		return errors.New("resource ID is required")
+	}
+
+	if v.Version < 1 {
+		return errors.New("version must be greater than zero")
+	}
+
+	if v.Snapshot == nil {
+		return errors.New("snapshot is required")
+	}
+
+	if v.Action == VersionActionRestore && v.RestoredFrom == nil {
+		return errors.New("restored version is required")
+	}
+
+	return nil
+}
+
+func (v EntityVersion) GetID() string           { return v.ID.String() }
+func (v EntityVersion) GetCreatedAt() time.Time { return v.CreatedAt }
+func (v EntityVersion) GetUpdatedAt() time.Time { return v.UpdatedAt }
+
+type restoredVersionKey struct{}
+
+// ContextWithRestoredVersion marca los cambios del contexto como restauración
+// de una versión; la versión nueva y el registro de auditoría lo reflejan
+func ContextWithRestoredVersion(ctx context.Context, version int) context.Context {
+	return context.WithValue(ctx, restoredVersionKey{}, version)
+}
+
+// RestoredVersionFromContext versión que se está restaurando (false si no es una restauración)
+func RestoredVersionFromContext(ctx context.Context) (int, bool) {
+	if ctx == nil {
+		return 0, false
+	}
+	version, ok := ctx.Value(restoredVersionKey{}).(int)
+	return version, ok
+}
+
+// recordEntityVersions guarda las versiones numerándolas a continuación de la
+// última del registro. La sentencia que las origina bloquea la fila versionada,
+// así que dos cambios del mismo registro no obtienen el mismo número
+func recordEntityVersions(tx *gorm.DB, versions []audit.Version) error {
+	ctx := tx.Statement.Context
+	userID := UserIDFromContext(ctx)
+	if userID == "" {
+		userID = SystemActor
+	}
+	restored, isRestore := RestoredVersionFromContext(ctx)
+
+	for _, version := range versions {
+		var last int
+		err := tx.Model(&EntityVersion{}).
+			Where("resource = ? AND resource_id = ?", version.Resource, version.ResourceID).
+			Select("COALESCE(MAX(version), 0)").
+			Scan(&last).Error
+		if err != nil {
+			return err
+		}
+
+		var rows []EntityVersion
+		// Registros anteriores al historial: se guarda su estado previo como base
+		if last == 0 && version.Previous != nil {
+			last++
+			rows = append(rows, EntityVersion{
+				Resource:   version.Resource,
+				ResourceID: version.ResourceID,
+				Version:    last,
+				Action:     VersionActionInitial,
+				UserID:     SystemActor,
+				Snapshot:   version.Previous,
+			})
+		}
+
+		current := EntityVersion{
+			Resource:      version.Resource,
+			ResourceID:    version.ResourceID,
+			Version:       last + 1,
+			Action:        version.Action,
+			UserID:        userID,
+			Snapshot:      version.Values,
+			ChangedFields: version.Changed,
+		}
+		if isRestore && version.Action == VersionActionUpdate {
+			current.Action = VersionActionRestore
+			current.RestoredFrom = &restored
+		}
+		rows = append(rows, current)
+
+		if err := tx.Create(&rows).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
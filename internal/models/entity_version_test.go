@@ -0,0 +1,112 @@
+package models
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"cybesphere-backend/pkg/audit"
+)
+
+// createTestEntityVersion crea una versión válida para testing
+func createTestEntityVersion() *EntityVersion {
+	return &EntityVersion{
+		Resource:   AuditResourceEvent,
+		ResourceID: uuid.New().String(),
+		Version:    2,
+		Action:     VersionActionUpdate,
+		UserID:     uuid.New().String(),
+		Snapshot:   map[string]any{"title": "Workshop de OSINT"},
+	}
+}
+
+// TestEntityVersion_ValidateEntityVersion tests unitarios para validación
+func TestEntityVersion_ValidateEntityVersion(t *testing.T) {
+	restored := 1
+
+	tests := []struct {
+		name    string
+		modify  func(v *EntityVersion)
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:   "versión válida",
+			modify: func(v *EntityVersion) {},
+		},
+		{
+			name:   "restauración válida",
+			modify: func(v *EntityVersion) { v.Action = VersionActionRestore; v.RestoredFrom = &restored },
+		},
+		{
+			name:    "recurso no versionado",
+			modify:  func(v *EntityVersion) { v.Resource = AuditResourceUser },
+			wantErr: true,
+			errMsg:  "invalid versioned resource",
+		},
+		{
+			name:    "sin registro",
+			modify:  func(v *EntityVersion) { v.ResourceID = " " },
+			wantErr: true,
+			errMsg:  "resource ID is required",
+		},
+		{
+			name:    "número de versión inválido",
+			modify:  func(v *EntityVersion) { v.Version = 0 },
+			wantErr: true,
+			errMsg:  "version must be greater than zero",
+		},
+		{
+			name:    "sin estado",
+			modify:  func(v *EntityVersion) { v.Snapshot = nil },
+			wantErr: true,
+			errMsg:  "snapshot is required",
+		},
+		{
+			name:    "restauración sin versión de origen",
+			modify:  func(v *EntityVersion) { v.Action = VersionActionRestore },
+			wantErr: true,
+			errMsg:  "restored version is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			version := createTestEntityVersion()
+			tt.modify(version)
+
+			err := version.ValidateEntityVersion()
+			if tt.wantErr {
+				assert.EqualError(t, err, tt.errMsg)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+// TestRestorableColumns las columnas restaurables existen en los modelos
+func TestRestorableColumns(t *testing.T) {
+	assert.NoError(t, audit.Restore(&Event{}, map[string]any{}, RestorableColumns[AuditResourceEvent]))
+	assert.NoError(t, audit.Restore(&Organization{}, map[string]any{}, RestorableColumns[AuditResourceOrganization]))
+
+	for _, column := range []string{"status", "is_featured", "is_verified", "views_count", "organization_id"} {
+		assert.NotContains(t, RestorableColumns[AuditResourceEvent], column)
+		assert.NotContains(t, RestorableColumns[AuditResourceOrganization], column)
+	}
+	for _, column := range []string{"tax_id", "legal_name"} {
+		assert.NotContains(t, RestorableColumns[AuditResourceOrganization], column)
+	}
+}
+
+// TestRestoredVersionFromContext tests para la marca de restauración en el contexto
+func TestRestoredVersionFromContext(t *testing.T) {
+	version, ok := RestoredVersionFromContext(ContextWithRestoredVersion(context.Background(), 3))
+	assert.True(t, ok)
+	assert.Equal(t, 3, version)
+
+	_, ok = RestoredVersionFromContext(context.Background())
+	assert.False(t, ok)
+}
@@ -15,10 +15,11 @@ import (
 type EventStatus string
 
 const (
-	EventStatusDraft     EventStatus = "draft"     // Borrador
-	EventStatusPublished EventStatus = "published" // Publicado y visible
-	EventStatusCanceled  EventStatus = "canceled"  // Cancelado
-	EventStatusCompleted EventStatus = "completed" // Finalizado
+	EventStatusDraft         EventStatus = "draft"          // Borrador
+	EventStatusPendingReview EventStatus = "pending_review" // Pendiente de aprobación por moderación
+	EventStatusPublished     EventStatus = "published"      // Publicado y visible
+	EventStatusCanceled      EventStatus = "canceled"       // Cancelado
+	EventStatusCompleted     EventStatus = "completed"      // Finalizado
 )
 
 // EventType define los tipos de evento
@@ -34,6 +35,14 @@ const (
 	EventTypeOther       EventType = "other"       // Otro tipo
 )
 
+// CoordinatesSource origen de las coordenadas de un evento u organización
+type CoordinatesSource string
+
+const (
+	CoordinatesSourceManual   CoordinatesSource = "manual"   // Indicadas por el organizador
+	CoordinatesSourceGeocoded CoordinatesSource = "geocoded" // Calculadas a partir de la dirección
+)
+
 // Event modelo para eventos de ciberseguridad
 type Event struct {
 	BaseModel
@@ -66,6 +75,9 @@ type Event struct {
 	OnlineURL    string   `json:"online_url" gorm:"size:500"`    // URL para eventos online
 	StreamingURL string   `json:"streaming_url" gorm:"size:500"` // URL de streaming
 
+	// Origen de las coordenadas; las manuales no se sobrescriben al geocodificar
+	CoordinatesSource CoordinatesSource `json:"coordinates_source,omitempty" gorm:"size:20"`
+
 	// Capacidad y registro
 	MaxAttendees     *int   `json:"max_attendees"` // null = ilimitado
 	CurrentAttendees int    `json:"current_attendees" gorm:"default:0"`
@@ -81,19 +93,28 @@ type Event struct {
 	ViewsCount int         `json:"views_count" gorm:"default:0"`
 
 	// Contenido y recursos
-	ImageURL     string         `json:"image_url" gorm:"size:500"`
-	BannerURL    string         `json:"banner_url" gorm:"size:500"`
-	Tags         datatypes.JSON `json:"tags" gorm:"type:jsonb"`        // Tags para categorización flexible
-	Requirements string         `json:"requirements" gorm:"type:text"` // Requisitos técnicos/conocimientos
-	Agenda       string         `json:"agenda" gorm:"type:text"`       // Agenda detallada
+	ImageURL      string         `json:"image_url" gorm:"size:500"`
+	BannerURL     string         `json:"banner_url" gorm:"size:500"`
+	MediaVariants datatypes.JSON `json:"media_variants,omitempty" gorm:"type:jsonb"` // Versiones de image y banner (MediaVariants)
+	Tags          datatypes.JSON `json:"tags" gorm:"type:jsonb"`                     // Tags para categorización flexible
+	Requirements  string         `json:"requirements" gorm:"type:text"`              // Requisitos técnicos/conocimientos
+	Agenda        string         `json:"agenda" gorm:"type:text"`                    // Agenda detallada
 
 	// Fechas importantes
 	RegistrationStartDate *time.Time `json:"registration_start_date"`
 	RegistrationEndDate   *time.Time `json:"registration_end_date"`
+	CFPStartDate          *time.Time `json:"cfp_start_date"` // Apertura del call for papers
+	CFPEndDate            *time.Time `json:"cfp_end_date"`   // Cierre del call for papers
 	PublishedAt           *time.Time `json:"published_at"`
+	ScheduledPublishAt    *time.Time `json:"scheduled_publish_at" gorm:"index"` // Publicación programada de un borrador
+	RegistrationClosedAt  *time.Time `json:"registration_closed_at"`            // Cierre de inscripciones del ciclo de vida
 	CanceledAt            *time.Time `json:"canceled_at"`
 	CompletedAt           *time.Time `json:"completed_at"`
 
+	// Moderación
+	HiddenAt     *time.Time `json:"hidden_at"`                      // Oculto por moderación; no se puede publicar
+	HiddenReason string     `json:"hidden_reason" gorm:"size:1000"` // Motivo comunicado al organizador
+
 	// Información de contacto
 	ContactEmail string `json:"contact_email" gorm:"size:255"`
 	ContactPhone string `json:"contact_phone" gorm:"size:20"`
@@ -102,10 +123,17 @@ type Event struct {
 	MetaTitle       string `json:"meta_title" gorm:"size:200"`
 	MetaDescription string `json:"meta_description" gorm:"size:500"`
 
+	// Series recurrentes
+	SeriesID          *string    `json:"series_id" gorm:"size:36;index"`
+	OccurrenceDate    *time.Time `json:"occurrence_date"`                          // Inicio original según la RRULE (RECURRENCE-ID)
+	IsSeriesException bool       `json:"is_series_exception" gorm:"default:false"` // Modificada individualmente
+
 	// Relaciones
-	OrganizationID string        `json:"organization_id" gorm:"not null;size:36;index"`
-	Organization   *Organization `json:"organization" gorm:"foreignKey:OrganizationID;references:ID"`
-	FavoritedBy    []User        `json:"favorited_by,omitempty" gorm:"many2many:user_favorite_events;"`
+	OrganizationID string         `json:"organization_id" gorm:"not null;size:36;index"`
+	Organization   *Organization  `json:"organization" gorm:"foreignKey:OrganizationID;references:ID"`
+	Series         *EventSeries   `json:"series,omitempty" gorm:"foreignKey:SeriesID;references:ID"`
+	Sessions       []EventSession `json:"sessions,omitempty" gorm:"foreignKey:EventID"`
+	FavoritedBy    []User         `json:"favorited_by,omitempty" gorm:"many2many:user_favorite_events;"`
 }
 
 // TableName especifica el nombre de tabla
@@ -197,6 +225,11 @@ func (e *Event) ValidateEvent() error {
 		return errors.New("max attendees must be at least 1")
 	}
 
+	// Validar ventana del CFP
+	if e.CFPStartDate != nil && e.CFPEndDate != nil && !e.CFPEndDate.After(*e.CFPStartDate) {
+		return errors.New("CFP end date must be after CFP start date")
+	}
+
 	return nil
 }
 
@@ -217,8 +250,9 @@ func (e *Event) IsValidType() bool {
 
 // IsValidStatus verifica si el estado es válido
 func (e *Event) IsValidStatus() bool {
-	return e.Status == EventStatusDraft || e.Status == EventStatusPublished ||
-		e.Status == EventStatusCanceled || e.Status == EventStatusCompleted
+	return e.Status == EventStatusDraft || e.Status == EventStatusPendingReview ||
+		e.Status == EventStatusPublished || e.Status == EventStatusCanceled ||
+		e.Status == EventStatusCompleted
 }
 
 // GenerateSlug genera un slug único basado en el título
@@ -243,41 +277,25 @@ func (e *Event) calculateDuration() {
 
 // Publish publica el evento
 func (e *Event) Publish() error {
-	if e.Status != EventStatusDraft {
-		return errors.New("only draft events can be published")
-	}
-
-	now := time.Now()
-	e.Status = EventStatusPublished
-	e.PublishedAt = &now
-
-	return nil
+	_, err := e.ApplyTransition(EventTransitionPublish, time.Now())
+	return err
 }
 
 // Cancel cancela el evento
 func (e *Event) Cancel() error {
-	if e.Status == EventStatusCanceled || e.Status == EventStatusCompleted {
-		return errors.New("event is already canceled or completed")
-	}
-
-	now := time.Now()
-	e.Status = EventStatusCanceled
-	e.CanceledAt = &now
-
-	return nil
+	_, err := e.ApplyTransition(EventTransitionCancel, time.Now())
+	return err
 }
 
 // Complete marca el evento como completado
 func (e *Event) Complete() error {
-	if e.Status != EventStatusPublished {
-		return errors.New("only published events can be completed")
-	}
-
-	now := time.Now()
-	e.Status = EventStatusCompleted
-	e.CompletedAt = &now
+	_, err := e.ApplyTransition(EventTransitionComplete, time.Now())
+	return err
+}
 
-	return nil
+// IsOccurrence verifica si el evento pertenece a una serie recurrente
+func (e *Event) IsOccurrence() bool {
+	return e.SeriesID != nil && *e.SeriesID != ""
 }
 
 // IsActive verifica si el evento está activo (publicado y no cancelado)
@@ -297,7 +315,7 @@ func (e *Event) IsPast() bool {
 
 // IsRegistrationOpen verifica si el registro está abierto
 func (e *Event) IsRegistrationOpen() bool {
-	if !e.IsActive() {
+	if !e.IsActive() || e.RegistrationClosedAt != nil {
 		return false
 	}
 
@@ -320,6 +338,26 @@ func (e *Event) IsRegistrationOpen() bool {
 	return true
 }
 
+// HasCFP verifica si el evento tiene call for papers
+func (e *Event) HasCFP() bool {
+	return e.CFPEndDate != nil
+}
+
+// IsCFPOpen verifica si el call for papers admite propuestas
+func (e *Event) IsCFPOpen() bool {
+	if !e.HasCFP() || !e.IsActive() {
+		return false
+	}
+
+	now := time.Now()
+
+	if e.CFPStartDate != nil && now.Before(*e.CFPStartDate) {
+		return false
+	}
+
+	return !now.After(*e.CFPEndDate)
+}
+
 // HasAvailableSpots verifica si hay cupos disponibles
 func (e *Event) HasAvailableSpots() bool {
 	if e.MaxAttendees == nil {
@@ -354,6 +392,28 @@ func (e *Event) HasLocation() bool {
 	return e.Latitude != nil && e.Longitude != nil
 }
 
+// SetGeocodedLocation establece coordenadas calculadas a partir de la dirección
+func (e *Event) SetGeocodedLocation(latitude, longitude float64) {
+	e.SetLocation(latitude, longitude)
+	e.CoordinatesSource = CoordinatesSourceGeocoded
+}
+
+// ClearLocation elimina las coordenadas para que se vuelvan a geocodificar
+func (e *Event) ClearLocation() {
+	e.Latitude = nil
+	e.Longitude = nil
+	e.CoordinatesSource = ""
+}
+
+// NeedsGeocoding indica si hay que calcular las coordenadas a partir de la
+// dirección: evento presencial, con dirección y sin coordenadas manuales
+func (e *Event) NeedsGeocoding() bool {
+	return !e.IsOnline &&
+		(e.VenueAddress != "" || e.VenueCity != "") &&
+		e.CoordinatesSource != CoordinatesSourceManual &&
+		!e.HasLocation()
+}
+
 // AddTag agrega un tag al evento
 func (e *Event) AddTag(tag string) error {
 	tag = strings.TrimSpace(strings.ToLower(tag))
@@ -437,6 +497,16 @@ func (e *Event) GetTags() []string {
 	return tags
 }
 
+// ImageVariants URLs de las versiones de la imagen principal
+func (e *Event) ImageVariants() map[string]string {
+	return ParseMediaVariants(e.MediaVariants).URLsFor(MediaFieldImage, e.ImageURL)
+}
+
+// BannerVariants URLs de las versiones del banner
+func (e *Event) BannerVariants() map[string]string {
+	return ParseMediaVariants(e.MediaVariants).URLsFor(MediaFieldBanner, e.BannerURL)
+}
+
 // IncrementViews incrementa el contador de visualizaciones
 func (e *Event) IncrementViews(tx *gorm.DB) error {
 	return tx.Model(e).UpdateColumn("views_count", gorm.Expr("views_count + ?", 1)).Error
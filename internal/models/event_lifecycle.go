@@ -0,0 +1,331 @@
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// EventTransition acción del ciclo de vida de un evento
+type EventTransition string
+
+const (
+	EventTransitionPublish            EventTransition = "publish"             // Publicación manual o programada
+	EventTransitionUnpublish          EventTransition = "unpublish"           // Vuelta a borrador
+	EventTransitionSchedulePublish    EventTransition = "schedule_publish"    // Programar la publicación de un borrador
+	EventTransitionUnschedulePublish  EventTransition = "unschedule_publish"  // Anular la publicación programada
+	EventTransitionCloseRegistration  EventTransition = "close_registration"  // Cierre de inscripciones
+	EventTransitionReopenRegistration EventTransition = "reopen_registration" // Reapertura al ampliar el plazo
+	EventTransitionCancel             EventTransition = "cancel"              // Cancelación
+	EventTransitionComplete           EventTransition = "complete"            // Fin del evento
+	EventTransitionSubmitReview       EventTransition = "submit_review"       // Envío a aprobación por moderación
+	EventTransitionApprove            EventTransition = "approve"             // Aprobación y publicación
+	EventTransitionReject             EventTransition = "reject"              // Rechazo, vuelta a borrador
+	EventTransitionHide               EventTransition = "hide"                // Ocultación por moderación
+	EventTransitionUnhide             EventTransition = "unhide"              // Fin de la ocultación
+)
+
+// eventTransitionRule estados desde los que se permite una transición, estado
+// resultante y error cuando el estado actual no lo permite
+type eventTransitionRule struct {
+	from []EventStatus
+	to   EventStatus
+	err  string
+}
+
+// eventLifecycle máquina de estados de los eventos. Es la única definición de
+// las transiciones permitidas: los métodos del modelo, el servicio, las
+// acciones masivas y las tareas programadas la consultan
+var eventLifecycle = map[EventTransition]eventTransitionRule{
+	EventTransitionPublish: {
+		from: []EventStatus{EventStatusDraft},
+		to:   EventStatusPublished,
+		err:  "only draft events can be published",
+	},
+	EventTransitionUnpublish: {
+		from: []EventStatus{EventStatusPublished},
+		to:   EventStatusDraft,
+		err:  "only published events can be unpublished",
+	},
+	EventTransitionSchedulePublish: {
+		from: []EventStatus{EventStatusDraft},
+		to:   EventStatusDraft,
+		err:  "only draft events can be scheduled for publication",
+	},
+	EventTransitionUnschedulePublish: {
+		from: []EventStatus{EventStatusDraft},
+		to:   EventStatusDraft,
+		err:  "only draft events have a scheduled publication",
+	},
+	EventTransitionCloseRegistration: {
+		from: []EventStatus{EventStatusPublished},
+		to:   EventStatusPublished,
+		err:  "only published events can close registration",
+	},
+	EventTransitionReopenRegistration: {
+		from: []EventStatus{EventStatusPublished},
+		to:   EventStatusPublished,
+		err:  "only published events can reopen registration",
+	},
+	EventTransitionCancel: {
+		from: []EventStatus{EventStatusDraft, EventStatusPendingReview, EventStatusPublished},
+		to:   EventStatusCanceled,
+		err:  "event is already canceled or completed",
+	},
+	EventTransitionComplete: {
+		from: []EventStatus{EventStatusPublished},
+		to:   EventStatusCompleted,
+		err:  "only published events can be completed",
+	},
+	EventTransitionSubmitReview: {
+		from: []EventStatus{EventStatusDraft},
+		to:   EventStatusPendingReview,
+		err:  "only draft events can be submitted for review",
+	},
+	EventTransitionApprove: {
+		from: []EventStatus{EventStatusPendingReview},
+		to:   EventStatusPublished,
+		err:  "only events pending review can be approved",
+	},
+	EventTransitionReject: {
+		from: []EventStatus{EventStatusPendingReview},
+		to:   EventStatusDraft,
+		err:  "only events pending review can be rejected",
+	},
+	EventTransitionHide: {
+		from: []EventStatus{EventStatusDraft, EventStatusPendingReview, EventStatusPublished},
+		to:   EventStatusDraft,
+		err:  "only draft, pending or published events can be hidden",
+	},
+	EventTransitionUnhide: {
+		from: []EventStatus{EventStatusDraft},
+		to:   EventStatusDraft,
+		err:  "only draft events can be unhidden",
+	},
+}
+
+// statusTransitions transiciones que cambian de estado, en el orden en que se
+// buscan al pedir un estado concreto
+var statusTransitions = []EventTransition{
+	EventTransitionPublish,
+	EventTransitionUnpublish,
+	EventTransitionSubmitReview,
+	EventTransitionApprove,
+	EventTransitionReject,
+	EventTransitionCancel,
+	EventTransitionComplete,
+}
+
+// EventLifecycleChange evento de dominio emitido tras cada transición
+type EventLifecycleChange struct {
+	EventID        string
+	OrganizationID string
+	Title          string
+	Transition     EventTransition
+	From           EventStatus
+	To             EventStatus
+	ActorID        string // Usuario que la provoca o SystemActor
+	OccurredAt     time.Time
+}
+
+// IsValidEventTransition verifica si la transición existe
+func IsValidEventTransition(transition EventTransition) bool {
+	_, ok := eventLifecycle[transition]
+	return ok
+}
+
+// EventTransitionSources estados desde los que se permite la transición
+func EventTransitionSources(transition EventTransition) []EventStatus {
+	return append([]EventStatus(nil), eventLifecycle[transition].from...)
+}
+
+// EventTransitionTo transición que lleva de un estado a otro (false si no hay
+// ninguna permitida)
+func EventTransitionTo(from, to EventStatus) (EventTransition, bool) {
+	for _, transition := range statusTransitions {
+		rule := eventLifecycle[transition]
+		if rule.to == to && rule.allows(from) {
+			return transition, true
+		}
+	}
+	return "", false
+}
+
+// allows verifica si la transición se permite desde el estado
+func (r eventTransitionRule) allows(status EventStatus) bool {
+	for _, from := range r.from {
+		if from == status {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckTransition verifica que la transición se pueda aplicar al evento
+func (e *Event) CheckTransition(transition EventTransition) error {
+	rule, ok := eventLifecycle[transition]
+	if !ok {
+		return fmt.Errorf("unknown event transition %q", transition)
+	}
+	if !rule.allows(e.Status) {
+		return errors.New(rule.err)
+	}
+
+	switch transition {
+	case EventTransitionPublish, EventTransitionSubmitReview:
+		if e.IsHidden() {
+			return errors.New("event is hidden by moderation")
+		}
+	case EventTransitionSchedulePublish:
+		if e.IsHidden() {
+			return errors.New("event is hidden by moderation")
+		}
+		if e.ScheduledPublishAt == nil {
+			return errors.New("publication time is required")
+		}
+	case EventTransitionUnschedulePublish:
+		if e.ScheduledPublishAt == nil {
+			return errors.New("event has no scheduled publication")
+		}
+	case EventTransitionCloseRegistration:
+		if e.RegistrationClosedAt != nil {
+			return errors.New("registration is already closed")
+		}
+	case EventTransitionReopenRegistration:
+		if e.RegistrationClosedAt == nil {
+			return errors.New("registration is not closed")
+		}
+		if e.RegistrationEndDate != nil && !e.RegistrationEndDate.After(time.Now()) {
+			return errors.New("registration end date has passed")
+		}
+	case EventTransitionHide:
+		if e.IsHidden() {
+			return errors.New("event is already hidden")
+		}
+	case EventTransitionUnhide:
+		if !e.IsHidden() {
+			return errors.New("event is not hidden")
+		}
+	}
+	return nil
+}
+
+// ApplyTransition aplica la transición en el instante at y devuelve el evento
+// de dominio correspondiente. Actualiza el estado y sus marcas de tiempo
+func (e *Event) ApplyTransition(transition EventTransition, at time.Time) (EventLifecycleChange, error) {
+	if err := e.CheckTransition(transition); err != nil {
+		return EventLifecycleChange{}, err
+	}
+
+	rule := eventLifecycle[transition]
+	change := EventLifecycleChange{
+		EventID:        e.ID.String(),
+		OrganizationID: e.OrganizationID,
+		Title:          e.Title,
+		Transition:     transition,
+		From:           e.Status,
+		To:             rule.to,
+		OccurredAt:     at,
+	}
+
+	e.Status = rule.to
+	switch transition {
+	case EventTransitionPublish, EventTransitionApprove:
+		e.PublishedAt = &at
+		e.ScheduledPublishAt = nil
+	case EventTransitionUnpublish:
+		e.PublishedAt = nil
+	case EventTransitionSubmitReview:
+		e.ScheduledPublishAt = nil
+	case EventTransitionHide:
+		e.HiddenAt = &at
+		e.PublishedAt = nil
+		e.ScheduledPublishAt = nil
+	case EventTransitionUnhide:
+		e.HiddenAt = nil
+		e.HiddenReason = ""
+	case EventTransitionUnschedulePublish:
+		e.ScheduledPublishAt = nil
+	case EventTransitionCloseRegistration:
+		e.RegistrationClosedAt = &at
+	case EventTransitionReopenRegistration:
+		e.RegistrationClosedAt = nil
+	case EventTransitionCancel:
+		e.CanceledAt = &at
+		e.ScheduledPublishAt = nil
+	case EventTransitionComplete:
+		e.CompletedAt = &at
+	}
+
+	return change, nil
+}
+
+// SchedulePublication programa la publicación de un borrador. La fecha debe
+// ser futura y anterior al fin del evento
+func (e *Event) SchedulePublication(publishAt, now time.Time) (EventLifecycleChange, error) {
+	if !publishAt.After(now) {
+		return EventLifecycleChange{}, errors.New("publication time must be in the future")
+	}
+	if !publishAt.Before(e.EndDate) {
+		return EventLifecycleChange{}, errors.New("publication time must be before the event ends")
+	}
+
+	previous := e.ScheduledPublishAt
+	e.ScheduledPublishAt = &publishAt
+	change, err := e.ApplyTransition(EventTransitionSchedulePublish, now)
+	if err != nil {
+		e.ScheduledPublishAt = previous
+	}
+	return change, err
+}
+
+// Hide oculta el evento por moderación y guarda el motivo que se comunica al
+// organizador. Vuelve a borrador y no se puede publicar hasta que se restaure
+func (e *Event) Hide(reason string, at time.Time) (EventLifecycleChange, error) {
+	change, err := e.ApplyTransition(EventTransitionHide, at)
+	if err != nil {
+		return change, err
+	}
+	e.HiddenReason = strings.TrimSpace(reason)
+	return change, nil
+}
+
+// IsHidden verifica si el evento está oculto por moderación
+func (e *Event) IsHidden() bool {
+	return e.HiddenAt != nil
+}
+
+// LifecycleColumns columnas del ciclo de vida con sus valores actuales, para
+// guardar una transición sin tocar el resto del evento
+func (e *Event) LifecycleColumns() map[string]interface{} {
+	return map[string]interface{}{
+		"status":                 e.Status,
+		"published_at":           e.PublishedAt,
+		"scheduled_publish_at":   e.ScheduledPublishAt,
+		"registration_closed_at": e.RegistrationClosedAt,
+		"canceled_at":            e.CanceledAt,
+		"completed_at":           e.CompletedAt,
+		"hidden_at":              e.HiddenAt,
+		"hidden_reason":          e.HiddenReason,
+	}
+}
+
+// eventTransitionKey clave de contexto de la transición que se está guardando
+type eventTransitionKey struct{}
+
+// ContextWithEventTransition marca los cambios del contexto como una
+// transición del ciclo de vida; la auditoría la registra como acción
+func ContextWithEventTransition(ctx context.Context, transition EventTransition) context.Context {
+	return context.WithValue(ctx, eventTransitionKey{}, transition)
+}
+
+// EventTransitionFromContext transición que se está guardando (false si no lo es)
+func EventTransitionFromContext(ctx context.Context) (EventTransition, bool) {
+	if ctx == nil {
+		return "", false
+	}
+	transition, ok := ctx.Value(eventTransitionKey{}).(EventTransition)
+	return transition, ok
+}
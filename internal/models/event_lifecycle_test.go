@@ -0,0 +1,282 @@
+package models
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestEvent_ApplyTransition tests para la máquina de estados del ciclo de vida
+func TestEvent_ApplyTransition(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name       string
+		status     EventStatus
+		setup      func(e *Event)
+		transition EventTransition
+		wantStatus EventStatus
+		wantErr    string
+	}{
+		{name: "publicar borrador", status: EventStatusDraft, transition: EventTransitionPublish, wantStatus: EventStatusPublished},
+		{name: "despublicar", status: EventStatusPublished, transition: EventTransitionUnpublish, wantStatus: EventStatusDraft},
+		{name: "cancelar borrador", status: EventStatusDraft, transition: EventTransitionCancel, wantStatus: EventStatusCanceled},
+		{name: "completar publicado", status: EventStatusPublished, transition: EventTransitionComplete, wantStatus: EventStatusCompleted},
+		{name: "cerrar inscripciones", status: EventStatusPublished, transition: EventTransitionCloseRegistration, wantStatus: EventStatusPublished},
+		{
+			name:       "reabrir inscripciones con plazo ampliado",
+			status:     EventStatusPublished,
+			setup:      func(e *Event) { e.RegistrationClosedAt = &past; e.RegistrationEndDate = &future },
+			transition: EventTransitionReopenRegistration,
+			wantStatus: EventStatusPublished,
+		},
+		{
+			name:       "anular publicación programada",
+			status:     EventStatusDraft,
+			setup:      func(e *Event) { e.ScheduledPublishAt = &future },
+			transition: EventTransitionUnschedulePublish,
+			wantStatus: EventStatusDraft,
+		},
+		{name: "reabrir un evento completado", status: EventStatusCompleted, transition: EventTransitionPublish, wantErr: "only draft events can be published"},
+		{name: "cancelar un evento cancelado", status: EventStatusCanceled, transition: EventTransitionCancel, wantErr: "event is already canceled or completed"},
+		{name: "completar un borrador", status: EventStatusDraft, transition: EventTransitionComplete, wantErr: "only published events can be completed"},
+		{name: "cerrar inscripciones de un borrador", status: EventStatusDraft, transition: EventTransitionCloseRegistration, wantErr: "only published events can close registration"},
+		{
+			name:       "cerrar inscripciones ya cerradas",
+			status:     EventStatusPublished,
+			setup:      func(e *Event) { e.RegistrationClosedAt = &past },
+			transition: EventTransitionCloseRegistration,
+			wantErr:    "registration is already closed",
+		},
+		{
+			name:       "reabrir con el plazo vencido",
+			status:     EventStatusPublished,
+			setup:      func(e *Event) { e.RegistrationClosedAt = &past; e.RegistrationEndDate = &past },
+			transition: EventTransitionReopenRegistration,
+			wantErr:    "registration end date has passed",
+		},
+		{name: "anular sin publicación programada", status: EventStatusDraft, transition: EventTransitionUnschedulePublish, wantErr: "event has no scheduled publication"},
+		{name: "transición desconocida", status: EventStatusDraft, transition: "archive", wantErr: `unknown event transition "archive"`},
+		{name: "enviar a revisión", status: EventStatusDraft, transition: EventTransitionSubmitReview, wantStatus: EventStatusPendingReview},
+		{name: "aprobar pendiente", status: EventStatusPendingReview, transition: EventTransitionApprove, wantStatus: EventStatusPublished},
+		{name: "rechazar pendiente", status: EventStatusPendingReview, transition: EventTransitionReject, wantStatus: EventStatusDraft},
+		{name: "cancelar pendiente", status: EventStatusPendingReview, transition: EventTransitionCancel, wantStatus: EventStatusCanceled},
+		{name: "aprobar un borrador", status: EventStatusDraft, transition: EventTransitionApprove, wantErr: "only events pending review can be approved"},
+		{name: "ocultar publicado", status: EventStatusPublished, transition: EventTransitionHide, wantStatus: EventStatusDraft},
+		{name: "ocultar completado", status: EventStatusCompleted, transition: EventTransitionHide, wantErr: "only draft, pending or published events can be hidden"},
+		{
+			name:       "ocultar ya oculto",
+			status:     EventStatusDraft,
+			setup:      func(e *Event) { e.HiddenAt = &past },
+			transition: EventTransitionHide,
+			wantErr:    "event is already hidden",
+		},
+		{
+			name:       "publicar oculto",
+			status:     EventStatusDraft,
+			setup:      func(e *Event) { e.HiddenAt = &past },
+			transition: EventTransitionPublish,
+			wantErr:    "event is hidden by moderation",
+		},
+		{
+			name:       "enviar a revisión oculto",
+			status:     EventStatusDraft,
+			setup:      func(e *Event) { e.HiddenAt = &past },
+			transition: EventTransitionSubmitReview,
+			wantErr:    "event is hidden by moderation",
+		},
+		{
+			name:       "restaurar oculto",
+			status:     EventStatusDraft,
+			setup:      func(e *Event) { e.HiddenAt = &past },
+			transition: EventTransitionUnhide,
+			wantStatus: EventStatusDraft,
+		},
+		{name: "restaurar no oculto", status: EventStatusDraft, transition: EventTransitionUnhide, wantErr: "event is not hidden"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := createTestEvent()
+			event.Status = tt.status
+			if tt.setup != nil {
+				tt.setup(event)
+			}
+			at := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+			change, err := event.ApplyTransition(tt.transition, at)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				assert.Equal(t, tt.status, event.Status)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, event.Status)
+			assert.Equal(t, tt.transition, change.Transition)
+			assert.Equal(t, tt.status, change.From)
+			assert.Equal(t, tt.wantStatus, change.To)
+			assert.Equal(t, at, change.OccurredAt)
+			assert.Equal(t, event.OrganizationID, change.OrganizationID)
+		})
+	}
+}
+
+// TestEvent_LifecycleTimestamps tests para las marcas de tiempo de cada transición
+func TestEvent_LifecycleTimestamps(t *testing.T) {
+	at := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	t.Run("publicar borra la publicación programada", func(t *testing.T) {
+		event := createTestEvent()
+		scheduled := at.Add(time.Hour)
+		event.ScheduledPublishAt = &scheduled
+
+		_, err := event.ApplyTransition(EventTransitionPublish, at)
+		require.NoError(t, err)
+		assert.Equal(t, at, *event.PublishedAt)
+		assert.Nil(t, event.ScheduledPublishAt)
+	})
+
+	t.Run("cerrar inscripciones", func(t *testing.T) {
+		event := createTestEvent()
+		event.Status = EventStatusPublished
+
+		_, err := event.ApplyTransition(EventTransitionCloseRegistration, at)
+		require.NoError(t, err)
+		assert.Equal(t, at, *event.RegistrationClosedAt)
+		assert.False(t, event.IsRegistrationOpen())
+	})
+
+	t.Run("aprobar publica", func(t *testing.T) {
+		event := createTestEvent()
+		event.Status = EventStatusPendingReview
+
+		_, err := event.ApplyTransition(EventTransitionApprove, at)
+		require.NoError(t, err)
+		assert.Equal(t, at, *event.PublishedAt)
+	})
+
+	t.Run("ocultar con motivo", func(t *testing.T) {
+		event := createTestEvent()
+		event.Status = EventStatusPublished
+		event.PublishedAt = &at
+		scheduled := at.Add(time.Hour)
+		event.ScheduledPublishAt = &scheduled
+
+		change, err := event.Hide("  Información engañosa  ", at)
+		require.NoError(t, err)
+		assert.Equal(t, EventTransitionHide, change.Transition)
+		assert.Equal(t, EventStatusDraft, event.Status)
+		assert.True(t, event.IsHidden())
+		assert.Equal(t, "Información engañosa", event.HiddenReason)
+		assert.Nil(t, event.PublishedAt)
+		assert.Nil(t, event.ScheduledPublishAt)
+		assert.Equal(t, "Información engañosa", event.LifecycleColumns()["hidden_reason"])
+	})
+
+	t.Run("restaurar borra el motivo", func(t *testing.T) {
+		event := createTestEvent()
+		_, err := event.Hide("Spam", at)
+		require.NoError(t, err)
+
+		_, err = event.ApplyTransition(EventTransitionUnhide, at)
+		require.NoError(t, err)
+		assert.False(t, event.IsHidden())
+		assert.Empty(t, event.HiddenReason)
+	})
+
+	t.Run("columnas guardadas", func(t *testing.T) {
+		event := createTestEvent()
+
+		_, err := event.ApplyTransition(EventTransitionCancel, at)
+		require.NoError(t, err)
+		columns := event.LifecycleColumns()
+		assert.Equal(t, EventStatusCanceled, columns["status"])
+		assert.Equal(t, &at, columns["canceled_at"])
+		assert.Contains(t, columns, "scheduled_publish_at")
+	})
+}
+
+// TestEvent_SchedulePublication tests para la publicación programada
+func TestEvent_SchedulePublication(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name      string
+		status    EventStatus
+		publishAt func(e *Event) time.Time
+		wantErr   string
+	}{
+		{name: "borrador con fecha futura", status: EventStatusDraft, publishAt: func(*Event) time.Time { return now.Add(time.Hour) }},
+		{name: "fecha pasada", status: EventStatusDraft, publishAt: func(*Event) time.Time { return now.Add(-time.Minute) }, wantErr: "publication time must be in the future"},
+		{name: "tras el fin del evento", status: EventStatusDraft, publishAt: func(e *Event) time.Time { return e.EndDate }, wantErr: "publication time must be before the event ends"},
+		{name: "evento ya publicado", status: EventStatusPublished, publishAt: func(*Event) time.Time { return now.Add(time.Hour) }, wantErr: "only draft events can be scheduled for publication"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := createTestEvent()
+			event.Status = tt.status
+			publishAt := tt.publishAt(event)
+
+			change, err := event.SchedulePublication(publishAt, now)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				assert.Nil(t, event.ScheduledPublishAt)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, EventTransitionSchedulePublish, change.Transition)
+			assert.Equal(t, EventStatusDraft, event.Status)
+			assert.Equal(t, publishAt, *event.ScheduledPublishAt)
+		})
+	}
+}
+
+// TestEventTransitionTo tests para la búsqueda de la transición entre dos estados
+func TestEventTransitionTo(t *testing.T) {
+	tests := []struct {
+		name   string
+		from   EventStatus
+		to     EventStatus
+		want   EventTransition
+		wantOK bool
+	}{
+		{name: "borrador a publicado", from: EventStatusDraft, to: EventStatusPublished, want: EventTransitionPublish, wantOK: true},
+		{name: "publicado a borrador", from: EventStatusPublished, to: EventStatusDraft, want: EventTransitionUnpublish, wantOK: true},
+		{name: "publicado a cancelado", from: EventStatusPublished, to: EventStatusCanceled, want: EventTransitionCancel, wantOK: true},
+		{name: "publicado a completado", from: EventStatusPublished, to: EventStatusCompleted, want: EventTransitionComplete, wantOK: true},
+		{name: "completado a publicado", from: EventStatusCompleted, to: EventStatusPublished},
+		{name: "cancelado a borrador", from: EventStatusCanceled, to: EventStatusDraft},
+		{name: "borrador a completado", from: EventStatusDraft, to: EventStatusCompleted},
+		{name: "borrador a pendiente", from: EventStatusDraft, to: EventStatusPendingReview, want: EventTransitionSubmitReview, wantOK: true},
+		{name: "pendiente a publicado", from: EventStatusPendingReview, to: EventStatusPublished, want: EventTransitionApprove, wantOK: true},
+		{name: "pendiente a borrador", from: EventStatusPendingReview, to: EventStatusDraft, want: EventTransitionReject, wantOK: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := EventTransitionTo(tt.from, tt.to)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("orígenes de la cancelación", func(t *testing.T) {
+		assert.ElementsMatch(t, []EventStatus{EventStatusDraft, EventStatusPendingReview, EventStatusPublished}, EventTransitionSources(EventTransitionCancel))
+	})
+
+	t.Run("transición en el contexto", func(t *testing.T) {
+		_, ok := EventTransitionFromContext(context.Background())
+		assert.False(t, ok)
+
+		transition, ok := EventTransitionFromContext(ContextWithEventTransition(context.Background(), EventTransitionComplete))
+		assert.True(t, ok)
+		assert.Equal(t, EventTransitionComplete, transition)
+	})
+}
@@ -0,0 +1,190 @@
+package models
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// RegistrationStatus define los estados de una inscripción
+type RegistrationStatus string
+
+const (
+	RegistrationStatusPending   RegistrationStatus = "pending"   // Plaza reservada, pendiente de pago
+	RegistrationStatusConfirmed RegistrationStatus = "confirmed" // Inscripción confirmada
+	RegistrationStatusCanceled  RegistrationStatus = "canceled"  // Cancelada (plaza liberada)
+)
+
+// EventRegistration inscripción de un usuario a un evento con el tipo de
+// entrada elegido y el precio calculado en el momento de la reserva
+type EventRegistration struct {
+	BaseModel
+
+	// Evento y asistente
+	EventID string `json:"event_id" gorm:"not null;size:36;index"`
+	UserID  string `json:"user_id" gorm:"not null;size:36;index"`
+
+	// Entrada y código aplicado (nil en eventos sin tipos de entrada)
+	TicketTypeID *string `json:"ticket_type_id" gorm:"size:36;index"`
+	PromoCodeID  *string `json:"promo_code_id" gorm:"size:36;index"`
+
+	// Importe en céntimos congelado al reservar
+	PriceCents    int    `json:"price_cents" gorm:"not null;default:0"`
+	DiscountCents int    `json:"discount_cents" gorm:"not null;default:0"`
+	TotalCents    int    `json:"total_cents" gorm:"not null;default:0"`
+	Currency      string `json:"currency" gorm:"size:3;default:'EUR'"`
+
+	// Estado
+	Status      RegistrationStatus `json:"status" gorm:"not null;default:'pending';size:20;index"`
+	ConfirmedAt *time.Time         `json:"confirmed_at"`
+	CanceledAt  *time.Time         `json:"canceled_at"`
+
+	// Relaciones
+	Event      *Event      `json:"event,omitempty" gorm:"foreignKey:EventID;references:ID"`
+	User       *User       `json:"user,omitempty" gorm:"foreignKey:UserID;references:ID"`
+	TicketType *TicketType `json:"ticket_type,omitempty" gorm:"foreignKey:TicketTypeID;references:ID"`
+	PromoCode  *PromoCode  `json:"promo_code,omitempty" gorm:"foreignKey:PromoCodeID;references:ID"`
+	Order      *Order      `json:"order,omitempty" gorm:"foreignKey:RegistrationID"`
+}
+
+// TableName especifica el nombre de tabla
+func (EventRegistration) TableName() string {
+	return "event_registrations"
+}
+
+// BeforeCreate hook de GORM para validación
+func (r *EventRegistration) BeforeCreate(tx *gorm.DB) error {
+	if err := r.BaseModel.BeforeCreate(tx); err != nil {
+		return err
+	}
+
+	r.Currency = strings.ToUpper(strings.TrimSpace(r.Currency))
+	return r.ValidateEventRegistration()
+}
+
+// BeforeUpdate hook de GORM para validación
+func (r *EventRegistration) BeforeUpdate(tx *gorm.DB) error {
+	if err := r.BaseModel.BeforeUpdate(tx); err != nil {
+		return err
+	}
+
+	return r.ValidateEventRegistration()
+}
+
+// ValidateEventRegistration valida los datos de la inscripción
+func (r *EventRegistration) ValidateEventRegistration() error {
+	if strings.TrimSpace(r.EventID) == "" {
+		return errors.New("event ID is required")
+	}
+
+	if strings.TrimSpace(r.UserID) == "" {
+		return errors.New("user ID is required")
+	}
+
+	if r.PriceCents < 0 || r.DiscountCents < 0 || r.DiscountCents > r.PriceCents {
+		return errors.New("invalid registration amounts")
+	}
+
+	if r.TotalCents != r.PriceCents-r.DiscountCents {
+		return errors.New("registration total must equal price minus discount")
+	}
+
+	if !r.IsValidStatus() {
+		return errors.New("invalid registration status")
+	}
+
+	return nil
+}
+
+// IsValidStatus verifica si el estado es válido
+func (r *EventRegistration) IsValidStatus() bool {
+	return r.Status == RegistrationStatusPending || r.Status == RegistrationStatusConfirmed ||
+		r.Status == RegistrationStatusCanceled
+}
+
+// ApplyPrice fija el importe de la inscripción. Las inscripciones sin coste
+// quedan confirmadas; el resto queda pendiente de pago.
+func (r *EventRegistration) ApplyPrice(quote PriceQuote) {
+	r.PriceCents = quote.PriceCents
+	r.DiscountCents = quote.DiscountCents
+	r.TotalCents = quote.TotalCents
+	r.Currency = quote.Currency
+
+	if r.TotalCents == 0 {
+		now := time.Now()
+		r.Status = RegistrationStatusConfirmed
+		r.ConfirmedAt = &now
+	} else {
+		r.Status = RegistrationStatusPending
+	}
+}
+
+// IsActive verifica si la inscripción ocupa plaza
+func (r *EventRegistration) IsActive() bool {
+	return r.Status == RegistrationStatusPending || r.Status == RegistrationStatusConfirmed
+}
+
+// Confirm confirma una inscripción pendiente
+func (r *EventRegistration) Confirm() error {
+	if r.Status != RegistrationStatusPending {
+		return errors.New("only pending registrations can be confirmed")
+	}
+
+	now := time.Now()
+	r.Status = RegistrationStatusConfirmed
+	r.ConfirmedAt = &now
+	return nil
+}
+
+// Cancel cancela una inscripción activa
+func (r *EventRegistration) Cancel() error {
+	if !r.IsActive() {
+		return errors.New("registration is already canceled")
+	}
+
+	now := time.Now()
+	r.Status = RegistrationStatusCanceled
+	r.CanceledAt = &now
+	return nil
+}
+
+// GetAuditData implementa AuditableModel
+func (r *EventRegistration) GetAuditData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":             r.ID,
+		"event_id":       r.EventID,
+		"user_id":        r.UserID,
+		"ticket_type_id": r.TicketTypeID,
+		"status":         r.Status,
+		"total_cents":    r.TotalCents,
+	}
+}
+
+func (r EventRegistration) GetID() string           { return r.ID.String() }
+func (r EventRegistration) GetCreatedAt() time.Time { return r.CreatedAt }
+func (r EventRegistration) GetUpdatedAt() time.Time { return r.UpdatedAt }
+
+// PriceQuote desglose del precio de una entrada en céntimos
+type PriceQuote struct {
+	PriceCents    int
+	DiscountCents int
+	TotalCents    int
+	Currency      string
+}
+
+// QuotePrice calcula el precio final de una entrada aplicando un código de descuento opcional
+func QuotePrice(priceCents int, currency string, promo *PromoCode) PriceQuote {
+	quote := PriceQuote{
+		PriceCents: priceCents,
+		Currency:   currency,
+	}
+
+	if promo != nil {
+		quote.DiscountCents = promo.DiscountFor(priceCents)
+	}
+
+	quote.TotalCents = quote.PriceCents - quote.DiscountCents
+	return quote
+}
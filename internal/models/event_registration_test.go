@@ -0,0 +1,62 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestQuotePrice tests para el desglose de precio
+func TestQuotePrice(t *testing.T) {
+	quote := QuotePrice(2999, "EUR", nil)
+	assert.Equal(t, PriceQuote{PriceCents: 2999, TotalCents: 2999, Currency: "EUR"}, quote)
+
+	promo := &PromoCode{DiscountType: DiscountTypePercentage, DiscountValue: 3333}
+	quote = QuotePrice(2999, "EUR", promo)
+	assert.Equal(t, 1000, quote.DiscountCents)
+	assert.Equal(t, 1999, quote.TotalCents)
+	assert.Equal(t, quote.PriceCents, quote.DiscountCents+quote.TotalCents)
+}
+
+// TestEventRegistration_Lifecycle tests para estados de la inscripción
+func TestEventRegistration_Lifecycle(t *testing.T) {
+	free := &EventRegistration{EventID: uuid.New().String(), UserID: uuid.New().String()}
+	free.ApplyPrice(QuotePrice(0, "EUR", nil))
+	assert.Equal(t, RegistrationStatusConfirmed, free.Status)
+	assert.NotNil(t, free.ConfirmedAt)
+	assert.NoError(t, free.ValidateEventRegistration())
+
+	paid := &EventRegistration{EventID: uuid.New().String(), UserID: uuid.New().String()}
+	paid.ApplyPrice(QuotePrice(5000, "EUR", &PromoCode{DiscountType: DiscountTypeFixed, DiscountValue: 1000}))
+	assert.Equal(t, RegistrationStatusPending, paid.Status)
+	assert.Equal(t, 4000, paid.TotalCents)
+	assert.True(t, paid.IsActive())
+	assert.NoError(t, paid.ValidateEventRegistration())
+
+	require.NoError(t, paid.Confirm())
+	assert.Error(t, paid.Confirm())
+
+	require.NoError(t, paid.Cancel())
+	assert.False(t, paid.IsActive())
+	assert.NotNil(t, paid.CanceledAt)
+	assert.Error(t, paid.Cancel())
+}
+
+// TestEventRegistration_Validate tests para importes incoherentes
+func TestEventRegistration_Validate(t *testing.T) {
+	reg := &EventRegistration{
+		EventID:       uuid.New().String(),
+		UserID:        uuid.New().String(),
+		Status:        RegistrationStatusPending,
+		PriceCents:    1000,
+		DiscountCents: 200,
+		TotalCents:    900,
+	}
+	assert.EqualError(t, reg.ValidateEventRegistration(), "registration total must equal price minus discount")
+
+	reg.DiscountCents = 1200
+	reg.TotalCents = -200
+	assert.EqualError(t, reg.ValidateEventRegistration(), "invalid registration amounts")
+}
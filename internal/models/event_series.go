@@ -0,0 +1,362 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"cybesphere-backend/pkg/rrule"
+	"cybesphere-backend/pkg/utils"
+
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// EventSeriesStatus define los estados de una serie de eventos
+type EventSeriesStatus string
+
+const (
+	EventSeriesStatusActive   EventSeriesStatus = "active"   // Genera nuevas ocurrencias
+	EventSeriesStatusEnded    EventSeriesStatus = "ended"    // Sin más ocurrencias (dividida o finalizada)
+	EventSeriesStatusCanceled EventSeriesStatus = "canceled" // Cancelada
+)
+
+// EditScope alcance de una modificación sobre una ocurrencia
+type EditScope string
+
+const (
+	EditScopeThis      EditScope = "this"      // Solo esta ocurrencia
+	EditScopeFollowing EditScope = "following" // Esta y las siguientes
+	EditScopeAll       EditScope = "all"       // Toda la serie
+)
+
+// occurrenceDateLayout formato de fecha usado en slugs y excepciones
+const occurrenceDateLayout = "2006-01-02"
+
+// EventSeries serie de eventos recurrentes definida por una RRULE (RFC 5545)
+type EventSeries struct {
+	BaseModel
+
+	// Organización propietaria
+	OrganizationID string `json:"organization_id" gorm:"not null;size:36;index"`
+
+	// Definición de la recurrencia
+	Title           string    `json:"title" gorm:"not null;size:300"`
+	RRule           string    `json:"rrule" gorm:"not null;size:500"`
+	Timezone        string    `json:"timezone" gorm:"size:50;default:'Europe/Madrid'"`
+	StartDate       time.Time `json:"start_date" gorm:"not null"` // DTSTART de la serie
+	DurationMinutes int       `json:"duration_minutes" gorm:"not null"`
+
+	// Plantilla con los datos comunes de las ocurrencias
+	Template datatypes.JSON `json:"-" gorm:"type:jsonb"`
+
+	// Ocurrencias excluidas (EXDATE) en formato RFC 3339
+	ExDates datatypes.JSON `json:"ex_dates" gorm:"type:jsonb"`
+
+	// Estado de la generación
+	Status         EventSeriesStatus `json:"status" gorm:"not null;default:'active';size:20;index"`
+	GeneratedUntil *time.Time        `json:"generated_until"`
+
+	// Relaciones
+	Organization *Organization `json:"organization,omitempty" gorm:"foreignKey:OrganizationID;references:ID"`
+}
+
+// TableName especifica el nombre de tabla
+func (EventSeries) TableName() string {
+	return "event_series"
+}
+
+// BeforeCreate hook de GORM para validación
+func (s *EventSeries) BeforeCreate(tx *gorm.DB) error {
+	if err := s.BaseModel.BeforeCreate(tx); err != nil {
+		return err
+	}
+
+	s.Title = strings.TrimSpace(s.Title)
+	return s.ValidateEventSeries()
+}
+
+// BeforeUpdate hook de GORM para validación
+func (s *EventSeries) BeforeUpdate(tx *gorm.DB) error {
+	if err := s.BaseModel.BeforeUpdate(tx); err != nil {
+		return err
+	}
+
+	return s.ValidateEventSeries()
+}
+
+// ValidateEventSeries valida los datos de la serie
+func (s *EventSeries) ValidateEventSeries() error {
+	if strings.TrimSpace(s.OrganizationID) == "" {
+		return errors.New("organization ID is required")
+	}
+
+	if strings.TrimSpace(s.Title) == "" {
+		return errors.New("series title is required")
+	}
+
+	if _, err := rrule.Parse(s.RRule); err != nil {
+		return errors.New("invalid recurrence rule: " + err.Error())
+	}
+
+	if _, err := time.LoadLocation(s.Timezone); err != nil {
+		return errors.New("invalid series timezone")
+	}
+
+	if s.StartDate.IsZero() {
+		return errors.New("series start date is required")
+	}
+
+	if s.DurationMinutes < 0 {
+		return errors.New("series duration cannot be negative")
+	}
+
+	if !s.IsValidStatus() {
+		return errors.New("invalid series status")
+	}
+
+	return nil
+}
+
+// IsValidStatus verifica si el estado es válido
+func (s *EventSeries) IsValidStatus() bool {
+	return s.Status == EventSeriesStatusActive || s.Status == EventSeriesStatusEnded ||
+		s.Status == EventSeriesStatusCanceled
+}
+
+// IsActive verifica si la serie sigue generando ocurrencias
+func (s *EventSeries) IsActive() bool {
+	return s.Status == EventSeriesStatusActive
+}
+
+// Rule devuelve la regla de recurrencia interpretada
+func (s *EventSeries) Rule() (*rrule.Rule, error) {
+	return rrule.Parse(s.RRule)
+}
+
+// Location devuelve la zona horaria de la serie (UTC si no es válida)
+func (s *EventSeries) Location() *time.Location {
+	loc, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// Duration devuelve la duración de cada ocurrencia
+func (s *EventSeries) Duration() time.Duration {
+	return time.Duration(s.DurationMinutes) * time.Minute
+}
+
+// Occurrences calcula los inicios de ocurrencia en [from, to] excluyendo EXDATE.
+// La expansión se hace en la zona de la serie para conservar la hora local
+// a través de los cambios de horario.
+func (s *EventSeries) Occurrences(from, to time.Time, limit int) ([]time.Time, error) {
+	rule, err := s.Rule()
+	if err != nil {
+		return nil, err
+	}
+
+	dtstart := s.StartDate.In(s.Location())
+	var result []time.Time
+	for _, t := range rule.Between(dtstart, from, to, limit+len(s.GetExDates())) {
+		if s.IsExcluded(t) {
+			continue
+		}
+		result = append(result, t)
+		if len(result) >= limit {
+			break
+		}
+	}
+	return result, nil
+}
+
+// GetExDates devuelve las ocurrencias excluidas
+func (s *EventSeries) GetExDates() []time.Time {
+	if len(s.ExDates) == 0 {
+		return nil
+	}
+
+	var values []string
+	if err := json.Unmarshal(s.ExDates, &values); err != nil {
+		return nil
+	}
+
+	dates := make([]time.Time, 0, len(values))
+	for _, v := range values {
+		if t, err := time.Parse(time.RFC3339, v); err == nil {
+			dates = append(dates, t)
+		}
+	}
+	return dates
+}
+
+// SetExDates reemplaza las ocurrencias excluidas
+func (s *EventSeries) SetExDates(dates []time.Time) error {
+	sort.Slice(dates, func(i, j int) bool { return dates[i].Before(dates[j]) })
+
+	values := make([]string, 0, len(dates))
+	for _, d := range dates {
+		values = append(values, d.UTC().Format(time.RFC3339))
+	}
+
+	data, err := json.Marshal(values)
+	if err != nil {
+		return err
+	}
+	s.ExDates = datatypes.JSON(data)
+	return nil
+}
+
+// AddExDate excluye una ocurrencia para que no vuelva a generarse
+func (s *EventSeries) AddExDate(t time.Time) error {
+	if s.IsExcluded(t) {
+		return nil
+	}
+	return s.SetExDates(append(s.GetExDates(), t))
+}
+
+// IsExcluded verifica si una ocurrencia está excluida
+func (s *EventSeries) IsExcluded(t time.Time) bool {
+	for _, d := range s.GetExDates() {
+		if d.Equal(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// SetTemplate guarda los datos comunes de las ocurrencias a partir de un evento
+func (s *EventSeries) SetTemplate(event *Event) error {
+	template := *event
+	template.BaseModel = BaseModel{}
+	template.Slug = ""
+	template.SeriesID = nil
+	template.OccurrenceDate = nil
+	template.IsSeriesException = false
+	template.CurrentAttendees = 0
+	template.ViewsCount = 0
+	template.Organization = nil
+	template.Series = nil
+	template.FavoritedBy = nil
+
+	data, err := json.Marshal(template)
+	if err != nil {
+		return err
+	}
+	s.Template = datatypes.JSON(data)
+	return nil
+}
+
+// GetTemplate devuelve la plantilla de las ocurrencias
+func (s *EventSeries) GetTemplate() (*Event, error) {
+	if len(s.Template) == 0 {
+		return nil, errors.New("series template is empty")
+	}
+
+	var event Event
+	if err := json.Unmarshal(s.Template, &event); err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+// BuildOccurrence crea el evento concreto que corresponde a un inicio de la serie.
+// Las fechas de registro y del CFP se desplazan igual que la fecha de inicio.
+func (s *EventSeries) BuildOccurrence(start time.Time) (*Event, error) {
+	event, err := s.GetTemplate()
+	if err != nil {
+		return nil, err
+	}
+
+	shift := start.Sub(event.StartDate)
+	if event.RegistrationStartDate != nil {
+		shifted := event.RegistrationStartDate.Add(shift)
+		event.RegistrationStartDate = &shifted
+	}
+	if event.RegistrationEndDate != nil {
+		shifted := event.RegistrationEndDate.Add(shift)
+		event.RegistrationEndDate = &shifted
+	}
+	if event.CFPStartDate != nil {
+		shifted := event.CFPStartDate.Add(shift)
+		event.CFPStartDate = &shifted
+	}
+	if event.CFPEndDate != nil {
+		shifted := event.CFPEndDate.Add(shift)
+		event.CFPEndDate = &shifted
+	}
+
+	seriesID := s.ID.String()
+	occurrence := start
+
+	event.StartDate = start
+	event.EndDate = start.Add(s.Duration())
+	event.Timezone = s.Timezone
+	event.OrganizationID = s.OrganizationID
+	event.SeriesID = &seriesID
+	event.OccurrenceDate = &occurrence
+	event.Slug = OccurrenceSlug(event.Title, start)
+
+	return event, nil
+}
+
+// EndBefore corta la serie para que su última ocurrencia sea anterior a t.
+// Devuelve las ocurrencias pendientes de COUNT que quedan tras el corte
+// (0 si la regla no usa COUNT).
+func (s *EventSeries) EndBefore(t time.Time) (int, error) {
+	rule, err := s.Rule()
+	if err != nil {
+		return 0, err
+	}
+
+	dtstart := s.StartDate.In(s.Location())
+	if !t.After(dtstart) {
+		return 0, errors.New("series cannot end before its first occurrence")
+	}
+
+	remaining := 0
+	if rule.Count > 0 {
+		before := len(rule.Between(dtstart, dtstart, t.Add(-time.Second), rule.Count))
+		remaining = rule.Count - before
+		rule.Count = before
+	} else {
+		until := t.Add(-time.Second).UTC()
+		if rule.Until == nil || until.Before(*rule.Until) {
+			rule.Until = &until
+		}
+	}
+
+	s.RRule = rule.String()
+	return remaining, nil
+}
+
+// OccurrenceSlug genera el slug de una ocurrencia: título y fecha local.
+// La unicidad final la garantiza el servicio añadiendo sufijos.
+func OccurrenceSlug(title string, start time.Time) string {
+	date := start.Format(occurrenceDateLayout)
+	return utils.GenerateSlug(title, 100-len(date)-1) + "-" + date
+}
+
+// IsValidEditScope verifica si el alcance de edición es válido
+func IsValidEditScope(scope EditScope) bool {
+	return scope == EditScopeThis || scope == EditScopeFollowing || scope == EditScopeAll
+}
+
+// GetAuditData implementa AuditableModel
+func (s *EventSeries) GetAuditData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":              s.ID,
+		"organization_id": s.OrganizationID,
+		"title":           s.Title,
+		"rrule":           s.RRule,
+		"start_date":      s.StartDate,
+		"status":          s.Status,
+	}
+}
+
+func (s EventSeries) GetID() string           { return s.ID.String() }
+func (s EventSeries) GetCreatedAt() time.Time { return s.CreatedAt }
+func (s EventSeries) GetUpdatedAt() time.Time { return s.UpdatedAt }
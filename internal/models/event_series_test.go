@@ -0,0 +1,179 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createTestEventSeries crea una serie mensual válida para testing
+func createTestEventSeries(t *testing.T) *EventSeries {
+	t.Helper()
+
+	loc, err := time.LoadLocation("Europe/Madrid")
+	require.NoError(t, err)
+
+	start := time.Date(2026, 2, 12, 19, 0, 0, 0, loc)
+	series := &EventSeries{
+		BaseModel:       BaseModel{ID: uuid.New()},
+		OrganizationID:  uuid.New().String(),
+		Title:           "Meetup mensual de Ciberseguridad",
+		RRule:           "FREQ=MONTHLY;BYDAY=2TH;COUNT=6",
+		Timezone:        "Europe/Madrid",
+		StartDate:       start,
+		DurationMinutes: 120,
+		Status:          EventSeriesStatusActive,
+	}
+
+	regStart := start.Add(-14 * 24 * time.Hour)
+	template := &Event{
+		Title:                 series.Title,
+		Description:           "Charlas y networking",
+		Type:                  EventTypeMeetup,
+		Status:                EventStatusPublished,
+		StartDate:             start,
+		EndDate:               start.Add(2 * time.Hour),
+		VenueAddress:          "Calle Mayor 1, Madrid",
+		OrganizationID:        series.OrganizationID,
+		RegistrationStartDate: &regStart,
+		CurrentAttendees:      30,
+	}
+	require.NoError(t, series.SetTemplate(template))
+
+	return series
+}
+
+// TestEventSeries_ValidateEventSeries tests unitarios para validación
+func TestEventSeries_ValidateEventSeries(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(s *EventSeries)
+		errMsg string
+	}{
+		{name: "serie válida", modify: func(s *EventSeries) {}},
+		{name: "sin organización", modify: func(s *EventSeries) { s.OrganizationID = "" }, errMsg: "organization ID is required"},
+		{name: "sin título", modify: func(s *EventSeries) { s.Title = "  " }, errMsg: "series title is required"},
+		{name: "regla inválida", modify: func(s *EventSeries) { s.RRule = "FREQ=SOMETIMES" }, errMsg: "invalid recurrence rule"},
+		{name: "zona horaria inválida", modify: func(s *EventSeries) { s.Timezone = "Mars/Olympus" }, errMsg: "invalid series timezone"},
+		{name: "sin fecha de inicio", modify: func(s *EventSeries) { s.StartDate = time.Time{} }, errMsg: "series start date is required"},
+		{name: "estado inválido", modify: func(s *EventSeries) { s.Status = "paused" }, errMsg: "invalid series status"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			series := createTestEventSeries(t)
+			tt.modify(series)
+
+			err := series.ValidateEventSeries()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+// TestEventSeries_Occurrences tests para la expansión de ocurrencias
+func TestEventSeries_Occurrences(t *testing.T) {
+	series := createTestEventSeries(t)
+	from := series.StartDate
+	to := series.StartDate.AddDate(1, 0, 0)
+
+	t.Run("respeta COUNT y hora local", func(t *testing.T) {
+		dates, err := series.Occurrences(from, to, 100)
+		require.NoError(t, err)
+		require.Len(t, dates, 6)
+
+		for _, d := range dates {
+			assert.Equal(t, 19, d.Hour())
+			assert.Equal(t, time.Thursday, d.Weekday())
+		}
+	})
+
+	t.Run("excluye EXDATE", func(t *testing.T) {
+		dates, err := series.Occurrences(from, to, 100)
+		require.NoError(t, err)
+
+		require.NoError(t, series.AddExDate(dates[1]))
+		assert.True(t, series.IsExcluded(dates[1]))
+
+		remaining, err := series.Occurrences(from, to, 100)
+		require.NoError(t, err)
+		assert.Len(t, remaining, 5)
+		assert.NotContains(t, remaining, dates[1])
+	})
+}
+
+// TestEventSeries_BuildOccurrence tests para la creación de ocurrencias
+func TestEventSeries_BuildOccurrence(t *testing.T) {
+	series := createTestEventSeries(t)
+	start := series.StartDate.AddDate(0, 1, 0)
+
+	event, err := series.BuildOccurrence(start)
+	require.NoError(t, err)
+
+	assert.Equal(t, start, event.StartDate)
+	assert.Equal(t, start.Add(2*time.Hour), event.EndDate)
+	assert.Equal(t, series.OrganizationID, event.OrganizationID)
+	require.NotNil(t, event.SeriesID)
+	assert.Equal(t, series.ID.String(), *event.SeriesID)
+	require.NotNil(t, event.OccurrenceDate)
+	assert.True(t, event.OccurrenceDate.Equal(start))
+	assert.True(t, event.IsOccurrence())
+	assert.Equal(t, 0, event.CurrentAttendees)
+	assert.Equal(t, "meetup-mensual-de-ciberseguridad-2026-03-12", event.Slug)
+
+	// Las fechas de registro se desplazan con la ocurrencia
+	require.NotNil(t, event.RegistrationStartDate)
+	assert.True(t, event.RegistrationStartDate.Equal(start.Add(-14*24*time.Hour)))
+}
+
+// TestEventSeries_EndBefore tests para el corte de series
+func TestEventSeries_EndBefore(t *testing.T) {
+	t.Run("con COUNT devuelve las pendientes", func(t *testing.T) {
+		series := createTestEventSeries(t)
+		dates, err := series.Occurrences(series.StartDate, series.StartDate.AddDate(1, 0, 0), 100)
+		require.NoError(t, err)
+
+		remaining, err := series.EndBefore(dates[2])
+		require.NoError(t, err)
+		assert.Equal(t, 4, remaining)
+		assert.Equal(t, "FREQ=MONTHLY;COUNT=2;BYDAY=2TH", series.RRule)
+	})
+
+	t.Run("sin COUNT fija UNTIL", func(t *testing.T) {
+		series := createTestEventSeries(t)
+		series.RRule = "FREQ=WEEKLY"
+		cut := series.StartDate.AddDate(0, 0, 21)
+
+		remaining, err := series.EndBefore(cut)
+		require.NoError(t, err)
+		assert.Equal(t, 0, remaining)
+
+		dates, err := series.Occurrences(series.StartDate, series.StartDate.AddDate(1, 0, 0), 100)
+		require.NoError(t, err)
+		assert.Len(t, dates, 3)
+	})
+
+	t.Run("no permite cortar antes del inicio", func(t *testing.T) {
+		series := createTestEventSeries(t)
+		_, err := series.EndBefore(series.StartDate)
+		assert.Error(t, err)
+	})
+}
+
+// TestOccurrenceSlug tests para slugs de ocurrencias
+func TestOccurrenceSlug(t *testing.T) {
+	start := time.Date(2026, 5, 14, 19, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, "owasp-madrid-2026-05-14", OccurrenceSlug("OWASP Madrid", start))
+
+	long := OccurrenceSlug("Un título extremadamente largo para un meetup mensual que supera con creces el límite permitido de caracteres", start)
+	assert.LessOrEqual(t, len(long), 100)
+	assert.Contains(t, long, "-2026-05-14")
+}
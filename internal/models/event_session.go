@@ -0,0 +1,172 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// SessionLevel define el nivel técnico de una sesión
+type SessionLevel string
+
+const (
+	SessionLevelBeginner     SessionLevel = "beginner"     // Introductorio
+	SessionLevelIntermediate SessionLevel = "intermediate" // Intermedio
+	SessionLevelAdvanced     SessionLevel = "advanced"     // Avanzado
+)
+
+// EventSession sesión de la agenda de un evento (charla, taller, keynote...)
+type EventSession struct {
+	BaseModel
+
+	// Evento al que pertenece
+	EventID string `json:"event_id" gorm:"not null;size:36;index"`
+
+	// Contenido
+	Title       string `json:"title" gorm:"not null;size:300"`
+	Description string `json:"description" gorm:"type:text"`
+
+	// Horario
+	StartTime time.Time `json:"start_time" gorm:"not null;index"`
+	EndTime   time.Time `json:"end_time" gorm:"not null"`
+
+	// Ubicación dentro del evento
+	Room  string `json:"room" gorm:"size:100;index"`
+	Track string `json:"track" gorm:"size:100;index"`
+
+	// Clasificación
+	Level SessionLevel   `json:"level" gorm:"size:20;index"`
+	Tags  datatypes.JSON `json:"tags" gorm:"type:jsonb"`
+
+	// Relaciones
+	Event       *Event    `json:"event,omitempty" gorm:"foreignKey:EventID;references:ID"`
+	Speakers    []Speaker `json:"speakers,omitempty" gorm:"many2many:event_session_speakers;"`
+	FavoritedBy []User    `json:"favorited_by,omitempty" gorm:"many2many:user_favorite_sessions;"`
+}
+
+// TableName especifica el nombre de tabla
+func (EventSession) TableName() string {
+	return "event_sessions"
+}
+
+// BeforeCreate hook de GORM para validación
+func (s *EventSession) BeforeCreate(tx *gorm.DB) error {
+	if err := s.BaseModel.BeforeCreate(tx); err != nil {
+		return err
+	}
+
+	s.normalizeFields()
+	return s.ValidateEventSession()
+}
+
+// BeforeUpdate hook de GORM para validación
+func (s *EventSession) BeforeUpdate(tx *gorm.DB) error {
+	if err := s.BaseModel.BeforeUpdate(tx); err != nil {
+		return err
+	}
+
+	s.normalizeFields()
+	return s.ValidateEventSession()
+}
+
+// ValidateEventSession valida los datos de la sesión
+func (s *EventSession) ValidateEventSession() error {
+	if strings.TrimSpace(s.EventID) == "" {
+		return errors.New("event ID is required")
+	}
+
+	if strings.TrimSpace(s.Title) == "" {
+		return errors.New("session title is required")
+	}
+
+	if s.StartTime.IsZero() || s.EndTime.IsZero() {
+		return errors.New("session start and end time are required")
+	}
+
+	if !s.EndTime.After(s.StartTime) {
+		return errors.New("session end time must be after start time")
+	}
+
+	if s.Level != "" && !s.IsValidLevel() {
+		return errors.New("invalid session level")
+	}
+
+	return nil
+}
+
+// IsValidLevel verifica si el nivel es válido
+func (s *EventSession) IsValidLevel() bool {
+	return s.Level == SessionLevelBeginner || s.Level == SessionLevelIntermediate ||
+		s.Level == SessionLevelAdvanced
+}
+
+// normalizeFields normaliza campos de texto
+func (s *EventSession) normalizeFields() {
+	s.Title = strings.TrimSpace(s.Title)
+	s.Description = strings.TrimSpace(s.Description)
+	s.Room = strings.TrimSpace(s.Room)
+	s.Track = strings.TrimSpace(s.Track)
+}
+
+// Duration devuelve la duración de la sesión
+func (s *EventSession) Duration() time.Duration {
+	return s.EndTime.Sub(s.StartTime)
+}
+
+// Overlaps verifica si dos sesiones se solapan en el tiempo
+func (s *EventSession) Overlaps(other *EventSession) bool {
+	return s.StartTime.Before(other.EndTime) && other.StartTime.Before(s.EndTime)
+}
+
+// IsWithinEvent verifica que la sesión está dentro del horario del evento
+func (s *EventSession) IsWithinEvent(event *Event) bool {
+	return !s.StartTime.Before(event.StartDate) && !s.EndTime.After(event.EndDate)
+}
+
+// SetTags establece los tags de la sesión
+func (s *EventSession) SetTags(tags []string) error {
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag != "" {
+			normalized = append(normalized, tag)
+		}
+	}
+
+	data, err := json.Marshal(normalized)
+	if err != nil {
+		return err
+	}
+	s.Tags = datatypes.JSON(data)
+	return nil
+}
+
+// GetTags obtiene los tags de la sesión
+func (s *EventSession) GetTags() []string {
+	var tags []string
+	if err := json.Unmarshal(s.Tags, &tags); err != nil {
+		return []string{}
+	}
+	return tags
+}
+
+// GetAuditData implementa AuditableModel
+func (s *EventSession) GetAuditData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":         s.ID,
+		"event_id":   s.EventID,
+		"title":      s.Title,
+		"start_time": s.StartTime,
+		"end_time":   s.EndTime,
+		"room":       s.Room,
+		"track":      s.Track,
+	}
+}
+
+func (s EventSession) GetID() string           { return s.ID.String() }
+func (s EventSession) GetCreatedAt() time.Time { return s.CreatedAt }
+func (s EventSession) GetUpdatedAt() time.Time { return s.UpdatedAt }
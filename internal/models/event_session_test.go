@@ -0,0 +1,107 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// createTestEventSession crea una sesión válida para testing
+func createTestEventSession() *EventSession {
+	start := time.Date(2026, 11, 12, 10, 0, 0, 0, time.UTC)
+	return &EventSession{
+		EventID:   uuid.New().String(),
+		Title:     "Threat hunting con Sigma",
+		StartTime: start,
+		EndTime:   start.Add(45 * time.Minute),
+		Room:      "Sala 1",
+		Track:     "Blue Team",
+		Level:     SessionLevelIntermediate,
+	}
+}
+
+// TestEventSession_ValidateEventSession tests unitarios para validación
+func TestEventSession_ValidateEventSession(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(s *EventSession)
+		wantErr bool
+		errMsg  string
+	}{
+		{name: "sesión válida", modify: func(s *EventSession) {}},
+		{name: "sin nivel", modify: func(s *EventSession) { s.Level = "" }},
+		{name: "sin evento", modify: func(s *EventSession) { s.EventID = "" }, wantErr: true, errMsg: "event ID is required"},
+		{name: "sin título", modify: func(s *EventSession) { s.Title = "" }, wantErr: true, errMsg: "session title is required"},
+		{name: "sin horario", modify: func(s *EventSession) { s.StartTime = time.Time{} }, wantErr: true, errMsg: "session start and end time are required"},
+		{name: "fin igual al inicio", modify: func(s *EventSession) { s.EndTime = s.StartTime }, wantErr: true, errMsg: "session end time must be after start time"},
+		{name: "nivel inválido", modify: func(s *EventSession) { s.Level = "expert" }, wantErr: true, errMsg: "invalid session level"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			session := createTestEventSession()
+			tt.modify(session)
+
+			err := session.ValidateEventSession()
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Equal(t, tt.errMsg, err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// TestEventSession_Overlaps tests para detección de solapamientos
+func TestEventSession_Overlaps(t *testing.T) {
+	base := createTestEventSession()
+
+	tests := []struct {
+		name     string
+		offset   time.Duration
+		duration time.Duration
+		expected bool
+	}{
+		{name: "misma franja", offset: 0, duration: 45 * time.Minute, expected: true},
+		{name: "empieza durante la sesión", offset: 30 * time.Minute, duration: time.Hour, expected: true},
+		{name: "contenida en la sesión", offset: 10 * time.Minute, duration: 10 * time.Minute, expected: true},
+		{name: "empieza justo al terminar", offset: 45 * time.Minute, duration: time.Hour, expected: false},
+		{name: "termina justo al empezar", offset: -time.Hour, duration: time.Hour, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			other := createTestEventSession()
+			other.StartTime = base.StartTime.Add(tt.offset)
+			other.EndTime = other.StartTime.Add(tt.duration)
+
+			assert.Equal(t, tt.expected, base.Overlaps(other))
+			assert.Equal(t, tt.expected, other.Overlaps(base))
+		})
+	}
+}
+
+// TestEventSession_IsWithinEvent tests para el horario del evento
+func TestEventSession_IsWithinEvent(t *testing.T) {
+	session := createTestEventSession()
+	event := &Event{
+		StartDate: session.StartTime.Add(-time.Hour),
+		EndDate:   session.EndTime.Add(time.Hour),
+	}
+	assert.True(t, session.IsWithinEvent(event))
+
+	event.EndDate = session.EndTime.Add(-time.Minute)
+	assert.False(t, session.IsWithinEvent(event))
+}
+
+// TestEventSession_TagsManagement tests para tags de sesiones
+func TestEventSession_TagsManagement(t *testing.T) {
+	session := createTestEventSession()
+	assert.Empty(t, session.GetTags())
+
+	assert.NoError(t, session.SetTags([]string{" DFIR ", "", "Sigma"}))
+	assert.Equal(t, []string{"dfir", "sigma"}, session.GetTags())
+}
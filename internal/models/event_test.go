@@ -491,6 +491,43 @@ func TestEvent_RegistrationLogic(t *testing.T) {
 }
 
 // TestEvent_LocationManagement tests para gestión de ubicación
+// TestEvent_CFPWindow tests para la ventana del call for papers
+func TestEvent_CFPWindow(t *testing.T) {
+	past := time.Now().Add(-24 * time.Hour)
+	future := time.Now().Add(24 * time.Hour)
+
+	tests := []struct {
+		name     string
+		modify   func(e *Event)
+		expected bool
+	}{
+		{name: "sin CFP", modify: func(e *Event) {}, expected: false},
+		{name: "CFP abierto", modify: func(e *Event) { e.CFPStartDate = &past; e.CFPEndDate = &future }, expected: true},
+		{name: "CFP sin fecha de apertura", modify: func(e *Event) { e.CFPEndDate = &future }, expected: true},
+		{name: "CFP cerrado", modify: func(e *Event) { e.CFPEndDate = &past }, expected: false},
+		{name: "CFP aún no abierto", modify: func(e *Event) { e.CFPStartDate = &future; e.CFPEndDate = &future }, expected: false},
+		{name: "evento en borrador", modify: func(e *Event) { e.CFPEndDate = &future; e.Status = EventStatusDraft }, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := createTestEvent()
+			event.Status = EventStatusPublished
+			tt.modify(event)
+			assert.Equal(t, tt.expected, event.IsCFPOpen())
+		})
+	}
+
+	t.Run("cierre anterior a la apertura no es válido", func(t *testing.T) {
+		event := createTestEvent()
+		event.CFPStartDate = &future
+		event.CFPEndDate = &past
+		err := event.ValidateEvent()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "CFP end date must be after CFP start date")
+	})
+}
+
 func TestEvent_LocationManagement(t *testing.T) {
 	event := createTestEvent()
 
@@ -507,6 +544,47 @@ func TestEvent_LocationManagement(t *testing.T) {
 	assert.Equal(t, lng, *event.Longitude)
 }
 
+// TestEvent_NeedsGeocoding tests para decidir si se geocodifica la dirección
+func TestEvent_NeedsGeocoding(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*Event)
+		want   bool
+	}{
+		{name: "presencial con dirección y sin coordenadas", modify: func(e *Event) {}, want: true},
+		{name: "solo con ciudad", modify: func(e *Event) { e.VenueAddress = ""; e.VenueCity = "Madrid" }, want: true},
+		{name: "online", modify: func(e *Event) { e.IsOnline = true }, want: false},
+		{name: "sin dirección", modify: func(e *Event) { e.VenueAddress = ""; e.VenueCity = "" }, want: false},
+		{name: "ya geocodificado", modify: func(e *Event) { e.SetGeocodedLocation(40.4168, -3.7038) }, want: false},
+		{
+			name:   "coordenadas manuales incompletas",
+			modify: func(e *Event) { e.CoordinatesSource = CoordinatesSourceManual },
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := createTestEvent()
+			event.IsOnline = false
+			event.VenueAddress = "Calle Mayor 1"
+			tt.modify(event)
+			assert.Equal(t, tt.want, event.NeedsGeocoding())
+		})
+	}
+}
+
+// TestEvent_ClearLocation tests para descartar coordenadas
+func TestEvent_ClearLocation(t *testing.T) {
+	event := createTestEvent()
+	event.SetGeocodedLocation(40.4168, -3.7038)
+	assert.Equal(t, CoordinatesSourceGeocoded, event.CoordinatesSource)
+
+	event.ClearLocation()
+	assert.False(t, event.HasLocation())
+	assert.Empty(t, event.CoordinatesSource)
+}
+
 // TestEvent_TagsManagement tests para gestión de tags
 func TestEvent_TagsManagement(t *testing.T) {
 	event := createTestEvent()
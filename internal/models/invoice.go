@@ -0,0 +1,253 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// InvoiceKind define el tipo de factura
+type InvoiceKind string
+
+const (
+	InvoiceKindInvoice    InvoiceKind = "invoice"     // Factura ordinaria de un pedido pagado
+	InvoiceKindCreditNote InvoiceKind = "credit_note" // Factura rectificativa de un reembolso
+)
+
+const (
+	// Series de numeración: cada organización numera cada serie por año
+	InvoiceSeriesInvoice    = "F"
+	InvoiceSeriesCreditNote = "R"
+
+	// DefaultVATRateBasisPoints tipo general de IVA en España (21%)
+	DefaultVATRateBasisPoints = 2100
+)
+
+// BillingDetails datos fiscales del comprador (opcionales para particulares)
+type BillingDetails struct {
+	Name    string `json:"name" gorm:"size:300"`
+	TaxID   string `json:"tax_id" gorm:"size:50"`
+	Address string `json:"address" gorm:"size:500"`
+}
+
+// Normalize limpia los datos de facturación
+func (b *BillingDetails) Normalize() {
+	b.Name = strings.TrimSpace(b.Name)
+	b.TaxID = strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(b.TaxID), " ", ""))
+	b.Address = strings.TrimSpace(b.Address)
+}
+
+// IsEmpty verifica si no se indicaron datos de facturación
+func (b BillingDetails) IsEmpty() bool {
+	return b.Name == "" && b.TaxID == "" && b.Address == ""
+}
+
+// Invoice factura (o factura rectificativa) emitida por la organización del
+// evento. Los datos del emisor y del cliente se copian al emitirla para que la
+// factura no cambie aunque cambien la organización o el usuario.
+type Invoice struct {
+	BaseModel
+
+	OrganizationID string `json:"organization_id" gorm:"not null;size:36;uniqueIndex:idx_invoice_number,priority:1"`
+	EventID        string `json:"event_id" gorm:"not null;size:36;index"`
+	OrderID        string `json:"order_id" gorm:"not null;size:36;uniqueIndex:idx_invoice_order_kind,priority:1"`
+	UserID         string `json:"user_id" gorm:"not null;size:36;index"`
+
+	// Numeración: serie, año y secuencia sin huecos por organización
+	Kind     InvoiceKind `json:"kind" gorm:"not null;size:20;uniqueIndex:idx_invoice_order_kind,priority:2"`
+	Series   string      `json:"series" gorm:"not null;size:5;uniqueIndex:idx_invoice_number,priority:2"`
+	Year     int         `json:"year" gorm:"not null;uniqueIndex:idx_invoice_number,priority:3"`
+	Sequence int         `json:"sequence" gorm:"not null;uniqueIndex:idx_invoice_number,priority:4"`
+	Number   string      `json:"number" gorm:"not null;size:30"`
+	IssuedAt time.Time   `json:"issued_at" gorm:"not null"`
+
+	// Rectificación
+	RectifiesID         *string `json:"rectifies_id,omitempty" gorm:"size:36;index"`
+	RectificationReason string  `json:"rectification_reason,omitempty" gorm:"size:500"`
+
+	// Emisor
+	IssuerName    string `json:"issuer_name" gorm:"not null;size:300"`
+	IssuerTaxID   string `json:"issuer_tax_id" gorm:"not null;size:50"`
+	IssuerAddress string `json:"issuer_address" gorm:"size:700"`
+
+	// Cliente
+	Customer      BillingDetails `json:"customer" gorm:"embedded;embeddedPrefix:customer_"`
+	CustomerEmail string         `json:"customer_email" gorm:"size:255"`
+
+	// Importes en céntimos (negativos en las rectificativas)
+	Description string `json:"description" gorm:"not null;size:500"`
+	BaseCents   int    `json:"base_cents" gorm:"not null"`
+	VATRate     int    `json:"vat_rate" gorm:"not null"` // Puntos básicos (2100 = 21%)
+	VATCents    int    `json:"vat_cents" gorm:"not null"`
+	TotalCents  int    `json:"total_cents" gorm:"not null"`
+	Currency    string `json:"currency" gorm:"size:3;not null"`
+
+	// Relaciones
+	Event     *Event   `json:"event,omitempty" gorm:"foreignKey:EventID;references:ID"`
+	Rectifies *Invoice `json:"rectifies,omitempty" gorm:"foreignKey:RectifiesID;references:ID"`
+}
+
+// TableName especifica el nombre de tabla
+func (Invoice) TableName() string {
+	return "invoices"
+}
+
+// BeforeCreate hook de GORM para validación
+func (i *Invoice) BeforeCreate(tx *gorm.DB) error {
+	if err := i.BaseModel.BeforeCreate(tx); err != nil {
+		return err
+	}
+
+	i.Customer.Normalize()
+	i.Currency = strings.ToUpper(strings.TrimSpace(i.Currency))
+	return i.ValidateInvoice()
+}
+
+// BeforeUpdate impide modificar facturas emitidas
+func (i *Invoice) BeforeUpdate(tx *gorm.DB) error {
+	return errors.New("issued invoices cannot be modified")
+}
+
+// ValidateInvoice valida los datos de la factura
+func (i *Invoice) ValidateInvoice() error {
+	if i.OrganizationID == "" || i.EventID == "" || i.OrderID == "" || i.UserID == "" {
+		return errors.New("invoice references are required")
+	}
+
+	switch i.Kind {
+	case InvoiceKindInvoice:
+		if i.TotalCents <= 0 || i.RectifiesID != nil {
+			return errors.New("invoice total must be positive")
+		}
+	case InvoiceKindCreditNote:
+		if i.TotalCents >= 0 || i.RectifiesID == nil {
+			return errors.New("credit note must rectify an invoice with a negative total")
+		}
+	default:
+		return errors.New("invalid invoice kind")
+	}
+
+	if i.Series == "" || i.Year <= 0 || i.Sequence <= 0 || i.Number == "" {
+		return errors.New("invoice number is required")
+	}
+
+	if strings.TrimSpace(i.IssuerName) == "" || strings.TrimSpace(i.IssuerTaxID) == "" {
+		return errors.New("issuer legal name and tax id are required")
+	}
+
+	if i.VATRate < 0 || i.VATRate > basisPointsPerUnit {
+		return errors.New("vat rate must be between 0 and 10000 basis points")
+	}
+
+	if i.BaseCents+i.VATCents != i.TotalCents {
+		return errors.New("invoice base plus vat must equal the total")
+	}
+
+	if len(i.Currency) != 3 {
+		return errors.New("currency must be a 3-letter ISO code")
+	}
+
+	return nil
+}
+
+// SetNumber asigna la numeración (p. ej. F2026-000042)
+func (i *Invoice) SetNumber(series string, year, sequence int) {
+	i.Series = series
+	i.Year = year
+	i.Sequence = sequence
+	i.Number = FormatInvoiceNumber(series, year, sequence)
+}
+
+// IsCreditNote verifica si es una factura rectificativa
+func (i *Invoice) IsCreditNote() bool {
+	return i.Kind == InvoiceKindCreditNote
+}
+
+// FormatInvoiceNumber formatea el número visible de una factura
+func FormatInvoiceNumber(series string, year, sequence int) string {
+	return fmt.Sprintf("%s%d-%06d", series, year, sequence)
+}
+
+// SplitVAT desglosa un importe con IVA incluido en base imponible y cuota,
+// redondeando la base al céntimo más cercano (las mitades hacia arriba)
+func SplitVAT(totalCents, rateBasisPoints int) (baseCents, vatCents int) {
+	if rateBasisPoints <= 0 {
+		return totalCents, 0
+	}
+
+	negative := totalCents < 0
+	total := int64(totalCents)
+	if negative {
+		total = -total
+	}
+
+	divisor := int64(basisPointsPerUnit + rateBasisPoints)
+	base := (total*basisPointsPerUnit + divisor/2) / divisor
+
+	baseCents, vatCents = int(base), int(total-base)
+	if negative {
+		return -baseCents, -vatCents
+	}
+	return baseCents, vatCents
+}
+
+// NewCreditNote prepara la rectificativa que anula totalmente una factura
+func (i *Invoice) NewCreditNote(reason string, issuedAt time.Time) *Invoice {
+	rectifiesID := i.ID.String()
+	return &Invoice{
+		OrganizationID:      i.OrganizationID,
+		EventID:             i.EventID,
+		OrderID:             i.OrderID,
+		UserID:              i.UserID,
+		Kind:                InvoiceKindCreditNote,
+		IssuedAt:            issuedAt,
+		RectifiesID:         &rectifiesID,
+		RectificationReason: reason,
+		IssuerName:          i.IssuerName,
+		IssuerTaxID:         i.IssuerTaxID,
+		IssuerAddress:       i.IssuerAddress,
+		Customer:            i.Customer,
+		CustomerEmail:       i.CustomerEmail,
+		Description:         i.Description,
+		BaseCents:           -i.BaseCents,
+		VATRate:             i.VATRate,
+		VATCents:            -i.VATCents,
+		TotalCents:          -i.TotalCents,
+		Currency:            i.Currency,
+	}
+}
+
+// GetAuditData implementa AuditableModel
+func (i *Invoice) GetAuditData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":              i.ID,
+		"organization_id": i.OrganizationID,
+		"order_id":        i.OrderID,
+		"kind":            i.Kind,
+		"number":          i.Number,
+		"total_cents":     i.TotalCents,
+		"currency":        i.Currency,
+	}
+}
+
+func (i Invoice) GetID() string           { return i.ID.String() }
+func (i Invoice) GetCreatedAt() time.Time { return i.CreatedAt }
+func (i Invoice) GetUpdatedAt() time.Time { return i.UpdatedAt }
+
+// InvoiceSequence contador de numeración por organización, serie y año
+type InvoiceSequence struct {
+	BaseModel
+
+	OrganizationID string `json:"organization_id" gorm:"not null;size:36;uniqueIndex:idx_invoice_sequence,priority:1"`
+	Series         string `json:"series" gorm:"not null;size:5;uniqueIndex:idx_invoice_sequence,priority:2"`
+	Year           int    `json:"year" gorm:"not null;uniqueIndex:idx_invoice_sequence,priority:3"`
+	LastNumber     int    `json:"last_number" gorm:"not null;default:0"`
+}
+
+// TableName especifica el nombre de tabla
+func (InvoiceSequence) TableName() string {
+	return "invoice_sequences"
+}
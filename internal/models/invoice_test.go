@@ -0,0 +1,122 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestInvoice() *Invoice {
+	invoice := &Invoice{
+		OrganizationID: uuid.New().String(),
+		EventID:        uuid.New().String(),
+		OrderID:        uuid.New().String(),
+		UserID:         uuid.New().String(),
+		Kind:           InvoiceKindInvoice,
+		IssuedAt:       time.Date(2026, 10, 18, 10, 0, 0, 0, time.UTC),
+		IssuerName:     "Hackingétic Formación S.L.",
+		IssuerTaxID:    "B98765432",
+		Description:    "Entrada General - CyberCon 2026",
+		BaseCents:      4050,
+		VATRate:        2100,
+		VATCents:       850,
+		TotalCents:     4900,
+		Currency:       "EUR",
+	}
+	invoice.ID = uuid.New()
+	invoice.SetNumber(InvoiceSeriesInvoice, 2026, 42)
+	return invoice
+}
+
+// TestInvoice_Validate tests unitarios para validación
+func TestInvoice_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*Invoice)
+		errMsg string
+	}{
+		{name: "factura válida", modify: func(i *Invoice) {}},
+		{name: "sin pedido", modify: func(i *Invoice) { i.OrderID = "" }, errMsg: "invoice references are required"},
+		{name: "tipo desconocido", modify: func(i *Invoice) { i.Kind = "proforma" }, errMsg: "invalid invoice kind"},
+		{name: "factura con total negativo", modify: func(i *Invoice) {
+			i.BaseCents, i.VATCents, i.TotalCents = -4050, -850, -4900
+		}, errMsg: "invoice total must be positive"},
+		{name: "rectificativa sin factura original", modify: func(i *Invoice) {
+			i.Kind = InvoiceKindCreditNote
+			i.BaseCents, i.VATCents, i.TotalCents = -4050, -850, -4900
+		}, errMsg: "credit note must rectify"},
+		{name: "sin numeración", modify: func(i *Invoice) { i.Number = "" }, errMsg: "invoice number is required"},
+		{name: "emisor sin NIF", modify: func(i *Invoice) { i.IssuerTaxID = " " }, errMsg: "issuer legal name and tax id are required"},
+		{name: "IVA fuera de rango", modify: func(i *Invoice) { i.VATRate = 10001 }, errMsg: "vat rate must be between"},
+		{name: "desglose descuadrado", modify: func(i *Invoice) { i.VATCents = 851 }, errMsg: "base plus vat must equal the total"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			invoice := newTestInvoice()
+			tt.modify(invoice)
+
+			err := invoice.ValidateInvoice()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+// TestSplitVAT tests unitarios para el desglose del IVA incluido
+func TestSplitVAT(t *testing.T) {
+	tests := []struct {
+		name     string
+		total    int
+		rate     int
+		wantBase int
+		wantVAT  int
+	}{
+		{name: "IVA general", total: 12100, rate: 2100, wantBase: 10000, wantVAT: 2100},
+		{name: "redondeo de la base", total: 4900, rate: 2100, wantBase: 4050, wantVAT: 850},
+		{name: "IVA reducido", total: 1100, rate: 1000, wantBase: 1000, wantVAT: 100},
+		{name: "exento", total: 4900, rate: 0, wantBase: 4900, wantVAT: 0},
+		{name: "importe negativo", total: -4900, rate: 2100, wantBase: -4050, wantVAT: -850},
+		{name: "un céntimo", total: 1, rate: 2100, wantBase: 1, wantVAT: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			base, vat := SplitVAT(tt.total, tt.rate)
+			assert.Equal(t, tt.wantBase, base)
+			assert.Equal(t, tt.wantVAT, vat)
+			assert.Equal(t, tt.total, base+vat)
+		})
+	}
+}
+
+// TestInvoice_NewCreditNote tests unitarios para facturas rectificativas
+func TestInvoice_NewCreditNote(t *testing.T) {
+	invoice := newTestInvoice()
+	issuedAt := time.Date(2026, 11, 2, 9, 0, 0, 0, time.UTC)
+
+	credit := invoice.NewCreditNote("Evento cancelado", issuedAt)
+	credit.SetNumber(InvoiceSeriesCreditNote, 2026, 1)
+
+	require.NoError(t, credit.ValidateInvoice())
+	assert.True(t, credit.IsCreditNote())
+	assert.Equal(t, invoice.ID.String(), *credit.RectifiesID)
+	assert.Equal(t, -invoice.TotalCents, credit.TotalCents)
+	assert.Equal(t, -invoice.VATCents, credit.VATCents)
+	assert.Equal(t, invoice.IssuerTaxID, credit.IssuerTaxID)
+	assert.Equal(t, "R2026-000001", credit.Number)
+	assert.Equal(t, issuedAt, credit.IssuedAt)
+}
+
+// TestFormatInvoiceNumber tests unitarios para el número de factura
+func TestFormatInvoiceNumber(t *testing.T) {
+	assert.Equal(t, "F2026-000042", FormatInvoiceNumber(InvoiceSeriesInvoice, 2026, 42))
+	assert.Equal(t, "R2027-1234567", FormatInvoiceNumber(InvoiceSeriesCreditNote, 2027, 1234567))
+}
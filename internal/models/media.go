@@ -0,0 +1,394 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"cybesphere-backend/pkg/storage"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// MediaPurpose uso previsto de un fichero subido
+type MediaPurpose string
+
+const (
+	MediaPurposeEventImage           MediaPurpose = "event_image"           // Imagen principal de un evento
+	MediaPurposeEventBanner          MediaPurpose = "event_banner"          // Banner de un evento
+	MediaPurposeOrganizationLogo     MediaPurpose = "organization_logo"     // Logo de una organización
+	MediaPurposeOrganizationBanner   MediaPurpose = "organization_banner"   // Banner de una organización
+	MediaPurposeVerificationDocument MediaPurpose = "verification_document" // Documentación para verificar una organización (privada)
+)
+
+// Tipos de recurso que pueden referenciar un fichero
+const (
+	MediaResourceEvent        = "event"
+	MediaResourceOrganization = "organization"
+	MediaResourceVerification = "organization_verification"
+)
+
+// Campos de los recursos que apuntan a un fichero
+const (
+	MediaFieldImage    = "image"
+	MediaFieldBanner   = "banner"
+	MediaFieldLogo     = "logo"
+	MediaFieldDocument = "document"
+)
+
+// MediaProcessingStatus estado del procesado de una imagen (versiones y color)
+type MediaProcessingStatus string
+
+const (
+	MediaProcessingPending    MediaProcessingStatus = "pending"    // En cola
+	MediaProcessingProcessing MediaProcessingStatus = "processing" // Un worker la está procesando
+	MediaProcessingReady      MediaProcessingStatus = "ready"      // Versiones generadas
+	MediaProcessingFailed     MediaProcessingStatus = "failed"     // Agotó los intentos
+	MediaProcessingSkipped    MediaProcessingStatus = "skipped"    // No se procesa (documentos, formatos sin decodificador)
+)
+
+// Versiones generadas de las imágenes
+const (
+	MediaVariantThumbnail = "thumbnail" // Miniatura para listados
+	MediaVariantCard      = "card"      // Tarjeta
+	MediaVariantBanner    = "banner"    // Cabecera a todo el ancho
+)
+
+// checksumPattern SHA-256 en hexadecimal
+var checksumPattern = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+// IsValid verifica si el uso es válido
+func (p MediaPurpose) IsValid() bool {
+	switch p {
+	case MediaPurposeEventImage, MediaPurposeEventBanner, MediaPurposeOrganizationLogo,
+		MediaPurposeOrganizationBanner, MediaPurposeVerificationDocument:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsPublic indica si los ficheros de este uso son visibles para cualquiera.
+// La documentación de verificación solo la ven la organización y los administradores
+func (p MediaPurpose) IsPublic() bool {
+	return p.IsValid() && p != MediaPurposeVerificationDocument
+}
+
+// AcceptsContentType indica si el tipo de contenido es admisible para el uso:
+// imágenes para eventos y organizaciones; PDF, Word o imágenes para documentos
+func (p MediaPurpose) AcceptsContentType(contentType string) bool {
+	if p == MediaPurposeVerificationDocument {
+		switch contentType {
+		case "application/pdf", "application/msword",
+			"application/vnd.openxmlformats-officedocument.wordprocessingml.document":
+			return true
+		}
+	}
+	return p.IsValid() && storage.IsImage(contentType)
+}
+
+// IsValid verifica si el estado es válido
+func (s MediaProcessingStatus) IsValid() bool {
+	switch s {
+	case MediaProcessingPending, MediaProcessingProcessing, MediaProcessingReady,
+		MediaProcessingFailed, MediaProcessingSkipped:
+		return true
+	default:
+		return false
+	}
+}
+
+// MediaPurposeForField uso de fichero que corresponde al campo de un recurso
+func MediaPurposeForField(resourceType, field string) (MediaPurpose, bool) {
+	switch resourceType + "." + field {
+	case MediaResourceEvent + "." + MediaFieldImage:
+		return MediaPurposeEventImage, true
+	case MediaResourceEvent + "." + MediaFieldBanner:
+		return MediaPurposeEventBanner, true
+	case MediaResourceOrganization + "." + MediaFieldLogo:
+		return MediaPurposeOrganizationLogo, true
+	case MediaResourceOrganization + "." + MediaFieldBanner:
+		return MediaPurposeOrganizationBanner, true
+	case MediaResourceVerification + "." + MediaFieldDocument:
+		return MediaPurposeVerificationDocument, true
+	default:
+		return "", false
+	}
+}
+
+// MediaStorageKey clave de almacenamiento de un fichero:
+// {uso}/{año}/{mes}/{id}{extensión}
+func MediaStorageKey(purpose MediaPurpose, id uuid.UUID, ext string, at time.Time) string {
+	return fmt.Sprintf("%s/%04d/%02d/%s%s", purpose, at.Year(), at.Month(), id, strings.ToLower(ext))
+}
+
+// MediaVariantKey clave de una versión, junto al original: {clave sin extensión}_{versión}{extensión}
+func MediaVariantKey(storageKey, name, ext string) string {
+	return strings.TrimSuffix(storageKey, path.Ext(storageKey)) + "_" + name + ext
+}
+
+// MediaVariant versión redimensionada de una imagen
+type MediaVariant struct {
+	Name        string `json:"name"`
+	StorageKey  string `json:"storage_key"`
+	URL         string `json:"url"`
+	ContentType string `json:"content_type"`
+	Width       int    `json:"width"`
+	Height      int    `json:"height"`
+	Size        int64  `json:"size"`
+	Checksum    string `json:"checksum"`
+}
+
+// Media fichero subido por un usuario en nombre de una organización
+type Media struct {
+	BaseModel
+
+	OwnerID        string       `json:"owner_id" gorm:"not null;size:36;index"`
+	OrganizationID string       `json:"organization_id" gorm:"not null;size:36;index"`
+	Purpose        MediaPurpose `json:"purpose" gorm:"not null;size:30;index"`
+
+	// Fichero
+	StorageKey  string `json:"storage_key" gorm:"not null;size:300;uniqueIndex"`
+	FileName    string `json:"file_name" gorm:"size:255"` // Nombre original, solo informativo
+	ContentType string `json:"content_type" gorm:"not null;size:100"`
+	Size        int64  `json:"size" gorm:"not null"`
+	Checksum    string `json:"checksum" gorm:"not null;size:64"` // SHA-256 del contenido
+	URL         string `json:"url" gorm:"size:500"`
+
+	// Procesado de imágenes: se limpian los metadatos al subirlas y un worker
+	// genera después las versiones y el color dominante
+	ProcessingStatus    MediaProcessingStatus `json:"processing_status" gorm:"not null;default:'skipped';size:20;index"`
+	ProcessingAttempts  int                   `json:"processing_attempts" gorm:"not null;default:0"`
+	ProcessingError     string                `json:"processing_error,omitempty" gorm:"size:500"`
+	ProcessingStartedAt *time.Time            `json:"processing_started_at,omitempty"`
+	ProcessedAt         *time.Time            `json:"processed_at,omitempty"`
+	Width               int                   `json:"width,omitempty"`
+	Height              int                   `json:"height,omitempty"`
+	DominantColor       string                `json:"dominant_color,omitempty" gorm:"size:7"`
+	Variants            datatypes.JSON        `json:"variants,omitempty" gorm:"type:jsonb"` // []MediaVariant
+
+	// Relaciones
+	Owner        *User            `json:"owner,omitempty" gorm:"foreignKey:OwnerID;references:ID"`
+	Organization *Organization    `json:"organization,omitempty" gorm:"foreignKey:OrganizationID;references:ID"`
+	References   []MediaReference `json:"references,omitempty" gorm:"foreignKey:MediaID;references:ID"`
+}
+
+// TableName especifica el nombre de tabla
+func (Media) TableName() string {
+	return "media"
+}
+
+// BeforeCreate hook de GORM para validación
+func (m *Media) BeforeCreate(tx *gorm.DB) error {
+	if err := m.BaseModel.BeforeCreate(tx); err != nil {
+		return err
+	}
+
+	m.FileName = strings.TrimSpace(m.FileName)
+	if m.ProcessingStatus == "" {
+		m.ProcessingStatus = MediaProcessingSkipped
+	}
+	return m.ValidateMedia()
+}
+
+// BeforeUpdate hook de GORM para validación
+func (m *Media) BeforeUpdate(tx *gorm.DB) error {
+	if err := m.BaseModel.BeforeUpdate(tx); err != nil {
+		return err
+	}
+
+	return m.ValidateMedia()
+}
+
+// ValidateMedia valida los datos del fichero
+func (m *Media) ValidateMedia() error {
+	if strings.TrimSpace(m.OwnerID) == "" {
+		return errors.New("owner ID is required")
+	}
+
+	if strings.TrimSpace(m.OrganizationID) == "" {
+		return errors.New("organization ID is required")
+	}
+
+	if !m.Purpose.IsValid() {
+		return errors.New("invalid media purpose")
+	}
+
+	if err := storage.ValidateKey(m.StorageKey); err != nil {
+		return errors.New("invalid storage key")
+	}
+
+	if !m.Purpose.AcceptsContentType(m.ContentType) {
+		return errors.New("content type not allowed for this purpose")
+	}
+
+	if m.Size <= 0 {
+		return errors.New("size must be positive")
+	}
+
+	if !checksumPattern.MatchString(m.Checksum) {
+		return errors.New("invalid checksum")
+	}
+
+	if len(m.FileName) > 255 {
+		return errors.New("file name cannot exceed 255 characters")
+	}
+
+	if !m.ProcessingStatus.IsValid() {
+		return errors.New("invalid processing status")
+	}
+
+	if m.DominantColor != "" && !isValidHexColor(m.DominantColor) {
+		return errors.New("invalid dominant color")
+	}
+
+	return nil
+}
+
+// IsPublic indica si el fichero es visible para cualquiera
+func (m *Media) IsPublic() bool {
+	return m.Purpose.IsPublic()
+}
+
+// IsInUse indica si algún recurso apunta al fichero. Requiere las referencias cargadas
+func (m *Media) IsInUse() bool {
+	return len(m.References) > 0
+}
+
+// GetVariants versiones generadas
+func (m *Media) GetVariants() []MediaVariant {
+	var variants []MediaVariant
+	if len(m.Variants) == 0 || json.Unmarshal(m.Variants, &variants) != nil {
+		return nil
+	}
+	return variants
+}
+
+// SetVariants guarda las versiones generadas
+func (m *Media) SetVariants(variants []MediaVariant) error {
+	data, err := json.Marshal(variants)
+	if err != nil {
+		return err
+	}
+	m.Variants = datatypes.JSON(data)
+	return nil
+}
+
+// FindVariant busca una versión por nombre
+func (m *Media) FindVariant(name string) (*MediaVariant, bool) {
+	for _, variant := range m.GetVariants() {
+		if variant.Name == name {
+			return &variant, true
+		}
+	}
+	return nil, false
+}
+
+// FieldVariants versiones que se copian al recurso que usa la imagen
+func (m *Media) FieldVariants() MediaFieldVariants {
+	entry := MediaFieldVariants{Source: m.URL, DominantColor: m.DominantColor}
+	for _, variant := range m.GetVariants() {
+		if entry.URLs == nil {
+			entry.URLs = make(map[string]string)
+		}
+		entry.URLs[variant.Name] = variant.URL
+	}
+	return entry
+}
+
+// GetAuditData implementa AuditableModel
+func (m *Media) GetAuditData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":              m.ID,
+		"organization_id": m.OrganizationID,
+		"purpose":         m.Purpose,
+		"storage_key":     m.StorageKey,
+	}
+}
+
+func (m Media) GetID() string           { return m.ID.String() }
+func (m Media) GetCreatedAt() time.Time { return m.CreatedAt }
+func (m Media) GetUpdatedAt() time.Time { return m.UpdatedAt }
+
+// MediaReference uso de un fichero desde un campo de un recurso. Cada campo
+// apunta como mucho a un fichero, así que al sustituirlo se reemplaza la referencia
+type MediaReference struct {
+	ID           uuid.UUID `json:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	MediaID      string    `json:"media_id" gorm:"not null;size:36;uniqueIndex:idx_media_reference"`
+	ResourceType string    `json:"resource_type" gorm:"not null;size:40;uniqueIndex:idx_media_reference;index:idx_media_reference_resource"`
+	ResourceID   string    `json:"resource_id" gorm:"not null;size:36;uniqueIndex:idx_media_reference;index:idx_media_reference_resource"`
+	Field        string    `json:"field" gorm:"not null;size:30;uniqueIndex:idx_media_reference"`
+	CreatedAt    time.Time `json:"created_at" gorm:"not null"`
+}
+
+// TableName especifica el nombre de tabla
+func (MediaReference) TableName() string {
+	return "media_references"
+}
+
+// BeforeCreate hook de GORM para validación
+func (r *MediaReference) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == uuid.Nil {
+		r.ID = uuid.New()
+	}
+	return r.Validate()
+}
+
+// Validate valida la referencia
+func (r *MediaReference) Validate() error {
+	if strings.TrimSpace(r.MediaID) == "" || strings.TrimSpace(r.ResourceID) == "" {
+		return errors.New("media and resource IDs are required")
+	}
+
+	if _, ok := MediaPurposeForField(r.ResourceType, r.Field); !ok {
+		return errors.New("invalid media reference field")
+	}
+
+	return nil
+}
+
+// MediaFieldVariants versiones de la imagen asignada a un campo de un evento
+// u organización. Source es la URL del original: si el campo cambia a otra
+// URL, las versiones dejan de corresponder y no se devuelven
+type MediaFieldVariants struct {
+	Source        string            `json:"source"`
+	URLs          map[string]string `json:"urls,omitempty"`
+	DominantColor string            `json:"dominant_color,omitempty"`
+}
+
+// MediaVariants versiones de las imágenes de un recurso por campo, tal y como
+// se guardan en la columna media_variants de eventos y organizaciones
+type MediaVariants map[string]MediaFieldVariants
+
+// ParseMediaVariants lee la columna media_variants
+func ParseMediaVariants(data datatypes.JSON) MediaVariants {
+	var variants MediaVariants
+	if len(data) == 0 || json.Unmarshal(data, &variants) != nil {
+		return nil
+	}
+	return variants
+}
+
+// For versiones del campo si siguen correspondiendo a su URL actual
+func (v MediaVariants) For(field, currentURL string) (MediaFieldVariants, bool) {
+	entry, ok := v[field]
+	if !ok || currentURL == "" || entry.Source != currentURL {
+		return MediaFieldVariants{}, false
+	}
+	return entry, true
+}
+
+// URLsFor URLs de las versiones del campo; nil si no hay o no corresponden
+func (v MediaVariants) URLsFor(field, currentURL string) map[string]string {
+	entry, ok := v.For(field, currentURL)
+	if !ok || len(entry.URLs) == 0 {
+		return nil
+	}
+	return entry.URLs
+}
@@ -0,0 +1,193 @@
+package models
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/datatypes"
+)
+
+// createTestMedia crea un fichero válido para testing
+func createTestMedia() *Media {
+	id := uuid.New()
+	return &Media{
+		BaseModel:        BaseModel{ID: id},
+		OwnerID:          uuid.New().String(),
+		OrganizationID:   uuid.New().String(),
+		Purpose:          MediaPurposeEventImage,
+		StorageKey:       MediaStorageKey(MediaPurposeEventImage, id, ".png", time.Now()),
+		FileName:         "cartel.png",
+		ContentType:      "image/png",
+		Size:             2048,
+		Checksum:         strings.Repeat("ab", 32),
+		ProcessingStatus: MediaProcessingPending,
+	}
+}
+
+// TestMedia_Validate tests unitarios para validación
+func TestMedia_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(m *Media)
+		errMsg string
+	}{
+		{name: "fichero válido", modify: func(m *Media) {}},
+		{name: "documento PDF de verificación", modify: func(m *Media) {
+			m.Purpose = MediaPurposeVerificationDocument
+			m.ContentType = "application/pdf"
+		}},
+		{name: "sin propietario", modify: func(m *Media) { m.OwnerID = "" }, errMsg: "owner ID is required"},
+		{name: "sin organización", modify: func(m *Media) { m.OrganizationID = " " }, errMsg: "organization ID is required"},
+		{name: "uso inválido", modify: func(m *Media) { m.Purpose = "avatar" }, errMsg: "invalid media purpose"},
+		{name: "clave con directorio padre", modify: func(m *Media) { m.StorageKey = "../x.png" }, errMsg: "invalid storage key"},
+		{name: "PDF como imagen de evento", modify: func(m *Media) { m.ContentType = "application/pdf" }, errMsg: "content type not allowed"},
+		{name: "SVG como logo", modify: func(m *Media) {
+			m.Purpose = MediaPurposeOrganizationLogo
+			m.ContentType = "image/svg+xml"
+		}, errMsg: "content type not allowed"},
+		{name: "tamaño cero", modify: func(m *Media) { m.Size = 0 }, errMsg: "size must be positive"},
+		{name: "checksum inválido", modify: func(m *Media) { m.Checksum = "abc" }, errMsg: "invalid checksum"},
+		{name: "nombre demasiado largo", modify: func(m *Media) { m.FileName = strings.Repeat("a", 256) }, errMsg: "file name cannot exceed"},
+		{name: "procesada con color", modify: func(m *Media) {
+			m.ProcessingStatus = MediaProcessingReady
+			m.DominantColor = "#c8102e"
+		}},
+		{name: "estado de procesado inválido", modify: func(m *Media) { m.ProcessingStatus = "done" }, errMsg: "invalid processing status"},
+		{name: "color dominante inválido", modify: func(m *Media) { m.DominantColor = "red" }, errMsg: "invalid dominant color"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := createTestMedia()
+			tt.modify(m)
+
+			err := m.ValidateMedia()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+// TestMediaPurpose tests para los usos de los ficheros
+func TestMediaPurpose(t *testing.T) {
+	assert.True(t, MediaPurposeEventBanner.IsPublic())
+	assert.True(t, MediaPurposeOrganizationLogo.IsPublic())
+	assert.False(t, MediaPurposeVerificationDocument.IsPublic())
+	assert.False(t, MediaPurpose("other").IsPublic())
+
+	assert.True(t, MediaPurposeVerificationDocument.AcceptsContentType("image/jpeg"))
+	assert.True(t, MediaPurposeVerificationDocument.AcceptsContentType("application/msword"))
+	assert.False(t, MediaPurposeOrganizationBanner.AcceptsContentType("application/msword"))
+	assert.False(t, MediaPurpose("other").AcceptsContentType("image/png"))
+}
+
+// TestMediaPurposeForField tests para la correspondencia entre campos y usos
+func TestMediaPurposeForField(t *testing.T) {
+	tests := []struct {
+		resourceType string
+		field        string
+		want         MediaPurpose
+		ok           bool
+	}{
+		{MediaResourceEvent, MediaFieldImage, MediaPurposeEventImage, true},
+		{MediaResourceEvent, MediaFieldBanner, MediaPurposeEventBanner, true},
+		{MediaResourceOrganization, MediaFieldLogo, MediaPurposeOrganizationLogo, true},
+		{MediaResourceOrganization, MediaFieldBanner, MediaPurposeOrganizationBanner, true},
+		{MediaResourceVerification, MediaFieldDocument, MediaPurposeVerificationDocument, true},
+		{MediaResourceEvent, MediaFieldLogo, "", false},
+		{"user", MediaFieldImage, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.resourceType+"."+tt.field, func(t *testing.T) {
+			got, ok := MediaPurposeForField(tt.resourceType, tt.field)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// TestMediaStorageKey tests para el formato de las claves
+func TestMediaStorageKey(t *testing.T) {
+	id := uuid.MustParse("7f1c2d3e-4b5a-4c6d-8e9f-0a1b2c3d4e5f")
+	key := MediaStorageKey(MediaPurposeOrganizationLogo, id, ".PNG", time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, "organization_logo/2026/03/7f1c2d3e-4b5a-4c6d-8e9f-0a1b2c3d4e5f.png", key)
+}
+
+// TestMediaReference_Validate tests para la validación de referencias
+func TestMediaReference_Validate(t *testing.T) {
+	ref := &MediaReference{MediaID: uuid.New().String(), ResourceType: MediaResourceEvent, ResourceID: uuid.New().String(), Field: MediaFieldBanner}
+	assert.NoError(t, ref.Validate())
+
+	ref.Field = MediaFieldLogo
+	assert.Error(t, ref.Validate())
+
+	ref.Field, ref.ResourceID = MediaFieldBanner, ""
+	assert.Error(t, ref.Validate())
+}
+
+// TestMediaVariantKey tests para las claves de las versiones
+func TestMediaVariantKey(t *testing.T) {
+	assert.Equal(t, "event_image/2026/03/abc_card.jpg", MediaVariantKey("event_image/2026/03/abc.png", MediaVariantCard, ".jpg"))
+	assert.Equal(t, "event_image/2026/03/abc_thumbnail.png", MediaVariantKey("event_image/2026/03/abc.png", MediaVariantThumbnail, ".png"))
+}
+
+// TestMedia_Variants tests para las versiones de un fichero
+func TestMedia_Variants(t *testing.T) {
+	m := createTestMedia()
+	m.URL = "/api/v1/public/media/" + m.ID.String()
+	assert.Empty(t, m.GetVariants())
+	assert.Equal(t, MediaFieldVariants{Source: m.URL}, m.FieldVariants())
+
+	m.DominantColor = "#0050a0"
+	require.NoError(t, m.SetVariants([]MediaVariant{
+		{Name: MediaVariantThumbnail, URL: "/t.jpg", Width: 320, Height: 180},
+		{Name: MediaVariantCard, URL: "/c.jpg", Width: 640, Height: 360},
+	}))
+
+	card, ok := m.FindVariant(MediaVariantCard)
+	require.True(t, ok)
+	assert.Equal(t, 640, card.Width)
+	_, ok = m.FindVariant(MediaVariantBanner)
+	assert.False(t, ok)
+
+	entry := m.FieldVariants()
+	assert.Equal(t, m.URL, entry.Source)
+	assert.Equal(t, "#0050a0", entry.DominantColor)
+	assert.Equal(t, map[string]string{MediaVariantThumbnail: "/t.jpg", MediaVariantCard: "/c.jpg"}, entry.URLs)
+}
+
+// TestMediaVariants_Recursos tests para las versiones guardadas en eventos y organizaciones
+func TestMediaVariants_Recursos(t *testing.T) {
+	variants := datatypes.JSON(`{
+		"image": {"source": "/api/v1/public/media/1", "urls": {"card": "/card.jpg"}},
+		"logo": {"source": "/api/v1/public/media/2", "urls": {"thumbnail": "/logo.jpg"}, "dominant_color": "#c8102e"}
+	}`)
+
+	event := &Event{ImageURL: "/api/v1/public/media/1", BannerURL: "/api/v1/public/media/3", MediaVariants: variants}
+	assert.Equal(t, map[string]string{"card": "/card.jpg"}, event.ImageVariants())
+	assert.Nil(t, event.BannerVariants())
+
+	// Si la URL cambia a mano, las versiones anteriores ya no valen
+	event.ImageURL = "https://example.com/otra.jpg"
+	assert.Nil(t, event.ImageVariants())
+
+	org := &Organization{LogoURL: "/api/v1/public/media/2", MediaVariants: variants}
+	assert.Equal(t, map[string]string{"thumbnail": "/logo.jpg"}, org.LogoVariants())
+	assert.Equal(t, "#c8102e", org.SuggestedPrimaryColor())
+
+	org.PrimaryColor = "#C8102E"
+	assert.Empty(t, org.SuggestedPrimaryColor(), "no se sugiere el color que ya tiene")
+
+	org.PrimaryColor, org.LogoURL = "", ""
+	assert.Empty(t, org.SuggestedPrimaryColor())
+	assert.Nil(t, (&Organization{}).LogoVariants())
+}
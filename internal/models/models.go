@@ -14,13 +14,61 @@ var AllModels = []interface{}{
 	&Event{},
 	&RefreshToken{}, // Agregado el nuevo modelo
 	&AuditLog{},
+	&CalendarFeed{},
+	&EventSeries{},
+	&Speaker{},
+	&EventSession{},
+	&TalkSubmission{},
+	&SubmissionReview{},
+	&Notification{},
+	&TicketType{},
+	&PromoCode{},
+	&EventRegistration{},
+	&Order{},
+	&InvoiceSequence{},
+	&Invoice{},
+	&EntityVersion{},
+	&DataExport{},
+	&ErasureRequest{},
+	&ScheduledJob{},
+	&AuditCheckpoint{},
+	&ContentReport{},
+	&ModerationNote{},
+	&OrganizationVerification{},
+	&Media{},
+	&MediaReference{},
 }
 
 // AutoMigrate ejecuta la auto-migración de todos los modelos
 func AutoMigrate(db *gorm.DB) error {
+	if err := migrateLegacyColumns(db); err != nil {
+		return err
+	}
 	return db.AutoMigrate(AllModels...)
 }
 
+// migrateLegacyColumns convierte columnas cuyo tipo ha cambiado y que la
+// auto-migración no sabe convertir. verified_at de organizaciones era texto y
+// podía guardar valores que no son fechas; esos se descartan
+func migrateLegacyColumns(db *gorm.DB) error {
+	return db.Exec(`
+		DO $$
+		BEGIN
+			IF EXISTS (
+				SELECT 1 FROM information_schema.columns
+				WHERE table_schema = current_schema() AND table_name = 'organizations'
+				AND column_name = 'verified_at' AND data_type = 'text'
+			) THEN
+				UPDATE organizations SET verified_at = NULL
+				WHERE verified_at !~ '^\d{4}-\d{2}-\d{2}';
+				ALTER TABLE organizations
+				ALTER COLUMN verified_at TYPE timestamptz USING verified_at::timestamptz;
+			END IF;
+		END
+		$$
+	`).Error
+}
+
 // CreateIndexes crea índices adicionales que no se pueden definir con tags
 func CreateIndexes(db *gorm.DB) error {
 	// Índice compuesto para búsquedas geoespaciales de usuarios
@@ -50,6 +98,22 @@ func CreateIndexes(db *gorm.DB) error {
 		return err
 	}
 
+	// Índice geography para búsquedas por radio, solo si PostGIS está instalado
+	// (sin PostGIS las búsquedas usan idx_events_location)
+	if err := db.Exec(`
+		DO $$
+		BEGIN
+			IF EXISTS (SELECT 1 FROM pg_extension WHERE extname = 'postgis') THEN
+				CREATE INDEX IF NOT EXISTS idx_events_geography 
+				ON events USING gist ((ST_SetSRID(ST_MakePoint(longitude, latitude), 4326)::geography)) 
+				WHERE latitude IS NOT NULL AND longitude IS NOT NULL;
+			END IF;
+		END
+		$$
+	`).Error; err != nil {
+		return err
+	}
+
 	// Índice para eventos por fecha y estado
 	if err := db.Exec(`
 		CREATE INDEX IF NOT EXISTS idx_events_status_dates 
@@ -75,6 +139,42 @@ func CreateIndexes(db *gorm.DB) error {
 		return err
 	}
 
+	// Una única ocurrencia por fecha original dentro de cada serie
+	if err := db.Exec(`
+		CREATE UNIQUE INDEX IF NOT EXISTS idx_events_series_occurrence 
+		ON events (series_id, occurrence_date) 
+		WHERE series_id IS NOT NULL
+	`).Error; err != nil {
+		return err
+	}
+
+	// Una única inscripción activa por usuario y evento
+	if err := db.Exec(`
+		CREATE UNIQUE INDEX IF NOT EXISTS idx_event_registrations_active 
+		ON event_registrations (event_id, user_id) 
+		WHERE status <> 'canceled' AND deleted_at IS NULL
+	`).Error; err != nil {
+		return err
+	}
+
+	// Un pago del proveedor solo puede pertenecer a un pedido
+	if err := db.Exec(`
+		CREATE UNIQUE INDEX IF NOT EXISTS idx_orders_provider_payment 
+		ON orders (provider, provider_payment_id) 
+		WHERE provider_payment_id <> ''
+	`).Error; err != nil {
+		return err
+	}
+
+	// Una única solicitud de supresión pendiente por usuario
+	if err := db.Exec(`
+		CREATE UNIQUE INDEX IF NOT EXISTS idx_erasure_requests_pending 
+		ON erasure_requests (user_id) 
+		WHERE status = 'pending' AND deleted_at IS NULL
+	`).Error; err != nil {
+		return err
+	}
+
 	// Índices específicos para refresh tokens
 	if err := db.Exec(`
 		CREATE INDEX IF NOT EXISTS idx_refresh_tokens_user_active 
@@ -100,6 +200,29 @@ func CreateIndexes(db *gorm.DB) error {
 		return err
 	}
 
+	// Índice para búsqueda full-text en organizaciones
+	if err := db.Exec(`
+		CREATE INDEX IF NOT EXISTS idx_organizations_fulltext 
+		ON organizations USING gin(to_tsvector('spanish_unaccent', name || ' ' || COALESCE(description, '')))
+	`).Error; err != nil {
+		return err
+	}
+
+	// Índices trigram para sugerencias tolerantes a errores (requiere pg_trgm)
+	if err := db.Exec(`
+		CREATE INDEX IF NOT EXISTS idx_events_title_trgm 
+		ON events USING gin(title gin_trgm_ops)
+	`).Error; err != nil {
+		return err
+	}
+
+	if err := db.Exec(`
+		CREATE INDEX IF NOT EXISTS idx_organizations_name_trgm 
+		ON organizations USING gin(name gin_trgm_ops)
+	`).Error; err != nil {
+		return err
+	}
+
 	return nil
 }
 
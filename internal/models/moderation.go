@@ -0,0 +1,258 @@
+package models
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ReportResourceType tipo de contenido denunciado
+type ReportResourceType string
+
+const (
+	ReportResourceEvent        ReportResourceType = "event"        // Evento
+	ReportResourceOrganization ReportResourceType = "organization" // Organización
+)
+
+// ReportReason motivo de una denuncia
+type ReportReason string
+
+const (
+	ReportReasonSpam          ReportReason = "spam"          // Spam o publicidad
+	ReportReasonInappropriate ReportReason = "inappropriate" // Contenido inapropiado
+	ReportReasonMisleading    ReportReason = "misleading"    // Información engañosa
+	ReportReasonFraud         ReportReason = "fraud"         // Fraude o estafa
+	ReportReasonCopyright     ReportReason = "copyright"     // Infracción de derechos
+	ReportReasonOther         ReportReason = "other"         // Otro (requiere detalles)
+)
+
+// ReportStatus estados de una denuncia en la cola de moderación
+type ReportStatus string
+
+const (
+	ReportStatusOpen      ReportStatus = "open"      // Pendiente de revisar
+	ReportStatusInReview  ReportStatus = "in_review" // Asignada a un moderador
+	ReportStatusActioned  ReportStatus = "actioned"  // Resuelta con una acción sobre el contenido
+	ReportStatusDismissed ReportStatus = "dismissed" // Descartada
+)
+
+// ModerationAction acción aplicada al resolver una denuncia
+type ModerationAction string
+
+const (
+	ModerationActionHide ModerationAction = "hide" // Ocultar el contenido y avisar al organizador
+	ModerationActionWarn ModerationAction = "warn" // Solo avisar al organizador
+)
+
+// MaxReportDetailsLength longitud máxima de los detalles de una denuncia
+const MaxReportDetailsLength = 2000
+
+// ContentReport denuncia de un usuario sobre un evento o una organización
+type ContentReport struct {
+	BaseModel
+
+	// Contenido denunciado y autor de la denuncia
+	ResourceType ReportResourceType `json:"resource_type" gorm:"not null;size:20;index:idx_content_reports_resource"`
+	ResourceID   string             `json:"resource_id" gorm:"not null;size:36;index:idx_content_reports_resource"`
+	ReporterID   string             `json:"reporter_id" gorm:"not null;size:36;index"`
+
+	// Motivo
+	Reason  ReportReason `json:"reason" gorm:"not null;size:20"`
+	Details string       `json:"details" gorm:"type:text"`
+
+	// Moderación
+	Status     ReportStatus     `json:"status" gorm:"not null;default:'open';size:20;index"`
+	AssignedTo *string          `json:"assigned_to" gorm:"size:36;index"`
+	Action     ModerationAction `json:"action" gorm:"size:20"`
+	Resolution string           `json:"resolution" gorm:"type:text"` // Mensaje al organizador o motivo del descarte
+	ResolvedBy *string          `json:"resolved_by" gorm:"size:36"`
+	ResolvedAt *time.Time       `json:"resolved_at"`
+
+	// Relaciones
+	Reporter *User            `json:"reporter,omitempty" gorm:"foreignKey:ReporterID;references:ID"`
+	Notes    []ModerationNote `json:"notes,omitempty" gorm:"foreignKey:ReportID"`
+}
+
+// TableName especifica el nombre de tabla
+func (ContentReport) TableName() string {
+	return "content_reports"
+}
+
+// BeforeCreate hook de GORM para validación
+func (r *ContentReport) BeforeCreate(tx *gorm.DB) error {
+	if err := r.BaseModel.BeforeCreate(tx); err != nil {
+		return err
+	}
+
+	r.Details = strings.TrimSpace(r.Details)
+	return r.ValidateContentReport()
+}
+
+// BeforeUpdate hook de GORM para validación
+func (r *ContentReport) BeforeUpdate(tx *gorm.DB) error {
+	if err := r.BaseModel.BeforeUpdate(tx); err != nil {
+		return err
+	}
+
+	return r.ValidateContentReport()
+}
+
+// ValidateContentReport valida los datos de la denuncia
+func (r *ContentReport) ValidateContentReport() error {
+	if !IsValidReportResourceType(r.ResourceType) {
+		return errors.New("invalid report resource type")
+	}
+
+	if strings.TrimSpace(r.ResourceID) == "" {
+		return errors.New("report resource ID is required")
+	}
+
+	if strings.TrimSpace(r.ReporterID) == "" {
+		return errors.New("reporter ID is required")
+	}
+
+	if !IsValidReportReason(r.Reason) {
+		return errors.New("invalid report reason")
+	}
+
+	if r.Reason == ReportReasonOther && strings.TrimSpace(r.Details) == "" {
+		return errors.New("report details are required for reason other")
+	}
+
+	if len(r.Details) > MaxReportDetailsLength {
+		return errors.New("report details are too long")
+	}
+
+	if !r.IsValidStatus() {
+		return errors.New("invalid report status")
+	}
+
+	return nil
+}
+
+// IsValidReportResourceType verifica si el tipo de contenido se puede denunciar
+func IsValidReportResourceType(resourceType ReportResourceType) bool {
+	return resourceType == ReportResourceEvent || resourceType == ReportResourceOrganization
+}
+
+// IsValidReportReason verifica si el motivo es válido
+func IsValidReportReason(reason ReportReason) bool {
+	switch reason {
+	case ReportReasonSpam, ReportReasonInappropriate, ReportReasonMisleading,
+		ReportReasonFraud, ReportReasonCopyright, ReportReasonOther:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsValidModerationAction verifica si la acción es válida
+func IsValidModerationAction(action ModerationAction) bool {
+	return action == ModerationActionHide || action == ModerationActionWarn
+}
+
+// IsValidStatus verifica si el estado es válido
+func (r *ContentReport) IsValidStatus() bool {
+	return r.Status == ReportStatusOpen || r.Status == ReportStatusInReview ||
+		r.Status == ReportStatusActioned || r.Status == ReportStatusDismissed
+}
+
+// IsResolved verifica si la denuncia ya se ha resuelto (con acción o descartada)
+func (r *ContentReport) IsResolved() bool {
+	return r.Status == ReportStatusActioned || r.Status == ReportStatusDismissed
+}
+
+// StartReview asigna la denuncia a un moderador. Un moderador puede tomar una
+// denuncia asignada a otro mientras no esté resuelta
+func (r *ContentReport) StartReview(moderatorID string) error {
+	if r.IsResolved() {
+		return errors.New("report is already resolved")
+	}
+
+	r.Status = ReportStatusInReview
+	r.AssignedTo = &moderatorID
+	return nil
+}
+
+// Resolve resuelve la denuncia aplicando una acción sobre el contenido
+func (r *ContentReport) Resolve(action ModerationAction, message, moderatorID string, at time.Time) error {
+	if !IsValidModerationAction(action) {
+		return errors.New("invalid moderation action")
+	}
+	return r.close(ReportStatusActioned, action, message, moderatorID, at)
+}
+
+// Dismiss descarta la denuncia sin actuar sobre el contenido
+func (r *ContentReport) Dismiss(reason, moderatorID string, at time.Time) error {
+	return r.close(ReportStatusDismissed, "", reason, moderatorID, at)
+}
+
+// close registra la resolución de la denuncia
+func (r *ContentReport) close(status ReportStatus, action ModerationAction, resolution, moderatorID string, at time.Time) error {
+	if r.IsResolved() {
+		return errors.New("report is already resolved")
+	}
+
+	r.Status = status
+	r.Action = action
+	r.Resolution = strings.TrimSpace(resolution)
+	r.ResolvedBy = &moderatorID
+	r.ResolvedAt = &at
+	if r.AssignedTo == nil {
+		r.AssignedTo = &moderatorID
+	}
+	return nil
+}
+
+// GetAuditData implementa AuditableModel
+func (r *ContentReport) GetAuditData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":            r.ID,
+		"resource_type": r.ResourceType,
+		"resource_id":   r.ResourceID,
+		"reason":        r.Reason,
+		"status":        r.Status,
+	}
+}
+
+func (r ContentReport) GetID() string           { return r.ID.String() }
+func (r ContentReport) GetCreatedAt() time.Time { return r.CreatedAt }
+func (r ContentReport) GetUpdatedAt() time.Time { return r.UpdatedAt }
+
+// ModerationNote nota interna de un moderador sobre una denuncia
+type ModerationNote struct {
+	BaseModel
+
+	ReportID string `json:"report_id" gorm:"not null;size:36;index"`
+	AuthorID string `json:"author_id" gorm:"not null;size:36"`
+	Note     string `json:"note" gorm:"type:text;not null"`
+
+	// Relaciones
+	Author *User `json:"author,omitempty" gorm:"foreignKey:AuthorID;references:ID"`
+}
+
+// TableName especifica el nombre de tabla
+func (ModerationNote) TableName() string {
+	return "moderation_notes"
+}
+
+// BeforeCreate hook de GORM para validación
+func (n *ModerationNote) BeforeCreate(tx *gorm.DB) error {
+	if err := n.BaseModel.BeforeCreate(tx); err != nil {
+		return err
+	}
+
+	n.Note = strings.TrimSpace(n.Note)
+	if strings.TrimSpace(n.ReportID) == "" {
+		return errors.New("report ID is required")
+	}
+	if strings.TrimSpace(n.AuthorID) == "" {
+		return errors.New("note author is required")
+	}
+	if n.Note == "" {
+		return errors.New("note is required")
+	}
+	return nil
+}
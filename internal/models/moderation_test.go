@@ -0,0 +1,113 @@
+package models
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createTestContentReport crea una denuncia válida para testing
+func createTestContentReport() *ContentReport {
+	return &ContentReport{
+		ResourceType: ReportResourceEvent,
+		ResourceID:   uuid.New().String(),
+		ReporterID:   uuid.New().String(),
+		Reason:       ReportReasonSpam,
+		Status:       ReportStatusOpen,
+	}
+}
+
+// TestContentReport_ValidateContentReport tests unitarios para validación
+func TestContentReport_ValidateContentReport(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(r *ContentReport)
+		errMsg string
+	}{
+		{name: "denuncia válida", modify: func(r *ContentReport) {}},
+		{name: "denuncia de organización", modify: func(r *ContentReport) { r.ResourceType = ReportResourceOrganization }},
+		{name: "otro motivo con detalles", modify: func(r *ContentReport) { r.Reason = ReportReasonOther; r.Details = "Suplanta a otra asociación" }},
+		{name: "tipo de contenido inválido", modify: func(r *ContentReport) { r.ResourceType = "speaker" }, errMsg: "invalid report resource type"},
+		{name: "sin contenido", modify: func(r *ContentReport) { r.ResourceID = " " }, errMsg: "report resource ID is required"},
+		{name: "sin autor", modify: func(r *ContentReport) { r.ReporterID = "" }, errMsg: "reporter ID is required"},
+		{name: "motivo inválido", modify: func(r *ContentReport) { r.Reason = "boring" }, errMsg: "invalid report reason"},
+		{name: "otro motivo sin detalles", modify: func(r *ContentReport) { r.Reason = ReportReasonOther }, errMsg: "report details are required for reason other"},
+		{name: "detalles demasiado largos", modify: func(r *ContentReport) { r.Details = strings.Repeat("a", MaxReportDetailsLength+1) }, errMsg: "report details are too long"},
+		{name: "estado inválido", modify: func(r *ContentReport) { r.Status = "closed" }, errMsg: "invalid report status"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := createTestContentReport()
+			tt.modify(report)
+
+			err := report.ValidateContentReport()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+// TestContentReport_Workflow tests para el flujo de la cola de moderación
+func TestContentReport_Workflow(t *testing.T) {
+	moderator := uuid.New().String()
+	at := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	t.Run("revisar y resolver ocultando", func(t *testing.T) {
+		report := createTestContentReport()
+
+		require.NoError(t, report.StartReview(moderator))
+		assert.Equal(t, ReportStatusInReview, report.Status)
+		assert.Equal(t, moderator, *report.AssignedTo)
+
+		require.NoError(t, report.Resolve(ModerationActionHide, "  Publicidad no relacionada  ", moderator, at))
+		assert.Equal(t, ReportStatusActioned, report.Status)
+		assert.Equal(t, ModerationActionHide, report.Action)
+		assert.Equal(t, "Publicidad no relacionada", report.Resolution)
+		assert.Equal(t, moderator, *report.ResolvedBy)
+		assert.Equal(t, at, *report.ResolvedAt)
+		assert.True(t, report.IsResolved())
+	})
+
+	t.Run("descartar sin revisión asigna al moderador", func(t *testing.T) {
+		report := createTestContentReport()
+
+		require.NoError(t, report.Dismiss("Sin infracción", moderator, at))
+		assert.Equal(t, ReportStatusDismissed, report.Status)
+		assert.Empty(t, report.Action)
+		assert.Equal(t, moderator, *report.AssignedTo)
+	})
+
+	t.Run("otro moderador toma una denuncia en revisión", func(t *testing.T) {
+		report := createTestContentReport()
+		require.NoError(t, report.StartReview(uuid.New().String()))
+
+		require.NoError(t, report.StartReview(moderator))
+		assert.Equal(t, moderator, *report.AssignedTo)
+	})
+
+	t.Run("acción inválida", func(t *testing.T) {
+		report := createTestContentReport()
+
+		assert.EqualError(t, report.Resolve("delete", "", moderator, at), "invalid moderation action")
+		assert.Equal(t, ReportStatusOpen, report.Status)
+	})
+
+	t.Run("una denuncia resuelta no cambia", func(t *testing.T) {
+		report := createTestContentReport()
+		require.NoError(t, report.Dismiss("", moderator, at))
+
+		assert.EqualError(t, report.StartReview(moderator), "report is already resolved")
+		assert.EqualError(t, report.Resolve(ModerationActionWarn, "Aviso", moderator, at), "report is already resolved")
+		assert.EqualError(t, report.Dismiss("", moderator, at), "report is already resolved")
+		assert.Equal(t, ReportStatusDismissed, report.Status)
+	})
+}
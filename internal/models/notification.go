@@ -0,0 +1,124 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// NotificationType define los tipos de notificación
+type NotificationType string
+
+const (
+	NotificationTypeSubmissionDecision   NotificationType = "submission_decision"   // Decisión sobre una propuesta del CFP
+	NotificationTypeReviewAssigned       NotificationType = "review_assigned"       // Propuesta asignada para revisión
+	NotificationTypePaymentConfirmed     NotificationType = "payment_confirmed"     // Pago confirmado, inscripción confirmada
+	NotificationTypeOrderRefunded        NotificationType = "order_refunded"        // Pedido reembolsado
+	NotificationTypeEventCanceled        NotificationType = "event_canceled"        // Evento cancelado, a sus asistentes
+	NotificationTypeContentModerated     NotificationType = "content_moderated"     // Aviso o contenido ocultado/restaurado por moderación
+	NotificationTypeReportResolved       NotificationType = "report_resolved"       // Denuncia revisada, a quien la presentó
+	NotificationTypeEventReviewed        NotificationType = "event_reviewed"        // Evento aprobado o rechazado por moderación
+	NotificationTypeVerificationReviewed NotificationType = "verification_reviewed" // Decisión sobre la verificación de la organización
+)
+
+// Notification notificación in-app para un usuario
+type Notification struct {
+	BaseModel
+
+	// Destinatario
+	UserID string `json:"user_id" gorm:"not null;size:36;index"`
+
+	// Contenido
+	Type    NotificationType `json:"type" gorm:"not null;size:50;index"`
+	Title   string           `json:"title" gorm:"not null;size:200"`
+	Message string           `json:"message" gorm:"type:text"`
+	Data    datatypes.JSON   `json:"data" gorm:"type:jsonb"` // Referencias al recurso relacionado
+
+	// Estado de lectura
+	ReadAt *time.Time `json:"read_at,omitempty" gorm:"index"`
+
+	// Relaciones
+	User *User `json:"user,omitempty" gorm:"foreignKey:UserID;references:ID"`
+}
+
+// TableName especifica el nombre de tabla
+func (Notification) TableName() string {
+	return "notifications"
+}
+
+// BeforeCreate hook de GORM para validación
+func (n *Notification) BeforeCreate(tx *gorm.DB) error {
+	if err := n.BaseModel.BeforeCreate(tx); err != nil {
+		return err
+	}
+
+	n.Title = strings.TrimSpace(n.Title)
+	return n.ValidateNotification()
+}
+
+// ValidateNotification valida los datos de la notificación
+func (n *Notification) ValidateNotification() error {
+	if strings.TrimSpace(n.UserID) == "" {
+		return errors.New("user ID is required")
+	}
+
+	if strings.TrimSpace(string(n.Type)) == "" {
+		return errors.New("notification type is required")
+	}
+
+	if strings.TrimSpace(n.Title) == "" {
+		return errors.New("notification title is required")
+	}
+
+	return nil
+}
+
+// IsRead verifica si la notificación ha sido leída
+func (n *Notification) IsRead() bool {
+	return n.ReadAt != nil
+}
+
+// MarkAsRead marca la notificación como leída
+func (n *Notification) MarkAsRead() {
+	if n.ReadAt == nil {
+		now := time.Now()
+		n.ReadAt = &now
+	}
+}
+
+// SetData establece los datos asociados
+func (n *Notification) SetData(data map[string]interface{}) error {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	n.Data = datatypes.JSON(raw)
+	return nil
+}
+
+// GetData obtiene los datos asociados
+func (n *Notification) GetData() map[string]interface{} {
+	var data map[string]interface{}
+	if err := json.Unmarshal(n.Data, &data); err != nil {
+		return nil
+	}
+	return data
+}
+
+// GetAuditData implementa AuditableModel
+func (n *Notification) GetAuditData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":      n.ID,
+		"user_id": n.UserID,
+		"type":    n.Type,
+		"title":   n.Title,
+	}
+}
+
+func (n Notification) GetID() string           { return n.ID.String() }
+func (n Notification) GetCreatedAt() time.Time { return n.CreatedAt }
+func (n Notification) GetUpdatedAt() time.Time { return n.UpdatedAt }
@@ -0,0 +1,251 @@
+package models
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// OrderStatus define los estados de un pedido
+type OrderStatus string
+
+const (
+	OrderStatusPending   OrderStatus = "pending"   // Esperando confirmación del proveedor
+	OrderStatusPaid      OrderStatus = "paid"      // Pago confirmado (inscripción confirmada)
+	OrderStatusFailed    OrderStatus = "failed"    // Pago rechazado o abandonado (plaza liberada)
+	OrderStatusRefunding OrderStatus = "refunding" // Reembolso en curso en el proveedor
+	OrderStatusRefunded  OrderStatus = "refunded"  // Pago reembolsado (plaza liberada)
+)
+
+// orderTransitions transiciones permitidas entre estados. Un reembolso
+// iniciado desde la plataforma pasa por refunding, que reserva el pedido antes
+// de llamar al proveedor; si el proveedor falla vuelve a paid (RevertRefunding).
+// Un pedido fallido cuyo pago llega después de cerrarlo (RecordLatePayment)
+// se reembolsa por el mismo camino
+var orderTransitions = map[OrderStatus][]OrderStatus{
+	OrderStatusPending:   {OrderStatusPaid, OrderStatusFailed},
+	OrderStatusFailed:    {OrderStatusRefunding},
+	OrderStatusPaid:      {OrderStatusRefunding, OrderStatusRefunded},
+	OrderStatusRefunding: {OrderStatusRefunded},
+}
+
+// Order pedido de pago de una inscripción
+type Order struct {
+	BaseModel
+
+	// Inscripción pagada (un pedido por inscripción)
+	RegistrationID string `json:"registration_id" gorm:"not null;size:36;uniqueIndex"`
+	EventID        string `json:"event_id" gorm:"not null;size:36;index"`
+	UserID         string `json:"user_id" gorm:"not null;size:36;index"`
+
+	// Importe en céntimos
+	AmountCents int    `json:"amount_cents" gorm:"not null"`
+	Currency    string `json:"currency" gorm:"size:3;not null"`
+
+	// Proveedor de pagos
+	Provider          string `json:"provider" gorm:"not null;size:50"`
+	ProviderPaymentID string `json:"provider_payment_id" gorm:"size:255;index"`
+	ProviderRefundID  string `json:"provider_refund_id" gorm:"size:255"`
+	CheckoutURL       string `json:"checkout_url" gorm:"size:1000"`
+
+	// Datos de facturación del comprador
+	Billing BillingDetails `json:"billing" gorm:"embedded;embeddedPrefix:billing_"`
+
+	// Estado
+	Status        OrderStatus `json:"status" gorm:"not null;default:'pending';size:20;index"`
+	FailureReason string      `json:"failure_reason" gorm:"size:500"`
+	PaidAt        *time.Time  `json:"paid_at"`
+	FailedAt      *time.Time  `json:"failed_at"`
+	RefundedAt    *time.Time  `json:"refunded_at"`
+
+	// Reembolso pendiente (p. ej. por la cancelación del evento): la tarea de
+	// reembolsos reintenta los pedidos marcados mientras sigan abiertos
+	RefundRequestedAt *time.Time `json:"refund_requested_at,omitempty" gorm:"index"`
+	RefundReason      string     `json:"refund_reason,omitempty" gorm:"size:500"`
+
+	// Relaciones
+	Event        *Event             `json:"event,omitempty" gorm:"foreignKey:EventID;references:ID"`
+	Registration *EventRegistration `json:"registration,omitempty" gorm:"foreignKey:RegistrationID;references:ID"`
+}
+
+// TableName especifica el nombre de tabla
+func (Order) TableName() string {
+	return "orders"
+}
+
+// BeforeCreate hook de GORM para validación
+func (o *Order) BeforeCreate(tx *gorm.DB) error {
+	if err := o.BaseModel.BeforeCreate(tx); err != nil {
+		return err
+	}
+
+	o.Currency = strings.ToUpper(strings.TrimSpace(o.Currency))
+	o.Billing.Normalize()
+	return o.ValidateOrder()
+}
+
+// BeforeUpdate hook de GORM para validación
+func (o *Order) BeforeUpdate(tx *gorm.DB) error {
+	if err := o.BaseModel.BeforeUpdate(tx); err != nil {
+		return err
+	}
+
+	return o.ValidateOrder()
+}
+
+// ValidateOrder valida los datos del pedido
+func (o *Order) ValidateOrder() error {
+	if strings.TrimSpace(o.RegistrationID) == "" {
+		return errors.New("registration ID is required")
+	}
+
+	if strings.TrimSpace(o.EventID) == "" || strings.TrimSpace(o.UserID) == "" {
+		return errors.New("event ID and user ID are required")
+	}
+
+	if o.AmountCents <= 0 {
+		return errors.New("order amount must be positive")
+	}
+
+	if len(o.Currency) != 3 {
+		return errors.New("currency must be a 3-letter ISO code")
+	}
+
+	if strings.TrimSpace(o.Provider) == "" {
+		return errors.New("payment provider is required")
+	}
+
+	if o.Billing.TaxID != "" && o.Billing.Name == "" {
+		return errors.New("billing name is required when a tax ID is provided")
+	}
+
+	if !o.IsValidStatus() {
+		return errors.New("invalid order status")
+	}
+
+	return nil
+}
+
+// IsValidStatus verifica si el estado es válido
+func (o *Order) IsValidStatus() bool {
+	_, ok := orderTransitions[o.Status]
+	return ok || o.Status == OrderStatusFailed || o.Status == OrderStatusRefunded
+}
+
+// CanTransitionTo verifica si el pedido puede pasar al estado indicado
+func (o *Order) CanTransitionTo(status OrderStatus) bool {
+	if o.Status == OrderStatusFailed && !o.HasLatePayment() {
+		return false
+	}
+	for _, allowed := range orderTransitions[o.Status] {
+		if allowed == status {
+			return true
+		}
+	}
+	return false
+}
+
+// MarkPaid marca el pedido como pagado
+func (o *Order) MarkPaid(at time.Time) error {
+	if !o.CanTransitionTo(OrderStatusPaid) {
+		return errors.New("only pending orders can be paid")
+	}
+
+	o.Status = OrderStatusPaid
+	o.PaidAt = &at
+	return nil
+}
+
+// MarkFailed marca el pedido como fallido
+func (o *Order) MarkFailed(reason string, at time.Time) error {
+	if !o.CanTransitionTo(OrderStatusFailed) {
+		return errors.New("only pending orders can fail")
+	}
+
+	o.Status = OrderStatusFailed
+	o.FailureReason = strings.TrimSpace(reason)
+	o.FailedAt = &at
+	return nil
+}
+
+// RecordLatePayment registra el cobro de un pedido que ya había fallado (p. ej.
+// cancelado mientras el usuario pagaba) y lo marca para reembolsarlo
+func (o *Order) RecordLatePayment(reason string, at time.Time) error {
+	if o.Status != OrderStatusFailed || o.HasLatePayment() {
+		return errors.New("only failed orders can record a late payment")
+	}
+
+	o.PaidAt = &at
+	o.RefundRequestedAt = &at
+	o.RefundReason = strings.TrimSpace(reason)
+	return nil
+}
+
+// HasLatePayment indica si el pedido se cobró después de fallar
+func (o *Order) HasLatePayment() bool {
+	return o.Status == OrderStatusFailed && o.PaidAt != nil
+}
+
+// MarkRefunding reserva el pedido pagado para reembolsarlo
+func (o *Order) MarkRefunding() error {
+	if !o.CanTransitionTo(OrderStatusRefunding) {
+		return errors.New("only paid orders can be refunded")
+	}
+
+	o.Status = OrderStatusRefunding
+	return nil
+}
+
+// RevertRefunding devuelve a pagado (o a fallido, si se cobró después de
+// fallar) un pedido cuyo reembolso ha fallado
+func (o *Order) RevertRefunding() error {
+	if o.Status != OrderStatusRefunding {
+		return errors.New("order is not being refunded")
+	}
+
+	o.Status = OrderStatusPaid
+	if o.FailedAt != nil {
+		o.Status = OrderStatusFailed
+	}
+	return nil
+}
+
+// MarkRefunded marca el pedido como reembolsado
+func (o *Order) MarkRefunded(refundID string, at time.Time) error {
+	if !o.CanTransitionTo(OrderStatusRefunded) {
+		return errors.New("only paid orders can be refunded")
+	}
+
+	o.Status = OrderStatusRefunded
+	o.ProviderRefundID = refundID
+	o.RefundedAt = &at
+	return nil
+}
+
+// IsFinal verifica si el pedido ya no admite cambios
+func (o *Order) IsFinal() bool {
+	for _, status := range orderTransitions[o.Status] {
+		if o.CanTransitionTo(status) {
+			return false
+		}
+	}
+	return true
+}
+
+// GetAuditData implementa AuditableModel
+func (o *Order) GetAuditData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":              o.ID,
+		"registration_id": o.RegistrationID,
+		"amount_cents":    o.AmountCents,
+		"currency":        o.Currency,
+		"status":          o.Status,
+		"provider":        o.Provider,
+	}
+}
+
+func (o Order) GetID() string           { return o.ID.String() }
+func (o Order) GetCreatedAt() time.Time { return o.CreatedAt }
+func (o Order) GetUpdatedAt() time.Time { return o.UpdatedAt }
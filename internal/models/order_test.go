@@ -0,0 +1,139 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestOrder() *Order {
+	return &Order{
+		RegistrationID: uuid.New().String(),
+		EventID:        uuid.New().String(),
+		UserID:         uuid.New().String(),
+		AmountCents:    4900,
+		Currency:       "EUR",
+		Provider:       "fake",
+		Status:         OrderStatusPending,
+	}
+}
+
+// TestOrder_Validate tests unitarios para validación
+func TestOrder_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*Order)
+		errMsg string
+	}{
+		{name: "pedido válido", modify: func(o *Order) {}},
+		{name: "sin inscripción", modify: func(o *Order) { o.RegistrationID = "" }, errMsg: "registration ID is required"},
+		{name: "importe cero", modify: func(o *Order) { o.AmountCents = 0 }, errMsg: "order amount must be positive"},
+		{name: "moneda inválida", modify: func(o *Order) { o.Currency = "E" }, errMsg: "currency must be a 3-letter ISO code"},
+		{name: "sin proveedor", modify: func(o *Order) { o.Provider = "" }, errMsg: "payment provider is required"},
+		{name: "estado desconocido", modify: func(o *Order) { o.Status = "authorized" }, errMsg: "invalid order status"},
+		{name: "NIF sin razón social", modify: func(o *Order) { o.Billing.TaxID = "B12345678" }, errMsg: "billing name is required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := newTestOrder()
+			tt.modify(order)
+
+			err := order.ValidateOrder()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+// TestOrder_Transitions tests para la máquina de estados
+func TestOrder_Transitions(t *testing.T) {
+	now := time.Now()
+
+	t.Run("pendiente a pagado a reembolsado", func(t *testing.T) {
+		order := newTestOrder()
+		require.NoError(t, order.MarkPaid(now))
+		assert.Equal(t, OrderStatusPaid, order.Status)
+		assert.NotNil(t, order.PaidAt)
+
+		assert.Error(t, order.MarkPaid(now))
+		assert.Error(t, order.MarkFailed("tarde", now))
+
+		require.NoError(t, order.MarkRefunded("ref_1", now))
+		assert.Equal(t, OrderStatusRefunded, order.Status)
+		assert.Equal(t, "ref_1", order.ProviderRefundID)
+		assert.True(t, order.IsFinal())
+	})
+
+	t.Run("pendiente a fallido", func(t *testing.T) {
+		order := newTestOrder()
+		require.NoError(t, order.MarkFailed("  tarjeta rechazada ", now))
+		assert.Equal(t, "tarjeta rechazada", order.FailureReason)
+		assert.True(t, order.IsFinal())
+
+		assert.Error(t, order.MarkPaid(now))
+		assert.Error(t, order.MarkRefunded("ref_1", now))
+	})
+
+	t.Run("reembolso reservado antes de llamar al proveedor", func(t *testing.T) {
+		order := newTestOrder()
+		require.NoError(t, order.MarkPaid(now))
+		require.NoError(t, order.MarkRefunding())
+		assert.Equal(t, OrderStatusRefunding, order.Status)
+		assert.False(t, order.IsFinal())
+
+		// Un segundo cierre no puede reservarlo otra vez
+		assert.Error(t, order.MarkRefunding())
+		assert.Error(t, order.MarkPaid(now))
+
+		require.NoError(t, order.MarkRefunded("ref_1", now))
+		assert.Equal(t, OrderStatusRefunded, order.Status)
+		assert.Error(t, order.RevertRefunding())
+	})
+
+	t.Run("reembolso fallido vuelve a pagado", func(t *testing.T) {
+		order := newTestOrder()
+		require.NoError(t, order.MarkPaid(now))
+		require.NoError(t, order.MarkRefunding())
+		require.NoError(t, order.RevertRefunding())
+		assert.Equal(t, OrderStatusPaid, order.Status)
+		assert.NoError(t, order.MarkRefunding())
+	})
+
+	t.Run("pago recibido después de fallar", func(t *testing.T) {
+		order := newTestOrder()
+		require.NoError(t, order.MarkFailed("Inscripción cancelada", now))
+		assert.True(t, order.IsFinal())
+		assert.Error(t, order.MarkRefunding())
+
+		require.NoError(t, order.RecordLatePayment("Pago recibido tras cancelar", now))
+		assert.True(t, order.HasLatePayment())
+		assert.NotNil(t, order.RefundRequestedAt)
+		assert.False(t, order.IsFinal())
+		assert.Error(t, order.RecordLatePayment("Pago repetido", now))
+
+		// Si el proveedor falla vuelve a fallido, no a pagado
+		require.NoError(t, order.MarkRefunding())
+		require.NoError(t, order.RevertRefunding())
+		assert.Equal(t, OrderStatusFailed, order.Status)
+
+		require.NoError(t, order.MarkRefunding())
+		require.NoError(t, order.MarkRefunded("ref_1", now))
+		assert.True(t, order.IsFinal())
+	})
+
+	t.Run("no se reembolsa un pedido pendiente", func(t *testing.T) {
+		order := newTestOrder()
+		assert.False(t, order.CanTransitionTo(OrderStatusRefunded))
+		assert.Error(t, order.MarkRefunded("ref_1", now))
+		assert.Error(t, order.MarkRefunding())
+		assert.False(t, order.IsFinal())
+	})
+}
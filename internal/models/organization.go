@@ -6,6 +6,7 @@ import (
 	"strings"
 	"time"
 
+	"gorm.io/datatypes"
 	"gorm.io/gorm"
 )
 
@@ -17,6 +18,7 @@ const (
 	OrgStatusActive    OrganizationStatus = "active"    // Verificada y activa
 	OrgStatusSuspended OrganizationStatus = "suspended" // Suspendida temporalmente
 	OrgStatusInactive  OrganizationStatus = "inactive"  // Inactiva por decisión propia
+	OrgStatusRejected  OrganizationStatus = "rejected"  // Verificación rechazada
 )
 
 // Organization modelo para organizaciones que crean eventos
@@ -41,11 +43,15 @@ type Organization struct {
 	Latitude  *float64 `json:"latitude" gorm:"index"`
 	Longitude *float64 `json:"longitude" gorm:"index"`
 
+	// Origen de las coordenadas; las manuales no se sobrescriben al geocodificar
+	CoordinatesSource CoordinatesSource `json:"coordinates_source,omitempty" gorm:"size:20"`
+
 	// Branding y medios
-	LogoURL        string `json:"logo_url" gorm:"size:500"`
-	BannerURL      string `json:"banner_url" gorm:"size:500"`
-	PrimaryColor   string `json:"primary_color" gorm:"size:7"`   // Hex color
-	SecondaryColor string `json:"secondary_color" gorm:"size:7"` // Hex color
+	LogoURL        string         `json:"logo_url" gorm:"size:500"`
+	BannerURL      string         `json:"banner_url" gorm:"size:500"`
+	PrimaryColor   string         `json:"primary_color" gorm:"size:7"`                // Hex color
+	SecondaryColor string         `json:"secondary_color" gorm:"size:7"`              // Hex color
+	MediaVariants  datatypes.JSON `json:"media_variants,omitempty" gorm:"type:jsonb"` // Versiones de logo y banner (MediaVariants)
 
 	// Redes sociales
 	LinkedIn  string `json:"linkedin" gorm:"size:255"`
@@ -57,13 +63,16 @@ type Organization struct {
 	// Estado y verificación
 	Status     OrganizationStatus `json:"status" gorm:"not null;default:'pending';size:20;index"`
 	IsVerified bool               `json:"is_verified" gorm:"not null;default:false;index"`
-	VerifiedAt *string            `json:"verified_at,omitempty"`
+	VerifiedAt *time.Time         `json:"verified_at,omitempty"`
 	VerifiedBy *string            `json:"verified_by,omitempty" gorm:"size:36"`
 
 	// Metadatos de verificación
 	TaxID            string `json:"tax_id,omitempty" gorm:"size:50"` // NIF, CIF, etc.
 	LegalName        string `json:"legal_name,omitempty" gorm:"size:300"`
-	RegistrationDocs string `json:"registration_docs,omitempty" gorm:"size:500"` // URLs de documentos
+	RegistrationDocs string `json:"registration_docs,omitempty" gorm:"size:500"` // URL aportada al crear; la documentación se revisa en las solicitudes de verificación
+
+	// Facturación
+	VATRate *int `json:"vat_rate,omitempty"` // IVA en puntos básicos (null = tipo general)
 
 	// Configuraciones
 	EventsCount     int  `json:"events_count" gorm:"default:0"`
@@ -147,13 +156,18 @@ func (o *Organization) ValidateOrganization() error {
 		return errors.New("invalid secondary color format")
 	}
 
+	if o.VATRate != nil && (*o.VATRate < 0 || *o.VATRate > basisPointsPerUnit) {
+		return errors.New("vat rate must be between 0 and 10000 basis points")
+	}
+
 	return nil
 }
 
 // IsValidStatus verifica si el status es válido
 func (o *Organization) IsValidStatus() bool {
 	return o.Status == OrgStatusPending || o.Status == OrgStatusActive ||
-		o.Status == OrgStatusSuspended || o.Status == OrgStatusInactive
+		o.Status == OrgStatusSuspended || o.Status == OrgStatusInactive ||
+		o.Status == OrgStatusRejected
 }
 
 // GenerateSlug genera un slug único basado en el nombre
@@ -188,18 +202,36 @@ func (o *Organization) CanCreateEvent() bool {
 	return true
 }
 
-// Verify marca la organización como verificada
-func (o *Organization) Verify(verifiedBy string) {
+// Verify marca la organización como verificada. Las pendientes o rechazadas
+// pasan a activas
+func (o *Organization) Verify(verifiedBy string, at time.Time) {
 	o.IsVerified = true
 	o.VerifiedBy = &verifiedBy
-	now := strings.TrimSpace(string(rune(1))) // Placeholder para timestamp
-	o.VerifiedAt = &now
+	o.VerifiedAt = &at
 
+	if o.Status == OrgStatusPending || o.Status == OrgStatusRejected {
+		o.Activate()
+	}
+}
+
+// RejectVerification marca como rechazada una organización pendiente de
+// verificación. Las que ya están activas conservan su estado
+func (o *Organization) RejectVerification() {
 	if o.Status == OrgStatusPending {
-		o.Status = OrgStatusActive
+		o.Status = OrgStatusRejected
+		o.CanCreateEvents = false
 	}
 }
 
+// CanRequestVerification indica si la organización puede enviar documentación
+// para verificarse: no verificada y no suspendida ni inactiva
+func (o *Organization) CanRequestVerification() bool {
+	if o.IsVerified {
+		return false
+	}
+	return o.Status == OrgStatusPending || o.Status == OrgStatusRejected || o.Status == OrgStatusActive
+}
+
 // Suspend suspende la organización
 func (o *Organization) Suspend() {
 	o.Status = OrgStatusSuspended
@@ -239,6 +271,27 @@ func (o *Organization) HasLocation() bool {
 	return o.Latitude != nil && o.Longitude != nil
 }
 
+// SetGeocodedLocation establece coordenadas calculadas a partir de la dirección
+func (o *Organization) SetGeocodedLocation(latitude, longitude float64) {
+	o.SetLocation(latitude, longitude)
+	o.CoordinatesSource = CoordinatesSourceGeocoded
+}
+
+// ClearLocation elimina las coordenadas para que se vuelvan a geocodificar
+func (o *Organization) ClearLocation() {
+	o.Latitude = nil
+	o.Longitude = nil
+	o.CoordinatesSource = ""
+}
+
+// NeedsGeocoding indica si hay que calcular las coordenadas a partir de la
+// dirección: con dirección y sin coordenadas manuales
+func (o *Organization) NeedsGeocoding() bool {
+	return (o.Address != "" || o.City != "") &&
+		o.CoordinatesSource != CoordinatesSourceManual &&
+		!o.HasLocation()
+}
+
 // SetBranding establece los colores de branding
 func (o *Organization) SetBranding(primaryColor, secondaryColor string) error {
 	if primaryColor != "" && !isValidHexColor(primaryColor) {
@@ -254,6 +307,50 @@ func (o *Organization) SetBranding(primaryColor, secondaryColor string) error {
 	return nil
 }
 
+// LogoVariants URLs de las versiones del logo
+func (o *Organization) LogoVariants() map[string]string {
+	return ParseMediaVariants(o.MediaVariants).URLsFor(MediaFieldLogo, o.LogoURL)
+}
+
+// BannerVariants URLs de las versiones del banner
+func (o *Organization) BannerVariants() map[string]string {
+	return ParseMediaVariants(o.MediaVariants).URLsFor(MediaFieldBanner, o.BannerURL)
+}
+
+// SuggestedPrimaryColor color dominante del logo actual como sugerencia de
+// color principal; vacío si no se conoce o ya es el color principal
+func (o *Organization) SuggestedPrimaryColor() string {
+	entry, ok := ParseMediaVariants(o.MediaVariants).For(MediaFieldLogo, o.LogoURL)
+	if !ok || entry.DominantColor == "" || strings.EqualFold(entry.DominantColor, o.PrimaryColor) {
+		return ""
+	}
+	return entry.DominantColor
+}
+
+// CanIssueInvoices verifica si la organización tiene los datos fiscales necesarios para facturar
+func (o *Organization) CanIssueInvoices() bool {
+	return strings.TrimSpace(o.LegalName) != "" && strings.TrimSpace(o.TaxID) != ""
+}
+
+// InvoiceVATRate devuelve el IVA aplicable en puntos básicos
+func (o *Organization) InvoiceVATRate() int {
+	if o.VATRate != nil {
+		return *o.VATRate
+	}
+	return DefaultVATRateBasisPoints
+}
+
+// FiscalAddress devuelve la dirección fiscal en una línea
+func (o *Organization) FiscalAddress() string {
+	parts := make([]string, 0, 4)
+	for _, part := range []string{o.Address, strings.TrimSpace(o.PostalCode + " " + o.City), o.Country} {
+		if part = strings.TrimSpace(part); part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
 // GetAuditData implementa AuditableModel
 func (o *Organization) GetAuditData() map[string]interface{} {
 	return map[string]interface{}{
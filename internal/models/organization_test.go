@@ -3,6 +3,7 @@ package models
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -153,6 +154,7 @@ func TestOrganization_IsValidStatus(t *testing.T) {
 		{"status active válido", OrgStatusActive, true},
 		{"status suspended válido", OrgStatusSuspended, true},
 		{"status inactive válido", OrgStatusInactive, true},
+		{"status rejected válido", OrgStatusRejected, true},
 		{"status inválido", OrganizationStatus("invalid"), false},
 		{"status vacío", OrganizationStatus(""), false},
 	}
@@ -325,15 +327,66 @@ func TestOrganization_StateTransitions(t *testing.T) {
 	t.Run("verificar organización", func(t *testing.T) {
 		org := createTestOrganization()
 		verifierID := "admin-user-id"
+		at := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
 
 		// Verificar
-		org.Verify(verifierID)
+		org.Verify(verifierID, at)
 
 		assert.True(t, org.IsVerified)
 		assert.Equal(t, OrgStatusActive, org.Status)
+		assert.True(t, org.CanCreateEvents)
 		assert.NotNil(t, org.VerifiedBy)
 		assert.Equal(t, verifierID, *org.VerifiedBy)
-		assert.NotNil(t, org.VerifiedAt)
+		require.NotNil(t, org.VerifiedAt)
+		assert.Equal(t, at, *org.VerifiedAt)
+		assert.False(t, org.CanRequestVerification())
+	})
+
+	t.Run("verificar organización rechazada", func(t *testing.T) {
+		org := createTestOrganization()
+		org.Status = OrgStatusRejected
+
+		org.Verify("admin-user-id", time.Now())
+
+		assert.Equal(t, OrgStatusActive, org.Status)
+	})
+
+	t.Run("verificar organización suspendida", func(t *testing.T) {
+		org := createTestOrganization()
+		org.Status = OrgStatusSuspended
+
+		org.Verify("admin-user-id", time.Now())
+
+		assert.True(t, org.IsVerified)
+		assert.Equal(t, OrgStatusSuspended, org.Status)
+	})
+
+	t.Run("rechazar verificación", func(t *testing.T) {
+		org := createTestOrganization()
+		org.CanCreateEvents = true
+
+		org.RejectVerification()
+
+		assert.Equal(t, OrgStatusRejected, org.Status)
+		assert.False(t, org.CanCreateEvents)
+		assert.True(t, org.CanRequestVerification())
+	})
+
+	t.Run("rechazar verificación de organización activa", func(t *testing.T) {
+		org := createTestOrganization()
+		org.Status = OrgStatusActive
+
+		org.RejectVerification()
+
+		assert.Equal(t, OrgStatusActive, org.Status)
+		assert.True(t, org.CanRequestVerification())
+	})
+
+	t.Run("organización suspendida no solicita verificación", func(t *testing.T) {
+		org := createTestOrganization()
+		org.Status = OrgStatusSuspended
+
+		assert.False(t, org.CanRequestVerification())
 	})
 
 	t.Run("suspender organización", func(t *testing.T) {
@@ -390,6 +443,26 @@ func TestOrganization_SetLocation(t *testing.T) {
 	assert.Equal(t, lng, *org.Longitude)
 }
 
+// TestOrganization_NeedsGeocoding tests para decidir si se geocodifica la dirección
+func TestOrganization_NeedsGeocoding(t *testing.T) {
+	org := createTestOrganization()
+	org.Address = ""
+	org.City = ""
+	assert.False(t, org.NeedsGeocoding())
+
+	org.City = "Bilbao"
+	assert.True(t, org.NeedsGeocoding())
+
+	org.SetGeocodedLocation(43.2630, -2.9350)
+	assert.False(t, org.NeedsGeocoding())
+
+	org.ClearLocation()
+	assert.True(t, org.NeedsGeocoding())
+
+	org.CoordinatesSource = CoordinatesSourceManual
+	assert.False(t, org.NeedsGeocoding())
+}
+
 // TestOrganization_SetBranding tests para configuración de branding
 func TestOrganization_SetBranding(t *testing.T) {
 	tests := []struct {
@@ -605,3 +678,33 @@ func TestOrganization_BeforeCreateLogic(t *testing.T) {
 	assert.Equal(t, "CyberSecurity España", org.Name)
 	assert.Equal(t, "info@cybersecurityspain.com", org.Email)
 }
+
+// TestOrganization_Invoicing tests unitarios para los datos de facturación
+func TestOrganization_Invoicing(t *testing.T) {
+	org := createTestOrganization()
+	org.LegalName = "Asociación CyberSecurity Spain"
+	org.TaxID = ""
+	assert.False(t, org.CanIssueInvoices())
+
+	org.TaxID = "G12345678"
+	assert.True(t, org.CanIssueInvoices())
+
+	assert.Equal(t, DefaultVATRateBasisPoints, org.InvoiceVATRate())
+	exempt := 0
+	org.VATRate = &exempt
+	assert.Equal(t, 0, org.InvoiceVATRate())
+	require.NoError(t, org.ValidateOrganization())
+
+	invalid := 12000
+	org.VATRate = &invalid
+	assert.Error(t, org.ValidateOrganization())
+
+	org.Address = "Calle Mayor 1"
+	org.PostalCode = "28013"
+	org.City = "Madrid"
+	org.Country = "España"
+	assert.Equal(t, "Calle Mayor 1, 28013 Madrid, España", org.FiscalAddress())
+
+	org.Address, org.PostalCode = "", ""
+	assert.Equal(t, "Madrid, España", org.FiscalAddress())
+}
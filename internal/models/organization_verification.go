@@ -0,0 +1,289 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	"cybesphere-backend/pkg/taxid"
+
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// VerificationStatus estados de una solicitud de verificación
+type VerificationStatus string
+
+const (
+	VerificationStatusSubmitted VerificationStatus = "submitted"  // Enviada, pendiente de revisar
+	VerificationStatusInReview  VerificationStatus = "in_review"  // Asignada a un administrador
+	VerificationStatusNeedsInfo VerificationStatus = "needs_info" // Se pidió más documentación; la organización envía una nueva solicitud
+	VerificationStatusApproved  VerificationStatus = "approved"   // Aprobada, organización verificada
+	VerificationStatusRejected  VerificationStatus = "rejected"   // Rechazada
+)
+
+// VerificationDocumentKind tipo de documento aportado para la verificación
+type VerificationDocumentKind string
+
+const (
+	VerificationDocTaxID         VerificationDocumentKind = "tax_id"        // Tarjeta o certificado del NIF/CIF
+	VerificationDocIncorporation VerificationDocumentKind = "incorporation" // Escritura de constitución o estatutos
+	VerificationDocRegistry      VerificationDocumentKind = "registry"      // Inscripción en el registro correspondiente
+	VerificationDocOther         VerificationDocumentKind = "other"         // Otra documentación
+)
+
+// MaxVerificationDocuments número máximo de documentos por solicitud
+const MaxVerificationDocuments = 10
+
+// VerificationDocument documento aportado en una solicitud de verificación. Si
+// se subió a la plataforma, MediaID apunta al fichero y URL es su ruta en la API
+type VerificationDocument struct {
+	Kind    VerificationDocumentKind `json:"kind"`
+	Name    string                   `json:"name"`
+	URL     string                   `json:"url"`
+	MediaID string                   `json:"media_id,omitempty"`
+}
+
+// OrganizationVerification solicitud de verificación de una organización. Cada
+// envío es una fila nueva, de modo que las solicitudes de una organización
+// forman su historial de revisión
+type OrganizationVerification struct {
+	BaseModel
+
+	OrganizationID string `json:"organization_id" gorm:"not null;size:36;index"`
+	SubmittedBy    string `json:"submitted_by" gorm:"not null;size:36"`
+
+	// Datos fiscales y documentación
+	TaxID     string         `json:"tax_id" gorm:"not null;size:20"`
+	LegalName string         `json:"legal_name" gorm:"not null;size:300"`
+	Documents datatypes.JSON `json:"documents" gorm:"type:jsonb"`
+	Comments  string         `json:"comments" gorm:"type:text"` // Aclaraciones de la organización
+
+	// Revisión
+	Status        VerificationStatus `json:"status" gorm:"not null;default:'submitted';size:20;index"`
+	ReviewerID    *string            `json:"reviewer_id" gorm:"size:36;index"`
+	ReviewMessage string             `json:"review_message" gorm:"type:text"` // Motivo del rechazo, información pedida o nota de aprobación
+	ReviewedAt    *time.Time         `json:"reviewed_at"`
+
+	// Relaciones
+	Organization *Organization `json:"organization,omitempty" gorm:"foreignKey:OrganizationID;references:ID"`
+	Submitter    *User         `json:"submitter,omitempty" gorm:"foreignKey:SubmittedBy;references:ID"`
+}
+
+// TableName especifica el nombre de tabla
+func (OrganizationVerification) TableName() string {
+	return "organization_verifications"
+}
+
+// BeforeCreate hook de GORM para validación
+func (v *OrganizationVerification) BeforeCreate(tx *gorm.DB) error {
+	if err := v.BaseModel.BeforeCreate(tx); err != nil {
+		return err
+	}
+
+	v.TaxID = taxid.Normalize(v.TaxID)
+	v.LegalName = strings.TrimSpace(v.LegalName)
+	v.Comments = strings.TrimSpace(v.Comments)
+	return v.ValidateVerification()
+}
+
+// BeforeUpdate hook de GORM para validación
+func (v *OrganizationVerification) BeforeUpdate(tx *gorm.DB) error {
+	if err := v.BaseModel.BeforeUpdate(tx); err != nil {
+		return err
+	}
+
+	return v.ValidateVerification()
+}
+
+// ValidateVerification valida los datos de la solicitud
+func (v *OrganizationVerification) ValidateVerification() error {
+	if strings.TrimSpace(v.OrganizationID) == "" {
+		return errors.New("organization ID is required")
+	}
+
+	if strings.TrimSpace(v.SubmittedBy) == "" {
+		return errors.New("submitter is required")
+	}
+
+	if _, err := taxid.Validate(v.TaxID); err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(v.LegalName) == "" {
+		return errors.New("legal name is required")
+	}
+
+	if err := validateVerificationDocuments(v.GetDocuments()); err != nil {
+		return err
+	}
+
+	if !v.IsValidStatus() {
+		return errors.New("invalid verification status")
+	}
+
+	return nil
+}
+
+// validateVerificationDocuments exige entre uno y MaxVerificationDocuments
+// documentos con URL http(s) o subidos a la plataforma, uno de ellos la
+// acreditación del NIF/CIF
+func validateVerificationDocuments(docs []VerificationDocument) error {
+	if len(docs) == 0 {
+		return errors.New("at least one document is required")
+	}
+
+	if len(docs) > MaxVerificationDocuments {
+		return errors.New("too many documents")
+	}
+
+	hasTaxID := false
+	for _, doc := range docs {
+		if !IsValidVerificationDocumentKind(doc.Kind) {
+			return errors.New("invalid document kind")
+		}
+
+		if !isValidDocumentURL(doc) {
+			return errors.New("invalid document URL")
+		}
+
+		if doc.Kind == VerificationDocTaxID {
+			hasTaxID = true
+		}
+	}
+
+	if !hasTaxID {
+		return errors.New("a tax ID document is required")
+	}
+
+	return nil
+}
+
+// isValidDocumentURL admite URL http(s) externas y rutas de la API para los
+// ficheros subidos
+func isValidDocumentURL(doc VerificationDocument) bool {
+	if doc.MediaID != "" && strings.HasPrefix(doc.URL, "/") && !strings.HasPrefix(doc.URL, "//") {
+		return true
+	}
+	parsed, err := url.Parse(doc.URL)
+	return err == nil && (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != ""
+}
+
+// IsValidVerificationDocumentKind verifica si el tipo de documento es válido
+func IsValidVerificationDocumentKind(kind VerificationDocumentKind) bool {
+	switch kind {
+	case VerificationDocTaxID, VerificationDocIncorporation, VerificationDocRegistry, VerificationDocOther:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsValidStatus verifica si el estado es válido
+func (v *OrganizationVerification) IsValidStatus() bool {
+	switch v.Status {
+	case VerificationStatusSubmitted, VerificationStatusInReview, VerificationStatusNeedsInfo,
+		VerificationStatusApproved, VerificationStatusRejected:
+		return true
+	default:
+		return false
+	}
+}
+
+// GetDocuments obtiene los documentos de la solicitud
+func (v *OrganizationVerification) GetDocuments() []VerificationDocument {
+	var docs []VerificationDocument
+	if len(v.Documents) == 0 {
+		return []VerificationDocument{}
+	}
+	if err := json.Unmarshal(v.Documents, &docs); err != nil {
+		return []VerificationDocument{}
+	}
+	return docs
+}
+
+// SetDocuments establece los documentos de la solicitud
+func (v *OrganizationVerification) SetDocuments(docs []VerificationDocument) error {
+	clean := make([]VerificationDocument, 0, len(docs))
+	for _, doc := range docs {
+		clean = append(clean, VerificationDocument{
+			Kind:    doc.Kind,
+			Name:    strings.TrimSpace(doc.Name),
+			URL:     strings.TrimSpace(doc.URL),
+			MediaID: doc.MediaID,
+		})
+	}
+
+	data, err := json.Marshal(clean)
+	if err != nil {
+		return err
+	}
+	v.Documents = datatypes.JSON(data)
+	return nil
+}
+
+// IsOpen indica si la solicitud está pendiente de decisión
+func (v *OrganizationVerification) IsOpen() bool {
+	return v.Status == VerificationStatusSubmitted || v.Status == VerificationStatusInReview
+}
+
+// StartReview asigna la solicitud a un administrador y la pasa a revisión
+func (v *OrganizationVerification) StartReview(reviewerID string) error {
+	if !v.IsOpen() {
+		return errors.New("verification request is already decided")
+	}
+
+	v.Status = VerificationStatusInReview
+	v.ReviewerID = &reviewerID
+	return nil
+}
+
+// Approve aprueba la solicitud; el mensaje es opcional
+func (v *OrganizationVerification) Approve(reviewerID, message string, at time.Time) error {
+	return v.decide(VerificationStatusApproved, reviewerID, message, at)
+}
+
+// Reject rechaza la solicitud indicando el motivo
+func (v *OrganizationVerification) Reject(reviewerID, reason string, at time.Time) error {
+	if strings.TrimSpace(reason) == "" {
+		return errors.New("rejection reason is required")
+	}
+	return v.decide(VerificationStatusRejected, reviewerID, reason, at)
+}
+
+// RequestInfo cierra la solicitud pidiendo más documentación
+func (v *OrganizationVerification) RequestInfo(reviewerID, message string, at time.Time) error {
+	if strings.TrimSpace(message) == "" {
+		return errors.New("requested information is required")
+	}
+	return v.decide(VerificationStatusNeedsInfo, reviewerID, message, at)
+}
+
+// decide registra la decisión sobre una solicitud pendiente
+func (v *OrganizationVerification) decide(status VerificationStatus, reviewerID, message string, at time.Time) error {
+	if !v.IsOpen() {
+		return errors.New("verification request is already decided")
+	}
+
+	v.Status = status
+	v.ReviewerID = &reviewerID
+	v.ReviewMessage = strings.TrimSpace(message)
+	v.ReviewedAt = &at
+	return nil
+}
+
+// GetAuditData implementa AuditableModel
+func (v *OrganizationVerification) GetAuditData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":              v.ID,
+		"organization_id": v.OrganizationID,
+		"tax_id":          v.TaxID,
+		"status":          v.Status,
+	}
+}
+
+func (v OrganizationVerification) GetID() string           { return v.ID.String() }
+func (v OrganizationVerification) GetCreatedAt() time.Time { return v.CreatedAt }
+func (v OrganizationVerification) GetUpdatedAt() time.Time { return v.UpdatedAt }
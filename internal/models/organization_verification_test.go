@@ -0,0 +1,158 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/datatypes"
+)
+
+// createTestVerification crea una solicitud de verificación válida para testing
+func createTestVerification() *OrganizationVerification {
+	v := &OrganizationVerification{
+		OrganizationID: uuid.New().String(),
+		SubmittedBy:    uuid.New().String(),
+		TaxID:          "B12345674",
+		LegalName:      "Ciberseguridad Norte S.L.",
+		Status:         VerificationStatusSubmitted,
+	}
+	_ = v.SetDocuments([]VerificationDocument{
+		{Kind: VerificationDocTaxID, Name: "Tarjeta NIF", URL: "https://files.example.com/nif.pdf"},
+	})
+	return v
+}
+
+// TestOrganizationVerification_Validate tests unitarios para validación
+func TestOrganizationVerification_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(v *OrganizationVerification)
+		errMsg string
+	}{
+		{name: "solicitud válida", modify: func(v *OrganizationVerification) {}},
+		{name: "NIE como identificador", modify: func(v *OrganizationVerification) { v.TaxID = "X1234567L" }},
+		{name: "varios documentos", modify: func(v *OrganizationVerification) {
+			_ = v.SetDocuments([]VerificationDocument{
+				{Kind: VerificationDocIncorporation, URL: "https://files.example.com/estatutos.pdf"},
+				{Kind: VerificationDocTaxID, URL: "http://files.example.com/nif.pdf"},
+			})
+		}},
+		{name: "sin organización", modify: func(v *OrganizationVerification) { v.OrganizationID = "" }, errMsg: "organization ID is required"},
+		{name: "sin remitente", modify: func(v *OrganizationVerification) { v.SubmittedBy = " " }, errMsg: "submitter is required"},
+		{name: "CIF con control incorrecto", modify: func(v *OrganizationVerification) { v.TaxID = "B12345675" }, errMsg: "invalid tax ID control character"},
+		{name: "identificador sin formato", modify: func(v *OrganizationVerification) { v.TaxID = "123" }, errMsg: "invalid tax ID format"},
+		{name: "sin razón social", modify: func(v *OrganizationVerification) { v.LegalName = "" }, errMsg: "legal name is required"},
+		{name: "sin documentos", modify: func(v *OrganizationVerification) { v.Documents = nil }, errMsg: "at least one document is required"},
+		{name: "JSON de documentos corrupto", modify: func(v *OrganizationVerification) { v.Documents = datatypes.JSON(`{`) }, errMsg: "at least one document is required"},
+		{name: "demasiados documentos", modify: func(v *OrganizationVerification) {
+			docs := make([]VerificationDocument, MaxVerificationDocuments+1)
+			for i := range docs {
+				docs[i] = VerificationDocument{Kind: VerificationDocTaxID, URL: "https://files.example.com/nif.pdf"}
+			}
+			_ = v.SetDocuments(docs)
+		}, errMsg: "too many documents"},
+		{name: "tipo de documento inválido", modify: func(v *OrganizationVerification) {
+			_ = v.SetDocuments([]VerificationDocument{{Kind: "passport", URL: "https://files.example.com/p.pdf"}})
+		}, errMsg: "invalid document kind"},
+		{name: "URL sin esquema http", modify: func(v *OrganizationVerification) {
+			_ = v.SetDocuments([]VerificationDocument{{Kind: VerificationDocTaxID, URL: "ftp://files.example.com/nif.pdf"}})
+		}, errMsg: "invalid document URL"},
+		{name: "documento subido a la plataforma", modify: func(v *OrganizationVerification) {
+			_ = v.SetDocuments([]VerificationDocument{{Kind: VerificationDocTaxID, URL: "/api/v1/media/abc/file", MediaID: uuid.New().String()}})
+		}},
+		{name: "ruta relativa sin fichero subido", modify: func(v *OrganizationVerification) {
+			_ = v.SetDocuments([]VerificationDocument{{Kind: VerificationDocTaxID, URL: "/api/v1/media/abc/file"}})
+		}, errMsg: "invalid document URL"},
+		{name: "sin acreditación del NIF", modify: func(v *OrganizationVerification) {
+			_ = v.SetDocuments([]VerificationDocument{{Kind: VerificationDocIncorporation, URL: "https://files.example.com/e.pdf"}})
+		}, errMsg: "a tax ID document is required"},
+		{name: "estado inválido", modify: func(v *OrganizationVerification) { v.Status = "pending" }, errMsg: "invalid verification status"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := createTestVerification()
+			tt.modify(v)
+
+			err := v.ValidateVerification()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+// TestOrganizationVerification_SetDocuments tests para la limpieza de documentos
+func TestOrganizationVerification_SetDocuments(t *testing.T) {
+	v := &OrganizationVerification{}
+	assert.Empty(t, v.GetDocuments())
+
+	require.NoError(t, v.SetDocuments([]VerificationDocument{
+		{Kind: VerificationDocTaxID, Name: "  NIF  ", URL: " https://files.example.com/nif.pdf "},
+	}))
+
+	docs := v.GetDocuments()
+	require.Len(t, docs, 1)
+	assert.Equal(t, "NIF", docs[0].Name)
+	assert.Equal(t, "https://files.example.com/nif.pdf", docs[0].URL)
+}
+
+// TestOrganizationVerification_Workflow tests para el flujo de revisión
+func TestOrganizationVerification_Workflow(t *testing.T) {
+	reviewer := uuid.New().String()
+	at := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	t.Run("revisar y aprobar", func(t *testing.T) {
+		v := createTestVerification()
+
+		require.NoError(t, v.StartReview(reviewer))
+		assert.Equal(t, VerificationStatusInReview, v.Status)
+		assert.Equal(t, reviewer, *v.ReviewerID)
+		assert.True(t, v.IsOpen())
+
+		require.NoError(t, v.Approve(reviewer, "", at))
+		assert.Equal(t, VerificationStatusApproved, v.Status)
+		assert.Equal(t, at, *v.ReviewedAt)
+		assert.False(t, v.IsOpen())
+	})
+
+	t.Run("rechazar con motivo", func(t *testing.T) {
+		v := createTestVerification()
+
+		require.NoError(t, v.Reject(reviewer, "  El CIF no coincide con la escritura  ", at))
+		assert.Equal(t, VerificationStatusRejected, v.Status)
+		assert.Equal(t, "El CIF no coincide con la escritura", v.ReviewMessage)
+	})
+
+	t.Run("rechazar sin motivo", func(t *testing.T) {
+		v := createTestVerification()
+
+		assert.Error(t, v.Reject(reviewer, " ", at))
+		assert.Equal(t, VerificationStatusSubmitted, v.Status)
+	})
+
+	t.Run("pedir más información", func(t *testing.T) {
+		v := createTestVerification()
+
+		assert.Error(t, v.RequestInfo(reviewer, "", at))
+		require.NoError(t, v.RequestInfo(reviewer, "Adjunta los estatutos", at))
+		assert.Equal(t, VerificationStatusNeedsInfo, v.Status)
+		assert.False(t, v.IsOpen())
+	})
+
+	t.Run("solicitud decidida no admite cambios", func(t *testing.T) {
+		v := createTestVerification()
+		require.NoError(t, v.Approve(reviewer, "", at))
+
+		assert.Error(t, v.StartReview(reviewer))
+		assert.Error(t, v.Reject(reviewer, "Motivo", at))
+		assert.Error(t, v.RequestInfo(reviewer, "Más datos", at))
+		assert.Error(t, v.Approve(reviewer, "", at))
+	})
+}
@@ -0,0 +1,243 @@
+package models
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// AuditActionErase acción registrada en la auditoría al anonimizar una cuenta
+const AuditActionErase = "erase"
+
+// DataExportStatus define los estados de una exportación de datos personales
+type DataExportStatus string
+
+const (
+	DataExportStatusPending    DataExportStatus = "pending"    // En cola
+	DataExportStatusProcessing DataExportStatus = "processing" // Generando el archivo
+	DataExportStatusReady      DataExportStatus = "ready"      // Archivo disponible para descargar
+	DataExportStatusFailed     DataExportStatus = "failed"     // Error al generar el archivo
+	DataExportStatusExpired    DataExportStatus = "expired"    // Archivo eliminado al vencer la retención
+)
+
+// ErasureStatus define los estados de una solicitud de supresión de cuenta
+type ErasureStatus string
+
+const (
+	ErasureStatusPending   ErasureStatus = "pending"   // Esperando a que venza el plazo de gracia
+	ErasureStatusCompleted ErasureStatus = "completed" // Cuenta anonimizada
+	ErasureStatusCanceled  ErasureStatus = "canceled"  // Retirada por el usuario o un administrador
+)
+
+// DataExport archivo con los datos personales de un usuario (derecho de
+// acceso y portabilidad). Se genera en segundo plano y se borra al vencer
+type DataExport struct {
+	BaseModel
+
+	// Titular de los datos
+	UserID string `json:"user_id" gorm:"not null;size:36;index"`
+
+	// Estado
+	Status        DataExportStatus `json:"status" gorm:"not null;default:'pending';size:20;index"`
+	FailureReason string           `json:"failure_reason,omitempty" gorm:"size:500"`
+	CompletedAt   *time.Time       `json:"completed_at"`
+	ExpiresAt     *time.Time       `json:"expires_at" gorm:"index"`
+
+	// Archivo ZIP y su huella SHA-256
+	Archive   []byte `json:"-" gorm:"type:bytea"`
+	SizeBytes int64  `json:"size_bytes" gorm:"not null;default:0"`
+	Checksum  string `json:"checksum,omitempty" gorm:"size:64"`
+}
+
+// TableName especifica el nombre de tabla
+func (DataExport) TableName() string {
+	return "data_exports"
+}
+
+// BeforeCreate hook de GORM para validación
+func (e *DataExport) BeforeCreate(tx *gorm.DB) error {
+	if err := e.BaseModel.BeforeCreate(tx); err != nil {
+		return err
+	}
+
+	return e.ValidateDataExport()
+}
+
+// ValidateDataExport valida los datos de la exportación
+func (e *DataExport) ValidateDataExport() error {
+	if strings.TrimSpace(e.UserID) == "" {
+		return errors.New("user ID is required")
+	}
+
+	if !e.IsValidStatus() {
+		return errors.New("invalid data export status")
+	}
+
+	if e.Status == DataExportStatusReady && e.ExpiresAt == nil {
+		return errors.New("ready exports must expire")
+	}
+
+	return nil
+}
+
+// IsValidStatus verifica si el estado es válido
+func (e *DataExport) IsValidStatus() bool {
+	switch e.Status {
+	case DataExportStatusPending, DataExportStatusProcessing, DataExportStatusReady,
+		DataExportStatusFailed, DataExportStatusExpired:
+		return true
+	}
+	return false
+}
+
+// IsInProgress verifica si el archivo se está generando
+func (e *DataExport) IsInProgress() bool {
+	return e.Status == DataExportStatusPending || e.Status == DataExportStatusProcessing
+}
+
+// IsDownloadable verifica si el archivo puede descargarse en el momento indicado
+func (e *DataExport) IsDownloadable(at time.Time) bool {
+	return e.Status == DataExportStatusReady && e.ExpiresAt != nil && at.Before(*e.ExpiresAt)
+}
+
+// MarkReady guarda el archivo generado, disponible durante retention
+func (e *DataExport) MarkReady(archive []byte, checksum string, at time.Time, retention time.Duration) error {
+	if !e.IsInProgress() {
+		return errors.New("only exports in progress can be completed")
+	}
+
+	expiresAt := at.Add(retention)
+	e.Status = DataExportStatusReady
+	e.Archive = archive
+	e.SizeBytes = int64(len(archive))
+	e.Checksum = checksum
+	e.CompletedAt = &at
+	e.ExpiresAt = &expiresAt
+	return nil
+}
+
+// MarkFailed marca la exportación como fallida
+func (e *DataExport) MarkFailed(reason string, at time.Time) error {
+	if !e.IsInProgress() {
+		return errors.New("only exports in progress can fail")
+	}
+
+	e.Status = DataExportStatusFailed
+	e.FailureReason = strings.TrimSpace(reason)
+	e.CompletedAt = &at
+	return nil
+}
+
+func (e DataExport) GetID() string           { return e.ID.String() }
+func (e DataExport) GetCreatedAt() time.Time { return e.CreatedAt }
+func (e DataExport) GetUpdatedAt() time.Time { return e.UpdatedAt }
+
+// ErasureRequest solicitud de supresión de la cuenta (derecho al olvido). Se
+// ejecuta al vencer el plazo de gracia anonimizando al usuario
+type ErasureRequest struct {
+	BaseModel
+
+	// Titular de los datos
+	UserID string `json:"user_id" gorm:"not null;size:36;index"`
+	Reason string `json:"reason" gorm:"type:text"`
+
+	// Estado y plazo
+	Status       ErasureStatus `json:"status" gorm:"not null;default:'pending';size:20;index"`
+	ScheduledFor time.Time     `json:"scheduled_for" gorm:"not null;index"`
+	CompletedAt  *time.Time    `json:"completed_at"`
+	CanceledAt   *time.Time    `json:"canceled_at"`
+	ProcessedBy  string        `json:"processed_by,omitempty" gorm:"size:100"` // Quien la ejecutó o retiró
+
+	// Relaciones
+	User *User `json:"user,omitempty" gorm:"foreignKey:UserID;references:ID"`
+}
+
+// TableName especifica el nombre de tabla
+func (ErasureRequest) TableName() string {
+	return "erasure_requests"
+}
+
+// BeforeCreate hook de GORM para validación
+func (r *ErasureRequest) BeforeCreate(tx *gorm.DB) error {
+	if err := r.BaseModel.BeforeCreate(tx); err != nil {
+		return err
+	}
+
+	r.Reason = strings.TrimSpace(r.Reason)
+	return r.ValidateErasureRequest()
+}
+
+// ValidateErasureRequest valida los datos de la solicitud
+func (r *ErasureRequest) ValidateErasureRequest() error {
+	if strings.TrimSpace(r.UserID) == "" {
+		return errors.New("user ID is required")
+	}
+
+	if r.Status != ErasureStatusPending && r.Status != ErasureStatusCompleted && r.Status != ErasureStatusCanceled {
+		return errors.New("invalid erasure status")
+	}
+
+	if r.ScheduledFor.IsZero() {
+		return errors.New("scheduled date is required")
+	}
+
+	return nil
+}
+
+// IsPending verifica si la solicitud aún no se ha ejecutado ni retirado
+func (r *ErasureRequest) IsPending() bool {
+	return r.Status == ErasureStatusPending
+}
+
+// IsDue verifica si ha vencido el plazo de gracia
+func (r *ErasureRequest) IsDue(at time.Time) bool {
+	return r.IsPending() && !at.Before(r.ScheduledFor)
+}
+
+// MarkCompleted marca la solicitud como ejecutada
+func (r *ErasureRequest) MarkCompleted(by string, at time.Time) error {
+	if !r.IsPending() {
+		return errors.New("only pending erasure requests can be completed")
+	}
+
+	r.Status = ErasureStatusCompleted
+	r.ProcessedBy = by
+	r.CompletedAt = &at
+	return nil
+}
+
+// MarkCanceled retira la solicitud
+func (r *ErasureRequest) MarkCanceled(by string, at time.Time) error {
+	if !r.IsPending() {
+		return errors.New("only pending erasure requests can be canceled")
+	}
+
+	r.Status = ErasureStatusCanceled
+	r.ProcessedBy = by
+	r.CanceledAt = &at
+	return nil
+}
+
+func (r ErasureRequest) GetID() string           { return r.ID.String() }
+func (r ErasureRequest) GetCreatedAt() time.Time { return r.CreatedAt }
+func (r ErasureRequest) GetUpdatedAt() time.Time { return r.UpdatedAt }
+
+type erasureKey struct{}
+
+// ContextWithErasure marca los cambios del contexto como supresión de datos
+// personales: la auditoría registra qué columnas cambian, pero no sus valores
+func ContextWithErasure(ctx context.Context) context.Context {
+	return context.WithValue(ctx, erasureKey{}, true)
+}
+
+// IsErasureContext indica si los cambios del contexto suprimen datos personales
+func IsErasureContext(ctx context.Context) bool {
+	if ctx == nil {
+		return false
+	}
+	erasure, _ := ctx.Value(erasureKey{}).(bool)
+	return erasure
+}
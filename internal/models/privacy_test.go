@@ -0,0 +1,165 @@
+package models
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDataExport_ValidateDataExport tests para validación de exportaciones
+func TestDataExport_ValidateDataExport(t *testing.T) {
+	expiresAt := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name    string
+		export  DataExport
+		wantErr string
+	}{
+		{
+			name:   "exportación pendiente válida",
+			export: DataExport{UserID: "user-1", Status: DataExportStatusPending},
+		},
+		{
+			name:   "exportación lista con caducidad",
+			export: DataExport{UserID: "user-1", Status: DataExportStatusReady, ExpiresAt: &expiresAt},
+		},
+		{
+			name:    "sin usuario",
+			export:  DataExport{Status: DataExportStatusPending},
+			wantErr: "user ID is required",
+		},
+		{
+			name:    "estado inválido",
+			export:  DataExport{UserID: "user-1", Status: "archived"},
+			wantErr: "invalid data export status",
+		},
+		{
+			name:    "lista sin caducidad",
+			export:  DataExport{UserID: "user-1", Status: DataExportStatusReady},
+			wantErr: "ready exports must expire",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.export.ValidateDataExport()
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// TestDataExport_Lifecycle tests para la generación y caducidad del archivo
+func TestDataExport_Lifecycle(t *testing.T) {
+	at := time.Date(2026, 10, 18, 10, 0, 0, 0, time.UTC)
+
+	t.Run("archivo listo hasta que vence", func(t *testing.T) {
+		export := &DataExport{UserID: "user-1", Status: DataExportStatusProcessing}
+		assert.True(t, export.IsInProgress())
+		assert.False(t, export.IsDownloadable(at))
+
+		require.NoError(t, export.MarkReady([]byte("zip"), "abc", at, 24*time.Hour))
+		assert.Equal(t, DataExportStatusReady, export.Status)
+		assert.Equal(t, int64(3), export.SizeBytes)
+		assert.Equal(t, "abc", export.Checksum)
+		assert.Equal(t, at.Add(24*time.Hour), *export.ExpiresAt)
+		assert.NoError(t, export.ValidateDataExport())
+
+		assert.True(t, export.IsDownloadable(at.Add(time.Hour)))
+		assert.False(t, export.IsDownloadable(at.Add(24*time.Hour)))
+		assert.Error(t, export.MarkReady([]byte("zip"), "abc", at, time.Hour))
+	})
+
+	t.Run("error al generar", func(t *testing.T) {
+		export := &DataExport{UserID: "user-1", Status: DataExportStatusPending}
+		require.NoError(t, export.MarkFailed("  sin espacio  ", at))
+		assert.Equal(t, DataExportStatusFailed, export.Status)
+		assert.Equal(t, "sin espacio", export.FailureReason)
+		assert.False(t, export.IsDownloadable(at))
+		assert.Error(t, export.MarkFailed("otra vez", at))
+	})
+}
+
+// TestErasureRequest_ValidateErasureRequest tests para validación de solicitudes de supresión
+func TestErasureRequest_ValidateErasureRequest(t *testing.T) {
+	scheduled := time.Now().Add(30 * 24 * time.Hour)
+
+	tests := []struct {
+		name    string
+		request ErasureRequest
+		wantErr string
+	}{
+		{
+			name:    "solicitud válida",
+			request: ErasureRequest{UserID: "user-1", Status: ErasureStatusPending, ScheduledFor: scheduled},
+		},
+		{
+			name:    "sin usuario",
+			request: ErasureRequest{Status: ErasureStatusPending, ScheduledFor: scheduled},
+			wantErr: "user ID is required",
+		},
+		{
+			name:    "estado inválido",
+			request: ErasureRequest{UserID: "user-1", Status: "done", ScheduledFor: scheduled},
+			wantErr: "invalid erasure status",
+		},
+		{
+			name:    "sin fecha prevista",
+			request: ErasureRequest{UserID: "user-1", Status: ErasureStatusPending},
+			wantErr: "scheduled date is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.ValidateErasureRequest()
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// TestErasureRequest_Transitions tests para el plazo de gracia y los cambios de estado
+func TestErasureRequest_Transitions(t *testing.T) {
+	scheduled := time.Date(2026, 11, 17, 10, 0, 0, 0, time.UTC)
+
+	t.Run("vence al llegar la fecha prevista", func(t *testing.T) {
+		request := &ErasureRequest{UserID: "user-1", Status: ErasureStatusPending, ScheduledFor: scheduled}
+		assert.False(t, request.IsDue(scheduled.Add(-time.Second)))
+		assert.True(t, request.IsDue(scheduled))
+		assert.True(t, request.IsDue(scheduled.Add(time.Hour)))
+	})
+
+	t.Run("ejecutada", func(t *testing.T) {
+		request := &ErasureRequest{UserID: "user-1", Status: ErasureStatusPending, ScheduledFor: scheduled}
+		require.NoError(t, request.MarkCompleted("system", scheduled))
+		assert.Equal(t, ErasureStatusCompleted, request.Status)
+		assert.Equal(t, "system", request.ProcessedBy)
+		assert.Equal(t, scheduled, *request.CompletedAt)
+		assert.False(t, request.IsDue(scheduled))
+		assert.Error(t, request.MarkCanceled("user-1", scheduled))
+	})
+
+	t.Run("retirada", func(t *testing.T) {
+		request := &ErasureRequest{UserID: "user-1", Status: ErasureStatusPending, ScheduledFor: scheduled}
+		require.NoError(t, request.MarkCanceled("user-1", scheduled))
+		assert.Equal(t, ErasureStatusCanceled, request.Status)
+		assert.NotNil(t, request.CanceledAt)
+		assert.Error(t, request.MarkCompleted("system", scheduled))
+	})
+}
+
+// TestErasureContext tests para el marcado de cambios de supresión
+func TestErasureContext(t *testing.T) {
+	assert.False(t, IsErasureContext(context.Background()))
+	assert.True(t, IsErasureContext(ContextWithErasure(context.Background())))
+}
@@ -0,0 +1,214 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// DiscountType define cómo se aplica un código de descuento
+type DiscountType string
+
+const (
+	DiscountTypePercentage DiscountType = "percentage" // Porcentaje en puntos básicos (1500 = 15%)
+	DiscountTypeFixed      DiscountType = "fixed"      // Importe fijo en céntimos
+)
+
+// basisPointsPerUnit puntos básicos que equivalen al 100%
+const basisPointsPerUnit = 10000
+
+// promoCodePattern formato permitido para los códigos
+var promoCodePattern = regexp.MustCompile(`^[A-Z0-9][A-Z0-9_-]{2,49}$`)
+
+// PromoCode código de descuento de un evento
+type PromoCode struct {
+	BaseModel
+
+	// Evento al que pertenece (el código es único dentro del evento)
+	EventID string `json:"event_id" gorm:"not null;size:36;uniqueIndex:idx_promo_event_code"`
+	Code    string `json:"code" gorm:"not null;size:50;uniqueIndex:idx_promo_event_code"`
+
+	// Descuento
+	DiscountType  DiscountType `json:"discount_type" gorm:"not null;size:20"`
+	DiscountValue int          `json:"discount_value" gorm:"not null"` // Puntos básicos o céntimos según el tipo
+
+	// Límites de uso (MaxUses nil = ilimitado)
+	MaxUses   *int       `json:"max_uses"`
+	UsedCount int        `json:"used_count" gorm:"not null;default:0"`
+	StartsAt  *time.Time `json:"starts_at"`
+	ExpiresAt *time.Time `json:"expires_at"`
+
+	// Tipos de entrada a los que aplica (vacío = todos)
+	TicketTypeIDs datatypes.JSON `json:"ticket_type_ids" gorm:"type:jsonb"`
+
+	IsActive bool `json:"is_active" gorm:"not null;default:true"`
+
+	// Relaciones
+	Event *Event `json:"event,omitempty" gorm:"foreignKey:EventID;references:ID"`
+}
+
+// TableName especifica el nombre de tabla
+func (PromoCode) TableName() string {
+	return "promo_codes"
+}
+
+// BeforeCreate hook de GORM para validación
+func (p *PromoCode) BeforeCreate(tx *gorm.DB) error {
+	if err := p.BaseModel.BeforeCreate(tx); err != nil {
+		return err
+	}
+
+	p.Code = NormalizePromoCode(p.Code)
+	return p.ValidatePromoCode()
+}
+
+// BeforeUpdate hook de GORM para validación
+func (p *PromoCode) BeforeUpdate(tx *gorm.DB) error {
+	if err := p.BaseModel.BeforeUpdate(tx); err != nil {
+		return err
+	}
+
+	p.Code = NormalizePromoCode(p.Code)
+	return p.ValidatePromoCode()
+}
+
+// ValidatePromoCode valida los datos del código
+func (p *PromoCode) ValidatePromoCode() error {
+	if strings.TrimSpace(p.EventID) == "" {
+		return errors.New("event ID is required")
+	}
+
+	if !promoCodePattern.MatchString(p.Code) {
+		return errors.New("promo code must be 3-50 characters: letters, digits, '-' or '_'")
+	}
+
+	switch p.DiscountType {
+	case DiscountTypePercentage:
+		if p.DiscountValue <= 0 || p.DiscountValue > basisPointsPerUnit {
+			return errors.New("percentage discount must be between 0.01% and 100%")
+		}
+	case DiscountTypeFixed:
+		if p.DiscountValue <= 0 {
+			return errors.New("fixed discount must be positive")
+		}
+	default:
+		return errors.New("invalid discount type")
+	}
+
+	if p.MaxUses != nil && *p.MaxUses < 1 {
+		return errors.New("max uses must be at least 1")
+	}
+
+	if p.StartsAt != nil && p.ExpiresAt != nil && !p.ExpiresAt.After(*p.StartsAt) {
+		return errors.New("expiry must be after start")
+	}
+
+	return nil
+}
+
+// NormalizePromoCode normaliza un código para compararlo (mayúsculas, sin espacios)
+func NormalizePromoCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// CheckUsable verifica que el código puede usarse en un momento dado
+func (p *PromoCode) CheckUsable(now time.Time) error {
+	if !p.IsActive {
+		return errors.New("promo code is not active")
+	}
+
+	if p.StartsAt != nil && now.Before(*p.StartsAt) {
+		return errors.New("promo code is not valid yet")
+	}
+
+	if p.ExpiresAt != nil && now.After(*p.ExpiresAt) {
+		return errors.New("promo code has expired")
+	}
+
+	if p.MaxUses != nil && p.UsedCount >= *p.MaxUses {
+		return errors.New("promo code has reached its maximum uses")
+	}
+
+	return nil
+}
+
+// GetTicketTypeIDs obtiene los tipos de entrada a los que aplica
+func (p *PromoCode) GetTicketTypeIDs() []string {
+	var ids []string
+	if err := json.Unmarshal(p.TicketTypeIDs, &ids); err != nil {
+		return []string{}
+	}
+	return ids
+}
+
+// SetTicketTypeIDs establece los tipos de entrada a los que aplica
+func (p *PromoCode) SetTicketTypeIDs(ids []string) error {
+	if ids == nil {
+		ids = []string{}
+	}
+
+	data, err := json.Marshal(ids)
+	if err != nil {
+		return err
+	}
+	p.TicketTypeIDs = datatypes.JSON(data)
+	return nil
+}
+
+// AppliesTo verifica si el código aplica a un tipo de entrada
+func (p *PromoCode) AppliesTo(ticketTypeID string) bool {
+	ids := p.GetTicketTypeIDs()
+	if len(ids) == 0 {
+		return true
+	}
+
+	for _, id := range ids {
+		if id == ticketTypeID {
+			return true
+		}
+	}
+	return false
+}
+
+// DiscountFor calcula el descuento en céntimos sobre un precio.
+// Los porcentajes se redondean al céntimo más cercano (mitades hacia arriba)
+// y el descuento nunca supera el precio.
+func (p *PromoCode) DiscountFor(priceCents int) int {
+	if priceCents <= 0 {
+		return 0
+	}
+
+	var discount int
+	switch p.DiscountType {
+	case DiscountTypePercentage:
+		discount = int((int64(priceCents)*int64(p.DiscountValue) + basisPointsPerUnit/2) / basisPointsPerUnit)
+	case DiscountTypeFixed:
+		discount = p.DiscountValue
+	}
+
+	if discount > priceCents {
+		discount = priceCents
+	}
+	return discount
+}
+
+// GetAuditData implementa AuditableModel
+func (p *PromoCode) GetAuditData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":             p.ID,
+		"event_id":       p.EventID,
+		"code":           p.Code,
+		"discount_type":  p.DiscountType,
+		"discount_value": p.DiscountValue,
+		"max_uses":       p.MaxUses,
+	}
+}
+
+func (p PromoCode) GetID() string           { return p.ID.String() }
+func (p PromoCode) GetCreatedAt() time.Time { return p.CreatedAt }
+func (p PromoCode) GetUpdatedAt() time.Time { return p.UpdatedAt }
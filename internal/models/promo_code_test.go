@@ -0,0 +1,108 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPromoCode_Validate tests unitarios para validación
+func TestPromoCode_Validate(t *testing.T) {
+	zero := 0
+
+	newPromo := func() *PromoCode {
+		return &PromoCode{
+			EventID:       uuid.New().String(),
+			Code:          "EARLY-2026",
+			DiscountType:  DiscountTypePercentage,
+			DiscountValue: 1500,
+		}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*PromoCode)
+		errMsg string
+	}{
+		{name: "porcentaje válido", modify: func(p *PromoCode) {}},
+		{name: "importe fijo válido", modify: func(p *PromoCode) { p.DiscountType, p.DiscountValue = DiscountTypeFixed, 500 }},
+		{name: "sin evento", modify: func(p *PromoCode) { p.EventID = "" }, errMsg: "event ID is required"},
+		{name: "código demasiado corto", modify: func(p *PromoCode) { p.Code = "AB" }, errMsg: "promo code must be"},
+		{name: "código con espacios", modify: func(p *PromoCode) { p.Code = "EARLY BIRD" }, errMsg: "promo code must be"},
+		{name: "porcentaje mayor que 100", modify: func(p *PromoCode) { p.DiscountValue = 10001 }, errMsg: "percentage discount must be"},
+		{name: "importe fijo nulo", modify: func(p *PromoCode) { p.DiscountType, p.DiscountValue = DiscountTypeFixed, 0 }, errMsg: "fixed discount must be positive"},
+		{name: "tipo desconocido", modify: func(p *PromoCode) { p.DiscountType = "bogo" }, errMsg: "invalid discount type"},
+		{name: "usos máximos a cero", modify: func(p *PromoCode) { p.MaxUses = &zero }, errMsg: "max uses must be at least 1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			promo := newPromo()
+			tt.modify(promo)
+
+			err := promo.ValidatePromoCode()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+// TestPromoCode_DiscountFor tests para el cálculo y redondeo del descuento
+func TestPromoCode_DiscountFor(t *testing.T) {
+	tests := []struct {
+		name     string
+		promo    PromoCode
+		price    int
+		expected int
+	}{
+		{name: "15% exacto", promo: PromoCode{DiscountType: DiscountTypePercentage, DiscountValue: 1500}, price: 10000, expected: 1500},
+		{name: "redondeo hacia abajo", promo: PromoCode{DiscountType: DiscountTypePercentage, DiscountValue: 1500}, price: 999, expected: 150},
+		{name: "medio céntimo redondea hacia arriba", promo: PromoCode{DiscountType: DiscountTypePercentage, DiscountValue: 5000}, price: 1, expected: 1},
+		{name: "12,5% con fracción", promo: PromoCode{DiscountType: DiscountTypePercentage, DiscountValue: 1250}, price: 1999, expected: 250},
+		{name: "100%", promo: PromoCode{DiscountType: DiscountTypePercentage, DiscountValue: 10000}, price: 4999, expected: 4999},
+		{name: "importe fijo", promo: PromoCode{DiscountType: DiscountTypeFixed, DiscountValue: 500}, price: 2500, expected: 500},
+		{name: "importe fijo limitado al precio", promo: PromoCode{DiscountType: DiscountTypeFixed, DiscountValue: 5000}, price: 2500, expected: 2500},
+		{name: "entrada gratuita", promo: PromoCode{DiscountType: DiscountTypeFixed, DiscountValue: 500}, price: 0, expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.promo.DiscountFor(tt.price))
+		})
+	}
+}
+
+// TestPromoCode_CheckUsable tests para la validez temporal y los usos
+func TestPromoCode_CheckUsable(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	past, future := now.Add(-time.Hour), now.Add(time.Hour)
+	maxUses := 3
+
+	assert.NoError(t, (&PromoCode{IsActive: true}).CheckUsable(now))
+	assert.Error(t, (&PromoCode{IsActive: false}).CheckUsable(now))
+	assert.Error(t, (&PromoCode{IsActive: true, StartsAt: &future}).CheckUsable(now))
+	assert.Error(t, (&PromoCode{IsActive: true, ExpiresAt: &past}).CheckUsable(now))
+	assert.NoError(t, (&PromoCode{IsActive: true, MaxUses: &maxUses, UsedCount: 2}).CheckUsable(now))
+	assert.Error(t, (&PromoCode{IsActive: true, MaxUses: &maxUses, UsedCount: 3}).CheckUsable(now))
+}
+
+// TestPromoCode_AppliesTo tests para la restricción por tipo de entrada
+func TestPromoCode_AppliesTo(t *testing.T) {
+	vip, student := uuid.New().String(), uuid.New().String()
+
+	promo := &PromoCode{}
+	assert.True(t, promo.AppliesTo(vip))
+
+	require.NoError(t, promo.SetTicketTypeIDs([]string{vip}))
+	assert.True(t, promo.AppliesTo(vip))
+	assert.False(t, promo.AppliesTo(student))
+
+	assert.Equal(t, "EARLY", NormalizePromoCode("  early "))
+}
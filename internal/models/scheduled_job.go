@@ -0,0 +1,116 @@
+package models
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// JobOutcome define el resultado de la última ejecución de una tarea
+type JobOutcome string
+
+const (
+	JobOutcomeRunning JobOutcome = "running" // En curso
+	JobOutcomeSuccess JobOutcome = "success" // Terminó correctamente
+	JobOutcomeFailed  JobOutcome = "failed"  // Terminó con error
+)
+
+// maxJobResultLength longitud máxima guardada del resumen y del error
+const maxJobResultLength = 1000
+
+// ScheduledJob tarea programada con su planificación y su última ejecución.
+// Cada tarea tiene una fila compartida por todas las instancias
+type ScheduledJob struct {
+	BaseModel
+
+	// Identificación y planificación
+	Name      string     `json:"name" gorm:"not null;size:100;uniqueIndex"`
+	Schedule  string     `json:"schedule" gorm:"not null;size:100"`
+	NextRunAt *time.Time `json:"next_run_at"`
+
+	// Última ejecución
+	LastStartedAt  *time.Time `json:"last_started_at"`
+	LastFinishedAt *time.Time `json:"last_finished_at"`
+	LastOutcome    JobOutcome `json:"last_outcome,omitempty" gorm:"size:20;index"`
+	LastResult     string     `json:"last_result,omitempty" gorm:"size:1000"`
+	LastError      string     `json:"last_error,omitempty" gorm:"size:1000"`
+	LastDurationMs int64      `json:"last_duration_ms" gorm:"not null;default:0"`
+
+	// Contadores
+	RunCount     int64 `json:"run_count" gorm:"not null;default:0"`
+	FailureCount int64 `json:"failure_count" gorm:"not null;default:0"`
+}
+
+// TableName especifica el nombre de tabla
+func (ScheduledJob) TableName() string {
+	return "scheduled_jobs"
+}
+
+// BeforeCreate hook de GORM para validación
+func (j *ScheduledJob) BeforeCreate(tx *gorm.DB) error {
+	if err := j.BaseModel.BeforeCreate(tx); err != nil {
+		return err
+	}
+
+	return j.ValidateScheduledJob()
+}
+
+// ValidateScheduledJob valida los datos de la tarea
+func (j *ScheduledJob) ValidateScheduledJob() error {
+	if strings.TrimSpace(j.Name) == "" {
+		return errors.New("job name is required")
+	}
+
+	if strings.TrimSpace(j.Schedule) == "" {
+		return errors.New("job schedule is required")
+	}
+
+	return nil
+}
+
+// IsRunning verifica si la última ejecución sigue en curso
+func (j *ScheduledJob) IsRunning() bool {
+	return j.LastOutcome == JobOutcomeRunning
+}
+
+// MarkStarted registra el inicio de una ejecución
+func (j *ScheduledJob) MarkStarted(at time.Time, next *time.Time) {
+	j.LastStartedAt = &at
+	j.LastFinishedAt = nil
+	j.LastOutcome = JobOutcomeRunning
+	j.NextRunAt = next
+}
+
+// MarkFinished registra el final de una ejecución con su resumen o su error
+func (j *ScheduledJob) MarkFinished(outcome JobOutcome, result, errMsg string, startedAt, finishedAt time.Time) error {
+	if outcome != JobOutcomeSuccess && outcome != JobOutcomeFailed {
+		return errors.New("invalid job outcome")
+	}
+
+	j.LastStartedAt = &startedAt
+	j.LastFinishedAt = &finishedAt
+	j.LastOutcome = outcome
+	j.LastResult = truncateJobText(result)
+	j.LastError = truncateJobText(errMsg)
+	j.LastDurationMs = finishedAt.Sub(startedAt).Milliseconds()
+	j.RunCount++
+	if outcome == JobOutcomeFailed {
+		j.FailureCount++
+	}
+	return nil
+}
+
+// truncateJobText recorta el texto a la longitud de la columna
+func truncateJobText(text string) string {
+	text = strings.TrimSpace(text)
+	if runes := []rune(text); len(runes) > maxJobResultLength {
+		return string(runes[:maxJobResultLength-1]) + "…"
+	}
+	return text
+}
+
+func (j ScheduledJob) GetID() string           { return j.ID.String() }
+func (j ScheduledJob) GetCreatedAt() time.Time { return j.CreatedAt }
+func (j ScheduledJob) GetUpdatedAt() time.Time { return j.UpdatedAt }
@@ -0,0 +1,95 @@
+package models
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestScheduledJob_ValidateScheduledJob tests para validación de tareas
+func TestScheduledJob_ValidateScheduledJob(t *testing.T) {
+	tests := []struct {
+		name    string
+		job     ScheduledJob
+		wantErr string
+	}{
+		{
+			name: "tarea válida",
+			job:  ScheduledJob{Name: "token_cleanup", Schedule: "15 * * * *"},
+		},
+		{
+			name:    "sin nombre",
+			job:     ScheduledJob{Name: "  ", Schedule: "@daily"},
+			wantErr: "job name is required",
+		},
+		{
+			name:    "sin planificación",
+			job:     ScheduledJob{Name: "token_cleanup"},
+			wantErr: "job schedule is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.job.ValidateScheduledJob()
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// TestScheduledJob_Runs tests para el registro de ejecuciones
+func TestScheduledJob_Runs(t *testing.T) {
+	startedAt := time.Date(2026, 10, 18, 3, 0, 0, 0, time.UTC)
+	finishedAt := startedAt.Add(1500 * time.Millisecond)
+	next := startedAt.Add(24 * time.Hour)
+
+	t.Run("inicio y fin correcto", func(t *testing.T) {
+		job := ScheduledJob{Name: "soft_delete_purge", Schedule: "0 4 * * *"}
+
+		job.MarkStarted(startedAt, &next)
+		assert.True(t, job.IsRunning())
+		assert.Equal(t, next, *job.NextRunAt)
+		assert.Nil(t, job.LastFinishedAt)
+
+		require.NoError(t, job.MarkFinished(JobOutcomeSuccess, " events 3 ", "", startedAt, finishedAt))
+		assert.False(t, job.IsRunning())
+		assert.Equal(t, "events 3", job.LastResult)
+		assert.Equal(t, int64(1500), job.LastDurationMs)
+		assert.Equal(t, int64(1), job.RunCount)
+		assert.Zero(t, job.FailureCount)
+	})
+
+	t.Run("fallo cuenta como ejecución", func(t *testing.T) {
+		job := ScheduledJob{Name: "audit_log_retention", Schedule: "30 3 * * *", RunCount: 4, FailureCount: 1}
+
+		require.NoError(t, job.MarkFinished(JobOutcomeFailed, "", "sin conexión", startedAt, finishedAt))
+		assert.Equal(t, JobOutcomeFailed, job.LastOutcome)
+		assert.Equal(t, "sin conexión", job.LastError)
+		assert.Equal(t, int64(5), job.RunCount)
+		assert.Equal(t, int64(2), job.FailureCount)
+	})
+
+	t.Run("resultado inválido", func(t *testing.T) {
+		job := ScheduledJob{Name: "token_cleanup", Schedule: "@hourly"}
+		assert.EqualError(t, job.MarkFinished(JobOutcomeRunning, "", "", startedAt, finishedAt), "invalid job outcome")
+		assert.Zero(t, job.RunCount)
+	})
+
+	t.Run("texto largo recortado", func(t *testing.T) {
+		job := ScheduledJob{Name: "soft_delete_purge", Schedule: "0 4 * * *"}
+		long := strings.Repeat("é", maxJobResultLength+50)
+
+		require.NoError(t, job.MarkFinished(JobOutcomeFailed, long, long, startedAt, finishedAt))
+		assert.Equal(t, maxJobResultLength, utf8.RuneCountInString(job.LastResult))
+		assert.Equal(t, maxJobResultLength, utf8.RuneCountInString(job.LastError))
+		assert.True(t, strings.HasSuffix(job.LastError, "…"))
+	})
+}
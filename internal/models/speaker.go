@@ -0,0 +1,110 @@
+package models
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Speaker perfil reutilizable de ponente, opcionalmente vinculado a un usuario
+type Speaker struct {
+	BaseModel
+
+	// Organización que gestiona el perfil
+	OrganizationID string `json:"organization_id" gorm:"not null;size:36;index"`
+
+	// Usuario de la plataforma vinculado (opcional)
+	UserID *string `json:"user_id,omitempty" gorm:"size:36;index"`
+
+	// Información pública
+	Name     string `json:"name" gorm:"not null;size:200;index"`
+	Position string `json:"position" gorm:"size:200"` // Cargo
+	Company  string `json:"company" gorm:"size:200"`
+	Bio      string `json:"bio" gorm:"type:text"`
+	PhotoURL string `json:"photo_url" gorm:"size:500"`
+
+	// Enlaces
+	Website  string `json:"website" gorm:"size:255"`
+	LinkedIn string `json:"linkedin" gorm:"size:255"`
+	Twitter  string `json:"twitter" gorm:"size:255"`
+	GitHub   string `json:"github" gorm:"size:255"`
+
+	// Contacto interno (no se publica)
+	Email string `json:"email,omitempty" gorm:"size:255"`
+
+	// Relaciones
+	User     *User          `json:"user,omitempty" gorm:"foreignKey:UserID;references:ID"`
+	Sessions []EventSession `json:"sessions,omitempty" gorm:"many2many:event_session_speakers;"`
+}
+
+// TableName especifica el nombre de tabla
+func (Speaker) TableName() string {
+	return "speakers"
+}
+
+// BeforeCreate hook de GORM para validación
+func (s *Speaker) BeforeCreate(tx *gorm.DB) error {
+	if err := s.BaseModel.BeforeCreate(tx); err != nil {
+		return err
+	}
+
+	s.normalizeFields()
+	return s.ValidateSpeaker()
+}
+
+// BeforeUpdate hook de GORM para validación
+func (s *Speaker) BeforeUpdate(tx *gorm.DB) error {
+	if err := s.BaseModel.BeforeUpdate(tx); err != nil {
+		return err
+	}
+
+	s.normalizeFields()
+	return s.ValidateSpeaker()
+}
+
+// ValidateSpeaker valida los datos del ponente
+func (s *Speaker) ValidateSpeaker() error {
+	if strings.TrimSpace(s.OrganizationID) == "" {
+		return errors.New("organization ID is required")
+	}
+
+	if strings.TrimSpace(s.Name) == "" {
+		return errors.New("speaker name is required")
+	}
+
+	if len(s.Name) < 2 {
+		return errors.New("speaker name must be at least 2 characters")
+	}
+
+	return nil
+}
+
+// normalizeFields normaliza campos de texto
+func (s *Speaker) normalizeFields() {
+	s.Name = strings.TrimSpace(s.Name)
+	s.Position = strings.TrimSpace(s.Position)
+	s.Company = strings.TrimSpace(s.Company)
+	s.Bio = strings.TrimSpace(s.Bio)
+	s.Email = strings.ToLower(strings.TrimSpace(s.Email))
+}
+
+// IsLinkedToUser verifica si el perfil está vinculado a un usuario
+func (s *Speaker) IsLinkedToUser() bool {
+	return s.UserID != nil && *s.UserID != ""
+}
+
+// GetAuditData implementa AuditableModel
+func (s *Speaker) GetAuditData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":              s.ID,
+		"organization_id": s.OrganizationID,
+		"user_id":         s.UserID,
+		"name":            s.Name,
+	}
+}
+
+func (s Speaker) GetID() string           { return s.ID.String() }
+func (s Speaker) GetCreatedAt() time.Time { return s.CreatedAt }
+func (s Speaker) GetUpdatedAt() time.Time { return s.UpdatedAt }
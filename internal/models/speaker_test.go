@@ -0,0 +1,103 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// createTestSpeaker crea un ponente válido para testing
+func createTestSpeaker() *Speaker {
+	return &Speaker{
+		OrganizationID: uuid.New().String(),
+		Name:           "Ana Martínez",
+		Position:       "Red Team Lead",
+		Company:        "Securitas Labs",
+		Bio:            "Especialista en seguridad ofensiva",
+	}
+}
+
+// TestSpeaker_ValidateSpeaker tests unitarios para validación
+func TestSpeaker_ValidateSpeaker(t *testing.T) {
+	tests := []struct {
+		name    string
+		speaker *Speaker
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:    "ponente válido",
+			speaker: createTestSpeaker(),
+			wantErr: false,
+		},
+		{
+			name: "sin organización",
+			speaker: func() *Speaker {
+				s := createTestSpeaker()
+				s.OrganizationID = ""
+				return s
+			}(),
+			wantErr: true,
+			errMsg:  "organization ID is required",
+		},
+		{
+			name: "sin nombre",
+			speaker: func() *Speaker {
+				s := createTestSpeaker()
+				s.Name = "   "
+				return s
+			}(),
+			wantErr: true,
+			errMsg:  "speaker name is required",
+		},
+		{
+			name: "nombre demasiado corto",
+			speaker: func() *Speaker {
+				s := createTestSpeaker()
+				s.Name = "A"
+				return s
+			}(),
+			wantErr: true,
+			errMsg:  "speaker name must be at least 2 characters",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.speaker.ValidateSpeaker()
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Equal(t, tt.errMsg, err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// TestSpeaker_normalizeFields tests para normalización
+func TestSpeaker_normalizeFields(t *testing.T) {
+	speaker := createTestSpeaker()
+	speaker.Name = "  Ana Martínez  "
+	speaker.Email = "  Ana@Example.COM "
+
+	speaker.normalizeFields()
+
+	assert.Equal(t, "Ana Martínez", speaker.Name)
+	assert.Equal(t, "ana@example.com", speaker.Email)
+}
+
+// TestSpeaker_IsLinkedToUser tests para vinculación con usuarios
+func TestSpeaker_IsLinkedToUser(t *testing.T) {
+	speaker := createTestSpeaker()
+	assert.False(t, speaker.IsLinkedToUser())
+
+	empty := ""
+	speaker.UserID = &empty
+	assert.False(t, speaker.IsLinkedToUser())
+
+	userID := uuid.New().String()
+	speaker.UserID = &userID
+	assert.True(t, speaker.IsLinkedToUser())
+}
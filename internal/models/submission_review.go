@@ -0,0 +1,107 @@
+package models
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// MinReviewScore puntuación mínima de una revisión
+	MinReviewScore = 1
+
+	// MaxReviewScore puntuación máxima de una revisión
+	MaxReviewScore = 5
+)
+
+// SubmissionReview asignación de un revisor a una propuesta y su puntuación (revisión ciega)
+type SubmissionReview struct {
+	BaseModel
+
+	// Propuesta y revisor (un revisor solo puntúa una vez cada propuesta)
+	SubmissionID string `json:"submission_id" gorm:"not null;size:36;uniqueIndex:idx_submission_reviewer"`
+	ReviewerID   string `json:"reviewer_id" gorm:"not null;size:36;uniqueIndex:idx_submission_reviewer;index"`
+
+	// Valoración (nil hasta que el revisor puntúa)
+	Score      *int       `json:"score"`
+	Comment    string     `json:"comment" gorm:"type:text"`
+	ReviewedAt *time.Time `json:"reviewed_at"`
+
+	// Relaciones
+	Submission *TalkSubmission `json:"submission,omitempty" gorm:"foreignKey:SubmissionID;references:ID"`
+	Reviewer   *User           `json:"reviewer,omitempty" gorm:"foreignKey:ReviewerID;references:ID"`
+}
+
+// TableName especifica el nombre de tabla
+func (SubmissionReview) TableName() string {
+	return "submission_reviews"
+}
+
+// BeforeCreate hook de GORM para validación
+func (r *SubmissionReview) BeforeCreate(tx *gorm.DB) error {
+	if err := r.BaseModel.BeforeCreate(tx); err != nil {
+		return err
+	}
+
+	return r.ValidateSubmissionReview()
+}
+
+// BeforeUpdate hook de GORM para validación
+func (r *SubmissionReview) BeforeUpdate(tx *gorm.DB) error {
+	if err := r.BaseModel.BeforeUpdate(tx); err != nil {
+		return err
+	}
+
+	return r.ValidateSubmissionReview()
+}
+
+// ValidateSubmissionReview valida los datos de la revisión
+func (r *SubmissionReview) ValidateSubmissionReview() error {
+	if strings.TrimSpace(r.SubmissionID) == "" {
+		return errors.New("submission ID is required")
+	}
+
+	if strings.TrimSpace(r.ReviewerID) == "" {
+		return errors.New("reviewer ID is required")
+	}
+
+	if r.Score != nil && (*r.Score < MinReviewScore || *r.Score > MaxReviewScore) {
+		return errors.New("review score must be between 1 and 5")
+	}
+
+	return nil
+}
+
+// IsCompleted verifica si el revisor ya ha puntuado
+func (r *SubmissionReview) IsCompleted() bool {
+	return r.Score != nil
+}
+
+// Rate registra la puntuación del revisor
+func (r *SubmissionReview) Rate(score int, comment string) error {
+	if score < MinReviewScore || score > MaxReviewScore {
+		return errors.New("review score must be between 1 and 5")
+	}
+
+	now := time.Now()
+	r.Score = &score
+	r.Comment = strings.TrimSpace(comment)
+	r.ReviewedAt = &now
+	return nil
+}
+
+// GetAuditData implementa AuditableModel
+func (r *SubmissionReview) GetAuditData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":            r.ID,
+		"submission_id": r.SubmissionID,
+		"reviewer_id":   r.ReviewerID,
+		"score":         r.Score,
+	}
+}
+
+func (r SubmissionReview) GetID() string           { return r.ID.String() }
+func (r SubmissionReview) GetCreatedAt() time.Time { return r.CreatedAt }
+func (r SubmissionReview) GetUpdatedAt() time.Time { return r.UpdatedAt }
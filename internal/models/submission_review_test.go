@@ -0,0 +1,71 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSubmissionReview_Validate tests unitarios para validación
+func TestSubmissionReview_Validate(t *testing.T) {
+	valid, tooHigh := 3, 6
+
+	tests := []struct {
+		name   string
+		review *SubmissionReview
+		errMsg string
+	}{
+		{
+			name:   "asignación sin puntuar",
+			review: &SubmissionReview{SubmissionID: uuid.New().String(), ReviewerID: uuid.New().String()},
+		},
+		{
+			name:   "revisión puntuada",
+			review: &SubmissionReview{SubmissionID: uuid.New().String(), ReviewerID: uuid.New().String(), Score: &valid},
+		},
+		{
+			name:   "sin propuesta",
+			review: &SubmissionReview{ReviewerID: uuid.New().String()},
+			errMsg: "submission ID is required",
+		},
+		{
+			name:   "sin revisor",
+			review: &SubmissionReview{SubmissionID: uuid.New().String()},
+			errMsg: "reviewer ID is required",
+		},
+		{
+			name:   "puntuación fuera de rango",
+			review: &SubmissionReview{SubmissionID: uuid.New().String(), ReviewerID: uuid.New().String(), Score: &tooHigh},
+			errMsg: "review score must be between 1 and 5",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.review.ValidateSubmissionReview()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+// TestSubmissionReview_Rate tests para la puntuación
+func TestSubmissionReview_Rate(t *testing.T) {
+	review := &SubmissionReview{SubmissionID: uuid.New().String(), ReviewerID: uuid.New().String()}
+	assert.False(t, review.IsCompleted())
+
+	assert.Error(t, review.Rate(0, ""))
+	assert.False(t, review.IsCompleted())
+
+	require.NoError(t, review.Rate(4, "  Buen enfoque práctico  "))
+	assert.True(t, review.IsCompleted())
+	assert.Equal(t, 4, *review.Score)
+	assert.Equal(t, "Buen enfoque práctico", review.Comment)
+	assert.NotNil(t, review.ReviewedAt)
+}
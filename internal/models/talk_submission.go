@@ -0,0 +1,227 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// SubmissionStatus define los estados de una propuesta del CFP
+type SubmissionStatus string
+
+const (
+	SubmissionStatusSubmitted  SubmissionStatus = "submitted"  // Pendiente de decisión
+	SubmissionStatusAccepted   SubmissionStatus = "accepted"   // Aceptada (genera sesión y ponente)
+	SubmissionStatusRejected   SubmissionStatus = "rejected"   // Rechazada
+	SubmissionStatusWaitlisted SubmissionStatus = "waitlisted" // En lista de espera
+)
+
+// TalkSubmission propuesta de charla enviada al call for papers de un evento
+type TalkSubmission struct {
+	BaseModel
+
+	// Evento y autor
+	EventID     string `json:"event_id" gorm:"not null;size:36;index"`
+	SubmitterID string `json:"submitter_id" gorm:"not null;size:36;index"`
+
+	// Contenido de la propuesta
+	Title           string         `json:"title" gorm:"not null;size:300"`
+	Abstract        string         `json:"abstract" gorm:"type:text;not null"`
+	Notes           string         `json:"notes" gorm:"type:text"` // Notas privadas para la organización
+	Level           SessionLevel   `json:"level" gorm:"size:20"`
+	Track           string         `json:"track" gorm:"size:100"`
+	DurationMinutes int            `json:"duration_minutes" gorm:"not null;default:45"`
+	Tags            datatypes.JSON `json:"tags" gorm:"type:jsonb"`
+
+	// Datos del ponente (se usan al crear su perfil si se acepta)
+	SpeakerName     string `json:"speaker_name" gorm:"not null;size:200"`
+	SpeakerBio      string `json:"speaker_bio" gorm:"type:text"`
+	SpeakerCompany  string `json:"speaker_company" gorm:"size:200"`
+	SpeakerPosition string `json:"speaker_position" gorm:"size:200"`
+
+	// Decisión
+	Status       SubmissionStatus `json:"status" gorm:"not null;default:'submitted';size:20;index"`
+	DecisionNote string           `json:"decision_note" gorm:"type:text"`
+	DecidedAt    *time.Time       `json:"decided_at"`
+	DecidedBy    *string          `json:"decided_by" gorm:"size:36"`
+
+	// Resultado de la aceptación
+	SessionID *string `json:"session_id" gorm:"size:36"`
+	SpeakerID *string `json:"speaker_id" gorm:"size:36"`
+
+	// Relaciones
+	Event     *Event             `json:"event,omitempty" gorm:"foreignKey:EventID;references:ID"`
+	Submitter *User              `json:"submitter,omitempty" gorm:"foreignKey:SubmitterID;references:ID"`
+	Reviews   []SubmissionReview `json:"reviews,omitempty" gorm:"foreignKey:SubmissionID"`
+}
+
+// TableName especifica el nombre de tabla
+func (TalkSubmission) TableName() string {
+	return "talk_submissions"
+}
+
+// BeforeCreate hook de GORM para validación
+func (s *TalkSubmission) BeforeCreate(tx *gorm.DB) error {
+	if err := s.BaseModel.BeforeCreate(tx); err != nil {
+		return err
+	}
+
+	s.normalizeFields()
+	return s.ValidateTalkSubmission()
+}
+
+// BeforeUpdate hook de GORM para validación
+func (s *TalkSubmission) BeforeUpdate(tx *gorm.DB) error {
+	if err := s.BaseModel.BeforeUpdate(tx); err != nil {
+		return err
+	}
+
+	s.normalizeFields()
+	return s.ValidateTalkSubmission()
+}
+
+// ValidateTalkSubmission valida los datos de la propuesta
+func (s *TalkSubmission) ValidateTalkSubmission() error {
+	if strings.TrimSpace(s.EventID) == "" {
+		return errors.New("event ID is required")
+	}
+
+	if strings.TrimSpace(s.SubmitterID) == "" {
+		return errors.New("submitter ID is required")
+	}
+
+	if strings.TrimSpace(s.Title) == "" {
+		return errors.New("submission title is required")
+	}
+
+	if strings.TrimSpace(s.Abstract) == "" {
+		return errors.New("submission abstract is required")
+	}
+
+	if strings.TrimSpace(s.SpeakerName) == "" {
+		return errors.New("speaker name is required")
+	}
+
+	if s.DurationMinutes <= 0 {
+		return errors.New("submission duration must be positive")
+	}
+
+	if s.Level != "" && s.Level != SessionLevelBeginner && s.Level != SessionLevelIntermediate &&
+		s.Level != SessionLevelAdvanced {
+		return errors.New("invalid submission level")
+	}
+
+	if !s.IsValidStatus() {
+		return errors.New("invalid submission status")
+	}
+
+	return nil
+}
+
+// IsValidStatus verifica si el estado es válido
+func (s *TalkSubmission) IsValidStatus() bool {
+	return s.Status == SubmissionStatusSubmitted || s.Status == SubmissionStatusAccepted ||
+		s.Status == SubmissionStatusRejected || s.Status == SubmissionStatusWaitlisted
+}
+
+// normalizeFields normaliza campos de texto
+func (s *TalkSubmission) normalizeFields() {
+	s.Title = strings.TrimSpace(s.Title)
+	s.Abstract = strings.TrimSpace(s.Abstract)
+	s.Track = strings.TrimSpace(s.Track)
+	s.SpeakerName = strings.TrimSpace(s.SpeakerName)
+}
+
+// IsPending verifica si la propuesta sigue abierta a decisión (enviada o en lista de espera)
+func (s *TalkSubmission) IsPending() bool {
+	return s.Status == SubmissionStatusSubmitted || s.Status == SubmissionStatusWaitlisted
+}
+
+// CanTransitionTo verifica si la propuesta puede pasar al estado indicado.
+// Las decisiones de aceptación y rechazo son definitivas; la lista de espera no.
+func (s *TalkSubmission) CanTransitionTo(status SubmissionStatus) bool {
+	switch status {
+	case SubmissionStatusAccepted, SubmissionStatusRejected:
+		return s.IsPending()
+	case SubmissionStatusWaitlisted:
+		return s.Status == SubmissionStatusSubmitted
+	default:
+		return false
+	}
+}
+
+// Decide registra la decisión sobre la propuesta
+func (s *TalkSubmission) Decide(status SubmissionStatus, note, decidedBy string) error {
+	if !s.CanTransitionTo(status) {
+		return errors.New("submission cannot transition from " + string(s.Status) + " to " + string(status))
+	}
+
+	now := time.Now()
+	s.Status = status
+	s.DecisionNote = strings.TrimSpace(note)
+	s.DecidedAt = &now
+	s.DecidedBy = &decidedBy
+	return nil
+}
+
+// ScoreSummary devuelve la puntuación media y el número de revisiones completadas
+func (s *TalkSubmission) ScoreSummary() (float64, int) {
+	total, count := 0, 0
+	for _, review := range s.Reviews {
+		if review.IsCompleted() {
+			total += *review.Score
+			count++
+		}
+	}
+
+	if count == 0 {
+		return 0, 0
+	}
+	return float64(total) / float64(count), count
+}
+
+// SetTags establece los tags de la propuesta
+func (s *TalkSubmission) SetTags(tags []string) error {
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag != "" {
+			normalized = append(normalized, tag)
+		}
+	}
+
+	data, err := json.Marshal(normalized)
+	if err != nil {
+		return err
+	}
+	s.Tags = datatypes.JSON(data)
+	return nil
+}
+
+// GetTags obtiene los tags de la propuesta
+func (s *TalkSubmission) GetTags() []string {
+	var tags []string
+	if err := json.Unmarshal(s.Tags, &tags); err != nil {
+		return []string{}
+	}
+	return tags
+}
+
+// GetAuditData implementa AuditableModel
+func (s *TalkSubmission) GetAuditData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":           s.ID,
+		"event_id":     s.EventID,
+		"submitter_id": s.SubmitterID,
+		"title":        s.Title,
+		"status":       s.Status,
+	}
+}
+
+func (s TalkSubmission) GetID() string           { return s.ID.String() }
+func (s TalkSubmission) GetCreatedAt() time.Time { return s.CreatedAt }
+func (s TalkSubmission) GetUpdatedAt() time.Time { return s.UpdatedAt }
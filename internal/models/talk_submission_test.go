@@ -0,0 +1,127 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createTestTalkSubmission crea una propuesta válida para testing
+func createTestTalkSubmission() *TalkSubmission {
+	return &TalkSubmission{
+		EventID:         uuid.New().String(),
+		SubmitterID:     uuid.New().String(),
+		Title:           "Detección de movimiento lateral con Sysmon",
+		Abstract:        "Cómo construir reglas de detección a partir de eventos de Sysmon",
+		Level:           SessionLevelIntermediate,
+		DurationMinutes: 45,
+		SpeakerName:     "Laura Gómez",
+		Status:          SubmissionStatusSubmitted,
+	}
+}
+
+// TestTalkSubmission_ValidateTalkSubmission tests unitarios para validación
+func TestTalkSubmission_ValidateTalkSubmission(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(s *TalkSubmission)
+		errMsg string
+	}{
+		{name: "propuesta válida", modify: func(s *TalkSubmission) {}},
+		{name: "sin evento", modify: func(s *TalkSubmission) { s.EventID = "" }, errMsg: "event ID is required"},
+		{name: "sin autor", modify: func(s *TalkSubmission) { s.SubmitterID = "" }, errMsg: "submitter ID is required"},
+		{name: "sin título", modify: func(s *TalkSubmission) { s.Title = " " }, errMsg: "submission title is required"},
+		{name: "sin resumen", modify: func(s *TalkSubmission) { s.Abstract = "" }, errMsg: "submission abstract is required"},
+		{name: "sin ponente", modify: func(s *TalkSubmission) { s.SpeakerName = "" }, errMsg: "speaker name is required"},
+		{name: "duración inválida", modify: func(s *TalkSubmission) { s.DurationMinutes = 0 }, errMsg: "submission duration must be positive"},
+		{name: "nivel inválido", modify: func(s *TalkSubmission) { s.Level = "expert" }, errMsg: "invalid submission level"},
+		{name: "estado inválido", modify: func(s *TalkSubmission) { s.Status = "draft" }, errMsg: "invalid submission status"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			submission := createTestTalkSubmission()
+			tt.modify(submission)
+
+			err := submission.ValidateTalkSubmission()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+// TestTalkSubmission_Transitions tests para el flujo de decisión
+func TestTalkSubmission_Transitions(t *testing.T) {
+	tests := []struct {
+		name     string
+		from     SubmissionStatus
+		to       SubmissionStatus
+		expected bool
+	}{
+		{name: "enviada a aceptada", from: SubmissionStatusSubmitted, to: SubmissionStatusAccepted, expected: true},
+		{name: "enviada a rechazada", from: SubmissionStatusSubmitted, to: SubmissionStatusRejected, expected: true},
+		{name: "enviada a lista de espera", from: SubmissionStatusSubmitted, to: SubmissionStatusWaitlisted, expected: true},
+		{name: "lista de espera a aceptada", from: SubmissionStatusWaitlisted, to: SubmissionStatusAccepted, expected: true},
+		{name: "lista de espera a rechazada", from: SubmissionStatusWaitlisted, to: SubmissionStatusRejected, expected: true},
+		{name: "aceptada es definitiva", from: SubmissionStatusAccepted, to: SubmissionStatusRejected, expected: false},
+		{name: "rechazada es definitiva", from: SubmissionStatusRejected, to: SubmissionStatusAccepted, expected: false},
+		{name: "no vuelve a enviada", from: SubmissionStatusWaitlisted, to: SubmissionStatusSubmitted, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			submission := createTestTalkSubmission()
+			submission.Status = tt.from
+			assert.Equal(t, tt.expected, submission.CanTransitionTo(tt.to))
+		})
+	}
+
+	t.Run("decide registra autor y fecha", func(t *testing.T) {
+		submission := createTestTalkSubmission()
+		organizerID := uuid.New().String()
+
+		require.NoError(t, submission.Decide(SubmissionStatusRejected, "  Fuera de temática  ", organizerID))
+		assert.Equal(t, SubmissionStatusRejected, submission.Status)
+		assert.Equal(t, "Fuera de temática", submission.DecisionNote)
+		require.NotNil(t, submission.DecidedAt)
+		require.NotNil(t, submission.DecidedBy)
+		assert.Equal(t, organizerID, *submission.DecidedBy)
+
+		assert.Error(t, submission.Decide(SubmissionStatusAccepted, "", organizerID))
+	})
+}
+
+// TestTalkSubmission_ScoreSummary tests para la puntuación media
+func TestTalkSubmission_ScoreSummary(t *testing.T) {
+	submission := createTestTalkSubmission()
+
+	avg, count := submission.ScoreSummary()
+	assert.Equal(t, 0.0, avg)
+	assert.Equal(t, 0, count)
+
+	four, five := 4, 5
+	submission.Reviews = []SubmissionReview{
+		{Score: &four},
+		{Score: &five},
+		{}, // Revisión asignada sin puntuar
+	}
+
+	avg, count = submission.ScoreSummary()
+	assert.Equal(t, 4.5, avg)
+	assert.Equal(t, 2, count)
+}
+
+// TestTalkSubmission_Tags tests para la gestión de tags
+func TestTalkSubmission_Tags(t *testing.T) {
+	submission := createTestTalkSubmission()
+	assert.Empty(t, submission.GetTags())
+
+	require.NoError(t, submission.SetTags([]string{" DFIR ", "", "Windows"}))
+	assert.Equal(t, []string{"dfir", "windows"}, submission.GetTags())
+}
@@ -0,0 +1,161 @@
+package models
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// TicketType tipo de entrada de un evento (early-bird, estudiante, VIP...)
+// con su precio, cupo y ventana de venta
+type TicketType struct {
+	BaseModel
+
+	// Evento al que pertenece
+	EventID string `json:"event_id" gorm:"not null;size:36;index"`
+
+	// Información
+	Name        string `json:"name" gorm:"not null;size:100"`
+	Description string `json:"description" gorm:"type:text"`
+	SortOrder   int    `json:"sort_order" gorm:"default:0"`
+
+	// Precio en céntimos (0 = gratuita)
+	PriceCents int    `json:"price_cents" gorm:"not null;default:0"`
+	Currency   string `json:"currency" gorm:"size:3;default:'EUR'"`
+
+	// Cupo (nil = ilimitado) y entradas vendidas o reservadas
+	Quantity     *int `json:"quantity"`
+	QuantitySold int  `json:"quantity_sold" gorm:"not null;default:0"`
+
+	// Ventana de venta
+	SalesStartDate *time.Time `json:"sales_start_date"`
+	SalesEndDate   *time.Time `json:"sales_end_date"`
+
+	// Visibilidad
+	IsActive bool `json:"is_active" gorm:"not null;default:true"`
+
+	// Relaciones
+	Event *Event `json:"event,omitempty" gorm:"foreignKey:EventID;references:ID"`
+}
+
+// TableName especifica el nombre de tabla
+func (TicketType) TableName() string {
+	return "ticket_types"
+}
+
+// BeforeCreate hook de GORM para validación
+func (t *TicketType) BeforeCreate(tx *gorm.DB) error {
+	if err := t.BaseModel.BeforeCreate(tx); err != nil {
+		return err
+	}
+
+	t.normalizeFields()
+	return t.ValidateTicketType()
+}
+
+// BeforeUpdate hook de GORM para validación
+func (t *TicketType) BeforeUpdate(tx *gorm.DB) error {
+	if err := t.BaseModel.BeforeUpdate(tx); err != nil {
+		return err
+	}
+
+	t.normalizeFields()
+	return t.ValidateTicketType()
+}
+
+// ValidateTicketType valida los datos del tipo de entrada
+func (t *TicketType) ValidateTicketType() error {
+	if strings.TrimSpace(t.EventID) == "" {
+		return errors.New("event ID is required")
+	}
+
+	if strings.TrimSpace(t.Name) == "" {
+		return errors.New("ticket type name is required")
+	}
+
+	if t.PriceCents < 0 {
+		return errors.New("ticket price cannot be negative")
+	}
+
+	if len(t.Currency) != 3 {
+		return errors.New("currency must be a 3-letter ISO code")
+	}
+
+	if t.Quantity != nil && *t.Quantity < 0 {
+		return errors.New("ticket quantity cannot be negative")
+	}
+
+	if t.Quantity != nil && t.QuantitySold > *t.Quantity {
+		return errors.New("ticket quantity cannot be lower than tickets already sold")
+	}
+
+	if t.SalesStartDate != nil && t.SalesEndDate != nil && !t.SalesEndDate.After(*t.SalesStartDate) {
+		return errors.New("sales end date must be after sales start date")
+	}
+
+	return nil
+}
+
+// normalizeFields normaliza campos de texto
+func (t *TicketType) normalizeFields() {
+	t.Name = strings.TrimSpace(t.Name)
+	t.Description = strings.TrimSpace(t.Description)
+	t.Currency = strings.ToUpper(strings.TrimSpace(t.Currency))
+}
+
+// IsFree verifica si la entrada es gratuita
+func (t *TicketType) IsFree() bool {
+	return t.PriceCents == 0
+}
+
+// IsSoldOut verifica si se ha agotado el cupo
+func (t *TicketType) IsSoldOut() bool {
+	return t.Quantity != nil && t.QuantitySold >= *t.Quantity
+}
+
+// Remaining devuelve las entradas disponibles (nil = ilimitadas)
+func (t *TicketType) Remaining() *int {
+	if t.Quantity == nil {
+		return nil
+	}
+
+	remaining := *t.Quantity - t.QuantitySold
+	if remaining < 0 {
+		remaining = 0
+	}
+	return &remaining
+}
+
+// IsOnSale verifica si la entrada está a la venta en un momento dado
+func (t *TicketType) IsOnSale(now time.Time) bool {
+	if !t.IsActive || t.IsSoldOut() {
+		return false
+	}
+
+	if t.SalesStartDate != nil && now.Before(*t.SalesStartDate) {
+		return false
+	}
+
+	if t.SalesEndDate != nil && now.After(*t.SalesEndDate) {
+		return false
+	}
+
+	return true
+}
+
+// GetAuditData implementa AuditableModel
+func (t *TicketType) GetAuditData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":          t.ID,
+		"event_id":    t.EventID,
+		"name":        t.Name,
+		"price_cents": t.PriceCents,
+		"quantity":    t.Quantity,
+	}
+}
+
+func (t TicketType) GetID() string           { return t.ID.String() }
+func (t TicketType) GetCreatedAt() time.Time { return t.CreatedAt }
+func (t TicketType) GetUpdatedAt() time.Time { return t.UpdatedAt }
@@ -0,0 +1,97 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTicketType_Validate tests unitarios para validación
+func TestTicketType_Validate(t *testing.T) {
+	quantity, negative := 10, -1
+	start := time.Now()
+	before := start.Add(-time.Hour)
+
+	newTicket := func() *TicketType {
+		return &TicketType{EventID: uuid.New().String(), Name: "Early bird", PriceCents: 2500, Currency: "EUR"}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*TicketType)
+		errMsg string
+	}{
+		{name: "entrada válida", modify: func(tt *TicketType) {}},
+		{name: "entrada gratuita", modify: func(tt *TicketType) { tt.PriceCents = 0 }},
+		{name: "sin evento", modify: func(tt *TicketType) { tt.EventID = "" }, errMsg: "event ID is required"},
+		{name: "sin nombre", modify: func(tt *TicketType) { tt.Name = " " }, errMsg: "ticket type name is required"},
+		{name: "precio negativo", modify: func(tt *TicketType) { tt.PriceCents = -1 }, errMsg: "ticket price cannot be negative"},
+		{name: "moneda inválida", modify: func(tt *TicketType) { tt.Currency = "EURO" }, errMsg: "currency must be a 3-letter ISO code"},
+		{name: "cupo negativo", modify: func(tt *TicketType) { tt.Quantity = &negative }, errMsg: "ticket quantity cannot be negative"},
+		{
+			name: "cupo inferior a lo vendido",
+			modify: func(tt *TicketType) {
+				tt.Quantity = &quantity
+				tt.QuantitySold = 11
+			},
+			errMsg: "ticket quantity cannot be lower than tickets already sold",
+		},
+		{
+			name: "ventana de venta invertida",
+			modify: func(tt *TicketType) {
+				tt.SalesStartDate = &start
+				tt.SalesEndDate = &before
+			},
+			errMsg: "sales end date must be after sales start date",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ticket := newTicket()
+			tt.modify(ticket)
+
+			err := ticket.ValidateTicketType()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+// TestTicketType_Availability tests para cupo y ventana de venta
+func TestTicketType_Availability(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	past, future := now.Add(-24*time.Hour), now.Add(24*time.Hour)
+	quantity := 2
+
+	unlimited := &TicketType{IsActive: true}
+	assert.Nil(t, unlimited.Remaining())
+	assert.False(t, unlimited.IsSoldOut())
+	assert.True(t, unlimited.IsOnSale(now))
+
+	limited := &TicketType{IsActive: true, Quantity: &quantity, QuantitySold: 1}
+	require.NotNil(t, limited.Remaining())
+	assert.Equal(t, 1, *limited.Remaining())
+	assert.True(t, limited.IsOnSale(now))
+
+	limited.QuantitySold = 2
+	assert.True(t, limited.IsSoldOut())
+	assert.Equal(t, 0, *limited.Remaining())
+	assert.False(t, limited.IsOnSale(now))
+
+	notYet := &TicketType{IsActive: true, SalesStartDate: &future}
+	assert.False(t, notYet.IsOnSale(now))
+
+	ended := &TicketType{IsActive: true, SalesEndDate: &past}
+	assert.False(t, ended.IsOnSale(now))
+
+	inactive := &TicketType{IsActive: false}
+	assert.False(t, inactive.IsOnSale(now))
+}
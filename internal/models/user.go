@@ -1,7 +1,10 @@
 package models
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"errors"
+	"fmt"
 	"strings"
 	"time"
 
@@ -9,6 +12,9 @@ import (
 	"gorm.io/gorm"
 )
 
+// anonymizedEmailDomain dominio reservado (RFC 2606) de los emails de cuentas suprimidas
+const anonymizedEmailDomain = "anonymized.invalid"
+
 // UserRole define los roles disponibles en el sistema
 type UserRole string
 
@@ -44,6 +50,9 @@ type User struct {
 	IsVerified  bool       `json:"is_verified" gorm:"not null;default:false"`
 	LastLoginAt *time.Time `json:"last_login_at"`
 
+	// Supresión de la cuenta (derecho al olvido)
+	AnonymizedAt *time.Time `json:"anonymized_at,omitempty" gorm:"index"`
+
 	// Geolocalización (para búsquedas espaciales)
 	Latitude  *float64 `json:"latitude" gorm:"index"`
 	Longitude *float64 `json:"longitude" gorm:"index"`
@@ -59,6 +68,8 @@ type User struct {
 	OrganizationID *string       `json:"organization_id,omitempty" gorm:"size:36;index"`
 	Organization   *Organization `json:"organization,omitempty" gorm:"foreignKey:OrganizationID;references:ID"`
 	FavoriteEvents []Event       `json:"favorite_events,omitempty" gorm:"many2many:user_favorite_events;"`
+
+	FollowedOrganizations []Organization `json:"followed_organizations,omitempty" gorm:"many2many:user_followed_organizations;"`
 }
 
 // TableName especifica el nombre de tabla
@@ -266,6 +277,51 @@ func (u *User) HasLocation() bool {
 	return u.Latitude != nil && u.Longitude != nil
 }
 
+// Anonymize sustituye los datos personales por valores neutros y desactiva la
+// cuenta. El ID se conserva para que inscripciones, favoritos y revisiones
+// sigan contando en las estadísticas
+func (u *User) Anonymize(at time.Time) error {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return err
+	}
+
+	u.Email = fmt.Sprintf("deleted-%s@%s", u.ID, anonymizedEmailDomain)
+	u.Password = hex.EncodeToString(secret)
+	if err := u.HashPassword(); err != nil {
+		return err
+	}
+	u.FirstName = "Usuario"
+	u.LastName = "eliminado"
+
+	u.Company = ""
+	u.Position = ""
+	u.Bio = ""
+	u.Website = ""
+	u.LinkedIn = ""
+	u.Twitter = ""
+
+	u.Latitude = nil
+	u.Longitude = nil
+	u.City = ""
+	u.Country = ""
+
+	u.Role = RoleUser
+	u.OrganizationID = nil
+	u.Organization = nil
+	u.IsActive = false
+	u.IsVerified = false
+	u.LastLoginAt = nil
+	u.NewsletterEnabled = false
+	u.AnonymizedAt = &at
+	return nil
+}
+
+// IsAnonymized verifica si la cuenta ya fue suprimida
+func (u *User) IsAnonymized() bool {
+	return u.AnonymizedAt != nil
+}
+
 // GetAuditData implementa AuditableModel
 func (u *User) GetAuditData() map[string]interface{} {
 	return map[string]interface{}{
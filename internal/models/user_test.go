@@ -486,6 +486,40 @@ func TestUser_SetLocation(t *testing.T) {
 	assert.Equal(t, "Spain", user.Country)
 }
 
+// TestUser_Anonymize tests para la supresión de datos personales
+func TestUser_Anonymize(t *testing.T) {
+	user := createTestUser()
+	user.ID = uuid.New()
+	user.Company = "ACME"
+	user.LinkedIn = "https://linkedin.com/in/juan"
+	user.Role = RoleOrganizer
+	user.NewsletterEnabled = true
+	user.SetLocation(40.4168, -3.7038, "Madrid", "Spain")
+	user.UpdateLastLogin()
+	require.NoError(t, user.HashPassword())
+	oldHash := user.Password
+
+	at := time.Date(2026, 10, 18, 10, 0, 0, 0, time.UTC)
+	require.NoError(t, user.Anonymize(at))
+
+	assert.Equal(t, "deleted-"+user.ID.String()+"@anonymized.invalid", user.Email)
+	assert.Equal(t, "Usuario eliminado", user.GetFullName())
+	assert.True(t, user.IsPasswordHashed())
+	assert.NotEqual(t, oldHash, user.Password)
+	assert.False(t, user.CheckPassword("password123"))
+	assert.Empty(t, user.Company)
+	assert.Empty(t, user.LinkedIn)
+	assert.False(t, user.HasLocation())
+	assert.Empty(t, user.City)
+	assert.Equal(t, RoleUser, user.Role)
+	assert.False(t, user.IsActive)
+	assert.False(t, user.NewsletterEnabled)
+	assert.Nil(t, user.LastLoginAt)
+	assert.True(t, user.IsAnonymized())
+	assert.Equal(t, at, *user.AnonymizedAt)
+	assert.NoError(t, user.ValidateUser())
+}
+
 // TestUser_GetAuditData tests para datos de auditoría
 func TestUser_GetAuditData(t *testing.T) {
 	user := createTestUser()
@@ -2,12 +2,16 @@ package query
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"strings"
+	"time"
 
 	"cybesphere-backend/internal/common"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
 )
 
 // Builder constructor de consultas genérico
@@ -16,9 +20,13 @@ type Builder[T common.BaseEntity] struct {
 	query          *gorm.DB
 	entityType     T
 	allowedFilters map[string]string // campo -> operador
+	filterColumns  map[string]string // filtro -> columna (si no coinciden)
+	filterFields   map[string]FilterField
 	allowedSorts   []string
 	defaultSort    string
 	searchFields   []string
+	facets         []Facet
+	expansions     map[string]Expansion
 }
 
 // NewBuilder crea un nuevo query builder
@@ -29,6 +37,7 @@ func NewBuilder[T common.BaseEntity](db *gorm.DB) *Builder[T] {
 		query:          db,
 		entityType:     entity,
 		allowedFilters: make(map[string]string),
+		filterColumns:  make(map[string]string),
 		allowedSorts:   []string{"created_at", "updated_at"},
 		defaultSort:    "created_at",
 		searchFields:   []string{},
@@ -41,6 +50,24 @@ func (b *Builder[T]) SetAllowedFilters(filters map[string]string) *Builder[T] {
 	return b
 }
 
+// SetFilterColumns configura la columna de los filtros cuyo nombre no coincide con ella
+func (b *Builder[T]) SetFilterColumns(columns map[string]string) *Builder[T] {
+	b.filterColumns = columns
+	return b
+}
+
+// SetFilterFields configura los campos admitidos en las expresiones ?filter=
+func (b *Builder[T]) SetFilterFields(fields map[string]FilterField) *Builder[T] {
+	b.filterFields = fields
+	return b
+}
+
+// SetExpansions configura las relaciones que se pueden incluir con ?expand=
+func (b *Builder[T]) SetExpansions(expansions map[string]Expansion) *Builder[T] {
+	b.expansions = expansions
+	return b
+}
+
 // SetAllowedSorts configura campos de ordenamiento permitidos
 func (b *Builder[T]) SetAllowedSorts(sorts []string) *Builder[T] {
 	b.allowedSorts = sorts
@@ -59,54 +86,124 @@ func (b *Builder[T]) SetSearchFields(fields []string) *Builder[T] {
 	return b
 }
 
+// SetFacets configura las facetas que se pueden calcular
+func (b *Builder[T]) SetFacets(facets ...Facet) *Builder[T] {
+	b.facets = facets
+	return b
+}
+
 // ApplyOptions aplica las opciones de consulta
 func (b *Builder[T]) ApplyOptions(opts common.QueryOptions) *Builder[T] {
 	b.query = b.db.Model(b.entityType)
 
-	// Aplicar filtros
-	b.applyFilters(opts)
+	// Aplicar filtros y búsqueda
+	b.query = b.applyFilters(b.query, opts, nil)
+	b.query = b.applySearch(b.query, opts.Search)
 
-	// Aplicar búsqueda
-	if opts.Search != "" {
-		b.applySearch(opts.Search)
+	// Aplicar preloads, incluidas las relaciones pedidas con ?expand=
+	preloads := opts.Preloads
+	if expand := opts.ExpandList(); len(expand) > 0 {
+		expanded, err := resolveExpansions(expand, b.expansions)
+		if err != nil {
+			_ = b.query.AddError(err)
+			return b
+		}
+		preloads = append(append([]string{}, preloads...), expanded...)
 	}
-
-	// Aplicar preloads
-	for _, preload := range opts.Preloads {
+	for _, preload := range preloads {
 		b.query = b.query.Preload(preload)
 	}
 
 	return b
 }
 
-// applyFilters aplica filtros de la consulta
-func (b *Builder[T]) applyFilters(opts common.QueryOptions) {
+// applyFilters aplica los filtros permitidos de la consulta salvo los indicados en skip
+func (b *Builder[T]) applyFilters(query *gorm.DB, opts common.QueryOptions, skip map[string]bool) *gorm.DB {
+	// Expresión ?filter=; un error queda en la consulta y lo devuelve su ejecución
+	if opts.Filter != "" {
+		if len(b.filterFields) == 0 {
+			_ = query.AddError(common.NewValidationError("filter", "Este listado no admite expresiones de filtro"))
+			return query
+		}
+		condition, args, err := compileFilter(opts.Filter, b.filterFields)
+		if err != nil {
+			_ = query.AddError(err)
+			return query
+		}
+		query = query.Where(condition, args...)
+	}
+
 	for field, value := range opts.Filters {
-		if operator, allowed := b.allowedFilters[field]; allowed {
-			switch operator {
-			case "=":
-				b.query = b.query.Where(fmt.Sprintf("%s = ?", field), value)
-			case "LIKE":
-				b.query = b.query.Where(fmt.Sprintf("%s ILIKE ?", field), "%"+fmt.Sprintf("%v", value)+"%")
-			case "IN":
-				b.query = b.query.Where(fmt.Sprintf("%s IN ?", field), value)
-			case ">=":
-				b.query = b.query.Where(fmt.Sprintf("%s >= ?", field), value)
-			case "<=":
-				b.query = b.query.Where(fmt.Sprintf("%s <= ?", field), value)
-			case ">":
-				b.query = b.query.Where(fmt.Sprintf("%s > ?", field), value)
-			case "<":
-				b.query = b.query.Where(fmt.Sprintf("%s < ?", field), value)
+		operator, allowed := b.allowedFilters[field]
+		if !allowed || skip[field] {
+			continue
+		}
+
+		column := field
+		if mapped, ok := b.filterColumns[field]; ok {
+			column = mapped
+		}
+
+		switch operator {
+		case "=":
+			query = query.Where(fmt.Sprintf("%s = ?", column), value)
+		case "LIKE":
+			query = query.Where(fmt.Sprintf("%s ILIKE ?", column), "%"+fmt.Sprintf("%v", value)+"%")
+		case "IN":
+			if values := filterValues(value); len(values) > 0 {
+				query = query.Where(fmt.Sprintf("%s IN ?", column), values)
+			}
+		case ">=":
+			query = query.Where(fmt.Sprintf("%s >= ?", column), value)
+		case "<=":
+			query = query.Where(fmt.Sprintf("%s <= ?", column), value)
+		case ">":
+			query = query.Where(fmt.Sprintf("%s > ?", column), value)
+		case "<":
+			query = query.Where(fmt.Sprintf("%s < ?", column), value)
+		case "DATE_FROM":
+			if from, _, ok := parseFilterDate(value); ok {
+				query = query.Where(fmt.Sprintf("%s >= ?", column), from)
+			}
+		case "DATE_TO":
+			// Una fecha sin hora incluye el día completo
+			if to, dateOnly, ok := parseFilterDate(value); ok {
+				if dateOnly {
+					query = query.Where(fmt.Sprintf("%s < ?", column), to.AddDate(0, 0, 1))
+				} else {
+					query = query.Where(fmt.Sprintf("%s <= ?", column), to)
+				}
+			}
+		case "DATE_PRESET":
+			// column indica "inicio,fin"; se incluyen los registros que solapan el rango
+			if from, to, ok := ResolveDatePreset(fmt.Sprintf("%v", value), time.Now()); ok {
+				startColumn, endColumn := splitRangeColumns(column)
+				query = query.Where(fmt.Sprintf("%s < ? AND %s >= ?", startColumn, endColumn), to, from)
+			}
+		case "JSONB_ANY":
+			if values := filterValues(value); len(values) > 0 {
+				conditions := make([]string, 0, len(values))
+				args := make([]interface{}, 0, len(values))
+				for _, v := range values {
+					conditions = append(conditions, fmt.Sprintf("%s @> jsonb_build_array(?::text)", column))
+					args = append(args, v)
+				}
+				query = query.Where("("+strings.Join(conditions, " OR ")+")", args...)
+			}
+		case "JSONB_ALL":
+			if values := filterValues(value); len(values) > 0 {
+				encoded, _ := json.Marshal(values)
+				query = query.Where(fmt.Sprintf("%s @> ?::jsonb", column), string(encoded))
 			}
 		}
 	}
+	return query
 }
 
 // applySearch aplica búsqueda en múltiples campos
-func (b *Builder[T]) applySearch(search string) {
-	if len(b.searchFields) == 0 {
-		return
+func (b *Builder[T]) applySearch(query *gorm.DB, search string) *gorm.DB {
+	if search == "" || len(b.searchFields) == 0 {
+		return query
 	}
 
 	var conditions []string
@@ -117,9 +214,7 @@ func (b *Builder[T]) applySearch(search string) {
 		args = append(args, "%"+search+"%")
 	}
 
-	if len(conditions) > 0 {
-		b.query = b.query.Where(strings.Join(conditions, " OR "), args...)
-	}
+	return query.Where("("+strings.Join(conditions, " OR ")+")", args...)
 }
 
 // AddWhere agrega una condición WHERE personalizada
@@ -137,14 +232,9 @@ func (b *Builder[T]) Count(ctx context.Context) (int64, error) {
 
 // Find ejecuta la consulta y retorna los resultados
 func (b *Builder[T]) Find(ctx context.Context, opts common.QueryOptions) ([]*T, error) {
-	// Aplicar ordenamiento
-	orderField := opts.OrderBy
-	if !b.isAllowedSort(orderField) {
-		orderField = b.defaultSort
-	}
-
-	orderClause := fmt.Sprintf("%s %s", orderField, strings.ToUpper(opts.OrderDir))
-	b.query = b.query.Order(orderClause)
+	// Aplicar ordenamiento (id desempata para que el orden sea estable)
+	orderField, orderDir := b.resolveSort(opts)
+	b.query = b.query.Order(sortOrder(orderField, orderDir == "asc"))
 
 	// Aplicar paginación
 	b.query = b.query.Offset(opts.Offset).Limit(opts.Limit)
@@ -154,8 +244,13 @@ func (b *Builder[T]) Find(ctx context.Context, opts common.QueryOptions) ([]*T,
 	return results, err
 }
 
-// FindWithPagination ejecuta la consulta con paginación
+// FindWithPagination ejecuta la consulta con paginación por página o, si se
+// indica opts.Cursor, por cursor
 func (b *Builder[T]) FindWithPagination(ctx context.Context, opts common.QueryOptions) ([]*T, *common.PaginationMeta, error) {
+	if opts.Cursor != "" {
+		return b.findWithCursor(ctx, opts)
+	}
+
 	// Contar total (antes de aplicar paginación)
 	total, err := b.Count(ctx)
 	if err != nil {
@@ -171,9 +266,131 @@ func (b *Builder[T]) FindWithPagination(ctx context.Context, opts common.QueryOp
 	// Crear metadatos de paginación
 	pagination := common.NewPaginationMeta(opts.Page, opts.Limit, total)
 
+	// Cursores para continuar desde esta página con paginación por cursor
+	if len(results) > 0 {
+		orderField, orderDir := b.resolveSort(opts)
+		if pagination.HasNext {
+			pagination.NextCursor = b.cursorFor(results[len(results)-1], orderField, orderDir, false)
+		}
+		if pagination.HasPrev {
+			pagination.PrevCursor = b.cursorFor(results[0], orderField, orderDir, true)
+		}
+	}
+
 	return results, pagination, nil
 }
 
+// findWithCursor pagina por keyset: los registros posteriores (o anteriores)
+// al del cursor según la columna de ordenación e id, sin OFFSET
+func (b *Builder[T]) findWithCursor(ctx context.Context, opts common.QueryOptions) ([]*T, *common.PaginationMeta, error) {
+	cursor, err := DecodeCursor(opts.Cursor)
+	if err != nil || !b.isAllowedSort(cursor.Sort) {
+		return nil, nil, common.NewValidationError("cursor", "Cursor inválido")
+	}
+
+	field := b.lookupField(cursor.Sort)
+	if field == nil {
+		return nil, nil, common.NewValidationError("cursor", "Cursor inválido")
+	}
+	var value interface{}
+	if !cursor.Null {
+		if value, err = parseCursorValue(cursor.Value, field.FieldType); err != nil {
+			return nil, nil, common.NewValidationError("cursor", "Cursor inválido")
+		}
+	}
+
+	// El total es opcional porque es la parte costosa de la consulta
+	total := int64(-1)
+	if opts.WithTotal {
+		if total, err = b.Count(ctx); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// Hacia atrás se invierte la comparación y el orden, y luego los resultados
+	ascending := cursor.Dir == "asc"
+	if cursor.Backward {
+		ascending = !ascending
+	}
+	condition, args := keysetCondition(cursor.Sort, ascending, value, cursor.Null, cursor.ID)
+
+	var results []*T
+	err = b.query.WithContext(ctx).
+		Where(condition, args...).
+		Order(sortOrder(cursor.Sort, ascending)).
+		Limit(opts.Limit + 1).
+		Find(&results).Error
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hasMore := len(results) > opts.Limit
+	if hasMore {
+		results = results[:opts.Limit]
+	}
+	if cursor.Backward {
+		for i, j := 0, len(results)-1; i < j; i, j = i+1, j-1 {
+			results[i], results[j] = results[j], results[i]
+		}
+	}
+
+	// Se llega desde un cursor, así que siempre hay registros en el sentido contrario
+	var nextCursor, prevCursor string
+	if len(results) > 0 {
+		if hasMore || cursor.Backward {
+			nextCursor = b.cursorFor(results[len(results)-1], cursor.Sort, cursor.Dir, false)
+		}
+		if hasMore || !cursor.Backward {
+			prevCursor = b.cursorFor(results[0], cursor.Sort, cursor.Dir, true)
+		}
+	}
+
+	return results, common.NewCursorPaginationMeta(opts.Limit, total, nextCursor, prevCursor), nil
+}
+
+// resolveSort devuelve la columna y dirección de ordenamiento efectivas
+func (b *Builder[T]) resolveSort(opts common.QueryOptions) (string, string) {
+	orderField := opts.OrderBy
+	if !b.isAllowedSort(orderField) {
+		orderField = b.defaultSort
+	}
+
+	orderDir := strings.ToLower(opts.OrderDir)
+	if orderDir != "asc" {
+		orderDir = "desc"
+	}
+
+	return orderField, orderDir
+}
+
+// cursorFor genera el cursor que apunta a un registro
+func (b *Builder[T]) cursorFor(row *T, sort, dir string, backward bool) string {
+	field := b.lookupField(sort)
+	if field == nil {
+		return ""
+	}
+
+	value, _ := field.ValueOf(context.Background(), reflect.ValueOf(row).Elem())
+	text, null := formatCursorValue(value)
+	return Cursor{
+		Sort:     sort,
+		Dir:      dir,
+		Value:    text,
+		Null:     null,
+		ID:       (*row).GetID(),
+		Backward: backward,
+	}.Encode()
+}
+
+// lookupField obtiene el campo del modelo asociado a una columna
+func (b *Builder[T]) lookupField(column string) *schema.Field {
+	stmt := &gorm.Statement{DB: b.db}
+	if err := stmt.Parse(&b.entityType); err != nil {
+		return nil
+	}
+	return stmt.Schema.LookUpField(column)
+}
+
 // First obtiene el primer resultado
 func (b *Builder[T]) First(ctx context.Context) (*T, error) {
 	var result T
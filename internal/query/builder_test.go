@@ -0,0 +1,139 @@
+package query
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"cybesphere-backend/internal/common"
+)
+
+// cursorRow registro de prueba con una columna de ordenación que admite NULL
+type cursorRow struct {
+	ID        string `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	ClosedAt  *time.Time
+}
+
+func (r cursorRow) GetID() string           { return r.ID }
+func (r cursorRow) GetCreatedAt() time.Time { return r.CreatedAt }
+func (r cursorRow) GetUpdatedAt() time.Time { return r.UpdatedAt }
+
+// newCursorDB base de datos en memoria con registros cerrados en distintas
+// fechas, algunos en la misma y otros sin cerrar (NULL)
+func newCursorDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: logger.Discard})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&cursorRow{}))
+
+	day := func(n int) *time.Time {
+		value := time.Date(2026, 10, n, 10, 0, 0, 0, time.UTC)
+		return &value
+	}
+	rows := []cursorRow{
+		{ID: "a", ClosedAt: day(1)},
+		{ID: "b", ClosedAt: day(2)},
+		{ID: "c", ClosedAt: day(2)},
+		{ID: "d"},
+		{ID: "e", ClosedAt: day(3)},
+		{ID: "f"},
+		{ID: "g"},
+	}
+	require.NoError(t, db.Create(&rows).Error)
+	return db
+}
+
+// fetchPage pide una página ordenada por closed_at
+func fetchPage(t *testing.T, db *gorm.DB, dir, cursor string) ([]string, *common.PaginationMeta) {
+	t.Helper()
+	opts := common.QueryOptions{Limit: 2, OrderBy: "closed_at", OrderDir: dir, Cursor: cursor}
+	require.NoError(t, opts.Validate())
+
+	builder := NewBuilder[cursorRow](db).SetAllowedSorts([]string{"created_at", "closed_at"})
+	rows, meta, err := builder.ApplyOptions(opts).FindWithPagination(context.Background(), opts)
+	require.NoError(t, err)
+
+	ids := make([]string, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, row.ID)
+	}
+	return ids, meta
+}
+
+// TestBuilder_CursorPagination tests para la paginación por cursor sobre una
+// columna con NULL: recorre todas las páginas hacia delante y vuelve hacia atrás
+func TestBuilder_CursorPagination(t *testing.T) {
+	tests := []struct {
+		dir   string
+		pages [][]string
+	}{
+		// NULL como el mayor valor: al final en ascendente y al principio en descendente
+		{dir: "asc", pages: [][]string{{"a", "b"}, {"c", "e"}, {"d", "f"}, {"g"}}},
+		{dir: "desc", pages: [][]string{{"g", "f"}, {"d", "e"}, {"c", "b"}, {"a"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dir, func(t *testing.T) {
+			db := newCursorDB(t)
+
+			// Hacia delante desde la primera página
+			var forward [][]string
+			var metas []*common.PaginationMeta
+			cursor := ""
+			for {
+				ids, meta := fetchPage(t, db, tt.dir, cursor)
+				forward = append(forward, ids)
+				metas = append(metas, meta)
+				if !meta.HasNext {
+					break
+				}
+				require.Less(t, len(forward), 10, "la paginación no termina")
+				cursor = meta.NextCursor
+			}
+			assert.Equal(t, tt.pages, forward)
+
+			// Hacia atrás desde la última página
+			backward := [][]string{forward[len(forward)-1]}
+			meta := metas[len(metas)-1]
+			for meta.HasPrev {
+				require.Less(t, len(backward), 10, "la paginación no termina")
+				var ids []string
+				ids, meta = fetchPage(t, db, tt.dir, meta.PrevCursor)
+				backward = append([][]string{ids}, backward...)
+			}
+			assert.Equal(t, tt.pages, backward)
+		})
+	}
+}
+
+// TestKeysetCondition tests para las condiciones según el valor del cursor
+func TestKeysetCondition(t *testing.T) {
+	tests := []struct {
+		name      string
+		ascending bool
+		null      bool
+		want      string
+		args      int
+	}{
+		{name: "ascendente", ascending: true, want: "((paid_at, id) > (?, ?) OR paid_at IS NULL)", args: 2},
+		{name: "ascendente desde NULL", ascending: true, null: true, want: "(paid_at IS NULL AND id > ?)", args: 1},
+		{name: "descendente", want: "(paid_at, id) < (?, ?)", args: 2},
+		{name: "descendente desde NULL", null: true, want: "(paid_at IS NOT NULL OR id < ?)", args: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			condition, args := keysetCondition("paid_at", tt.ascending, time.Now(), tt.null, "uuid-1")
+			assert.Equal(t, tt.want, condition)
+			assert.Len(t, args, tt.args)
+		})
+	}
+}
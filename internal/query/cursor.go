@@ -0,0 +1,121 @@
+package query
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// ErrInvalidCursor cursor mal formado o de otra consulta
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor posición de un registro en una consulta ordenada por Sort e id.
+// Se serializa como JSON en base64url para que sea opaco para el cliente.
+type Cursor struct {
+	Sort     string `json:"s"`
+	Dir      string `json:"d"`
+	Value    string `json:"v"`
+	Null     bool   `json:"n,omitempty"` // La columna de ordenación es NULL en el registro
+	ID       string `json:"id"`
+	Backward bool   `json:"b,omitempty"` // Página anterior al registro
+}
+
+// Encode serializa el cursor
+func (c Cursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor interpreta un cursor generado por Encode
+func DecodeCursor(encoded string) (*Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var cursor Cursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	if cursor.Sort == "" || cursor.ID == "" || (cursor.Dir != "asc" && cursor.Dir != "desc") {
+		return nil, ErrInvalidCursor
+	}
+
+	return &cursor, nil
+}
+
+// formatCursorValue convierte el valor de la columna de ordenación a texto;
+// null indica que el registro no tiene valor
+func formatCursorValue(value interface{}) (text string, null bool) {
+	if value == nil {
+		return "", true
+	}
+	if rv := reflect.ValueOf(value); rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return "", true
+		}
+		value = rv.Elem().Interface()
+	}
+
+	switch v := value.(type) {
+	case time.Time:
+		return v.UTC().Format(time.RFC3339Nano), false
+	default:
+		return fmt.Sprintf("%v", v), false
+	}
+}
+
+// parseCursorValue recupera el valor de la columna con el tipo del campo
+func parseCursorValue(value string, fieldType reflect.Type) (interface{}, error) {
+	for fieldType.Kind() == reflect.Ptr {
+		fieldType = fieldType.Elem()
+	}
+
+	if fieldType == reflect.TypeOf(time.Time{}) {
+		return time.Parse(time.RFC3339Nano, value)
+	}
+
+	switch fieldType.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.ParseInt(value, 10, 64)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.ParseUint(value, 10, 64)
+	case reflect.Float32, reflect.Float64:
+		return strconv.ParseFloat(value, 64)
+	case reflect.Bool:
+		return strconv.ParseBool(value)
+	default:
+		return value, nil
+	}
+}
+
+// sortOrder cláusula ORDER BY de la columna de ordenación con id como
+// desempate. Los NULL van al final en orden ascendente y al principio en
+// descendente (el criterio por defecto de PostgreSQL), como si fueran el mayor
+// valor; keysetCondition sigue el mismo criterio
+func sortOrder(column string, ascending bool) string {
+	if ascending {
+		return fmt.Sprintf("%s ASC NULLS LAST, id ASC", column)
+	}
+	return fmt.Sprintf("%s DESC NULLS FIRST, id DESC", column)
+}
+
+// keysetCondition condición de los registros posteriores al del cursor en el
+// orden de sortOrder. Una comparación de tuplas con NULL no es cierta, así que
+// las columnas que admiten NULL necesitan condiciones aparte
+func keysetCondition(column string, ascending bool, value interface{}, null bool, id string) (string, []interface{}) {
+	switch {
+	case ascending && null:
+		return fmt.Sprintf("(%s IS NULL AND id > ?)", column), []interface{}{id}
+	case ascending:
+		return fmt.Sprintf("((%s, id) > (?, ?) OR %s IS NULL)", column, column), []interface{}{value, id}
+	case null:
+		return fmt.Sprintf("(%s IS NOT NULL OR id < ?)", column), []interface{}{id}
+	default:
+		return fmt.Sprintf("(%s, id) < (?, ?)", column), []interface{}{value, id}
+	}
+}
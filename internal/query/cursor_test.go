@@ -0,0 +1,85 @@
+package query
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCursor_EncodeDecode tests para la serialización de los cursores
+func TestCursor_EncodeDecode(t *testing.T) {
+	tests := []struct {
+		name   string
+		cursor Cursor
+	}{
+		{
+			name:   "valor de fecha",
+			cursor: Cursor{Sort: "created_at", Dir: "desc", Value: "2026-10-18T10:00:00.123456Z", ID: "uuid-1"},
+		},
+		{
+			name:   "valor NULL hacia atrás",
+			cursor: Cursor{Sort: "paid_at", Dir: "asc", Null: true, ID: "uuid-2", Backward: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decoded, err := DecodeCursor(tt.cursor.Encode())
+			require.NoError(t, err)
+			assert.Equal(t, tt.cursor, *decoded)
+		})
+	}
+}
+
+// TestDecodeCursor_Invalid tests para los cursores mal formados
+func TestDecodeCursor_Invalid(t *testing.T) {
+	for _, encoded := range []string{
+		"no-es-base64!",
+		Cursor{Dir: "asc", ID: "uuid-1"}.Encode(),
+		Cursor{Sort: "created_at", Dir: "asc"}.Encode(),
+		Cursor{Sort: "created_at", Dir: "up", ID: "uuid-1"}.Encode(),
+	} {
+		_, err := DecodeCursor(encoded)
+		assert.ErrorIs(t, err, ErrInvalidCursor, encoded)
+	}
+}
+
+// TestCursorValue_RoundTrip tests para la conversión del valor de ordenación
+func TestCursorValue_RoundTrip(t *testing.T) {
+	paidAt := time.Date(2026, 10, 18, 12, 30, 0, 123456000, time.FixedZone("CEST", 2*3600))
+	price := 12.5
+	var nilTime *time.Time
+	var nilFloat *float64
+
+	tests := []struct {
+		name  string
+		value interface{}
+		want  interface{}
+	}{
+		{name: "fecha", value: paidAt, want: paidAt.UTC()},
+		{name: "puntero a fecha", value: &paidAt, want: paidAt.UTC()},
+		{name: "puntero a número", value: &price, want: 12.5},
+		{name: "entero", value: int64(42), want: int64(42)},
+		{name: "texto", value: "osint", want: "osint"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text, null := formatCursorValue(tt.value)
+			require.False(t, null)
+
+			parsed, err := parseCursorValue(text, reflect.TypeOf(tt.value))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, parsed)
+		})
+	}
+
+	for _, value := range []interface{}{nil, nilTime, nilFloat} {
+		text, null := formatCursorValue(value)
+		assert.True(t, null)
+		assert.Empty(t, text)
+	}
+}
@@ -0,0 +1,44 @@
+package query
+
+import (
+	"fmt"
+	"strings"
+
+	"cybesphere-backend/internal/common"
+)
+
+// MaxExpandDepth niveles de relaciones anidadas admitidos en ?expand=
+const MaxExpandDepth = 2
+
+// Expansion relación que se puede incluir en la respuesta con ?expand=
+type Expansion struct {
+	Preloads []string // Asociaciones de GORM a precargar
+}
+
+// resolveExpansions valida las relaciones pedidas contra las permitidas y
+// devuelve las asociaciones que hay que precargar
+func resolveExpansions(names []string, allowed map[string]Expansion) ([]string, error) {
+	var preloads []string
+	seen := make(map[string]bool)
+
+	for _, name := range names {
+		if strings.Count(name, ".")+1 > MaxExpandDepth {
+			return nil, common.NewValidationError("expand."+name,
+				fmt.Sprintf("Solo se admiten %d niveles de relaciones anidadas", MaxExpandDepth))
+		}
+
+		expansion, ok := allowed[name]
+		if !ok {
+			return nil, common.NewValidationError("expand."+name, fmt.Sprintf("La relación '%s' no se puede incluir", name))
+		}
+
+		for _, preload := range expansion.Preloads {
+			if !seen[preload] {
+				seen[preload] = true
+				preloads = append(preloads, preload)
+			}
+		}
+	}
+
+	return preloads, nil
+}
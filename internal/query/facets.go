@@ -0,0 +1,71 @@
+package query
+
+import (
+	"context"
+	"fmt"
+
+	"cybesphere-backend/internal/common"
+)
+
+// defaultFacetLimit valores devueltos por faceta si no se indica otro límite
+const defaultFacetLimit = 20
+
+// Facet faceta calculada agrupando los resultados por una columna
+type Facet struct {
+	Name    string   // Nombre de la faceta en la respuesta
+	Column  string   // Columna agrupada
+	Array   bool     // La columna es un array JSONB y se cuenta cada elemento
+	Filters []string // Filtros sobre la propia faceta, que no se aplican al contarla
+	Limit   int
+}
+
+// FacetCount número de resultados para un valor de faceta
+type FacetCount struct {
+	Value string
+	Count int64
+}
+
+// Facets cuenta los resultados por cada faceta configurada. Cada faceta
+// aplica todos los filtros salvo los suyos, de modo que muestra cuántos
+// resultados habría al elegir otro valor de la misma faceta.
+func (b *Builder[T]) Facets(ctx context.Context, opts common.QueryOptions) (map[string][]FacetCount, error) {
+	result := make(map[string][]FacetCount, len(b.facets))
+
+	for _, facet := range b.facets {
+		skip := make(map[string]bool, len(facet.Filters))
+		for _, filter := range facet.Filters {
+			skip[filter] = true
+		}
+
+		query := b.db.WithContext(ctx).Model(b.entityType)
+		query = b.applyFilters(query, opts, skip)
+		query = b.applySearch(query, opts.Search)
+
+		if facet.Array {
+			query = query.
+				Joins(fmt.Sprintf("CROSS JOIN LATERAL jsonb_array_elements_text("+
+					"CASE WHEN jsonb_typeof(%[1]s) = 'array' THEN %[1]s ELSE '[]'::jsonb END) AS facet_value", facet.Column)).
+				Select("facet_value AS value, COUNT(*) AS count").
+				Group("facet_value")
+		} else {
+			value := fmt.Sprintf("CAST(%s AS TEXT)", facet.Column)
+			query = query.
+				Select(value + " AS value, COUNT(*) AS count").
+				Where(value + " <> ''").
+				Group(value)
+		}
+
+		limit := facet.Limit
+		if limit <= 0 {
+			limit = defaultFacetLimit
+		}
+
+		counts := []FacetCount{}
+		if err := query.Order("count DESC, value ASC").Limit(limit).Scan(&counts).Error; err != nil {
+			return nil, err
+		}
+		result[facet.Name] = counts
+	}
+
+	return result, nil
+}
@@ -0,0 +1,241 @@
+package query
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/pkg/filterexpr"
+)
+
+// ErrInvalidFilterValue valor incompatible con el tipo del campo
+var ErrInvalidFilterValue = errors.New("invalid filter value")
+
+// FieldType tipo de un campo filtrable con ?filter=
+type FieldType int
+
+const (
+	FieldString FieldType = iota
+	FieldNumber
+	FieldBool
+	FieldTime
+	FieldArray // Array JSONB de textos
+)
+
+// FilterField campo admitido en las expresiones de ?filter=
+type FilterField struct {
+	Column string
+	Type   FieldType
+	Values []string // Valores admitidos; vacío admite cualquiera
+}
+
+// operadores admitidos por tipo de campo
+var fieldOperators = map[FieldType][]filterexpr.Operator{
+	FieldString: {filterexpr.OpEqual, filterexpr.OpNotEqual, filterexpr.OpIn, filterexpr.OpNotIn, filterexpr.OpLike},
+	FieldNumber: {filterexpr.OpEqual, filterexpr.OpNotEqual, filterexpr.OpGreater, filterexpr.OpGreaterEqual,
+		filterexpr.OpLess, filterexpr.OpLessEqual, filterexpr.OpIn, filterexpr.OpNotIn},
+	FieldBool: {filterexpr.OpEqual, filterexpr.OpNotEqual},
+	FieldTime: {filterexpr.OpEqual, filterexpr.OpNotEqual, filterexpr.OpGreater, filterexpr.OpGreaterEqual,
+		filterexpr.OpLess, filterexpr.OpLessEqual},
+	FieldArray: {filterexpr.OpHas, filterexpr.OpIn},
+}
+
+// compileFilter convierte una expresión ?filter= en una condición SQL
+// parametrizada; solo se admiten los campos configurados
+func compileFilter(expression string, fields map[string]FilterField) (string, []interface{}, error) {
+	node, err := filterexpr.Parse(expression)
+	if err != nil {
+		validationErr := common.NewValidationError("filter", "Expresión de filtro inválida")
+		var syntaxErr *filterexpr.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			validationErr.Details = syntaxErr.Error()
+		}
+		return "", nil, validationErr
+	}
+
+	var args []interface{}
+	sql, err := compileNode(node, fields, &args)
+	if err != nil {
+		return "", nil, err
+	}
+	return sql, args, nil
+}
+
+func compileNode(node filterexpr.Node, fields map[string]FilterField, args *[]interface{}) (string, error) {
+	switch n := node.(type) {
+	case filterexpr.And:
+		return compileBinary(n.Left, n.Right, "AND", fields, args)
+	case filterexpr.Or:
+		return compileBinary(n.Left, n.Right, "OR", fields, args)
+	case filterexpr.Not:
+		inner, err := compileNode(n.Expr, fields, args)
+		if err != nil {
+			return "", err
+		}
+		return "NOT (" + inner + ")", nil
+	case filterexpr.Comparison:
+		return compileComparison(n, fields, args)
+	default:
+		return "", common.NewValidationError("filter", "Expresión de filtro inválida")
+	}
+}
+
+func compileBinary(left, right filterexpr.Node, operator string, fields map[string]FilterField, args *[]interface{}) (string, error) {
+	leftSQL, err := compileNode(left, fields, args)
+	if err != nil {
+		return "", err
+	}
+	rightSQL, err := compileNode(right, fields, args)
+	if err != nil {
+		return "", err
+	}
+	return "(" + leftSQL + " " + operator + " " + rightSQL + ")", nil
+}
+
+func compileComparison(c filterexpr.Comparison, fields map[string]FilterField, args *[]interface{}) (string, error) {
+	errorField := "filter." + c.Field
+
+	field, ok := fields[c.Field]
+	if !ok {
+		return "", common.NewValidationError(errorField, fmt.Sprintf("El campo '%s' no se puede filtrar", c.Field))
+	}
+	if !operatorAllowed(field.Type, c.Operator) {
+		return "", common.NewValidationError(errorField, fmt.Sprintf("Operador '%s' no admitido para '%s'", c.Operator, c.Field))
+	}
+
+	values := make([]interface{}, 0, len(c.Values))
+	for _, raw := range c.Values {
+		value, err := convertFilterValue(field, raw)
+		if err != nil {
+			return "", common.NewValidationError(errorField, fmt.Sprintf("Valor '%s' no válido para '%s'", raw, c.Field))
+		}
+		values = append(values, value)
+	}
+
+	column := field.Column
+
+	switch field.Type {
+	case FieldArray:
+		conditions := make([]string, 0, len(values))
+		for _, value := range values {
+			conditions = append(conditions, column+" @> jsonb_build_array(?::text)")
+			*args = append(*args, value)
+		}
+		return "(" + strings.Join(conditions, " OR ") + ")", nil
+
+	case FieldTime:
+		// Una fecha sin hora se refiere al día completo
+		value := values[0].(filterTime)
+		if value.dateOnly {
+			next := value.time.AddDate(0, 0, 1)
+			switch c.Operator {
+			case filterexpr.OpEqual:
+				*args = append(*args, value.time, next)
+				return "(" + column + " >= ? AND " + column + " < ?)", nil
+			case filterexpr.OpNotEqual:
+				*args = append(*args, value.time, next)
+				return "(" + column + " < ? OR " + column + " >= ?)", nil
+			case filterexpr.OpGreater:
+				*args = append(*args, next)
+				return column + " >= ?", nil
+			case filterexpr.OpLessEqual:
+				*args = append(*args, next)
+				return column + " < ?", nil
+			}
+		}
+		*args = append(*args, value.time)
+		return fmt.Sprintf("%s %s ?", column, sqlOperator(c.Operator)), nil
+
+	default:
+		switch c.Operator {
+		case filterexpr.OpIn, filterexpr.OpNotIn:
+			*args = append(*args, values)
+			return fmt.Sprintf("%s %s ?", column, sqlOperator(c.Operator)), nil
+		case filterexpr.OpLike:
+			*args = append(*args, "%"+escapeLike(values[0].(string))+"%")
+			return column + ` ILIKE ? ESCAPE '\'`, nil
+		default:
+			*args = append(*args, values[0])
+			return fmt.Sprintf("%s %s ?", column, sqlOperator(c.Operator)), nil
+		}
+	}
+}
+
+// filterTime instante de una expresión e indicación de si era solo una fecha
+type filterTime struct {
+	time     time.Time
+	dateOnly bool
+}
+
+// convertFilterValue valida y convierte un valor según el tipo del campo
+func convertFilterValue(field FilterField, raw string) (interface{}, error) {
+	if len(field.Values) > 0 {
+		allowed := false
+		for _, value := range field.Values {
+			if value == raw {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return nil, ErrInvalidFilterValue
+		}
+	}
+
+	switch field.Type {
+	case FieldNumber:
+		if value, err := strconv.ParseInt(raw, 10, 64); err == nil {
+			return value, nil
+		}
+		value, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return nil, ErrInvalidFilterValue
+		}
+		return value, nil
+	case FieldBool:
+		value, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, ErrInvalidFilterValue
+		}
+		return value, nil
+	case FieldTime:
+		value, dateOnly, ok := parseFilterDate(raw)
+		if !ok {
+			return nil, ErrInvalidFilterValue
+		}
+		return filterTime{time: value, dateOnly: dateOnly}, nil
+	default:
+		return raw, nil
+	}
+}
+
+func operatorAllowed(fieldType FieldType, operator filterexpr.Operator) bool {
+	for _, allowed := range fieldOperators[fieldType] {
+		if allowed == operator {
+			return true
+		}
+	}
+	return false
+}
+
+// sqlOperator traduce un operador de la expresión a SQL
+func sqlOperator(operator filterexpr.Operator) string {
+	switch operator {
+	case filterexpr.OpNotEqual:
+		return "<>"
+	case filterexpr.OpIn:
+		return "IN"
+	case filterexpr.OpNotIn:
+		return "NOT IN"
+	default:
+		return string(operator)
+	}
+}
+
+// escapeLike escapa los comodines de un patrón LIKE
+func escapeLike(text string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(text)
+}
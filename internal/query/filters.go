@@ -0,0 +1,118 @@
+package query
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DefaultLocation zona horaria en la que se resuelven los rangos de fechas relativos
+const DefaultLocation = "Europe/Madrid"
+
+// Rangos de fechas relativos admitidos por el operador DATE_PRESET
+const (
+	DatePresetToday       = "today"
+	DatePresetTomorrow    = "tomorrow"
+	DatePresetThisWeekend = "this_weekend"
+	DatePresetThisWeek    = "this_week"
+	DatePresetNext7Days   = "next_7_days"
+	DatePresetNext30Days  = "next_30_days"
+	DatePresetThisMonth   = "this_month"
+)
+
+// ResolveDatePreset convierte un rango relativo en el intervalo [from, to)
+// calculado en DefaultLocation a partir de now
+func ResolveDatePreset(preset string, now time.Time) (time.Time, time.Time, bool) {
+	if loc, err := time.LoadLocation(DefaultLocation); err == nil {
+		now = now.In(loc)
+	}
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	switch preset {
+	case DatePresetToday:
+		return today, today.AddDate(0, 0, 1), true
+	case DatePresetTomorrow:
+		return today.AddDate(0, 0, 1), today.AddDate(0, 0, 2), true
+	case DatePresetThisWeekend:
+		// Sábado y domingo de esta semana (el fin de semana en curso si ya ha empezado)
+		var saturday time.Time
+		switch now.Weekday() {
+		case time.Saturday:
+			saturday = today
+		case time.Sunday:
+			saturday = today.AddDate(0, 0, -1)
+		default:
+			saturday = today.AddDate(0, 0, int(time.Saturday-now.Weekday()))
+		}
+		return saturday, saturday.AddDate(0, 0, 2), true
+	case DatePresetThisWeek:
+		// Desde hoy hasta el domingo incluido
+		daysToMonday := (8 - int(now.Weekday())) % 7
+		if daysToMonday == 0 {
+			daysToMonday = 7
+		}
+		return today, today.AddDate(0, 0, daysToMonday), true
+	case DatePresetNext7Days:
+		return today, today.AddDate(0, 0, 7), true
+	case DatePresetNext30Days:
+		return today, today.AddDate(0, 0, 30), true
+	case DatePresetThisMonth:
+		return today, time.Date(now.Year(), now.Month()+1, 1, 0, 0, 0, 0, now.Location()), true
+	default:
+		return time.Time{}, time.Time{}, false
+	}
+}
+
+// filterValues normaliza el valor de un filtro multivalor ("a,b" o slice)
+func filterValues(value interface{}) []string {
+	var raw []string
+	switch v := value.(type) {
+	case []string:
+		raw = v
+	case []interface{}:
+		for _, item := range v {
+			raw = append(raw, fmt.Sprintf("%v", item))
+		}
+	case string:
+		raw = strings.Split(v, ",")
+	default:
+		raw = []string{fmt.Sprintf("%v", v)}
+	}
+
+	values := make([]string, 0, len(raw))
+	for _, item := range raw {
+		if item = strings.TrimSpace(item); item != "" {
+			values = append(values, item)
+		}
+	}
+	return values
+}
+
+// parseFilterDate interpreta una fecha (YYYY-MM-DD, en DefaultLocation) o un
+// instante RFC3339; indica si el valor no incluía hora
+func parseFilterDate(value interface{}) (time.Time, bool, bool) {
+	switch v := value.(type) {
+	case time.Time:
+		return v, false, true
+	case string:
+		if t, err := time.Parse(time.RFC3339, v); err == nil {
+			return t, false, true
+		}
+		loc, err := time.LoadLocation(DefaultLocation)
+		if err != nil {
+			loc = time.UTC
+		}
+		if t, err := time.ParseInLocation("2006-01-02", v, loc); err == nil {
+			return t, true, true
+		}
+	}
+	return time.Time{}, false, false
+}
+
+// splitRangeColumns separa las columnas "inicio,fin" de un filtro de rango
+func splitRangeColumns(column string) (string, string) {
+	if start, end, ok := strings.Cut(column, ","); ok {
+		return start, end
+	}
+	return column, column
+}
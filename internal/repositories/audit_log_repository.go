@@ -0,0 +1,169 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/models"
+	"cybesphere-backend/pkg/database"
+
+	"gorm.io/gorm"
+)
+
+// AuditLogRepository repositorio para la cadena de registros de auditoría
+type AuditLogRepository struct {
+	db *gorm.DB
+}
+
+// NewAuditLogRepository crea una nueva instancia
+func NewAuditLogRepository() *AuditLogRepository {
+	return &AuditLogRepository{db: database.GetDB()}
+}
+
+// GetChainAfter registros encadenados posteriores a una posición, en orden.
+// Los registros borrados no se devuelven y aparecen como huecos en la cadena
+func (r *AuditLogRepository) GetChainAfter(ctx context.Context, afterSequence int64, limit int) ([]*models.AuditLog, error) {
+	var logs []*models.AuditLog
+	err := r.db.WithContext(ctx).
+		Where("sequence > ?", afterSequence).
+		Order("sequence ASC").
+		Limit(limit).
+		Find(&logs).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return logs, nil
+}
+
+// GetChainRange registros encadenados entre dos posiciones (ambas incluidas)
+func (r *AuditLogRepository) GetChainRange(ctx context.Context, fromSequence, toSequence int64) ([]*models.AuditLog, error) {
+	var logs []*models.AuditLog
+	err := r.db.WithContext(ctx).
+		Where("sequence BETWEEN ? AND ?", fromSequence, toSequence).
+		Order("sequence ASC").
+		Find(&logs).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return logs, nil
+}
+
+// GetChainHead último registro encadenado, aunque esté borrado (nil si la
+// cadena está vacía)
+func (r *AuditLogRepository) GetChainHead(ctx context.Context) (*models.AuditLog, error) {
+	var logs []*models.AuditLog
+	err := r.db.WithContext(ctx).
+		Unscoped().
+		Where("sequence IS NOT NULL").
+		Order("sequence DESC").
+		Limit(1).
+		Find(&logs).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	if len(logs) == 0 {
+		return nil, nil
+	}
+	return logs[0], nil
+}
+
+// CountUnchained cuenta los registros anteriores al encadenado
+func (r *AuditLogRepository) CountUnchained(ctx context.Context) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&models.AuditLog{}).
+		Where("sequence IS NULL").
+		Count(&count).Error
+	if err != nil {
+		return 0, common.MapGormError(err)
+	}
+	return count, nil
+}
+
+// GetBySubject registros de auditoría hechos por un usuario o sobre su cuenta,
+// del más antiguo al más reciente. De los registros en los que el usuario solo
+// es el autor se omiten los cambios, que contienen datos de otros titulares
+func (r *AuditLogRepository) GetBySubject(ctx context.Context, userID string, limit int) ([]*models.AuditLog, error) {
+	var logs []*models.AuditLog
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? OR (resource = ? AND resource_id = ?)", userID, models.AuditResourceUser, userID).
+		Order("timestamp ASC").
+		Limit(limit).
+		Find(&logs).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+
+	for _, log := range logs {
+		if !log.IsAbout(userID) {
+			log.Changes = nil
+		}
+	}
+	return logs, nil
+}
+
+// GetLatestCheckpoint último punto de retención de la cadena (nil si nunca
+// se ha aplicado la retención)
+func (r *AuditLogRepository) GetLatestCheckpoint(ctx context.Context) (*models.AuditCheckpoint, error) {
+	var checkpoints []*models.AuditCheckpoint
+	err := r.db.WithContext(ctx).
+		Order("sequence DESC").
+		Limit(1).
+		Find(&checkpoints).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	if len(checkpoints) == 0 {
+		return nil, nil
+	}
+	return checkpoints[0], nil
+}
+
+// GetLastSequenceBefore última posición de la cadena registrada antes de una
+// fecha, aunque esté borrada (0 si no hay ninguna)
+func (r *AuditLogRepository) GetLastSequenceBefore(ctx context.Context, before time.Time) (int64, error) {
+	var sequence *int64
+	err := r.db.WithContext(ctx).
+		Unscoped().
+		Model(&models.AuditLog{}).
+		Where("sequence IS NOT NULL AND timestamp < ?", before).
+		Select("MAX(sequence)").
+		Scan(&sequence).Error
+	if err != nil {
+		return 0, common.MapGormError(err)
+	}
+	if sequence == nil {
+		return 0, nil
+	}
+	return *sequence, nil
+}
+
+// PruneChain borra definitivamente los registros encadenados hasta la posición
+// del punto de retención y guarda el punto en la misma transacción
+func (r *AuditLogRepository) PruneChain(ctx context.Context, checkpoint *models.AuditCheckpoint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Unscoped().
+			Where("sequence IS NOT NULL AND sequence <= ?", checkpoint.Sequence).
+			Delete(&models.AuditLog{})
+		if result.Error != nil {
+			return common.MapGormError(result.Error)
+		}
+
+		checkpoint.PrunedCount = result.RowsAffected
+		return common.MapGormError(tx.Create(checkpoint).Error)
+	})
+}
+
+// DeleteUnchainedBefore borra definitivamente los registros anteriores al
+// encadenado con más antigüedad que la indicada
+func (r *AuditLogRepository) DeleteUnchainedBefore(ctx context.Context, before time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Unscoped().
+		Where("sequence IS NULL AND timestamp < ?", before).
+		Delete(&models.AuditLog{})
+	if result.Error != nil {
+		return 0, common.MapGormError(result.Error)
+	}
+	return result.RowsAffected, nil
+}
@@ -53,6 +53,15 @@ func (r *BaseRepository[T]) Update(ctx context.Context, entity *T) error {
 	return common.MapGormError(err)
 }
 
+// UpdateColumns actualiza solo las columnas indicadas de la entidad, además de
+// updated_at y updated_by. El resto, como los contadores que otros procesos
+// cambian entretanto, no se sobrescribe
+func (r *BaseRepository[T]) UpdateColumns(ctx context.Context, entity *T, columns []string) error {
+	selected := append(append([]string{}, columns...), "updated_at", "updated_by")
+	err := r.db.WithContext(ctx).Model(entity).Select(selected).Updates(entity).Error
+	return common.MapGormError(err)
+}
+
 // Delete elimina una entidad (soft delete)
 func (r *BaseRepository[T]) Delete(ctx context.Context, id string) error {
 	var entity T
@@ -65,3 +74,12 @@ func (r *BaseRepository[T]) Count(ctx context.Context, opts common.QueryOptions)
 	r.builder.ApplyOptions(opts)
 	return r.builder.Count(ctx)
 }
+
+// Facets cuenta las entidades por cada faceta configurada en el builder
+func (r *BaseRepository[T]) Facets(ctx context.Context, opts common.QueryOptions) (map[string][]query.FacetCount, error) {
+	facets, err := r.builder.Facets(ctx, opts)
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return facets, nil
+}
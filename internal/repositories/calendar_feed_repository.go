@@ -0,0 +1,75 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/models"
+)
+
+// CalendarFeedRepository repositorio para feeds de calendario
+type CalendarFeedRepository struct {
+	*BaseRepository[models.CalendarFeed]
+}
+
+// NewCalendarFeedRepository crea una nueva instancia
+func NewCalendarFeedRepository() *CalendarFeedRepository {
+	base := NewBaseRepository[models.CalendarFeed]()
+
+	base.builder.SetAllowedFilters(map[string]string{
+		"user_id":    "=",
+		"scope":      "=",
+		"is_revoked": "=",
+	})
+
+	base.builder.SetAllowedSorts([]string{
+		"created_at", "updated_at", "last_accessed_at",
+	})
+
+	return &CalendarFeedRepository{BaseRepository: base}
+}
+
+// GetByTokenHash obtiene un feed activo por el hash de su token
+func (r *CalendarFeedRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*models.CalendarFeed, error) {
+	var feed models.CalendarFeed
+	err := r.db.WithContext(ctx).
+		Where("token_hash = ? AND is_revoked = false", tokenHash).
+		First(&feed).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return &feed, nil
+}
+
+// GetByUserID obtiene los feeds activos de un usuario
+func (r *CalendarFeedRepository) GetByUserID(ctx context.Context, userID string) ([]*models.CalendarFeed, error) {
+	var feeds []*models.CalendarFeed
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND is_revoked = false", userID).
+		Order("created_at DESC").
+		Find(&feeds).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return feeds, nil
+}
+
+// Revoke revoca un feed
+func (r *CalendarFeedRepository) Revoke(ctx context.Context, id string) error {
+	err := r.db.WithContext(ctx).Model(&models.CalendarFeed{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"is_revoked": true,
+			"revoked_at": time.Now(),
+		}).Error
+	return common.MapGormError(err)
+}
+
+// TouchLastAccessed actualiza la fecha de último acceso sin modificar updated_at
+func (r *CalendarFeedRepository) TouchLastAccessed(ctx context.Context, id string) error {
+	err := r.db.WithContext(ctx).Model(&models.CalendarFeed{}).
+		Where("id = ?", id).
+		UpdateColumn("last_accessed_at", time.Now()).Error
+	return common.MapGormError(err)
+}
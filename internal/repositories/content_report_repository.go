@@ -0,0 +1,70 @@
+package repositories
+
+import (
+	"context"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/models"
+
+	"gorm.io/gorm"
+)
+
+// ContentReportRepository repositorio para las denuncias de contenido y sus notas
+type ContentReportRepository struct {
+	*BaseRepository[models.ContentReport]
+}
+
+// NewContentReportRepository crea una nueva instancia
+func NewContentReportRepository() *ContentReportRepository {
+	base := NewBaseRepository[models.ContentReport]()
+
+	// status admite varios estados separados por comas
+	base.builder.SetAllowedFilters(map[string]string{
+		"status":        "IN",
+		"resource_type": "=",
+		"resource_id":   "=",
+		"reason":        "=",
+		"reporter_id":   "=",
+		"assigned_to":   "=",
+	})
+
+	base.builder.SetAllowedSorts([]string{
+		"created_at", "updated_at", "resolved_at",
+	})
+
+	return &ContentReportRepository{BaseRepository: base}
+}
+
+// GetWithNotes obtiene una denuncia con su autor y las notas de moderación en orden
+func (r *ContentReportRepository) GetWithNotes(ctx context.Context, id string) (*models.ContentReport, error) {
+	var report models.ContentReport
+	err := r.db.WithContext(ctx).
+		Preload("Reporter").
+		Preload("Notes", func(db *gorm.DB) *gorm.DB { return db.Order("created_at ASC") }).
+		Preload("Notes.Author").
+		Where("id = ?", id).
+		First(&report).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return &report, nil
+}
+
+// HasUnresolved verifica si el usuario tiene una denuncia sin resolver sobre el contenido
+func (r *ContentReportRepository) HasUnresolved(ctx context.Context, reporterID string, resourceType models.ReportResourceType, resourceID string) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.ContentReport{}).
+		Where("reporter_id = ? AND resource_type = ? AND resource_id = ?", reporterID, resourceType, resourceID).
+		Where("status IN ?", []models.ReportStatus{models.ReportStatusOpen, models.ReportStatusInReview}).
+		Count(&count).Error
+	if err != nil {
+		return false, common.MapGormError(err)
+	}
+	return count > 0, nil
+}
+
+// AddNote guarda una nota de moderación
+func (r *ContentReportRepository) AddNote(ctx context.Context, note *models.ModerationNote) error {
+	err := r.db.WithContext(ctx).Create(note).Error
+	return common.MapGormError(err)
+}
@@ -0,0 +1,96 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/models"
+)
+
+// DataExportRepository repositorio para las exportaciones de datos personales
+type DataExportRepository struct {
+	*BaseRepository[models.DataExport]
+}
+
+// NewDataExportRepository crea una nueva instancia
+func NewDataExportRepository() *DataExportRepository {
+	return &DataExportRepository{BaseRepository: NewBaseRepository[models.DataExport]()}
+}
+
+// GetByUser obtiene las exportaciones de un usuario sin el archivo, de la más reciente a la más antigua
+func (r *DataExportRepository) GetByUser(ctx context.Context, userID string) ([]*models.DataExport, error) {
+	var exports []*models.DataExport
+	err := r.db.WithContext(ctx).
+		Omit("archive").
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Find(&exports).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return exports, nil
+}
+
+// GetForUser obtiene una exportación del usuario sin el archivo
+func (r *DataExportRepository) GetForUser(ctx context.Context, id, userID string) (*models.DataExport, error) {
+	var export models.DataExport
+	err := r.db.WithContext(ctx).
+		Omit("archive").
+		Where("id = ? AND user_id = ?", id, userID).
+		First(&export).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return &export, nil
+}
+
+// GetWithArchive obtiene una exportación del usuario con el archivo
+func (r *DataExportRepository) GetWithArchive(ctx context.Context, id, userID string) (*models.DataExport, error) {
+	var export models.DataExport
+	err := r.db.WithContext(ctx).
+		Where("id = ? AND user_id = ?", id, userID).
+		First(&export).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return &export, nil
+}
+
+// HasInProgress indica si el usuario tiene una exportación en curso creada después de since
+func (r *DataExportRepository) HasInProgress(ctx context.Context, userID string, since time.Time) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.DataExport{}).
+		Where("user_id = ? AND status IN ? AND created_at > ?", userID,
+			[]models.DataExportStatus{models.DataExportStatusPending, models.DataExportStatusProcessing}, since).
+		Count(&count).Error
+	return count > 0, common.MapGormError(err)
+}
+
+// ExpireReady borra el archivo de las exportaciones vencidas
+func (r *DataExportRepository) ExpireReady(ctx context.Context, now time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).Model(&models.DataExport{}).
+		Where("status = ? AND expires_at <= ?", models.DataExportStatusReady, now).
+		UpdateColumns(map[string]interface{}{
+			"status":     models.DataExportStatusExpired,
+			"archive":    nil,
+			"updated_at": now,
+		})
+	return result.RowsAffected, common.MapGormError(result.Error)
+}
+
+// FailStale marca como fallidas las exportaciones en curso creadas antes de
+// before (interrumpidas por un reinicio del servidor)
+func (r *DataExportRepository) FailStale(ctx context.Context, before time.Time) (int64, error) {
+	now := time.Now()
+	result := r.db.WithContext(ctx).Model(&models.DataExport{}).
+		Where("status IN ? AND created_at < ?",
+			[]models.DataExportStatus{models.DataExportStatusPending, models.DataExportStatusProcessing}, before).
+		UpdateColumns(map[string]interface{}{
+			"status":         models.DataExportStatusFailed,
+			"failure_reason": "interrupted",
+			"completed_at":   now,
+			"updated_at":     now,
+		})
+	return result.RowsAffected, common.MapGormError(result.Error)
+}
@@ -0,0 +1,67 @@
+package repositories
+
+import (
+	"context"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/models"
+)
+
+// EntityVersionRepository repositorio para el historial de versiones
+type EntityVersionRepository struct {
+	*BaseRepository[models.EntityVersion]
+}
+
+// NewEntityVersionRepository crea una nueva instancia
+func NewEntityVersionRepository() *EntityVersionRepository {
+	return &EntityVersionRepository{BaseRepository: NewBaseRepository[models.EntityVersion]()}
+}
+
+// GetByResource obtiene las versiones de un registro, de la más reciente a la más antigua
+func (r *EntityVersionRepository) GetByResource(ctx context.Context, resource, resourceID string, opts common.QueryOptions) ([]*models.EntityVersion, *common.PaginationMeta, error) {
+	query := r.db.WithContext(ctx).
+		Model(&models.EntityVersion{}).
+		Where("resource = ? AND resource_id = ?", resource, resourceID)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, nil, common.MapGormError(err)
+	}
+
+	var versions []*models.EntityVersion
+	err := query.
+		Order("version DESC").
+		Offset(opts.Offset).
+		Limit(opts.Limit).
+		Find(&versions).Error
+	if err != nil {
+		return nil, nil, common.MapGormError(err)
+	}
+
+	return versions, common.NewPaginationMeta(opts.Page, opts.Limit, total), nil
+}
+
+// GetVersion obtiene una versión concreta de un registro
+func (r *EntityVersionRepository) GetVersion(ctx context.Context, resource, resourceID string, version int) (*models.EntityVersion, error) {
+	var entityVersion models.EntityVersion
+	err := r.db.WithContext(ctx).
+		Where("resource = ? AND resource_id = ? AND version = ?", resource, resourceID, version).
+		First(&entityVersion).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return &entityVersion, nil
+}
+
+// GetLatest obtiene la última versión de un registro
+func (r *EntityVersionRepository) GetLatest(ctx context.Context, resource, resourceID string) (*models.EntityVersion, error) {
+	var entityVersion models.EntityVersion
+	err := r.db.WithContext(ctx).
+		Where("resource = ? AND resource_id = ?", resource, resourceID).
+		Order("version DESC").
+		First(&entityVersion).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return &entityVersion, nil
+}
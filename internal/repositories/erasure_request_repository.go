@@ -0,0 +1,158 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ErasureRequestRepository repositorio para las solicitudes de supresión de cuenta
+type ErasureRequestRepository struct {
+	*BaseRepository[models.ErasureRequest]
+}
+
+// NewErasureRequestRepository crea una nueva instancia
+func NewErasureRequestRepository() *ErasureRequestRepository {
+	base := NewBaseRepository[models.ErasureRequest]()
+
+	base.builder.SetAllowedFilters(map[string]string{
+		"user_id": "=",
+		"status":  "=",
+	})
+
+	base.builder.SetAllowedSorts([]string{
+		"created_at", "scheduled_for", "completed_at",
+	})
+
+	return &ErasureRequestRepository{BaseRepository: base}
+}
+
+// GetWithUser obtiene una solicitud con su usuario
+func (r *ErasureRequestRepository) GetWithUser(ctx context.Context, id string) (*models.ErasureRequest, error) {
+	var request models.ErasureRequest
+	err := r.db.WithContext(ctx).
+		Preload("User").
+		Where("id = ?", id).
+		First(&request).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return &request, nil
+}
+
+// GetLatestByUser obtiene la última solicitud de un usuario
+func (r *ErasureRequestRepository) GetLatestByUser(ctx context.Context, userID string) (*models.ErasureRequest, error) {
+	var request models.ErasureRequest
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		First(&request).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return &request, nil
+}
+
+// GetByStatus obtiene las solicitudes con un estado y su usuario
+func (r *ErasureRequestRepository) GetByStatus(ctx context.Context, status models.ErasureStatus, opts common.QueryOptions) ([]*models.ErasureRequest, *common.PaginationMeta, error) {
+	opts.AddFilter("status", string(status))
+	opts.Preloads = append(opts.Preloads, "User")
+	return r.GetAll(ctx, opts)
+}
+
+// GetDue obtiene las solicitudes pendientes cuyo plazo de gracia ha vencido
+func (r *ErasureRequestRepository) GetDue(ctx context.Context, now time.Time, limit int) ([]*models.ErasureRequest, error) {
+	var requests []*models.ErasureRequest
+	err := r.db.WithContext(ctx).
+		Where("status = ? AND scheduled_for <= ?", models.ErasureStatusPending, now).
+		Order("scheduled_for ASC").
+		Limit(limit).
+		Find(&requests).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return requests, nil
+}
+
+// Execute guarda el usuario anonimizado y elimina en la misma transacción los
+// datos personales sin valor estadístico: sesiones, feeds de calendario,
+// notificaciones, exportaciones, los datos de ponente de las propuestas no
+// aceptadas y los perfiles de ponente no publicados. Inscripciones, favoritos,
+// seguimientos y revisiones se conservan asociados al usuario anónimo; pedidos
+// y facturas, por obligación legal
+func (r *ErasureRequestRepository) Execute(ctx context.Context, request *models.ErasureRequest, user *models.User) error {
+	userID := user.ID.String()
+
+	err := r.db.WithContext(models.ContextWithErasure(ctx)).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Save(user).Error; err != nil {
+			return err
+		}
+
+		// Borrado definitivo: un borrado lógico conservaría los datos
+		for _, model := range []interface{}{
+			&models.RefreshToken{},
+			&models.CalendarFeed{},
+			&models.Notification{},
+			&models.DataExport{},
+		} {
+			if err := tx.Unscoped().Where("user_id = ?", userID).Delete(model).Error; err != nil {
+				return err
+			}
+		}
+
+		err := tx.Model(&models.TalkSubmission{}).
+			Where("submitter_id = ? AND status <> ?", userID, models.SubmissionStatusAccepted).
+			UpdateColumns(map[string]interface{}{
+				"speaker_name":     "Ponente eliminado",
+				"speaker_bio":      "",
+				"speaker_company":  "",
+				"speaker_position": "",
+			}).Error
+		if err != nil {
+			return err
+		}
+
+		if err := anonymizeSpeakers(tx, userID); err != nil {
+			return err
+		}
+
+		return tx.Omit(clause.Associations).Save(request).Error
+	})
+	return common.MapGormError(err)
+}
+
+// anonymizeSpeakers desvincula del usuario sus perfiles de ponente y borra su
+// contacto. Los perfiles que no figuran en ninguna sesión no se han publicado
+// y se anonimizan; los de una agenda conservan los datos públicos, como las
+// propuestas aceptadas. Incluye los perfiles borrados lógicamente
+func anonymizeSpeakers(tx *gorm.DB, userID string) error {
+	err := tx.Unscoped().Model(&models.Speaker{}).
+		Where("user_id = ?", userID).
+		Where("NOT EXISTS (SELECT 1 FROM event_session_speakers WHERE event_session_speakers.speaker_id = speakers.id)").
+		UpdateColumns(map[string]interface{}{
+			"name":      "Ponente eliminado",
+			"position":  "",
+			"company":   "",
+			"bio":       "",
+			"photo_url": "",
+			"website":   "",
+			"linked_in": "",
+			"twitter":   "",
+			"git_hub":   "",
+		}).Error
+	if err != nil {
+		return err
+	}
+
+	return tx.Unscoped().Model(&models.Speaker{}).
+		Where("user_id = ?", userID).
+		UpdateColumns(map[string]interface{}{
+			"user_id": nil,
+			"email":   "",
+		}).Error
+}
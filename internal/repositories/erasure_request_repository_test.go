@@ -0,0 +1,70 @@
+package repositories
+
+import (
+	"testing"
+
+	"github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// TestAnonymizeSpeakers los perfiles de ponente del usuario se desvinculan y
+// pierden el contacto; los no publicados se anonimizan del todo
+func TestAnonymizeSpeakers(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: logger.Discard})
+	require.NoError(t, err)
+
+	for _, ddl := range []string{
+		`CREATE TABLE speakers (id TEXT PRIMARY KEY, user_id TEXT, name TEXT, position TEXT, company TEXT,
+			bio TEXT, photo_url TEXT, website TEXT, linked_in TEXT, twitter TEXT, git_hub TEXT, email TEXT, deleted_at DATETIME)`,
+		"CREATE TABLE event_session_speakers (event_session_id TEXT, speaker_id TEXT)",
+	} {
+		require.NoError(t, db.Exec(ddl).Error)
+	}
+
+	insert := "INSERT INTO speakers VALUES (?, ?, ?, 'CISO', 'Acme', 'Bio', 'https://cdn/p.jpg', 'https://web', 'in/ana', '@ana', 'ana', 'ana@acme.com', ?)"
+	require.NoError(t, db.Exec(insert, "speaker-public", "user-1", "Ana Pérez", nil).Error)
+	require.NoError(t, db.Exec(insert, "speaker-private", "user-1", "Ana Pérez", nil).Error)
+	require.NoError(t, db.Exec(insert, "speaker-deleted", "user-1", "Ana Pérez", "2026-01-01 00:00:00").Error)
+	require.NoError(t, db.Exec(insert, "speaker-other", "user-2", "Luis Gómez", nil).Error)
+	require.NoError(t, db.Exec("INSERT INTO event_session_speakers VALUES ('session-1', 'speaker-public')").Error)
+
+	require.NoError(t, anonymizeSpeakers(db, "user-1"))
+
+	type row struct {
+		ID     string
+		UserID *string
+		Name   string
+		Bio    string
+		Email  string
+		GitHub string `gorm:"column:git_hub"`
+	}
+	var rows []row
+	require.NoError(t, db.Raw("SELECT id, user_id, name, bio, email, git_hub FROM speakers ORDER BY id").Scan(&rows).Error)
+	require.Len(t, rows, 4)
+
+	// speaker-deleted: borrado lógico y sin sesiones, también se anonimiza
+	assert.Nil(t, rows[0].UserID)
+	assert.Equal(t, "Ponente eliminado", rows[0].Name)
+	assert.Empty(t, rows[0].Email)
+
+	// speaker-other: de otro usuario, intacto
+	require.NotNil(t, rows[1].UserID)
+	assert.Equal(t, "user-2", *rows[1].UserID)
+	assert.Equal(t, "ana@acme.com", rows[1].Email)
+
+	// speaker-private: sin sesiones, anonimizado
+	assert.Nil(t, rows[2].UserID)
+	assert.Equal(t, "Ponente eliminado", rows[2].Name)
+	assert.Empty(t, rows[2].Bio)
+	assert.Empty(t, rows[2].GitHub)
+	assert.Empty(t, rows[2].Email)
+
+	// speaker-public: en una agenda, conserva los datos públicos
+	assert.Nil(t, rows[3].UserID)
+	assert.Equal(t, "Ana Pérez", rows[3].Name)
+	assert.Equal(t, "Bio", rows[3].Bio)
+	assert.Empty(t, rows[3].Email)
+}
@@ -0,0 +1,277 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/models"
+
+	"gorm.io/gorm"
+)
+
+// Errores de reserva de plaza (el servicio los traduce a errores de negocio)
+var (
+	ErrAlreadyRegistered  = errors.New("user already registered")
+	ErrTicketSoldOut      = errors.New("ticket type sold out")
+	ErrPromoCodeExhausted = errors.New("promo code exhausted")
+	ErrEventFull          = errors.New("event full")
+)
+
+// EventRegistrationRepository repositorio para inscripciones a eventos
+type EventRegistrationRepository struct {
+	*BaseRepository[models.EventRegistration]
+}
+
+// NewEventRegistrationRepository crea una nueva instancia
+func NewEventRegistrationRepository() *EventRegistrationRepository {
+	base := NewBaseRepository[models.EventRegistration]()
+
+	base.builder.SetAllowedFilters(map[string]string{
+		"event_id":       "=",
+		"user_id":        "=",
+		"ticket_type_id": "=",
+		"status":         "=",
+	})
+
+	base.builder.SetAllowedSorts([]string{
+		"created_at", "confirmed_at", "total_cents",
+	})
+
+	return &EventRegistrationRepository{BaseRepository: base}
+}
+
+// GetActiveByEventAndUser obtiene la inscripción activa de un usuario en un evento
+func (r *EventRegistrationRepository) GetActiveByEventAndUser(ctx context.Context, eventID, userID string) (*models.EventRegistration, error) {
+	var registration models.EventRegistration
+	err := r.db.WithContext(ctx).
+		Preload("TicketType").
+		Preload("Order").
+		Where("event_id = ? AND user_id = ? AND status <> ?", eventID, userID, models.RegistrationStatusCanceled).
+		First(&registration).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return &registration, nil
+}
+
+// GetActiveUserIDsByEvent obtiene los usuarios con inscripción activa en un evento
+func (r *EventRegistrationRepository) GetActiveUserIDsByEvent(ctx context.Context, eventID string) ([]string, error) {
+	var userIDs []string
+	err := r.db.WithContext(ctx).Model(&models.EventRegistration{}).
+		Where("event_id = ? AND status <> ?", eventID, models.RegistrationStatusCanceled).
+		Distinct().
+		Pluck("user_id", &userIDs).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return userIDs, nil
+}
+
+// GetByUser obtiene las inscripciones de un usuario con su evento y entrada
+func (r *EventRegistrationRepository) GetByUser(ctx context.Context, userID string, opts common.QueryOptions) ([]*models.EventRegistration, *common.PaginationMeta, error) {
+	opts.AddFilter("user_id", userID)
+	opts.Preloads = append(opts.Preloads, "Event", "TicketType", "Order")
+	return r.GetAll(ctx, opts)
+}
+
+// GetAllByUser obtiene todas las inscripciones de un usuario con su evento, entrada y pedido
+func (r *EventRegistrationRepository) GetAllByUser(ctx context.Context, userID string) ([]*models.EventRegistration, error) {
+	var registrations []*models.EventRegistration
+	err := r.db.WithContext(ctx).
+		Preload("Event").
+		Preload("TicketType").
+		Preload("Order").
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Find(&registrations).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return registrations, nil
+}
+
+// GetUpcomingActiveByUser obtiene las inscripciones activas de un usuario en
+// eventos que aún no han empezado, con su pedido
+func (r *EventRegistrationRepository) GetUpcomingActiveByUser(ctx context.Context, userID string, now time.Time) ([]*models.EventRegistration, error) {
+	var registrations []*models.EventRegistration
+	err := r.db.WithContext(ctx).
+		Preload("Order").
+		Joins("JOIN events ON events.id = event_registrations.event_id").
+		Where("event_registrations.user_id = ? AND event_registrations.status <> ? AND events.start_date > ?",
+			userID, models.RegistrationStatusCanceled, now).
+		Order("events.start_date ASC").
+		Find(&registrations).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return registrations, nil
+}
+
+// GetByEvent obtiene las inscripciones de un evento con su asistente y entrada
+func (r *EventRegistrationRepository) GetByEvent(ctx context.Context, eventID string, opts common.QueryOptions) ([]*models.EventRegistration, *common.PaginationMeta, error) {
+	opts.AddFilter("event_id", eventID)
+	opts.Preloads = append(opts.Preloads, "User", "TicketType")
+	return r.GetAll(ctx, opts)
+}
+
+// CountByStatus cuenta las inscripciones de un evento agrupadas por estado
+func (r *EventRegistrationRepository) CountByStatus(ctx context.Context, eventID string) (map[models.RegistrationStatus]int, error) {
+	var rows []struct {
+		Status models.RegistrationStatus
+		Count  int
+	}
+
+	err := r.db.WithContext(ctx).
+		Model(&models.EventRegistration{}).
+		Select("status, COUNT(*) AS count").
+		Where("event_id = ?", eventID).
+		Group("status").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+
+	counts := make(map[models.RegistrationStatus]int, len(rows))
+	for _, row := range rows {
+		counts[row.Status] = row.Count
+	}
+	return counts, nil
+}
+
+// Reserve crea la inscripción reservando de forma atómica la plaza del evento,
+// el cupo del tipo de entrada y un uso del código de descuento. Cada contador
+// se incrementa con una condición sobre su límite, de modo que dos reservas
+// concurrentes nunca pueden superar el cupo.
+func (r *EventRegistrationRepository) Reserve(ctx context.Context, registration *models.EventRegistration) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var existing int64
+		err := tx.Model(&models.EventRegistration{}).
+			Where("event_id = ? AND user_id = ? AND status <> ?",
+				registration.EventID, registration.UserID, models.RegistrationStatusCanceled).
+			Count(&existing).Error
+		if err != nil {
+			return err
+		}
+		if existing > 0 {
+			return ErrAlreadyRegistered
+		}
+
+		if registration.TicketTypeID != nil {
+			result := tx.Model(&models.TicketType{}).
+				Where("id = ? AND (quantity IS NULL OR quantity_sold < quantity)", *registration.TicketTypeID).
+				UpdateColumn("quantity_sold", gorm.Expr("quantity_sold + 1"))
+			if err := reservationResult(result, ErrTicketSoldOut); err != nil {
+				return err
+			}
+		}
+
+		if registration.PromoCodeID != nil {
+			result := tx.Model(&models.PromoCode{}).
+				Where("id = ? AND (max_uses IS NULL OR used_count < max_uses)", *registration.PromoCodeID).
+				UpdateColumn("used_count", gorm.Expr("used_count + 1"))
+			if err := reservationResult(result, ErrPromoCodeExhausted); err != nil {
+				return err
+			}
+		}
+
+		result := tx.Model(&models.Event{}).
+			Where("id = ? AND (max_attendees IS NULL OR current_attendees < max_attendees)", registration.EventID).
+			UpdateColumn("current_attendees", gorm.Expr("current_attendees + 1"))
+		if err := reservationResult(result, ErrEventFull); err != nil {
+			return err
+		}
+
+		return tx.Create(registration).Error
+	})
+
+	return mapReservationError(err)
+}
+
+// Release cancela una inscripción activa y devuelve la plaza, el cupo de la
+// entrada y el uso del código de descuento
+func (r *EventRegistrationRepository) Release(ctx context.Context, registration *models.EventRegistration) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		released, err := releaseRegistration(tx, registration)
+		if err != nil {
+			return err
+		}
+		if !released {
+			return common.ErrNotFound
+		}
+		return nil
+	})
+
+	return mapReservationError(err)
+}
+
+// releaseRegistration cancela una inscripción dentro de una transacción y
+// libera sus contadores. Devuelve false si ya estaba cancelada.
+func releaseRegistration(tx *gorm.DB, registration *models.EventRegistration) (bool, error) {
+	now := time.Now()
+	result := tx.Model(&models.EventRegistration{}).
+		Where("id = ? AND status <> ?", registration.ID, models.RegistrationStatusCanceled).
+		UpdateColumns(map[string]interface{}{
+			"status":      models.RegistrationStatusCanceled,
+			"canceled_at": now,
+			"updated_at":  now,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return false, nil
+	}
+
+	if registration.TicketTypeID != nil {
+		if err := tx.Model(&models.TicketType{}).
+			Where("id = ?", *registration.TicketTypeID).
+			UpdateColumn("quantity_sold", gorm.Expr("GREATEST(quantity_sold - 1, 0)")).Error; err != nil {
+			return false, err
+		}
+	}
+
+	if registration.PromoCodeID != nil {
+		if err := tx.Model(&models.PromoCode{}).
+			Where("id = ?", *registration.PromoCodeID).
+			UpdateColumn("used_count", gorm.Expr("GREATEST(used_count - 1, 0)")).Error; err != nil {
+			return false, err
+		}
+	}
+
+	if err := tx.Model(&models.Event{}).
+		Where("id = ?", registration.EventID).
+		UpdateColumn("current_attendees", gorm.Expr("GREATEST(current_attendees - 1, 0)")).Error; err != nil {
+		return false, err
+	}
+
+	registration.Status = models.RegistrationStatusCanceled
+	registration.CanceledAt = &now
+	return true, nil
+}
+
+// reservationResult convierte una actualización condicional sin filas afectadas en el error indicado
+func reservationResult(result *gorm.DB, notAffected error) error {
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return notAffected
+	}
+	return nil
+}
+
+// mapReservationError conserva los errores de reserva y mapea el resto
+func mapReservationError(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, ErrAlreadyRegistered), errors.Is(err, ErrTicketSoldOut),
+		errors.Is(err, ErrPromoCodeExhausted), errors.Is(err, ErrEventFull),
+		errors.Is(err, common.ErrNotFound):
+		return err
+	default:
+		return common.MapGormError(err)
+	}
+}
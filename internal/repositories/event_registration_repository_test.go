@@ -0,0 +1,64 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cybesphere-backend/internal/models"
+
+	"github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// TestGetUpcomingActiveByUser solo devuelve las inscripciones activas del
+// usuario en eventos que aún no han empezado, con su pedido
+func TestGetUpcomingActiveByUser(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: logger.Discard})
+	require.NoError(t, err)
+
+	for _, ddl := range []string{
+		"CREATE TABLE events (id TEXT PRIMARY KEY, start_date DATETIME, deleted_at DATETIME)",
+		`CREATE TABLE event_registrations (id TEXT PRIMARY KEY, created_at DATETIME, updated_at DATETIME, deleted_at DATETIME,
+			created_by TEXT, updated_by TEXT, event_id TEXT, user_id TEXT, ticket_type_id TEXT, promo_code_id TEXT,
+			price_cents INTEGER, discount_cents INTEGER, total_cents INTEGER, currency TEXT, status TEXT,
+			confirmed_at DATETIME, canceled_at DATETIME)`,
+		"CREATE TABLE orders (id TEXT PRIMARY KEY, registration_id TEXT, status TEXT, deleted_at DATETIME)",
+	} {
+		require.NoError(t, db.Exec(ddl).Error)
+	}
+
+	now := time.Now()
+	seed := []struct {
+		query string
+		args  []interface{}
+	}{
+		{"INSERT INTO events VALUES (?, ?, NULL)", []interface{}{"event-future", now.Add(48 * time.Hour)}},
+		{"INSERT INTO events VALUES (?, ?, NULL)", []interface{}{"event-past", now.Add(-48 * time.Hour)}},
+		{"INSERT INTO event_registrations (id, event_id, user_id, status) VALUES (?, ?, ?, ?)", []interface{}{
+			"11111111-1111-1111-1111-111111111111", "event-future", "user-1", models.RegistrationStatusPending}},
+		{"INSERT INTO event_registrations (id, event_id, user_id, status) VALUES (?, ?, ?, ?)", []interface{}{
+			"22222222-2222-2222-2222-222222222222", "event-past", "user-1", models.RegistrationStatusConfirmed}},
+		{"INSERT INTO event_registrations (id, event_id, user_id, status) VALUES (?, ?, ?, ?)", []interface{}{
+			"33333333-3333-3333-3333-333333333333", "event-future", "user-1", models.RegistrationStatusCanceled}},
+		{"INSERT INTO event_registrations (id, event_id, user_id, status) VALUES (?, ?, ?, ?)", []interface{}{
+			"44444444-4444-4444-4444-444444444444", "event-future", "user-2", models.RegistrationStatusConfirmed}},
+		{"INSERT INTO orders VALUES (?, ?, ?, NULL)", []interface{}{
+			"55555555-5555-5555-5555-555555555555", "11111111-1111-1111-1111-111111111111", models.OrderStatusPending}},
+	}
+	for _, row := range seed {
+		require.NoError(t, db.Exec(row.query, row.args...).Error)
+	}
+
+	repo := &EventRegistrationRepository{BaseRepository: &BaseRepository[models.EventRegistration]{db: db}}
+	registrations, err := repo.GetUpcomingActiveByUser(context.Background(), "user-1", now)
+	require.NoError(t, err)
+
+	require.Len(t, registrations, 1)
+	assert.Equal(t, "11111111-1111-1111-1111-111111111111", registrations[0].ID.String())
+	require.NotNil(t, registrations[0].Order)
+	assert.Equal(t, models.OrderStatusPending, registrations[0].Order.Status)
+}
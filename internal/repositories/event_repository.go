@@ -2,28 +2,39 @@ package repositories
 
 import (
 	"context"
+	"encoding/json"
+	"sync"
 	"time"
 
 	"cybesphere-backend/internal/common"
 	"cybesphere-backend/internal/models"
+	"cybesphere-backend/internal/query"
+	"cybesphere-backend/pkg/geo"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // EventRepository repositorio específico para eventos
 type EventRepository struct {
 	*BaseRepository[models.Event]
+
+	// Presencia de PostGIS; solo se guarda una vez obtenida de la base de datos
+	postgisMu      sync.Mutex
+	postgisChecked bool
+	postgis        bool
 }
 
 // NewEventRepository crea una nueva instancia
 func NewEventRepository() *EventRepository {
 	base := NewBaseRepository[models.Event]()
 
-	// Configurar filtros permitidos para eventos
+	// Configurar filtros permitidos para eventos (IN y JSONB_* admiten varios
+	// valores separados por comas)
 	base.builder.SetAllowedFilters(map[string]string{
-		"type":            "=",
+		"type":            "IN",
 		"category":        "LIKE",
-		"level":           "=",
+		"level":           "IN",
 		"status":          "=",
 		"is_online":       "=",
 		"is_free":         "=",
@@ -34,8 +45,62 @@ func NewEventRepository() *EventRepository {
 		"venue_country":   "LIKE",
 		"start_date":      ">=",
 		"end_date":        "<=",
+
+		// Filtros del catálogo público
+		"city":            "IN",
+		"country":         "IN",
+		"min_price":       ">=",
+		"max_price":       "<=",
+		"start_date_from": "DATE_FROM",
+		"start_date_to":   "DATE_TO",
+		"date":            "DATE_PRESET",
+		"tags":            "JSONB_ANY",
+		"tags_all":        "JSONB_ALL",
+	})
+	base.builder.SetFilterColumns(map[string]string{
+		"city":            "venue_city",
+		"country":         "venue_country",
+		"min_price":       "price",
+		"max_price":       "price",
+		"start_date_from": "start_date",
+		"start_date_to":   "start_date",
+		"date":            "start_date,end_date",
+		"tags_all":        "tags",
 	})
 
+	// Campos admitidos en las expresiones ?filter=
+	base.builder.SetFilterFields(map[string]query.FilterField{
+		"title":             {Column: "title", Type: query.FieldString},
+		"type":              {Column: "type", Type: query.FieldString, Values: eventTypeValues()},
+		"category":          {Column: "category", Type: query.FieldString},
+		"level":             {Column: "level", Type: query.FieldString, Values: []string{"beginner", "intermediate", "advanced"}},
+		"status":            {Column: "status", Type: query.FieldString},
+		"organization_id":   {Column: "organization_id", Type: query.FieldString},
+		"city":              {Column: "venue_city", Type: query.FieldString},
+		"country":           {Column: "venue_country", Type: query.FieldString},
+		"is_online":         {Column: "is_online", Type: query.FieldBool},
+		"is_free":           {Column: "is_free", Type: query.FieldBool},
+		"is_featured":       {Column: "is_featured", Type: query.FieldBool},
+		"price":             {Column: "price", Type: query.FieldNumber},
+		"views_count":       {Column: "views_count", Type: query.FieldNumber},
+		"current_attendees": {Column: "current_attendees", Type: query.FieldNumber},
+		"start_date":        {Column: "start_date", Type: query.FieldTime},
+		"end_date":          {Column: "end_date", Type: query.FieldTime},
+		"created_at":        {Column: "created_at", Type: query.FieldTime},
+		"tags":              {Column: "tags", Type: query.FieldArray},
+	})
+
+	// Facetas del catálogo público
+	base.builder.SetFacets(
+		query.Facet{Name: "type", Column: "type", Filters: []string{"type"}},
+		query.Facet{Name: "level", Column: "level", Filters: []string{"level"}},
+		query.Facet{Name: "is_online", Column: "is_online", Filters: []string{"is_online"}},
+		query.Facet{Name: "is_free", Column: "is_free", Filters: []string{"is_free", "min_price", "max_price"}},
+		query.Facet{Name: "city", Column: "venue_city", Filters: []string{"city", "venue_city"}},
+		query.Facet{Name: "country", Column: "venue_country", Filters: []string{"country", "venue_country"}},
+		query.Facet{Name: "tags", Column: "tags", Array: true, Filters: []string{"tags"}, Limit: 30},
+	)
+
 	// Configurar campos de ordenamiento permitidos
 	base.builder.SetAllowedSorts([]string{
 		"start_date", "end_date", "created_at", "updated_at",
@@ -47,9 +112,28 @@ func NewEventRepository() *EventRepository {
 		"title", "description", "short_desc",
 	})
 
+	// Relaciones que se pueden incluir con ?expand=
+	base.builder.SetExpansions(map[string]query.Expansion{
+		"organization": {Preloads: []string{"Organization"}},
+		"speakers":     {Preloads: []string{"Sessions.Speakers"}},
+	})
+
 	return &EventRepository{BaseRepository: base}
 }
 
+// eventTypeValues tipos de evento admitidos en las expresiones de filtro
+func eventTypeValues() []string {
+	types := []models.EventType{
+		models.EventTypeConference, models.EventTypeWorkshop, models.EventTypeMeetup,
+		models.EventTypeWebinar, models.EventTypeTraining, models.EventTypeCompetition, models.EventTypeOther,
+	}
+	values := make([]string, 0, len(types))
+	for _, eventType := range types {
+		values = append(values, string(eventType))
+	}
+	return values
+}
+
 // GetPublicEvents obtiene eventos públicos para usuarios no autenticados
 func (r *EventRepository) GetPublicEvents(ctx context.Context, opts common.QueryOptions) ([]*models.Event, *common.PaginationMeta, error) {
 	// Agregar filtros específicos para eventos públicos
@@ -65,6 +149,19 @@ func (r *EventRepository) GetByOrganization(ctx context.Context, organizationID
 	return r.GetAll(ctx, opts)
 }
 
+// HasPublishedEvents verifica si la organización ha publicado algún evento
+func (r *EventRepository) HasPublishedEvents(ctx context.Context, organizationID string) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.Event{}).
+		Where("organization_id = ?", organizationID).
+		Where("published_at IS NOT NULL OR status IN ?", []models.EventStatus{models.EventStatusPublished, models.EventStatusCompleted}).
+		Count(&count).Error
+	if err != nil {
+		return false, common.MapGormError(err)
+	}
+	return count > 0, nil
+}
+
 // GetUpcoming obtiene eventos futuros
 func (r *EventRepository) GetUpcoming(ctx context.Context, opts common.QueryOptions) ([]*models.Event, *common.PaginationMeta, error) {
 	opts.AddFilter("start_date", time.Now())
@@ -134,26 +231,18 @@ func (r *EventRepository) IncrementViews(ctx context.Context, id string) error {
 	return common.MapGormError(err)
 }
 
-// UpdateStatus actualiza el estado de un evento
-func (r *EventRepository) UpdateStatus(ctx context.Context, id string, status models.EventStatus) error {
-	updates := map[string]interface{}{
-		"status": status,
+// ApplyTransition guarda las columnas del ciclo de vida de un evento al que
+// ya se ha aplicado la transición. Solo se actualiza si el estado sigue
+// siendo el de origen; false si otro cambio se ha adelantado
+func (r *EventRepository) ApplyTransition(ctx context.Context, event *models.Event, change models.EventLifecycleChange) (bool, error) {
+	result := r.db.WithContext(models.ContextWithEventTransition(ctx, change.Transition)).
+		Model(&models.Event{}).
+		Where("id = ? AND status = ?", event.ID, change.From).
+		Updates(event.LifecycleColumns())
+	if result.Error != nil {
+		return false, common.MapGormError(result.Error)
 	}
-
-	// Agregar timestamps específicos según el estado
-	switch status {
-	case models.EventStatusPublished:
-		updates["published_at"] = time.Now()
-	case models.EventStatusCanceled:
-		updates["canceled_at"] = time.Now()
-	case models.EventStatusCompleted:
-		updates["completed_at"] = time.Now()
-	}
-
-	err := r.db.WithContext(ctx).Model(&models.Event{}).
-		Where("id = ?", id).
-		Updates(updates).Error
-	return common.MapGormError(err)
+	return result.RowsAffected > 0, nil
 }
 
 // GetEventsByTags obtiene eventos por tags
@@ -166,20 +255,360 @@ func (r *EventRepository) GetEventsByTags(ctx context.Context, tags []string, op
 	return r.GetAll(ctx, opts)
 }
 
-// GetNearEvents obtiene eventos cerca de una ubicación
-func (r *EventRepository) GetNearEvents(ctx context.Context, latitude, longitude float64, radiusKm int, opts common.QueryOptions) ([]*models.Event, *common.PaginationMeta, error) {
-	// Query geoespacial usando PostGIS
-	r.builder.AddWhere(`
-		ST_DWithin(
-			ST_SetSRID(ST_MakePoint(longitude, latitude), 4326)::geography,
-			ST_SetSRID(ST_MakePoint(?, ?), 4326)::geography,
-			?
-		)
-	`, longitude, latitude, radiusKm*1000) // radiusKm a metros
+// eventGeography punto del evento como geography; coincide con la expresión
+// del índice idx_events_geography para que PostGIS pueda usarlo
+const eventGeography = "(ST_SetSRID(ST_MakePoint(events.longitude, events.latitude), 4326)::geography)"
+
+// eventHaversine distancia en km del evento a un punto (lat, lat, lng) sin PostGIS
+const eventHaversine = `(2 * 6371 * asin(LEAST(1, sqrt(
+	power(sin(radians(events.latitude - ?) / 2), 2) +
+	cos(radians(?)) * cos(radians(events.latitude)) * power(sin(radians(events.longitude - ?) / 2), 2)))))`
+
+// NearbyParams búsqueda de eventos presenciales alrededor de un punto
+type NearbyParams struct {
+	Center   geo.Point
+	RadiusKm float64
+	Limit    int
+	Offset   int
+}
 
-	opts.AddFilter("status", models.EventStatusPublished)
-	opts.AddFilter("is_public", true)
-	opts.AddFilter("is_online", false) // Solo eventos presenciales
+// EventDistance evento con su distancia al punto de búsqueda
+type EventDistance struct {
+	models.Event
+	DistanceKm       float64
+	OrganizationName string
+}
 
-	return r.GetAll(ctx, opts)
+// GetNearbyEvents eventos presenciales publicados y no finalizados dentro del
+// radio, ordenados por distancia. Usa PostGIS y su índice geography si está
+// instalado; si no, prefiltra con una caja delimitadora sobre idx_events_location
+// y calcula la distancia con Haversine
+func (r *EventRepository) GetNearbyEvents(ctx context.Context, params NearbyParams) ([]*EventDistance, int64, error) {
+	query := r.db.WithContext(ctx).Table("events").
+		Where("events.deleted_at IS NULL AND events.status = ? AND events.is_public = ? AND events.is_online = ?",
+			models.EventStatusPublished, true, false).
+		Where("events.latitude IS NOT NULL AND events.longitude IS NOT NULL").
+		Where("events.end_date >= ?", time.Now())
+
+	var distance clause.Expr
+	if r.hasPostGIS(ctx) {
+		center := gorm.Expr("ST_SetSRID(ST_MakePoint(?, ?), 4326)::geography", params.Center.Longitude, params.Center.Latitude)
+		query = query.Where("ST_DWithin("+eventGeography+", ?, ?)", center, params.RadiusKm*1000)
+		distance = gorm.Expr("ST_Distance("+eventGeography+", ?) / 1000", center)
+	} else {
+		box := geo.Bounds(params.Center, params.RadiusKm)
+		query = query.Where("events.latitude BETWEEN ? AND ?", box.MinLatitude, box.MaxLatitude)
+		if box.CrossesAntimeridian() {
+			query = query.Where("(events.longitude >= ? OR events.longitude <= ?)", box.MinLongitude, box.MaxLongitude)
+		} else {
+			query = query.Where("events.longitude BETWEEN ? AND ?", box.MinLongitude, box.MaxLongitude)
+		}
+		distance = gorm.Expr(eventHaversine, params.Center.Latitude, params.Center.Latitude, params.Center.Longitude)
+		query = query.Where("? <= ?", distance, params.RadiusKm)
+	}
+
+	var total int64
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, common.MapGormError(err)
+	}
+	if total == 0 {
+		return []*EventDistance{}, 0, nil
+	}
+
+	var hits []*EventDistance
+	err := query.
+		Select("events.*, organizations.name AS organization_name, ? AS distance_km", distance).
+		Joins("LEFT JOIN organizations ON organizations.id::text = events.organization_id").
+		Order("distance_km ASC, events.start_date ASC, events.id ASC").
+		Limit(params.Limit).
+		Offset(params.Offset).
+		Scan(&hits).Error
+	if err != nil {
+		return nil, 0, common.MapGormError(err)
+	}
+
+	return hits, total, nil
+}
+
+// hasPostGIS indica si la extensión PostGIS está instalada. Se consulta hasta
+// obtener respuesta: si la consulta falla (p. ej. porque se cancela la
+// petición) se usa Haversine y la siguiente búsqueda vuelve a consultarla
+func (r *EventRepository) hasPostGIS(ctx context.Context) bool {
+	r.postgisMu.Lock()
+	defer r.postgisMu.Unlock()
+
+	if !r.postgisChecked {
+		var installed bool
+		err := r.db.WithContext(ctx).
+			Raw("SELECT EXISTS (SELECT 1 FROM pg_extension WHERE extname = 'postgis')").
+			Scan(&installed).Error
+		if err != nil {
+			return false
+		}
+		r.postgis, r.postgisChecked = installed, true
+	}
+	return r.postgis
+}
+
+// AddFavorite agrega un evento a los favoritos de un usuario
+func (r *EventRepository) AddFavorite(ctx context.Context, userID, eventID string) error {
+	err := r.db.WithContext(ctx).Exec(
+		"INSERT INTO user_favorite_events (user_id, event_id) VALUES (?, ?) ON CONFLICT DO NOTHING",
+		userID, eventID,
+	).Error
+	return common.MapGormError(err)
+}
+
+// RemoveFavorite elimina un evento de los favoritos de un usuario
+func (r *EventRepository) RemoveFavorite(ctx context.Context, userID, eventID string) error {
+	err := r.db.WithContext(ctx).Exec(
+		"DELETE FROM user_favorite_events WHERE user_id = ? AND event_id = ?",
+		userID, eventID,
+	).Error
+	return common.MapGormError(err)
+}
+
+// GetFavoriteEvents obtiene los eventos favoritos de un usuario, del más
+// reciente al más antiguo por fecha de inicio
+func (r *EventRepository) GetFavoriteEvents(ctx context.Context, userID string) ([]*models.Event, error) {
+	var events []*models.Event
+	err := r.db.WithContext(ctx).
+		Where("id IN (?)", r.db.Table("user_favorite_events").
+			Select("event_id").
+			Where("user_id = ?", userID)).
+		Order("start_date DESC").
+		Find(&events).Error
+	return events, common.MapGormError(err)
+}
+
+// GetFavoriteEventIDsByUser obtiene los IDs de eventos favoritos de todos los
+// usuarios, agrupados por usuario
+func (r *EventRepository) GetFavoriteEventIDsByUser(ctx context.Context) (map[string][]string, error) {
+	var rows []struct {
+		UserID  string
+		EventID string
+	}
+	err := r.db.WithContext(ctx).
+		Table("user_favorite_events").
+		Select("user_favorite_events.user_id, user_favorite_events.event_id").
+		Joins("JOIN events ON events.id = user_favorite_events.event_id").
+		Order("user_favorite_events.user_id ASC, events.start_date DESC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+
+	result := make(map[string][]string)
+	for _, row := range rows {
+		result[row.UserID] = append(result[row.UserID], row.EventID)
+	}
+	return result, nil
+}
+
+// GetRecommendationCandidates obtiene los eventos públicos y publicados que
+// terminan después de since, con su organización, por fecha de inicio
+func (r *EventRepository) GetRecommendationCandidates(ctx context.Context, since time.Time, limit int) ([]*models.Event, error) {
+	var events []*models.Event
+	err := r.db.WithContext(ctx).
+		Preload("Organization").
+		Where("status = ? AND is_public = ?", models.EventStatusPublished, true).
+		Where("end_date >= ?", since).
+		Order("start_date ASC").
+		Limit(limit).
+		Find(&events).Error
+	return events, common.MapGormError(err)
+}
+
+// CalendarFeedMaxEvents número máximo de eventos incluidos en un feed
+const CalendarFeedMaxEvents = 500
+
+// calendarEventsQuery query base para feeds de calendario: incluye eventos
+// cancelados para que los clientes los marquen como tales
+func (r *EventRepository) calendarEventsQuery(ctx context.Context, since time.Time) *gorm.DB {
+	return r.db.WithContext(ctx).Model(&models.Event{}).
+		Where("status IN ?", []models.EventStatus{
+			models.EventStatusPublished,
+			models.EventStatusCanceled,
+			models.EventStatusCompleted,
+		}).
+		Where("end_date >= ?", since).
+		Order("start_date ASC").
+		Limit(CalendarFeedMaxEvents)
+}
+
+// GetCalendarEventsByOrganization obtiene los eventos públicos de una organización para un feed
+func (r *EventRepository) GetCalendarEventsByOrganization(ctx context.Context, organizationID string, since time.Time) ([]*models.Event, error) {
+	var events []*models.Event
+	err := r.calendarEventsQuery(ctx, since).
+		Where("organization_id = ? AND is_public = true", organizationID).
+		Find(&events).Error
+	return events, common.MapGormError(err)
+}
+
+// GetCalendarEventsByTag obtiene los eventos públicos con un tag para un feed
+func (r *EventRepository) GetCalendarEventsByTag(ctx context.Context, tag string, since time.Time) ([]*models.Event, error) {
+	tagJSON, err := json.Marshal([]string{tag})
+	if err != nil {
+		return nil, err
+	}
+
+	var events []*models.Event
+	err = r.calendarEventsQuery(ctx, since).
+		Where("is_public = true AND tags @> ?::jsonb", string(tagJSON)).
+		Find(&events).Error
+	return events, common.MapGormError(err)
+}
+
+// GetCalendarEventsForUser obtiene los eventos favoritos y con inscripción activa
+// de un usuario para un feed
+func (r *EventRepository) GetCalendarEventsForUser(ctx context.Context, userID string, since time.Time) ([]*models.Event, error) {
+	var events []*models.Event
+	err := r.calendarEventsQuery(ctx, since).
+		Where("id IN (?) OR id IN (?)",
+			r.db.Table("user_favorite_events").Select("event_id").Where("user_id = ?", userID),
+			r.db.Model(&models.EventRegistration{}).Select("event_id").
+				Where("user_id = ? AND status <> ?", userID, models.RegistrationStatusCanceled)).
+		Find(&events).Error
+	return events, common.MapGormError(err)
+}
+
+// UpdatePricing actualiza el precio mostrado del evento sin pasar por los hooks de validación
+func (r *EventRepository) UpdatePricing(ctx context.Context, eventID string, isFree bool, priceCents int) error {
+	err := r.db.WithContext(ctx).Model(&models.Event{}).
+		Where("id = ?", eventID).
+		UpdateColumns(map[string]interface{}{
+			"is_free": isFree,
+			"price":   priceCents,
+		}).Error
+	return common.MapGormError(err)
+}
+
+// SlugExists verifica si un slug está en uso, incluyendo eventos eliminados
+// (el índice único también los cubre)
+func (r *EventRepository) SlugExists(ctx context.Context, slug string) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Unscoped().Model(&models.Event{}).
+		Where("slug = ?", slug).
+		Count(&count).Error
+	if err != nil {
+		return false, common.MapGormError(err)
+	}
+	return count > 0, nil
+}
+
+// GetSeriesOccurrences obtiene las ocurrencias de una serie ordenadas por fecha
+func (r *EventRepository) GetSeriesOccurrences(ctx context.Context, seriesID string) ([]*models.Event, error) {
+	var events []*models.Event
+	err := r.db.WithContext(ctx).
+		Where("series_id = ?", seriesID).
+		Order("start_date ASC").
+		Find(&events).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return events, nil
+}
+
+// GetSeriesOccurrenceDates obtiene las fechas originales ya generadas de una serie,
+// incluyendo ocurrencias eliminadas para no volver a crearlas
+func (r *EventRepository) GetSeriesOccurrenceDates(ctx context.Context, seriesID string) ([]time.Time, error) {
+	var dates []time.Time
+	err := r.db.WithContext(ctx).Unscoped().Model(&models.Event{}).
+		Where("series_id = ? AND occurrence_date IS NOT NULL", seriesID).
+		Pluck("occurrence_date", &dates).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return dates, nil
+}
+
+// MoveSeriesOccurrences reasigna a otra serie las ocurrencias desde una fecha original
+func (r *EventRepository) MoveSeriesOccurrences(ctx context.Context, fromSeriesID, toSeriesID string, since time.Time) error {
+	err := r.db.WithContext(ctx).Unscoped().Model(&models.Event{}).
+		Where("series_id = ? AND occurrence_date >= ?", fromSeriesID, since).
+		UpdateColumn("series_id", toSeriesID).Error
+	return common.MapGormError(err)
+}
+
+// GetCancelableSeriesOccurrences obtiene las ocurrencias de una serie desde una
+// fecha original que todavía se pueden cancelar
+func (r *EventRepository) GetCancelableSeriesOccurrences(ctx context.Context, seriesID string, since time.Time) ([]*models.Event, error) {
+	var events []*models.Event
+	err := r.db.WithContext(ctx).
+		Where("series_id = ? AND occurrence_date >= ?", seriesID, since).
+		Where("status IN ?", models.EventTransitionSources(models.EventTransitionCancel)).
+		Order("occurrence_date ASC").
+		Find(&events).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return events, nil
+}
+
+// UpdateGeocodedLocation guarda las coordenadas calculadas para la dirección del
+// evento. No cambia nada si el organizador ha fijado coordenadas manuales o si la
+// dirección ha cambiado mientras se geocodificaba. Indica si se actualizó el evento
+func (r *EventRepository) UpdateGeocodedLocation(ctx context.Context, event *models.Event, point geo.Point) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&models.Event{}).
+		Where("id = ?", event.ID).
+		Where("venue_address = ? AND venue_city = ? AND venue_country = ?",
+			event.VenueAddress, event.VenueCity, event.VenueCountry).
+		Where("COALESCE(coordinates_source, '') <> ?", models.CoordinatesSourceManual).
+		UpdateColumns(map[string]interface{}{
+			"latitude":           point.Latitude,
+			"longitude":          point.Longitude,
+			"coordinates_source": models.CoordinatesSourceGeocoded,
+		})
+	if result.Error != nil {
+		return false, common.MapGormError(result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// GetPendingGeocoding obtiene, ordenados por ID, los eventos presenciales con
+// dirección y sin coordenadas posteriores a afterID
+func (r *EventRepository) GetPendingGeocoding(ctx context.Context, afterID string, limit int) ([]*models.Event, error) {
+	query := r.db.WithContext(ctx).
+		Where("is_online = ?", false).
+		Where("venue_address <> '' OR venue_city <> ''").
+		Where("latitude IS NULL OR longitude IS NULL").
+		Where("COALESCE(coordinates_source, '') <> ?", models.CoordinatesSourceManual)
+	if afterID != "" {
+		query = query.Where("id > ?", afterID)
+	}
+
+	var events []*models.Event
+	if err := query.Order("id ASC").Limit(limit).Find(&events).Error; err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return events, nil
+}
+
+// GetDueForTransition obtiene, ordenados por ID, los eventos posteriores a
+// afterID a los que les toca una transición automática: publicación
+// programada, cierre de inscripciones o fin del evento en due
+func (r *EventRepository) GetDueForTransition(ctx context.Context, transition models.EventTransition, due time.Time, afterID string, limit int) ([]*models.Event, error) {
+	query := r.db.WithContext(ctx).
+		Where("status IN ?", models.EventTransitionSources(transition))
+
+	switch transition {
+	case models.EventTransitionPublish:
+		query = query.Where("scheduled_publish_at IS NOT NULL AND scheduled_publish_at <= ?", due)
+	case models.EventTransitionCloseRegistration:
+		query = query.Where("registration_closed_at IS NULL AND registration_end_date IS NOT NULL AND registration_end_date <= ?", due)
+	case models.EventTransitionComplete:
+		query = query.Where("end_date < ?", due)
+	default:
+		return nil, common.NewValidationError("transition", "La transición no es automática: "+string(transition))
+	}
+
+	if afterID != "" {
+		query = query.Where("id > ?", afterID)
+	}
+
+	var events []*models.Event
+	if err := query.Order("id ASC").Limit(limit).Find(&events).Error; err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return events, nil
 }
@@ -0,0 +1,42 @@
+package repositories
+
+import (
+	"context"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/models"
+)
+
+// EventSeriesRepository repositorio para series de eventos recurrentes
+type EventSeriesRepository struct {
+	*BaseRepository[models.EventSeries]
+}
+
+// NewEventSeriesRepository crea una nueva instancia
+func NewEventSeriesRepository() *EventSeriesRepository {
+	base := NewBaseRepository[models.EventSeries]()
+
+	base.builder.SetAllowedFilters(map[string]string{
+		"organization_id": "=",
+		"status":          "=",
+	})
+
+	base.builder.SetAllowedSorts([]string{
+		"start_date", "created_at", "updated_at", "title",
+	})
+
+	return &EventSeriesRepository{BaseRepository: base}
+}
+
+// GetActive obtiene las series que siguen generando ocurrencias
+func (r *EventSeriesRepository) GetActive(ctx context.Context) ([]*models.EventSeries, error) {
+	var series []*models.EventSeries
+	err := r.db.WithContext(ctx).
+		Where("status = ?", models.EventSeriesStatusActive).
+		Order("created_at ASC").
+		Find(&series).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return series, nil
+}
@@ -0,0 +1,130 @@
+package repositories
+
+import (
+	"context"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/models"
+
+	"gorm.io/gorm"
+)
+
+// EventSessionRepository repositorio para sesiones de agenda
+type EventSessionRepository struct {
+	*BaseRepository[models.EventSession]
+}
+
+// NewEventSessionRepository crea una nueva instancia
+func NewEventSessionRepository() *EventSessionRepository {
+	base := NewBaseRepository[models.EventSession]()
+
+	base.builder.SetAllowedFilters(map[string]string{
+		"event_id": "=",
+		"room":     "=",
+		"track":    "=",
+		"level":    "=",
+	})
+
+	base.builder.SetAllowedSorts([]string{
+		"start_time", "end_time", "title", "created_at",
+	})
+
+	return &EventSessionRepository{BaseRepository: base}
+}
+
+// orderedSpeakers ordena los ponentes precargados por nombre
+func orderedSpeakers(db *gorm.DB) *gorm.DB {
+	return db.Order("speakers.name ASC")
+}
+
+// GetByEvent obtiene la agenda de un evento con sus ponentes
+func (r *EventSessionRepository) GetByEvent(ctx context.Context, eventID string) ([]*models.EventSession, error) {
+	var sessions []*models.EventSession
+	err := r.db.WithContext(ctx).
+		Preload("Speakers", orderedSpeakers).
+		Where("event_id = ?", eventID).
+		Order("start_time ASC, room ASC").
+		Find(&sessions).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return sessions, nil
+}
+
+// GetWithSpeakers obtiene una sesión con sus ponentes
+func (r *EventSessionRepository) GetWithSpeakers(ctx context.Context, id string) (*models.EventSession, error) {
+	var session models.EventSession
+	err := r.db.WithContext(ctx).
+		Preload("Speakers", orderedSpeakers).
+		First(&session, "id = ?", id).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return &session, nil
+}
+
+// ReplaceSpeakers reemplaza los ponentes de una sesión
+func (r *EventSessionRepository) ReplaceSpeakers(ctx context.Context, session *models.EventSession, speakers []*models.Speaker) error {
+	values := make([]models.Speaker, 0, len(speakers))
+	for _, speaker := range speakers {
+		values = append(values, *speaker)
+	}
+
+	err := r.db.WithContext(ctx).Model(session).Association("Speakers").Replace(values)
+	return common.MapGormError(err)
+}
+
+// AddFavorite agrega una sesión a la agenda personal de un usuario
+func (r *EventSessionRepository) AddFavorite(ctx context.Context, userID, sessionID string) error {
+	err := r.db.WithContext(ctx).Exec(
+		"INSERT INTO user_favorite_sessions (user_id, event_session_id) VALUES (?, ?) ON CONFLICT DO NOTHING",
+		userID, sessionID,
+	).Error
+	return common.MapGormError(err)
+}
+
+// RemoveFavorite elimina una sesión de la agenda personal de un usuario
+func (r *EventSessionRepository) RemoveFavorite(ctx context.Context, userID, sessionID string) error {
+	err := r.db.WithContext(ctx).Exec(
+		"DELETE FROM user_favorite_sessions WHERE user_id = ? AND event_session_id = ?",
+		userID, sessionID,
+	).Error
+	return common.MapGormError(err)
+}
+
+// GetFavoritesForUser obtiene la agenda personal de un usuario (sesiones futuras y en curso)
+func (r *EventSessionRepository) GetFavoritesForUser(ctx context.Context, userID string) ([]*models.EventSession, error) {
+	var sessions []*models.EventSession
+	err := r.db.WithContext(ctx).
+		Preload("Speakers", orderedSpeakers).
+		Preload("Event").
+		Where("id IN (?)", r.db.Table("user_favorite_sessions").
+			Select("event_session_id").
+			Where("user_id = ?", userID)).
+		Where("end_time >= NOW()").
+		Order("start_time ASC").
+		Find(&sessions).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return sessions, nil
+}
+
+// GetFavoriteIDs obtiene los IDs de sesiones de un evento marcadas por el usuario
+func (r *EventSessionRepository) GetFavoriteIDs(ctx context.Context, userID, eventID string) (map[string]bool, error) {
+	var ids []string
+	err := r.db.WithContext(ctx).
+		Table("user_favorite_sessions").
+		Joins("JOIN event_sessions ON event_sessions.id = user_favorite_sessions.event_session_id").
+		Where("user_favorite_sessions.user_id = ? AND event_sessions.event_id = ?", userID, eventID).
+		Pluck("user_favorite_sessions.event_session_id", &ids).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+
+	result := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		result[id] = true
+	}
+	return result, nil
+}
@@ -0,0 +1,117 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ErrInvoiceAlreadyIssued el pedido ya tiene una factura del mismo tipo
+var ErrInvoiceAlreadyIssued = errors.New("invoice already issued for order")
+
+// InvoiceRepository repositorio para facturas y facturas rectificativas
+type InvoiceRepository struct {
+	*BaseRepository[models.Invoice]
+}
+
+// NewInvoiceRepository crea una nueva instancia
+func NewInvoiceRepository() *InvoiceRepository {
+	base := NewBaseRepository[models.Invoice]()
+
+	base.builder.SetAllowedFilters(map[string]string{
+		"organization_id": "=",
+		"event_id":        "=",
+		"kind":            "=",
+		"year":            "=",
+	})
+
+	base.builder.SetAllowedSorts([]string{
+		"issued_at", "number", "total_cents",
+	})
+
+	return &InvoiceRepository{BaseRepository: base}
+}
+
+// GetByOrder obtiene las facturas de un pedido en orden de emisión
+func (r *InvoiceRepository) GetByOrder(ctx context.Context, orderID string) ([]*models.Invoice, error) {
+	var invoices []*models.Invoice
+	err := r.db.WithContext(ctx).
+		Where("order_id = ?", orderID).
+		Order("issued_at ASC").
+		Find(&invoices).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return invoices, nil
+}
+
+// GetByOrderAndKind obtiene la factura de un tipo de un pedido
+func (r *InvoiceRepository) GetByOrderAndKind(ctx context.Context, orderID string, kind models.InvoiceKind) (*models.Invoice, error) {
+	var invoice models.Invoice
+	err := r.db.WithContext(ctx).
+		Where("order_id = ? AND kind = ?", orderID, kind).
+		First(&invoice).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return &invoice, nil
+}
+
+// GetByOrganization obtiene las facturas emitidas por una organización
+func (r *InvoiceRepository) GetByOrganization(ctx context.Context, organizationID string, opts common.QueryOptions) ([]*models.Invoice, *common.PaginationMeta, error) {
+	opts.AddFilter("organization_id", organizationID)
+	return r.GetAll(ctx, opts)
+}
+
+// Issue numera y guarda una factura. El contador de la serie se incrementa en
+// la misma transacción (bloqueando su fila), por lo que la numeración no deja
+// huecos aunque se emitan facturas concurrentemente.
+func (r *InvoiceRepository) Issue(ctx context.Context, invoice *models.Invoice, series string) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var existing int64
+		if err := tx.Model(&models.Invoice{}).
+			Where("order_id = ? AND kind = ?", invoice.OrderID, invoice.Kind).
+			Count(&existing).Error; err != nil {
+			return err
+		}
+		if existing > 0 {
+			return ErrInvoiceAlreadyIssued
+		}
+
+		year := invoice.IssuedAt.Year()
+		sequence := &models.InvoiceSequence{
+			OrganizationID: invoice.OrganizationID,
+			Series:         series,
+			Year:           year,
+		}
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(sequence).Error; err != nil {
+			return err
+		}
+
+		scope := tx.Model(&models.InvoiceSequence{}).
+			Where("organization_id = ? AND series = ? AND year = ?", invoice.OrganizationID, series, year)
+		if err := scope.UpdateColumn("last_number", gorm.Expr("last_number + 1")).Error; err != nil {
+			return err
+		}
+
+		var next int
+		if err := tx.Model(&models.InvoiceSequence{}).
+			Where("organization_id = ? AND series = ? AND year = ?", invoice.OrganizationID, series, year).
+			Pluck("last_number", &next).Error; err != nil {
+			return err
+		}
+
+		invoice.SetNumber(series, year, next)
+		return tx.Create(invoice).Error
+	})
+
+	if err != nil && !errors.Is(err, ErrInvoiceAlreadyIssued) {
+		return common.MapGormError(err)
+	}
+	return err
+}
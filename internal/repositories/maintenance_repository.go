@@ -0,0 +1,117 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/pkg/database"
+)
+
+// PurgeableTables tablas cuyas filas eliminadas (soft delete) se borran
+// definitivamente al vencer la retención, de las dependientes a las
+// principales. No se incluyen usuarios (se anonimizan), pedidos y facturas
+// (obligación legal), auditoría e historial de versiones (tienen su propia
+// retención) ni solicitudes de supresión (prueba de su cumplimiento)
+var PurgeableTables = []string{
+	"notifications",
+	"refresh_tokens",
+	"calendar_feeds",
+	"data_exports",
+	"submission_reviews",
+	"talk_submissions",
+	"event_sessions",
+	"speakers",
+	"promo_codes",
+	"event_registrations",
+	"ticket_types",
+	"events",
+	"event_series",
+	"organizations",
+}
+
+// purgeReferences filas que se conservan mientras las referencien pedidos o
+// facturas, que no se purgan y cuyas columnas de referencia no tienen clave
+// foránea que impida el borrado. Las organizaciones se conservan mientras
+// queden eventos suyos, que pueden estar retenidos por sus pedidos
+var purgeReferences = map[string]string{
+	"event_registrations": "EXISTS (SELECT 1 FROM orders WHERE orders.registration_id = CAST(event_registrations.id AS TEXT))",
+	"events": "EXISTS (SELECT 1 FROM orders WHERE orders.event_id = CAST(events.id AS TEXT))" +
+		" OR EXISTS (SELECT 1 FROM invoices WHERE invoices.event_id = CAST(events.id AS TEXT))",
+	"organizations": "EXISTS (SELECT 1 FROM invoices WHERE invoices.organization_id = CAST(organizations.id AS TEXT))" +
+		" OR EXISTS (SELECT 1 FROM events WHERE events.organization_id = CAST(organizations.id AS TEXT))",
+}
+
+// PurgeResult filas borradas y conservadas de una tabla
+type PurgeResult struct {
+	Table   string
+	Deleted int64
+	Kept    int64 // Filas que siguen referenciadas por otras tablas
+}
+
+// MaintenanceRepository operaciones de limpieza sobre varias tablas
+type MaintenanceRepository struct {
+	db *gorm.DB
+}
+
+// NewMaintenanceRepository crea una nueva instancia
+func NewMaintenanceRepository() *MaintenanceRepository {
+	return &MaintenanceRepository{db: database.GetDB()}
+}
+
+// PurgeSoftDeleted borra definitivamente las filas de una tabla de
+// PurgeableTables eliminadas antes de deletedBefore. Se conservan las que
+// siguen referenciadas por pedidos o facturas (purgeReferences) y, si el
+// borrado falla por otra referencia, se borran una a una hasta limit
+func (r *MaintenanceRepository) PurgeSoftDeleted(ctx context.Context, table string, deletedBefore time.Time, limit int) (PurgeResult, error) {
+	result := PurgeResult{Table: table}
+	if !isPurgeable(table) {
+		return result, common.NewValidationError("table", "Tabla no admitida: "+table)
+	}
+
+	db := r.db.WithContext(ctx)
+	where := "deleted_at IS NOT NULL AND deleted_at < ?"
+	if references, ok := purgeReferences[table]; ok {
+		err := db.Raw("SELECT COUNT(*) FROM "+table+" WHERE "+where+" AND ("+references+")", deletedBefore).
+			Scan(&result.Kept).Error
+		if err != nil {
+			return result, common.MapGormError(err)
+		}
+		where += " AND NOT (" + references + ")"
+	}
+
+	bulk := db.Exec("DELETE FROM "+table+" WHERE "+where, deletedBefore)
+	if bulk.Error == nil {
+		result.Deleted = bulk.RowsAffected
+		return result, nil
+	}
+
+	var ids []string
+	err := db.Raw("SELECT id FROM "+table+" WHERE "+where+" ORDER BY deleted_at LIMIT ?",
+		deletedBefore, limit).Scan(&ids).Error
+	if err != nil {
+		return result, common.MapGormError(err)
+	}
+
+	for _, id := range ids {
+		row := db.Exec("DELETE FROM "+table+" WHERE id = ?", id)
+		if row.Error != nil {
+			result.Kept++
+			continue
+		}
+		result.Deleted += row.RowsAffected
+	}
+	return result, nil
+}
+
+// isPurgeable verifica que la tabla esté en PurgeableTables
+func isPurgeable(table string) bool {
+	for _, t := range PurgeableTables {
+		if t == table {
+			return true
+		}
+	}
+	return false
+}
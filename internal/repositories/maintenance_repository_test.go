@@ -0,0 +1,94 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// newPurgeDB base de datos en memoria con las columnas que usa la purga
+func newPurgeDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: logger.Discard})
+	require.NoError(t, err)
+
+	for _, ddl := range []string{
+		"CREATE TABLE organizations (id TEXT PRIMARY KEY, deleted_at DATETIME)",
+		"CREATE TABLE events (id TEXT PRIMARY KEY, organization_id TEXT, deleted_at DATETIME)",
+		"CREATE TABLE event_registrations (id TEXT PRIMARY KEY, event_id TEXT, deleted_at DATETIME)",
+		"CREATE TABLE orders (id TEXT PRIMARY KEY, registration_id TEXT, event_id TEXT)",
+		"CREATE TABLE invoices (id TEXT PRIMARY KEY, organization_id TEXT, event_id TEXT)",
+	} {
+		require.NoError(t, db.Exec(ddl).Error)
+	}
+	return db
+}
+
+// TestPurgeSoftDeleted_KeepsBilledRows las filas referenciadas por pedidos o
+// facturas no se borran aunque haya vencido su retención
+func TestPurgeSoftDeleted_KeepsBilledRows(t *testing.T) {
+	db := newPurgeDB(t)
+	ctx := context.Background()
+	repo := &MaintenanceRepository{db: db}
+
+	old := time.Now().AddDate(0, -2, 0)
+	recent := time.Now().Add(-time.Hour)
+	cutoff := time.Now().AddDate(0, -1, 0)
+
+	seed := []struct {
+		query string
+		args  []interface{}
+	}{
+		{"INSERT INTO organizations VALUES (?, ?)", []interface{}{"org-billed", old}},
+		{"INSERT INTO organizations VALUES (?, ?)", []interface{}{"org-with-event", old}},
+		{"INSERT INTO organizations VALUES (?, ?)", []interface{}{"org-free", old}},
+		{"INSERT INTO events VALUES (?, ?, ?)", []interface{}{"event-ordered", "org-with-event", old}},
+		{"INSERT INTO events VALUES (?, ?, ?)", []interface{}{"event-invoiced", "org-billed", old}},
+		{"INSERT INTO events VALUES (?, ?, ?)", []interface{}{"event-free", "org-free", old}},
+		{"INSERT INTO events VALUES (?, ?, ?)", []interface{}{"event-recent", "org-free", recent}},
+		{"INSERT INTO event_registrations VALUES (?, ?, ?)", []interface{}{"reg-ordered", "event-ordered", old}},
+		{"INSERT INTO event_registrations VALUES (?, ?, ?)", []interface{}{"reg-free", "event-free", old}},
+		{"INSERT INTO orders VALUES (?, ?, ?)", []interface{}{"order-1", "reg-ordered", "event-ordered"}},
+		{"INSERT INTO invoices VALUES (?, ?, ?)", []interface{}{"invoice-1", "org-billed", "event-invoiced"}},
+	}
+	for _, row := range seed {
+		require.NoError(t, db.Exec(row.query, row.args...).Error)
+	}
+
+	remaining := func(table string) []string {
+		var ids []string
+		require.NoError(t, db.Raw("SELECT id FROM "+table+" ORDER BY id").Scan(&ids).Error)
+		return ids
+	}
+
+	// En el orden de PurgeableTables: inscripciones, eventos y organizaciones
+	result, err := repo.PurgeSoftDeleted(ctx, "event_registrations", cutoff, 100)
+	require.NoError(t, err)
+	assert.Equal(t, PurgeResult{Table: "event_registrations", Deleted: 1, Kept: 1}, result)
+	assert.Equal(t, []string{"reg-ordered"}, remaining("event_registrations"))
+
+	result, err = repo.PurgeSoftDeleted(ctx, "events", cutoff, 100)
+	require.NoError(t, err)
+	assert.Equal(t, PurgeResult{Table: "events", Deleted: 1, Kept: 2}, result)
+	assert.Equal(t, []string{"event-invoiced", "event-ordered", "event-recent"}, remaining("events"))
+
+	// org-free conserva un evento eliminado hace poco
+	result, err = repo.PurgeSoftDeleted(ctx, "organizations", cutoff, 100)
+	require.NoError(t, err)
+	assert.Equal(t, PurgeResult{Table: "organizations", Deleted: 0, Kept: 3}, result)
+
+	require.NoError(t, db.Exec("DELETE FROM events WHERE id = ?", "event-recent").Error)
+	result, err = repo.PurgeSoftDeleted(ctx, "organizations", cutoff, 100)
+	require.NoError(t, err)
+	assert.Equal(t, PurgeResult{Table: "organizations", Deleted: 1, Kept: 2}, result)
+	assert.Equal(t, []string{"org-billed", "org-with-event"}, remaining("organizations"))
+
+	_, err = repo.PurgeSoftDeleted(ctx, "orders", cutoff, 100)
+	assert.Error(t, err)
+}
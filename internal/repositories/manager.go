@@ -6,6 +6,28 @@ type RepositoryManager struct {
 	Organizations *OrganizationRepository
 	Users         *UserRepository
 	RefreshTokens *RefreshTokenRepository
+	CalendarFeeds *CalendarFeedRepository
+	EventSeries   *EventSeriesRepository
+	Speakers      *SpeakerRepository
+	Sessions      *EventSessionRepository
+	Submissions   *TalkSubmissionRepository
+	Reviews       *SubmissionReviewRepository
+	Notifications *NotificationRepository
+	TicketTypes   *TicketTypeRepository
+	PromoCodes    *PromoCodeRepository
+	Registrations *EventRegistrationRepository
+	Orders        *OrderRepository
+	Invoices      *InvoiceRepository
+	Search        *SearchRepository
+	AuditLogs     *AuditLogRepository
+	Versions      *EntityVersionRepository
+	DataExports   *DataExportRepository
+	Erasures      *ErasureRequestRepository
+	Jobs          *ScheduledJobRepository
+	Maintenance   *MaintenanceRepository
+	Reports       *ContentReportRepository
+	Verifications *OrganizationVerificationRepository
+	Media         *MediaRepository
 }
 
 // NewRepositoryManager crea una nueva instancia del manager
@@ -15,5 +37,27 @@ func NewRepositoryManager() *RepositoryManager {
 		Organizations: NewOrganizationRepository(),
 		Users:         NewUserRepository(),
 		RefreshTokens: NewRefreshTokenRepository(),
+		CalendarFeeds: NewCalendarFeedRepository(),
+		EventSeries:   NewEventSeriesRepository(),
+		Speakers:      NewSpeakerRepository(),
+		Sessions:      NewEventSessionRepository(),
+		Submissions:   NewTalkSubmissionRepository(),
+		Reviews:       NewSubmissionReviewRepository(),
+		Notifications: NewNotificationRepository(),
+		TicketTypes:   NewTicketTypeRepository(),
+		PromoCodes:    NewPromoCodeRepository(),
+		Registrations: NewEventRegistrationRepository(),
+		Orders:        NewOrderRepository(),
+		Invoices:      NewInvoiceRepository(),
+		Search:        NewSearchRepository(),
+		AuditLogs:     NewAuditLogRepository(),
+		Versions:      NewEntityVersionRepository(),
+		DataExports:   NewDataExportRepository(),
+		Erasures:      NewErasureRequestRepository(),
+		Jobs:          NewScheduledJobRepository(),
+		Maintenance:   NewMaintenanceRepository(),
+		Reports:       NewContentReportRepository(),
+		Verifications: NewOrganizationVerificationRepository(),
+		Media:         NewMediaRepository(),
 	}
 }
@@ -0,0 +1,236 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// MediaRepository repositorio para los ficheros subidos y sus referencias
+type MediaRepository struct {
+	*BaseRepository[models.Media]
+}
+
+// NewMediaRepository crea una nueva instancia
+func NewMediaRepository() *MediaRepository {
+	base := NewBaseRepository[models.Media]()
+
+	// purpose admite varios usos separados por comas
+	base.builder.SetAllowedFilters(map[string]string{
+		"owner_id":        "=",
+		"organization_id": "=",
+		"purpose":         "IN",
+	})
+
+	base.builder.SetAllowedSorts([]string{
+		"created_at", "size", "file_name",
+	})
+
+	return &MediaRepository{BaseRepository: base}
+}
+
+// GetWithReferences obtiene un fichero con los recursos que lo usan
+func (r *MediaRepository) GetWithReferences(ctx context.Context, id string) (*models.Media, error) {
+	var media models.Media
+	err := r.db.WithContext(ctx).
+		Preload("References").
+		Where("id = ?", id).
+		First(&media).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return &media, nil
+}
+
+// CountReferences cuenta los recursos que usan el fichero
+func (r *MediaRepository) CountReferences(ctx context.Context, mediaID string) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.MediaReference{}).
+		Where("media_id = ?", mediaID).
+		Count(&count).Error
+	if err != nil {
+		return 0, common.MapGormError(err)
+	}
+	return count, nil
+}
+
+// Attach apunta el campo de un recurso al fichero en una transacción: guarda
+// la URL y las versiones del fichero en el recurso (target es un puntero al
+// modelo, p. ej. &models.Event{}) y sustituye la referencia anterior de ese
+// campo. El fichero se bloquea para que el worker de imágenes no termine de
+// procesarlo sin ver la nueva referencia
+func (r *MediaRepository) Attach(ctx context.Context, ref *models.MediaReference, target interface{}, column string) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var media models.Media
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", ref.MediaID).First(&media).Error; err != nil {
+			return err
+		}
+
+		variants, err := mediaVariantsExpr(ref.Field, media.FieldVariants())
+		if err != nil {
+			return err
+		}
+
+		// UpdateColumns evita los hooks de validación del modelo parcial
+		result := tx.Model(target).Where("id = ?", ref.ResourceID).
+			UpdateColumns(map[string]interface{}{
+				column:           media.URL,
+				"media_variants": variants,
+				"updated_at":     time.Now(),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		if err := replaceMediaReferences(tx, ref.ResourceType, ref.ResourceID, ref.Field, ref); err != nil {
+			return err
+		}
+		return nil
+	})
+	return common.MapGormError(err)
+}
+
+// =============================================================================
+// PROCESADO DE IMÁGENES
+// =============================================================================
+
+// ListProcessable IDs de los ficheros pendientes de procesar y de los que se
+// quedaron procesándose antes de staleBefore (p. ej. por un reinicio), con
+// menos de maxAttempts intentos
+func (r *MediaRepository) ListProcessable(ctx context.Context, staleBefore time.Time, maxAttempts, limit int) ([]string, error) {
+	var ids []string
+	err := r.db.WithContext(ctx).Model(&models.Media{}).
+		Where("(processing_status = ? OR (processing_status = ? AND processing_started_at < ?)) AND processing_attempts < ?",
+			models.MediaProcessingPending, models.MediaProcessingProcessing, staleBefore, maxAttempts).
+		Order("created_at").
+		Limit(limit).
+		Pluck("id", &ids).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return ids, nil
+}
+
+// ClaimProcessing marca el fichero como en proceso si sigue pendiente (o
+// abandonado antes de staleBefore). Solo una instancia lo consigue
+func (r *MediaRepository) ClaimProcessing(ctx context.Context, id string, staleBefore time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&models.Media{}).
+		Where("id = ? AND (processing_status = ? OR (processing_status = ? AND processing_started_at < ?))",
+			id, models.MediaProcessingPending, models.MediaProcessingProcessing, staleBefore).
+		UpdateColumns(map[string]interface{}{
+			"processing_status":     models.MediaProcessingProcessing,
+			"processing_started_at": time.Now(),
+			"processing_attempts":   gorm.Expr("processing_attempts + 1"),
+		})
+	if result.Error != nil {
+		return false, common.MapGormError(result.Error)
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// CompleteProcessing guarda las versiones y el color del fichero y los copia
+// a los eventos y organizaciones que ya lo usan
+func (r *MediaRepository) CompleteProcessing(ctx context.Context, media *models.Media) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		if err := tx.Model(&models.Media{}).Where("id = ?", media.ID).
+			UpdateColumns(map[string]interface{}{
+				"processing_status": models.MediaProcessingReady,
+				"processing_error":  "",
+				"processed_at":      now,
+				"width":             media.Width,
+				"height":            media.Height,
+				"dominant_color":    media.DominantColor,
+				"variants":          media.Variants,
+			}).Error; err != nil {
+			return err
+		}
+
+		var refs []models.MediaReference
+		if err := tx.Where("media_id = ?", media.ID.String()).Find(&refs).Error; err != nil {
+			return err
+		}
+		for _, ref := range refs {
+			var target interface{}
+			switch ref.ResourceType {
+			case models.MediaResourceEvent:
+				target = &models.Event{}
+			case models.MediaResourceOrganization:
+				target = &models.Organization{}
+			default:
+				continue
+			}
+
+			variants, err := mediaVariantsExpr(ref.Field, media.FieldVariants())
+			if err != nil {
+				return err
+			}
+			if err := tx.Model(target).Where("id = ?", ref.ResourceID).
+				UpdateColumn("media_variants", variants).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return common.MapGormError(err)
+}
+
+// FinishProcessing cierra el procesado sin versiones: failed o skipped con el
+// motivo, o pending para reintentarlo
+func (r *MediaRepository) FinishProcessing(ctx context.Context, id string, status models.MediaProcessingStatus, reason string) error {
+	if runes := []rune(reason); len(runes) > 500 {
+		reason = string(runes[:500])
+	}
+	err := r.db.WithContext(ctx).Model(&models.Media{}).Where("id = ?", id).
+		UpdateColumns(map[string]interface{}{
+			"processing_status": status,
+			"processing_error":  reason,
+		}).Error
+	return common.MapGormError(err)
+}
+
+// ResetProcessing vuelve a poner el fichero en cola con los intentos a cero
+func (r *MediaRepository) ResetProcessing(ctx context.Context, id string) error {
+	err := r.db.WithContext(ctx).Model(&models.Media{}).Where("id = ?", id).
+		UpdateColumns(map[string]interface{}{
+			"processing_status":   models.MediaProcessingPending,
+			"processing_attempts": 0,
+			"processing_error":    "",
+		}).Error
+	return common.MapGormError(err)
+}
+
+// mediaVariantsExpr expresión que guarda las versiones de un campo en la
+// columna media_variants sin tocar las de los demás campos
+func mediaVariantsExpr(field string, entry models.MediaFieldVariants) (clause.Expr, error) {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return clause.Expr{}, err
+	}
+	return gorm.Expr("jsonb_set(COALESCE(media_variants, '{}'::jsonb), ARRAY[?]::text[], ?::jsonb)", field, string(data)), nil
+}
+
+// replaceMediaReferences borra las referencias de un campo y crea las nuevas
+func replaceMediaReferences(tx *gorm.DB, resourceType, resourceID, field string, refs ...*models.MediaReference) error {
+	if err := tx.Where("resource_type = ? AND resource_id = ? AND field = ?", resourceType, resourceID, field).
+		Delete(&models.MediaReference{}).Error; err != nil {
+		return err
+	}
+
+	for _, ref := range refs {
+		if err := tx.Create(ref).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
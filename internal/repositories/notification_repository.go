@@ -0,0 +1,73 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/models"
+)
+
+// NotificationRepository repositorio para notificaciones in-app
+type NotificationRepository struct {
+	*BaseRepository[models.Notification]
+}
+
+// NewNotificationRepository crea una nueva instancia
+func NewNotificationRepository() *NotificationRepository {
+	base := NewBaseRepository[models.Notification]()
+
+	base.builder.SetAllowedFilters(map[string]string{
+		"user_id": "=",
+		"type":    "=",
+	})
+
+	base.builder.SetAllowedSorts([]string{
+		"created_at", "read_at",
+	})
+
+	return &NotificationRepository{BaseRepository: base}
+}
+
+// GetByUser obtiene las notificaciones de un usuario
+func (r *NotificationRepository) GetByUser(ctx context.Context, userID string, opts common.QueryOptions) ([]*models.Notification, *common.PaginationMeta, error) {
+	opts.AddFilter("user_id", userID)
+	return r.GetAll(ctx, opts)
+}
+
+// CountUnread cuenta las notificaciones sin leer de un usuario
+func (r *NotificationRepository) CountUnread(ctx context.Context, userID string) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&models.Notification{}).
+		Where("user_id = ? AND read_at IS NULL", userID).
+		Count(&count).Error
+	if err != nil {
+		return 0, common.MapGormError(err)
+	}
+	return count, nil
+}
+
+// MarkAsRead marca una notificación del usuario como leída
+func (r *NotificationRepository) MarkAsRead(ctx context.Context, id, userID string) error {
+	result := r.db.WithContext(ctx).
+		Model(&models.Notification{}).
+		Where("id = ? AND user_id = ?", id, userID).
+		Update("read_at", time.Now())
+	if result.Error != nil {
+		return common.MapGormError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return common.ErrNotFound
+	}
+	return nil
+}
+
+// MarkAllAsRead marca como leídas todas las notificaciones del usuario
+func (r *NotificationRepository) MarkAllAsRead(ctx context.Context, userID string) error {
+	err := r.db.WithContext(ctx).
+		Model(&models.Notification{}).
+		Where("user_id = ? AND read_at IS NULL", userID).
+		Update("read_at", time.Now()).Error
+	return common.MapGormError(err)
+}
@@ -0,0 +1,216 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/models"
+
+	"gorm.io/gorm"
+)
+
+// ErrOrderStatusChanged el pedido cambió de estado mientras se procesaba
+var ErrOrderStatusChanged = errors.New("order status changed concurrently")
+
+// OrderRepository repositorio para pedidos de pago
+type OrderRepository struct {
+	*BaseRepository[models.Order]
+}
+
+// NewOrderRepository crea una nueva instancia
+func NewOrderRepository() *OrderRepository {
+	base := NewBaseRepository[models.Order]()
+
+	base.builder.SetAllowedFilters(map[string]string{
+		"user_id":  "=",
+		"event_id": "=",
+		"status":   "=",
+	})
+
+	base.builder.SetAllowedSorts([]string{
+		"created_at", "paid_at", "amount_cents",
+	})
+
+	return &OrderRepository{BaseRepository: base}
+}
+
+// GetByProviderPayment obtiene un pedido por el identificador de pago del proveedor
+func (r *OrderRepository) GetByProviderPayment(ctx context.Context, provider, paymentID string) (*models.Order, error) {
+	var order models.Order
+	err := r.db.WithContext(ctx).
+		Preload("Registration").
+		Where("provider = ? AND provider_payment_id = ?", provider, paymentID).
+		First(&order).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return &order, nil
+}
+
+// GetWithRegistration obtiene un pedido con su inscripción y tipo de entrada
+func (r *OrderRepository) GetWithRegistration(ctx context.Context, id string) (*models.Order, error) {
+	var order models.Order
+	err := r.db.WithContext(ctx).
+		Preload("Registration.TicketType").
+		Preload("Event").
+		First(&order, "id = ?", id).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return &order, nil
+}
+
+// GetByUser obtiene los pedidos de un usuario con su evento
+func (r *OrderRepository) GetByUser(ctx context.Context, userID string, opts common.QueryOptions) ([]*models.Order, *common.PaginationMeta, error) {
+	opts.AddFilter("user_id", userID)
+	opts.Preloads = append(opts.Preloads, "Event")
+	return r.GetAll(ctx, opts)
+}
+
+// GetOpenByEvent obtiene los pedidos pendientes o pagados de un evento
+func (r *OrderRepository) GetOpenByEvent(ctx context.Context, eventID string) ([]*models.Order, error) {
+	var orders []*models.Order
+	err := r.db.WithContext(ctx).
+		Preload("Registration").
+		Where("event_id = ? AND status IN ?", eventID, openOrderStatuses).
+		Order("created_at ASC").
+		Find(&orders).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return orders, nil
+}
+
+// openOrderStatuses estados de los pedidos que aún se pueden cerrar
+var openOrderStatuses = []models.OrderStatus{models.OrderStatusPending, models.OrderStatusPaid}
+
+// RequestEventRefunds marca para reembolso los pedidos abiertos de un evento.
+// La marca se conserva hasta que el pedido se cierra, así que un reembolso que
+// falla se reintenta más tarde
+func (r *OrderRepository) RequestEventRefunds(ctx context.Context, eventID, reason string) (int64, error) {
+	return r.requestRefunds(ctx, reason, "event_id = ?", eventID)
+}
+
+// RequestCanceledEventRefunds marca para reembolso los pedidos abiertos de
+// todos los eventos cancelados que aún no lo estén
+func (r *OrderRepository) RequestCanceledEventRefunds(ctx context.Context, reason string) (int64, error) {
+	return r.requestRefunds(ctx, reason,
+		"event_id IN (SELECT id::text FROM events WHERE status = ?)", models.EventStatusCanceled)
+}
+
+// requestRefunds marca los pedidos abiertos que cumplen la condición
+func (r *OrderRepository) requestRefunds(ctx context.Context, reason, condition string, args ...interface{}) (int64, error) {
+	now := time.Now()
+	result := r.db.WithContext(ctx).Model(&models.Order{}).
+		Where("status IN ? AND refund_requested_at IS NULL", openOrderStatuses).
+		Where(condition, args...).
+		UpdateColumns(map[string]interface{}{
+			"refund_requested_at": now,
+			"refund_reason":       reason,
+			"updated_at":          now,
+		})
+	if result.Error != nil {
+		return 0, common.MapGormError(result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// GetRefundPending obtiene los pedidos marcados para reembolso que siguen
+// abiertos o que se cobraron después de fallar, con su inscripción, de los
+// más antiguos a los más recientes
+func (r *OrderRepository) GetRefundPending(ctx context.Context, limit int) ([]*models.Order, error) {
+	var orders []*models.Order
+	err := r.db.WithContext(ctx).
+		Preload("Registration").
+		Where("refund_requested_at IS NOT NULL").
+		Where("(status IN ? OR (status = ? AND paid_at IS NOT NULL))", openOrderStatuses, models.OrderStatusFailed).
+		Order("refund_requested_at ASC").
+		Limit(limit).
+		Find(&orders).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return orders, nil
+}
+
+// RecordLatePayment guarda el cobro de un pedido fallido y su marca de
+// reembolso. Devuelve ErrOrderStatusChanged si otra notificación ya lo registró
+func (r *OrderRepository) RecordLatePayment(ctx context.Context, order *models.Order) error {
+	result := r.db.WithContext(ctx).Model(&models.Order{}).
+		Where("id = ? AND status = ? AND paid_at IS NULL", order.ID, models.OrderStatusFailed).
+		UpdateColumns(map[string]interface{}{
+			"paid_at":             order.PaidAt,
+			"refund_requested_at": order.RefundRequestedAt,
+			"refund_reason":       order.RefundReason,
+			"updated_at":          time.Now(),
+		})
+	if result.Error != nil {
+		return common.MapGormError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrOrderStatusChanged
+	}
+	return nil
+}
+
+// SetProviderPayment guarda el pago creado en el proveedor
+func (r *OrderRepository) SetProviderPayment(ctx context.Context, order *models.Order) error {
+	err := r.db.WithContext(ctx).Model(&models.Order{}).
+		Where("id = ?", order.ID).
+		UpdateColumns(map[string]interface{}{
+			"provider_payment_id": order.ProviderPaymentID,
+			"checkout_url":        order.CheckoutURL,
+			"updated_at":          time.Now(),
+		}).Error
+	return common.MapGormError(err)
+}
+
+// ApplyTransition persiste el nuevo estado de un pedido solo si sigue en el
+// estado de origen y actualiza su inscripción en la misma transacción: un pago
+// confirma la inscripción y un fallo o reembolso la cancela liberando la plaza.
+// Devuelve ErrOrderStatusChanged si otro proceso ya cambió el pedido.
+func (r *OrderRepository) ApplyTransition(ctx context.Context, order *models.Order, from models.OrderStatus, registration *models.EventRegistration) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		result := tx.Model(&models.Order{}).
+			Where("id = ? AND status = ?", order.ID, from).
+			UpdateColumns(map[string]interface{}{
+				"status":             order.Status,
+				"failure_reason":     order.FailureReason,
+				"provider_refund_id": order.ProviderRefundID,
+				"paid_at":            order.PaidAt,
+				"failed_at":          order.FailedAt,
+				"refunded_at":        order.RefundedAt,
+				"updated_at":         now,
+			})
+		if err := reservationResult(result, ErrOrderStatusChanged); err != nil {
+			return err
+		}
+
+		if registration == nil {
+			return nil
+		}
+
+		switch order.Status {
+		case models.OrderStatusPaid:
+			return tx.Model(&models.EventRegistration{}).
+				Where("id = ? AND status = ?", registration.ID, models.RegistrationStatusPending).
+				UpdateColumns(map[string]interface{}{
+					"status":       models.RegistrationStatusConfirmed,
+					"confirmed_at": now,
+					"updated_at":   now,
+				}).Error
+		case models.OrderStatusFailed, models.OrderStatusRefunded:
+			_, err := releaseRegistration(tx, registration)
+			return err
+		}
+		return nil
+	})
+
+	if err != nil && !errors.Is(err, ErrOrderStatusChanged) {
+		return common.MapGormError(err)
+	}
+	return err
+}
@@ -6,6 +6,8 @@ import (
 
 	"cybesphere-backend/internal/common"
 	"cybesphere-backend/internal/models"
+	"cybesphere-backend/internal/query"
+	"cybesphere-backend/pkg/geo"
 
 	"gorm.io/gorm"
 )
@@ -27,6 +29,17 @@ func NewOrganizationRepository() *OrganizationRepository {
 		"country":     "LIKE",
 	})
 
+	// Campos admitidos en las expresiones ?filter=
+	base.builder.SetFilterFields(map[string]query.FilterField{
+		"name":         {Column: "name", Type: query.FieldString},
+		"status":       {Column: "status", Type: query.FieldString},
+		"is_verified":  {Column: "is_verified", Type: query.FieldBool},
+		"city":         {Column: "city", Type: query.FieldString},
+		"country":      {Column: "country", Type: query.FieldString},
+		"events_count": {Column: "events_count", Type: query.FieldNumber},
+		"created_at":   {Column: "created_at", Type: query.FieldTime},
+	})
+
 	// Configurar ordenamiento
 	base.builder.SetAllowedSorts([]string{
 		"name", "created_at", "updated_at", "events_count", "city",
@@ -111,11 +124,10 @@ func (r *OrganizationRepository) UpdateStatus(ctx context.Context, id string, st
 
 // Verify marca una organización como verificada
 func (r *OrganizationRepository) Verify(ctx context.Context, id string, verifierID string) error {
-	now := time.Now()
 	updates := map[string]interface{}{
 		"is_verified": true,
 		"verified_by": verifierID,
-		"verified_at": now.Format(time.RFC3339),
+		"verified_at": time.Now(),
 		"status":      models.OrgStatusActive,
 	}
 
@@ -124,3 +136,73 @@ func (r *OrganizationRepository) Verify(ctx context.Context, id string, verifier
 		Updates(updates).Error
 	return common.MapGormError(err)
 }
+
+// UpdateGeocodedLocation guarda las coordenadas calculadas para la dirección de la
+// organización. No cambia nada si tiene coordenadas manuales o si la dirección ha
+// cambiado mientras se geocodificaba. Indica si se actualizó la organización
+func (r *OrganizationRepository) UpdateGeocodedLocation(ctx context.Context, org *models.Organization, point geo.Point) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&models.Organization{}).
+		Where("id = ?", org.ID).
+		Where("address = ? AND city = ? AND country = ?", org.Address, org.City, org.Country).
+		Where("COALESCE(coordinates_source, '') <> ?", models.CoordinatesSourceManual).
+		UpdateColumns(map[string]interface{}{
+			"latitude":           point.Latitude,
+			"longitude":          point.Longitude,
+			"coordinates_source": models.CoordinatesSourceGeocoded,
+		})
+	if result.Error != nil {
+		return false, common.MapGormError(result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// GetPendingGeocoding obtiene, ordenadas por ID, las organizaciones con dirección
+// y sin coordenadas posteriores a afterID
+func (r *OrganizationRepository) GetPendingGeocoding(ctx context.Context, afterID string, limit int) ([]*models.Organization, error) {
+	query := r.db.WithContext(ctx).
+		Where("address <> '' OR city <> ''").
+		Where("latitude IS NULL OR longitude IS NULL").
+		Where("COALESCE(coordinates_source, '') <> ?", models.CoordinatesSourceManual)
+	if afterID != "" {
+		query = query.Where("id > ?", afterID)
+	}
+
+	var orgs []*models.Organization
+	if err := query.Order("id ASC").Limit(limit).Find(&orgs).Error; err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return orgs, nil
+}
+
+// Follow hace que un usuario siga a una organización
+func (r *OrganizationRepository) Follow(ctx context.Context, userID, orgID string) error {
+	err := r.db.WithContext(ctx).Exec(
+		"INSERT INTO user_followed_organizations (user_id, organization_id) VALUES (?, ?) ON CONFLICT DO NOTHING",
+		userID, orgID,
+	).Error
+	return common.MapGormError(err)
+}
+
+// Unfollow deja de seguir una organización
+func (r *OrganizationRepository) Unfollow(ctx context.Context, userID, orgID string) error {
+	err := r.db.WithContext(ctx).Exec(
+		"DELETE FROM user_followed_organizations WHERE user_id = ? AND organization_id = ?",
+		userID, orgID,
+	).Error
+	return common.MapGormError(err)
+}
+
+// GetFollowed obtiene las organizaciones que sigue un usuario
+func (r *OrganizationRepository) GetFollowed(ctx context.Context, userID string) ([]*models.Organization, error) {
+	var orgs []*models.Organization
+	err := r.db.WithContext(ctx).
+		Where("id IN (?)", r.db.Table("user_followed_organizations").
+			Select("organization_id").
+			Where("user_id = ?", userID)).
+		Order("name ASC").
+		Find(&orgs).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return orgs, nil
+}
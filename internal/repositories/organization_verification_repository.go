@@ -0,0 +1,118 @@
+package repositories
+
+import (
+	"context"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/models"
+
+	"gorm.io/gorm"
+)
+
+// OrganizationVerificationRepository repositorio para las solicitudes de
+// verificación de organizaciones
+type OrganizationVerificationRepository struct {
+	*BaseRepository[models.OrganizationVerification]
+}
+
+// NewOrganizationVerificationRepository crea una nueva instancia
+func NewOrganizationVerificationRepository() *OrganizationVerificationRepository {
+	base := NewBaseRepository[models.OrganizationVerification]()
+
+	// status admite varios estados separados por comas
+	base.builder.SetAllowedFilters(map[string]string{
+		"status":          "IN",
+		"organization_id": "=",
+		"reviewer_id":     "=",
+	})
+
+	base.builder.SetAllowedSorts([]string{
+		"created_at", "updated_at", "reviewed_at",
+	})
+
+	return &OrganizationVerificationRepository{BaseRepository: base}
+}
+
+// GetWithRelations obtiene una solicitud con su organización y su remitente
+func (r *OrganizationVerificationRepository) GetWithRelations(ctx context.Context, id string) (*models.OrganizationVerification, error) {
+	var verification models.OrganizationVerification
+	err := r.db.WithContext(ctx).
+		Preload("Organization").
+		Preload("Submitter").
+		Where("id = ?", id).
+		First(&verification).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return &verification, nil
+}
+
+// GetHistory obtiene todas las solicitudes de una organización, de la más
+// reciente a la más antigua
+func (r *OrganizationVerificationRepository) GetHistory(ctx context.Context, organizationID string) ([]*models.OrganizationVerification, error) {
+	var verifications []*models.OrganizationVerification
+	err := r.db.WithContext(ctx).
+		Preload("Submitter").
+		Where("organization_id = ?", organizationID).
+		Order("created_at DESC").
+		Find(&verifications).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return verifications, nil
+}
+
+// HasOpen verifica si la organización tiene una solicitud pendiente de decisión
+func (r *OrganizationVerificationRepository) HasOpen(ctx context.Context, organizationID string) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.OrganizationVerification{}).
+		Where("organization_id = ?", organizationID).
+		Where("status IN ?", []models.VerificationStatus{models.VerificationStatusSubmitted, models.VerificationStatusInReview}).
+		Count(&count).Error
+	if err != nil {
+		return false, common.MapGormError(err)
+	}
+	return count > 0, nil
+}
+
+// Submit crea la solicitud con las referencias a los ficheros subidos y, si
+// se indica, guarda el nuevo estado de la organización en la misma transacción
+func (r *OrganizationVerificationRepository) Submit(ctx context.Context, verification *models.OrganizationVerification, refs []*models.MediaReference, org *models.Organization) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(verification).Error; err != nil {
+			return err
+		}
+		for _, ref := range refs {
+			if err := tx.Create(ref).Error; err != nil {
+				return err
+			}
+		}
+		if org != nil {
+			return tx.Model(org).Select("status", "updated_at", "updated_by").Updates(org).Error
+		}
+		return nil
+	})
+	return common.MapGormError(err)
+}
+
+// verificationOrgColumns columnas de la organización que cambia una decisión.
+// El resto (perfil, contadores) puede haber cambiado desde que se cargó
+var verificationOrgColumns = []string{
+	"is_verified", "verified_at", "verified_by", "status", "can_create_events",
+	"tax_id", "legal_name", "updated_at", "updated_by",
+}
+
+// Decide guarda la decisión sobre la solicitud y, si se indica, los cambios de
+// la organización en la misma transacción
+func (r *OrganizationVerificationRepository) Decide(ctx context.Context, verification *models.OrganizationVerification, org *models.Organization) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Organization", "Submitter").Save(verification).Error; err != nil {
+			return err
+		}
+		if org != nil {
+			return tx.Model(org).Select(verificationOrgColumns).Updates(org).Error
+		}
+		return nil
+	})
+	return common.MapGormError(err)
+}
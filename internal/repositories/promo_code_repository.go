@@ -0,0 +1,100 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/models"
+)
+
+// ErrPromoMaxUsesBelowUsed el nuevo límite es menor que los usos ya realizados
+var ErrPromoMaxUsesBelowUsed = errors.New("promo code max uses below used count")
+
+// promoCodeEditableColumns columnas que modifica el organizador. used_count
+// solo cambia con las reservas atómicas de las inscripciones
+var promoCodeEditableColumns = []string{
+	"discount_value", "max_uses", "starts_at", "expires_at", "ticket_type_ids", "is_active",
+	"updated_at", "updated_by",
+}
+
+// PromoCodeRepository repositorio para códigos de descuento
+type PromoCodeRepository struct {
+	*BaseRepository[models.PromoCode]
+}
+
+// NewPromoCodeRepository crea una nueva instancia
+func NewPromoCodeRepository() *PromoCodeRepository {
+	base := NewBaseRepository[models.PromoCode]()
+
+	base.builder.SetAllowedFilters(map[string]string{
+		"event_id":      "=",
+		"discount_type": "=",
+		"is_active":     "=",
+	})
+
+	base.builder.SetAllowedSorts([]string{
+		"code", "used_count", "expires_at", "created_at",
+	})
+
+	return &PromoCodeRepository{BaseRepository: base}
+}
+
+// GetByEvent obtiene los códigos de descuento de un evento
+func (r *PromoCodeRepository) GetByEvent(ctx context.Context, eventID string) ([]*models.PromoCode, error) {
+	var codes []*models.PromoCode
+	err := r.db.WithContext(ctx).
+		Where("event_id = ?", eventID).
+		Order("created_at DESC").
+		Find(&codes).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return codes, nil
+}
+
+// GetByEventAndCode obtiene un código de un evento (el código debe venir normalizado)
+func (r *PromoCodeRepository) GetByEventAndCode(ctx context.Context, eventID, code string) (*models.PromoCode, error) {
+	var promo models.PromoCode
+	err := r.db.WithContext(ctx).
+		Where("event_id = ? AND code = ?", eventID, code).
+		First(&promo).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return &promo, nil
+}
+
+// CodeExists verifica si un código está en uso en el evento, incluyendo los
+// eliminados (el índice único también los cubre)
+func (r *PromoCodeRepository) CodeExists(ctx context.Context, eventID, code, excludeID string) (bool, error) {
+	var count int64
+	query := r.db.WithContext(ctx).Unscoped().
+		Model(&models.PromoCode{}).
+		Where("event_id = ? AND code = ?", eventID, code)
+	if excludeID != "" {
+		query = query.Where("id <> ?", excludeID)
+	}
+
+	if err := query.Count(&count).Error; err != nil {
+		return false, common.MapGormError(err)
+	}
+	return count > 0, nil
+}
+
+// UpdateDetails guarda los datos editables del código sin tocar sus usos. El
+// límite se comprueba en la misma sentencia contra los usos en ese momento
+func (r *PromoCodeRepository) UpdateDetails(ctx context.Context, promo *models.PromoCode) error {
+	query := r.db.WithContext(ctx).Model(promo).Select(promoCodeEditableColumns)
+	if promo.MaxUses != nil {
+		query = query.Where("used_count <= ?", *promo.MaxUses)
+	}
+
+	if err := reservationResult(query.Updates(promo), ErrPromoMaxUsesBelowUsed); err != nil {
+		if errors.Is(err, ErrPromoMaxUsesBelowUsed) {
+			return err
+		}
+		return common.MapGormError(err)
+	}
+	return nil
+}
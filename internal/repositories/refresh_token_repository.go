@@ -54,6 +54,19 @@ func (r *RefreshTokenRepository) GetActiveByUserID(ctx context.Context, userID s
 	return tokens, nil
 }
 
+// GetAllByUserID obtiene todas las sesiones de un usuario, también las revocadas y expiradas
+func (r *RefreshTokenRepository) GetAllByUserID(ctx context.Context, userID string) ([]*models.RefreshToken, error) {
+	var tokens []*models.RefreshToken
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Find(&tokens).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return tokens, nil
+}
+
 // RevokeByTokenHash revoca un token por hash
 func (r *RefreshTokenRepository) RevokeByTokenHash(ctx context.Context, tokenHash string) error {
 	now := time.Now()
@@ -79,20 +92,20 @@ func (r *RefreshTokenRepository) RevokeAllByUserID(ctx context.Context, userID s
 }
 
 // DeleteExpired elimina tokens expirados
-func (r *RefreshTokenRepository) DeleteExpired(ctx context.Context) error {
-	err := r.db.WithContext(ctx).
+func (r *RefreshTokenRepository) DeleteExpired(ctx context.Context) (int64, error) {
+	result := r.db.WithContext(ctx).
 		Where("expires_at < ?", time.Now()).
-		Delete(&models.RefreshToken{}).Error
-	return common.MapGormError(err)
+		Delete(&models.RefreshToken{})
+	return result.RowsAffected, common.MapGormError(result.Error)
 }
 
 // CleanupOldTokens limpia tokens antiguos revocados
-func (r *RefreshTokenRepository) CleanupOldTokens(ctx context.Context, olderThan time.Duration) error {
+func (r *RefreshTokenRepository) CleanupOldTokens(ctx context.Context, olderThan time.Duration) (int64, error) {
 	cutoff := time.Now().Add(-olderThan)
-	err := r.db.WithContext(ctx).
+	result := r.db.WithContext(ctx).
 		Where("is_revoked = true AND revoked_at < ?", cutoff).
-		Delete(&models.RefreshToken{}).Error
-	return common.MapGormError(err)
+		Delete(&models.RefreshToken{})
+	return result.RowsAffected, common.MapGormError(result.Error)
 }
 
 // CountActiveByUserID cuenta tokens activos de un usuario
@@ -0,0 +1,141 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/models"
+	"cybesphere-backend/pkg/database"
+	"cybesphere-backend/pkg/scheduler"
+)
+
+// jobLockNamespace primera clave de los bloqueos consultivos de las tareas; la
+// segunda es el hash del nombre de la tarea
+const jobLockNamespace int32 = 0x6a6f62
+
+// ScheduledJobRepository repositorio de las tareas programadas. Implementa el
+// bloqueo entre instancias y el registro de ejecuciones del planificador
+type ScheduledJobRepository struct {
+	db *gorm.DB
+}
+
+// Verificación en tiempo de compilación
+var (
+	_ scheduler.Locker   = (*ScheduledJobRepository)(nil)
+	_ scheduler.Recorder = (*ScheduledJobRepository)(nil)
+)
+
+// NewScheduledJobRepository crea una nueva instancia
+func NewScheduledJobRepository() *ScheduledJobRepository {
+	return &ScheduledJobRepository{db: database.GetDB()}
+}
+
+// GetAll obtiene todas las tareas ordenadas por nombre
+func (r *ScheduledJobRepository) GetAll(ctx context.Context) ([]*models.ScheduledJob, error) {
+	var jobs []*models.ScheduledJob
+	if err := r.db.WithContext(ctx).Order("name ASC").Find(&jobs).Error; err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return jobs, nil
+}
+
+// GetByName obtiene una tarea por su nombre
+func (r *ScheduledJobRepository) GetByName(ctx context.Context, name string) (*models.ScheduledJob, error) {
+	var job models.ScheduledJob
+	if err := r.db.WithContext(ctx).Where("name = ?", name).First(&job).Error; err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return &job, nil
+}
+
+// TryLock bloqueo consultivo de sesión sobre una conexión propia, que se
+// mantiene hasta llamar a unlock. Si la instancia cae, PostgreSQL lo libera
+// al cerrarse la conexión
+func (r *ScheduledJobRepository) TryLock(ctx context.Context, job string) (func(), bool, error) {
+	sqlDB, err := r.db.DB()
+	if err != nil {
+		return nil, false, err
+	}
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var acquired bool
+	err = conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1, hashtext($2))", jobLockNamespace, job).Scan(&acquired)
+	if err != nil || !acquired {
+		conn.Close()
+		return nil, false, err
+	}
+
+	unlock := func() {
+		// Sin el contexto de la tarea, que puede estar cancelado
+		_, _ = conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1, hashtext($2))", jobLockNamespace, job)
+		conn.Close()
+	}
+	return unlock, true, nil
+}
+
+// Register da de alta la tarea o actualiza su planificación
+func (r *ScheduledJobRepository) Register(ctx context.Context, job, spec string, next time.Time) error {
+	record := &models.ScheduledJob{Name: job, Schedule: spec, NextRunAt: optionalTime(next)}
+	err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "name"}},
+			DoUpdates: clause.AssignmentColumns([]string{"schedule", "next_run_at", "updated_at"}),
+		}).
+		Create(record).Error
+	return common.MapGormError(err)
+}
+
+// LastStarted inicio de la última ejecución en cualquier instancia
+func (r *ScheduledJobRepository) LastStarted(ctx context.Context, job string) (time.Time, error) {
+	record, err := r.GetByName(ctx, job)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if record.LastStartedAt == nil {
+		return time.Time{}, nil
+	}
+	return *record.LastStartedAt, nil
+}
+
+// Started marca el inicio de una ejecución
+func (r *ScheduledJobRepository) Started(ctx context.Context, job string, at, next time.Time) error {
+	record, err := r.GetByName(ctx, job)
+	if err != nil {
+		return err
+	}
+
+	record.MarkStarted(at, optionalTime(next))
+	return common.MapGormError(r.db.WithContext(ctx).Save(record).Error)
+}
+
+// Finished guarda el resultado de una ejecución
+func (r *ScheduledJobRepository) Finished(ctx context.Context, result scheduler.Result) error {
+	record, err := r.GetByName(ctx, result.Job)
+	if err != nil {
+		return err
+	}
+
+	var errMsg string
+	if result.Err != nil {
+		errMsg = result.Err.Error()
+	}
+	if err := record.MarkFinished(models.JobOutcome(result.Outcome), result.Summary, errMsg, result.StartedAt, result.FinishedAt); err != nil {
+		return err
+	}
+	return common.MapGormError(r.db.WithContext(ctx).Save(record).Error)
+}
+
+// optionalTime nil para la fecha cero
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
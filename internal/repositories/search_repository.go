@@ -0,0 +1,275 @@
+package repositories
+
+import (
+	"context"
+	"strings"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/models"
+	"cybesphere-backend/internal/query"
+	"cybesphere-backend/pkg/database"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// HighlightStart y HighlightStop delimitan los términos resaltados por
+	// ts_headline; se sustituyen por etiquetas al escapar el texto
+	HighlightStart = "⟦"
+	HighlightStop  = "⟧"
+
+	// eventDocument debe coincidir con la expresión del índice idx_events_fulltext
+	eventDocument = "to_tsvector('spanish_unaccent', events.title || ' ' || COALESCE(events.description, ''))"
+	// organizationDocument debe coincidir con la expresión del índice idx_organizations_fulltext
+	organizationDocument = "to_tsvector('spanish_unaccent', organizations.name || ' ' || COALESCE(organizations.description, ''))"
+	webSearchQuery       = "websearch_to_tsquery('spanish_unaccent', ?)"
+
+	// eventTags expande los tags de un evento (tolera valores que no son arrays)
+	eventTags = "CROSS JOIN LATERAL jsonb_array_elements_text(" +
+		"CASE WHEN jsonb_typeof(events.tags) = 'array' THEN events.tags ELSE '[]'::jsonb END) AS tag"
+
+	maxFacetValues = 20
+)
+
+// Opciones de ts_headline para títulos completos y fragmentos de descripción
+var (
+	titleHeadlineOptions   = "HighlightAll=true, StartSel=" + HighlightStart + ", StopSel=" + HighlightStop
+	snippetHeadlineOptions = "MaxWords=35, MinWords=15, MaxFragments=2, FragmentDelimiter=\" … \", " +
+		"StartSel=" + HighlightStart + ", StopSel=" + HighlightStop
+)
+
+// SearchParams parámetros de una búsqueda full-text
+type SearchParams struct {
+	Query  string
+	Type   string // Tipo de evento
+	Level  string
+	City   string
+	Tag    string
+	Limit  int
+	Offset int
+}
+
+// EventSearchHit evento encontrado con su relevancia y fragmentos resaltados
+type EventSearchHit struct {
+	models.Event
+	Rank             float64
+	TitleHighlight   string
+	Snippet          string
+	OrganizationName string
+}
+
+// OrganizationSearchHit organización encontrada con su relevancia y fragmentos resaltados
+type OrganizationSearchHit struct {
+	models.Organization
+	Rank          float64
+	NameHighlight string
+	Snippet       string
+}
+
+// EventFacets recuentos de los eventos encontrados por dimensión
+type EventFacets struct {
+	Types  []query.FacetCount
+	Levels []query.FacetCount
+	Cities []query.FacetCount
+	Tags   []query.FacetCount
+}
+
+// SearchRepository búsquedas full-text sobre eventos, organizaciones y tags
+type SearchRepository struct {
+	db *gorm.DB
+}
+
+// NewSearchRepository crea una nueva instancia
+func NewSearchRepository() *SearchRepository {
+	return &SearchRepository{db: database.GetDB()}
+}
+
+// SearchEvents busca eventos publicados ordenados por relevancia
+func (r *SearchRepository) SearchEvents(ctx context.Context, params SearchParams) ([]*EventSearchHit, int64, error) {
+	var total int64
+	if err := r.eventMatches(ctx, params).Count(&total).Error; err != nil {
+		return nil, 0, common.MapGormError(err)
+	}
+	if total == 0 {
+		return []*EventSearchHit{}, 0, nil
+	}
+
+	// Los fragmentos se calculan solo para la página solicitada
+	ranked := r.eventMatches(ctx, params).
+		Select("events.*, ts_rank_cd("+eventDocument+", "+webSearchQuery+") AS rank", params.Query).
+		Order("rank DESC, events.start_date ASC").
+		Limit(params.Limit).
+		Offset(params.Offset)
+
+	var hits []*EventSearchHit
+	err := r.db.WithContext(ctx).
+		Table("(?) AS events", ranked).
+		Select(`events.*, organizations.name AS organization_name,
+			ts_headline('spanish_unaccent', events.title, `+webSearchQuery+`, ?) AS title_highlight,
+			ts_headline('spanish_unaccent', COALESCE(events.description, ''), `+webSearchQuery+`, ?) AS snippet`,
+			params.Query, titleHeadlineOptions, params.Query, snippetHeadlineOptions).
+		Joins("LEFT JOIN organizations ON organizations.id::text = events.organization_id").
+		Order("events.rank DESC, events.start_date ASC").
+		Scan(&hits).Error
+	if err != nil {
+		return nil, 0, common.MapGormError(err)
+	}
+
+	return hits, total, nil
+}
+
+// EventFacets cuenta los eventos encontrados por tipo, nivel, ciudad y tag
+func (r *SearchRepository) EventFacets(ctx context.Context, params SearchParams) (*EventFacets, error) {
+	facets := &EventFacets{}
+
+	columns := []struct {
+		column string
+		target *[]query.FacetCount
+	}{
+		{"events.type", &facets.Types},
+		{"events.level", &facets.Levels},
+		{"events.venue_city", &facets.Cities},
+	}
+	for _, facet := range columns {
+		err := r.eventMatches(ctx, params).
+			Select(facet.column + " AS value, COUNT(*) AS count").
+			Where(facet.column + " <> ''").
+			Group(facet.column).
+			Order("count DESC, value ASC").
+			Limit(maxFacetValues).
+			Scan(facet.target).Error
+		if err != nil {
+			return nil, common.MapGormError(err)
+		}
+	}
+
+	err := r.eventMatches(ctx, params).
+		Joins(eventTags).
+		Select("tag AS value, COUNT(*) AS count").
+		Group("tag").
+		Order("count DESC, value ASC").
+		Limit(maxFacetValues).
+		Scan(&facets.Tags).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+
+	return facets, nil
+}
+
+// SearchOrganizations busca organizaciones activas ordenadas por relevancia
+func (r *SearchRepository) SearchOrganizations(ctx context.Context, params SearchParams) ([]*OrganizationSearchHit, int64, error) {
+	matches := func() *gorm.DB {
+		return r.db.WithContext(ctx).Table("organizations").
+			Where("organizations.deleted_at IS NULL AND organizations.status = ?", models.OrgStatusActive).
+			Where(organizationDocument+" @@ "+webSearchQuery, params.Query)
+	}
+
+	var total int64
+	if err := matches().Count(&total).Error; err != nil {
+		return nil, 0, common.MapGormError(err)
+	}
+	if total == 0 {
+		return []*OrganizationSearchHit{}, 0, nil
+	}
+
+	ranked := matches().
+		Select("organizations.*, ts_rank_cd("+organizationDocument+", "+webSearchQuery+") AS rank", params.Query).
+		Order("rank DESC, organizations.name ASC").
+		Limit(params.Limit).
+		Offset(params.Offset)
+
+	var hits []*OrganizationSearchHit
+	err := r.db.WithContext(ctx).
+		Table("(?) AS organizations", ranked).
+		Select(`organizations.*,
+			ts_headline('spanish_unaccent', organizations.name, `+webSearchQuery+`, ?) AS name_highlight,
+			ts_headline('spanish_unaccent', COALESCE(organizations.description, ''), `+webSearchQuery+`, ?) AS snippet`,
+			params.Query, titleHeadlineOptions, params.Query, snippetHeadlineOptions).
+		Order("organizations.rank DESC, organizations.name ASC").
+		Scan(&hits).Error
+	if err != nil {
+		return nil, 0, common.MapGormError(err)
+	}
+
+	return hits, total, nil
+}
+
+// SearchTags busca tags de eventos publicados que contienen el texto (sin
+// distinguir mayúsculas ni acentos) con el número de eventos de cada uno
+func (r *SearchRepository) SearchTags(ctx context.Context, text string, limit int) ([]query.FacetCount, error) {
+	var tags []query.FacetCount
+	err := r.publishedEvents(ctx).
+		Joins(eventTags).
+		Where("unaccent(lower(tag)) LIKE unaccent(lower(?)) ESCAPE '\\'", "%"+escapeLike(text)+"%").
+		Select("tag AS value, COUNT(*) AS count").
+		Group("tag").
+		Order("count DESC, value ASC").
+		Limit(limit).
+		Scan(&tags).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return tags, nil
+}
+
+// Suggest propone títulos de eventos, nombres de organizaciones y tags
+// parecidos al texto buscado (pg_trgm), para corregir errores tipográficos
+func (r *SearchRepository) Suggest(ctx context.Context, text string, limit int) ([]string, error) {
+	titles := r.publishedEvents(ctx).
+		Select("events.title AS term, word_similarity(?, events.title) AS score", text).
+		Where("? <% events.title", text)
+
+	organizations := r.db.WithContext(ctx).Table("organizations").
+		Select("organizations.name AS term, word_similarity(?, organizations.name) AS score", text).
+		Where("organizations.deleted_at IS NULL AND organizations.status = ?", models.OrgStatusActive).
+		Where("? <% organizations.name", text)
+
+	tags := r.publishedEvents(ctx).
+		Joins(eventTags).
+		Select("tag AS term, word_similarity(?, tag) AS score", text).
+		Where("? <% tag", text)
+
+	var suggestions []string
+	err := r.db.WithContext(ctx).
+		Raw(`SELECT term FROM ((?) UNION ALL (?) UNION ALL (?)) AS terms
+			GROUP BY term ORDER BY MAX(score) DESC, term ASC LIMIT ?`,
+			titles, organizations, tags, limit).
+		Scan(&suggestions).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return suggestions, nil
+}
+
+// publishedEvents consulta base de eventos visibles públicamente
+func (r *SearchRepository) publishedEvents(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).Table("events").
+		Where("events.deleted_at IS NULL AND events.status = ? AND events.is_public = ?", models.EventStatusPublished, true)
+}
+
+// eventMatches eventos publicados que coinciden con la búsqueda y los filtros
+func (r *SearchRepository) eventMatches(ctx context.Context, params SearchParams) *gorm.DB {
+	query := r.publishedEvents(ctx).
+		Where(eventDocument+" @@ "+webSearchQuery, params.Query)
+
+	if params.Type != "" {
+		query = query.Where("events.type = ?", params.Type)
+	}
+	if params.Level != "" {
+		query = query.Where("events.level = ?", params.Level)
+	}
+	if params.City != "" {
+		query = query.Where("lower(events.venue_city) = lower(?)", params.City)
+	}
+	if params.Tag != "" {
+		query = query.Where("events.tags @> jsonb_build_array(?::text)", params.Tag)
+	}
+
+	return query
+}
+
+// escapeLike escapa los comodines de un patrón LIKE
+func escapeLike(text string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(text)
+}
@@ -0,0 +1,84 @@
+package repositories
+
+import (
+	"context"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/models"
+
+	"gorm.io/gorm"
+)
+
+// SpeakerRepository repositorio para perfiles de ponentes
+type SpeakerRepository struct {
+	*BaseRepository[models.Speaker]
+}
+
+// NewSpeakerRepository crea una nueva instancia
+func NewSpeakerRepository() *SpeakerRepository {
+	base := NewBaseRepository[models.Speaker]()
+
+	base.builder.SetAllowedFilters(map[string]string{
+		"organization_id": "=",
+		"user_id":         "=",
+		"company":         "LIKE",
+	})
+
+	base.builder.SetAllowedSorts([]string{
+		"name", "created_at", "updated_at",
+	})
+
+	base.builder.SetSearchFields([]string{
+		"name", "company", "position",
+	})
+
+	return &SpeakerRepository{BaseRepository: base}
+}
+
+// GetByOrganization obtiene los ponentes de una organización
+func (r *SpeakerRepository) GetByOrganization(ctx context.Context, organizationID string, opts common.QueryOptions) ([]*models.Speaker, *common.PaginationMeta, error) {
+	opts.AddFilter("organization_id", organizationID)
+	return r.GetAll(ctx, opts)
+}
+
+// GetByOrganizationAndUser obtiene el perfil de ponente de un usuario en una organización
+func (r *SpeakerRepository) GetByOrganizationAndUser(ctx context.Context, organizationID, userID string) (*models.Speaker, error) {
+	var speaker models.Speaker
+	err := r.db.WithContext(ctx).
+		Where("organization_id = ? AND user_id = ?", organizationID, userID).
+		Order("created_at ASC").
+		First(&speaker).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return &speaker, nil
+}
+
+// GetByIDs obtiene varios ponentes por ID
+func (r *SpeakerRepository) GetByIDs(ctx context.Context, ids []string) ([]*models.Speaker, error) {
+	var speakers []*models.Speaker
+	if len(ids) == 0 {
+		return speakers, nil
+	}
+
+	err := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&speakers).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return speakers, nil
+}
+
+// GetWithSessions obtiene un ponente con sus sesiones y eventos
+func (r *SpeakerRepository) GetWithSessions(ctx context.Context, id string) (*models.Speaker, error) {
+	var speaker models.Speaker
+	err := r.db.WithContext(ctx).
+		Preload("Sessions", func(db *gorm.DB) *gorm.DB {
+			return db.Order("start_time ASC")
+		}).
+		Preload("Sessions.Event").
+		First(&speaker, "id = ?", id).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return &speaker, nil
+}
@@ -0,0 +1,75 @@
+package repositories
+
+import (
+	"context"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/models"
+)
+
+// SubmissionReviewRepository repositorio para revisiones de propuestas
+type SubmissionReviewRepository struct {
+	*BaseRepository[models.SubmissionReview]
+}
+
+// NewSubmissionReviewRepository crea una nueva instancia
+func NewSubmissionReviewRepository() *SubmissionReviewRepository {
+	base := NewBaseRepository[models.SubmissionReview]()
+
+	base.builder.SetAllowedFilters(map[string]string{
+		"submission_id": "=",
+		"reviewer_id":   "=",
+	})
+
+	base.builder.SetAllowedSorts([]string{
+		"created_at", "reviewed_at",
+	})
+
+	return &SubmissionReviewRepository{BaseRepository: base}
+}
+
+// GetByReviewer obtiene las revisiones asignadas a un usuario con la propuesta y su evento
+func (r *SubmissionReviewRepository) GetByReviewer(ctx context.Context, reviewerID string) ([]*models.SubmissionReview, error) {
+	var reviews []*models.SubmissionReview
+	err := r.db.WithContext(ctx).
+		Preload("Submission").
+		Preload("Submission.Event").
+		Where("reviewer_id = ?", reviewerID).
+		Order("reviewed_at IS NOT NULL, created_at ASC"). // Pendientes primero
+		Find(&reviews).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return reviews, nil
+}
+
+// GetWithSubmission obtiene una revisión con su propuesta y evento
+func (r *SubmissionReviewRepository) GetWithSubmission(ctx context.Context, id string) (*models.SubmissionReview, error) {
+	var review models.SubmissionReview
+	err := r.db.WithContext(ctx).
+		Preload("Submission").
+		Preload("Submission.Event").
+		First(&review, "id = ?", id).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return &review, nil
+}
+
+// GetAssignedReviewerIDs obtiene los revisores ya asignados a una propuesta
+func (r *SubmissionReviewRepository) GetAssignedReviewerIDs(ctx context.Context, submissionID string) (map[string]bool, error) {
+	var ids []string
+	err := r.db.WithContext(ctx).
+		Model(&models.SubmissionReview{}).
+		Where("submission_id = ?", submissionID).
+		Pluck("reviewer_id", &ids).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+
+	result := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		result[id] = true
+	}
+	return result, nil
+}
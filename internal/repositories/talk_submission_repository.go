@@ -0,0 +1,156 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ErrSubmissionStatusChanged otra decisión ha cambiado el estado de la propuesta entretanto
+var ErrSubmissionStatusChanged = errors.New("submission status changed")
+
+// submissionDecisionColumns columnas que guarda una decisión
+var submissionDecisionColumns = []string{
+	"status", "decision_note", "decided_at", "decided_by", "session_id", "speaker_id",
+	"updated_at", "updated_by",
+}
+
+// SubmissionAcceptance ponente y sesión de la agenda que crea la aceptación de una propuesta
+type SubmissionAcceptance struct {
+	Speaker    *models.Speaker
+	NewSpeaker bool // El perfil de ponente aún no existe y se crea
+	Session    *models.EventSession
+}
+
+// TalkSubmissionRepository repositorio para propuestas del call for papers
+type TalkSubmissionRepository struct {
+	*BaseRepository[models.TalkSubmission]
+}
+
+// NewTalkSubmissionRepository crea una nueva instancia
+func NewTalkSubmissionRepository() *TalkSubmissionRepository {
+	base := NewBaseRepository[models.TalkSubmission]()
+
+	base.builder.SetAllowedFilters(map[string]string{
+		"event_id":     "=",
+		"submitter_id": "=",
+		"status":       "=",
+		"track":        "=",
+		"level":        "=",
+	})
+
+	base.builder.SetAllowedSorts([]string{
+		"created_at", "updated_at", "title", "status",
+	})
+
+	base.builder.SetSearchFields([]string{
+		"title", "abstract",
+	})
+
+	return &TalkSubmissionRepository{BaseRepository: base}
+}
+
+// GetByEvent obtiene las propuestas de un evento con sus revisiones (status vacío = todas)
+func (r *TalkSubmissionRepository) GetByEvent(ctx context.Context, eventID string, status models.SubmissionStatus) ([]*models.TalkSubmission, error) {
+	var submissions []*models.TalkSubmission
+	db := r.db.WithContext(ctx).
+		Preload("Reviews").
+		Preload("Submitter").
+		Where("event_id = ?", eventID)
+
+	if status != "" {
+		db = db.Where("status = ?", status)
+	}
+
+	err := db.Order("created_at ASC").Find(&submissions).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return submissions, nil
+}
+
+// GetBySubmitter obtiene las propuestas enviadas por un usuario
+func (r *TalkSubmissionRepository) GetBySubmitter(ctx context.Context, submitterID string) ([]*models.TalkSubmission, error) {
+	var submissions []*models.TalkSubmission
+	err := r.db.WithContext(ctx).
+		Preload("Event").
+		Where("submitter_id = ?", submitterID).
+		Order("created_at DESC").
+		Find(&submissions).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return submissions, nil
+}
+
+// GetWithReviews obtiene una propuesta con su evento, autor y revisiones
+func (r *TalkSubmissionRepository) GetWithReviews(ctx context.Context, id string) (*models.TalkSubmission, error) {
+	var submission models.TalkSubmission
+	err := r.db.WithContext(ctx).
+		Preload("Event").
+		Preload("Submitter").
+		Preload("Reviews", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at ASC")
+		}).
+		Preload("Reviews.Reviewer").
+		First(&submission, "id = ?", id).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return &submission, nil
+}
+
+// CountBySubmitter cuenta las propuestas de un usuario para un evento
+func (r *TalkSubmissionRepository) CountBySubmitter(ctx context.Context, eventID, submitterID string) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&models.TalkSubmission{}).
+		Where("event_id = ? AND submitter_id = ?", eventID, submitterID).
+		Count(&count).Error
+	if err != nil {
+		return 0, common.MapGormError(err)
+	}
+	return count, nil
+}
+
+// Decide guarda la decisión sobre la propuesta si sigue en el estado from y,
+// al aceptarla, crea el ponente y la sesión en la misma transacción. Si otra
+// decisión se ha adelantado devuelve ErrSubmissionStatusChanged y no crea nada
+func (r *TalkSubmissionRepository) Decide(ctx context.Context, submission *models.TalkSubmission, from models.SubmissionStatus, acceptance *SubmissionAcceptance) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if acceptance != nil {
+			if acceptance.NewSpeaker {
+				if err := tx.Omit(clause.Associations).Create(acceptance.Speaker).Error; err != nil {
+					return err
+				}
+			}
+			if err := tx.Omit(clause.Associations).Create(acceptance.Session).Error; err != nil {
+				return err
+			}
+			if err := tx.Model(acceptance.Session).Association("Speakers").Append(acceptance.Speaker); err != nil {
+				return err
+			}
+
+			sessionID := acceptance.Session.ID.String()
+			speakerID := acceptance.Speaker.ID.String()
+			submission.SessionID = &sessionID
+			submission.SpeakerID = &speakerID
+		}
+
+		result := tx.Model(submission).
+			Where("status = ?", from).
+			Select(submissionDecisionColumns).
+			Updates(submission)
+		return reservationResult(result, ErrSubmissionStatusChanged)
+	})
+
+	if errors.Is(err, ErrSubmissionStatusChanged) {
+		return err
+	}
+	return common.MapGormError(err)
+}
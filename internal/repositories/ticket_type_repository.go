@@ -0,0 +1,86 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/models"
+)
+
+// ErrTicketQuantityBelowSold el nuevo cupo es menor que las entradas ya vendidas o reservadas
+var ErrTicketQuantityBelowSold = errors.New("ticket quantity below tickets sold")
+
+// ticketTypeEditableColumns columnas que modifica el organizador. quantity_sold
+// solo cambia con las reservas atómicas de las inscripciones
+var ticketTypeEditableColumns = []string{
+	"name", "description", "sort_order", "price_cents", "quantity",
+	"sales_start_date", "sales_end_date", "is_active", "updated_at", "updated_by",
+}
+
+// TicketTypeRepository repositorio para tipos de entrada
+type TicketTypeRepository struct {
+	*BaseRepository[models.TicketType]
+}
+
+// NewTicketTypeRepository crea una nueva instancia
+func NewTicketTypeRepository() *TicketTypeRepository {
+	base := NewBaseRepository[models.TicketType]()
+
+	base.builder.SetAllowedFilters(map[string]string{
+		"event_id":  "=",
+		"is_active": "=",
+	})
+
+	base.builder.SetAllowedSorts([]string{
+		"sort_order", "price_cents", "created_at",
+	})
+
+	return &TicketTypeRepository{BaseRepository: base}
+}
+
+// GetByEvent obtiene los tipos de entrada de un evento ordenados para mostrar
+func (r *TicketTypeRepository) GetByEvent(ctx context.Context, eventID string, activeOnly bool) ([]*models.TicketType, error) {
+	var ticketTypes []*models.TicketType
+	query := r.db.WithContext(ctx).Where("event_id = ?", eventID)
+	if activeOnly {
+		query = query.Where("is_active = ?", true)
+	}
+
+	err := query.Order("sort_order ASC, price_cents ASC, created_at ASC").Find(&ticketTypes).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return ticketTypes, nil
+}
+
+// CountByEvent cuenta los tipos de entrada de un evento
+func (r *TicketTypeRepository) CountByEvent(ctx context.Context, eventID string) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&models.TicketType{}).
+		Where("event_id = ?", eventID).
+		Count(&count).Error
+	if err != nil {
+		return 0, common.MapGormError(err)
+	}
+	return count, nil
+}
+
+// UpdateDetails guarda los datos editables del tipo de entrada sin tocar las
+// entradas vendidas. El cupo se comprueba en la misma sentencia contra las
+// vendidas en ese momento, así que una reserva simultánea no permite sobreventa
+func (r *TicketTypeRepository) UpdateDetails(ctx context.Context, ticketType *models.TicketType) error {
+	query := r.db.WithContext(ctx).Model(ticketType).Select(ticketTypeEditableColumns)
+	if ticketType.Quantity != nil {
+		query = query.Where("quantity_sold <= ?", *ticketType.Quantity)
+	}
+
+	if err := reservationResult(query.Updates(ticketType), ErrTicketQuantityBelowSold); err != nil {
+		if errors.Is(err, ErrTicketQuantityBelowSold) {
+			return err
+		}
+		return common.MapGormError(err)
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 
 	"cybesphere-backend/internal/common"
 	"cybesphere-backend/internal/models"
+	"cybesphere-backend/internal/query"
 )
 
 // UserRepository repositorio específico para usuarios
@@ -28,6 +29,20 @@ func NewUserRepository() *UserRepository {
 		"organization_id": "=",
 	})
 
+	// Campos admitidos en las expresiones ?filter=
+	base.builder.SetFilterFields(map[string]query.FilterField{
+		"role":            {Column: "role", Type: query.FieldString, Values: []string{"admin", "organizer", "user"}},
+		"is_active":       {Column: "is_active", Type: query.FieldBool},
+		"is_verified":     {Column: "is_verified", Type: query.FieldBool},
+		"email":           {Column: "email", Type: query.FieldString},
+		"company":         {Column: "company", Type: query.FieldString},
+		"city":            {Column: "city", Type: query.FieldString},
+		"country":         {Column: "country", Type: query.FieldString},
+		"organization_id": {Column: "organization_id", Type: query.FieldString},
+		"created_at":      {Column: "created_at", Type: query.FieldTime},
+		"last_login_at":   {Column: "last_login_at", Type: query.FieldTime},
+	})
+
 	// Configurar ordenamiento
 	base.builder.SetAllowedSorts([]string{
 		"created_at", "updated_at", "email", "first_name", "last_name", "last_login_at",
@@ -38,6 +53,11 @@ func NewUserRepository() *UserRepository {
 		"first_name", "last_name", "email", "company",
 	})
 
+	// Relaciones que se pueden incluir con ?expand=
+	base.builder.SetExpansions(map[string]query.Expansion{
+		"organization": {Preloads: []string{"Organization"}},
+	})
+
 	return &UserRepository{BaseRepository: base}
 }
 
@@ -69,6 +89,18 @@ func (r *UserRepository) GetByOrganization(ctx context.Context, organizationID s
 	return r.GetAll(ctx, opts)
 }
 
+// GetActiveIDsByOrganization obtiene los IDs de los usuarios activos de una organización
+func (r *UserRepository) GetActiveIDsByOrganization(ctx context.Context, organizationID string) ([]string, error) {
+	var userIDs []string
+	err := r.db.WithContext(ctx).Model(&models.User{}).
+		Where("organization_id = ? AND is_active = ?", organizationID, true).
+		Pluck("id", &userIDs).Error
+	if err != nil {
+		return nil, common.MapGormError(err)
+	}
+	return userIDs, nil
+}
+
 // GetWithOrganization obtiene usuario con su organización
 func (r *UserRepository) GetWithOrganization(ctx context.Context, id string) (*models.User, error) {
 	var user models.User
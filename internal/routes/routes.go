@@ -2,6 +2,7 @@
 package routes
 
 import (
+	"crypto/ed25519"
 	"net/http"
 	"time"
 
@@ -9,6 +10,7 @@ import (
 
 	"cybesphere-backend/internal/common"
 	"cybesphere-backend/internal/config"
+	"cybesphere-backend/internal/dto"
 	"cybesphere-backend/internal/handlers"
 	"cybesphere-backend/internal/helpers"
 	"cybesphere-backend/internal/mappers"
@@ -17,8 +19,14 @@ import (
 	"cybesphere-backend/internal/permissions"
 	"cybesphere-backend/internal/repositories"
 	"cybesphere-backend/internal/services"
+	"cybesphere-backend/pkg/audit"
 	"cybesphere-backend/pkg/auth"
 	"cybesphere-backend/pkg/database"
+	"cybesphere-backend/pkg/geo"
+	"cybesphere-backend/pkg/geocoding"
+	"cybesphere-backend/pkg/logger"
+	"cybesphere-backend/pkg/payments"
+	"cybesphere-backend/pkg/storage"
 )
 
 // Application estructura que contiene todas las dependencias
@@ -32,20 +40,55 @@ type Application struct {
 
 // ServiceContainer contiene todos los servicios
 type ServiceContainer struct {
-	Auth          services.AuthService
-	Authorization services.AuthorizationService
-	Events        services.EventService
-	Organizations services.OrganizationService
-	Users         services.UserService
+	Auth            services.AuthService
+	Authorization   services.AuthorizationService
+	Events          services.EventService
+	Organizations   services.OrganizationService
+	Users           services.UserService
+	Calendars       services.CalendarService
+	EventSeries     services.EventSeriesService
+	Agenda          services.AgendaService
+	Notifications   services.NotificationService
+	CFP             services.CFPService
+	Ticketing       services.TicketingService
+	Payments        services.PaymentService
+	Invoices        services.InvoiceService
+	Search          services.SearchService
+	Recommendations services.RecommendationService
+	Audit           services.AuditService
+	Versions        services.VersionService
+	Privacy         services.PrivacyService
+	Jobs            services.JobService
+	Moderation      services.ModerationService
+	Verification    services.VerificationService
+	Media           services.MediaService
+	MediaProcessing services.MediaProcessingService
+	EventLifecycle  services.EventLifecycleService
 }
 
 // HandlerContainer contiene todos los handlers
 type HandlerContainer struct {
-	Auth          *handlers.AuthHandler
-	Events        *handlers.EventHandler
-	Organizations *handlers.OrganizationHandler
-	Users         *handlers.UserHandler
-	Capabilities  *handlers.UserCapabilitiesHandler
+	Auth            *handlers.AuthHandler
+	Events          *handlers.EventHandler
+	Organizations   *handlers.OrganizationHandler
+	Users           *handlers.UserHandler
+	Capabilities    *handlers.UserCapabilitiesHandler
+	Calendars       *handlers.CalendarHandler
+	EventSeries     *handlers.EventSeriesHandler
+	Agenda          *handlers.AgendaHandler
+	Notifications   *handlers.NotificationHandler
+	CFP             *handlers.CFPHandler
+	Ticketing       *handlers.TicketingHandler
+	Payments        *handlers.PaymentHandler
+	Search          *handlers.SearchHandler
+	Recommendations *handlers.RecommendationHandler
+	Audit           *handlers.AuditHandler
+	Versions        *handlers.VersionHandler
+	Privacy         *handlers.PrivacyHandler
+	Jobs            *handlers.JobHandler
+	Moderation      *handlers.ModerationHandler
+	Verification    *handlers.VerificationHandler
+	Media           *handlers.MediaHandler
 }
 
 // InitializeApplication inicializa toda la aplicación con sus dependencias
@@ -67,23 +110,116 @@ func InitializeApplication(cfg *config.Config, jwtManager *auth.JWTManager) *App
 		mapper,
 	)
 
-	// 5. Crear service manager
+	// 5. Crear proveedor de pagos
+	paymentProvider, err := payments.New(payments.Config{
+		Provider:      cfg.Payments.Provider,
+		WebhookSecret: cfg.Payments.WebhookSecret,
+		CheckoutURL:   cfg.Payments.CheckoutURL,
+	})
+	if err != nil {
+		logger.Fatalf("Error inicializando el proveedor de pagos: %v", err)
+	}
+
+	// 6. Crear geocodificador de direcciones (nil si está desactivado)
+	geocoder, err := geocoding.New(geocoding.Config{
+		Provider:    cfg.Geocoding.Provider,
+		FixturePath: cfg.Geocoding.FixturePath,
+		BaseURL:     cfg.Geocoding.BaseURL,
+		UserAgent:   cfg.Geocoding.UserAgent,
+		Timeout:     cfg.Geocoding.Timeout,
+		CacheTTL:    cfg.Geocoding.CacheTTL,
+		CacheSize:   cfg.Geocoding.CacheSize,
+	})
+	if err != nil {
+		logger.Fatalf("Error inicializando el geocodificador: %v", err)
+	}
+
+	// 7. Clave de firma de las exportaciones de auditoría (nil si no se firman)
+	var auditSigningKey ed25519.PrivateKey
+	if cfg.Audit.SigningKey != "" {
+		auditSigningKey, err = audit.ParseSigningKey(cfg.Audit.SigningKey)
+		if err != nil {
+			logger.Fatalf("Error inicializando la firma de auditoría: %v", err)
+		}
+	}
+
+	// 8. Almacén de ficheros subidos
+	store, err := storage.New(storage.Config{
+		Backend:   cfg.Upload.Backend,
+		PublicURL: cfg.Upload.PublicURL,
+		Path:      cfg.Upload.Path,
+		Endpoint:  cfg.Upload.S3.Endpoint,
+		Bucket:    cfg.Upload.S3.Bucket,
+		Region:    cfg.Upload.S3.Region,
+		AccessKey: cfg.Upload.S3.AccessKey,
+		SecretKey: cfg.Upload.S3.SecretKey,
+		PathStyle: cfg.Upload.S3.PathStyle,
+	})
+	if err != nil {
+		logger.Fatalf("Error inicializando el almacén de ficheros: %v", err)
+	}
+	maxUploadSize := int64(cfg.Upload.MaxSizeMB) << 20
+
+	// 9. Crear service manager
 	serviceManager := services.NewServiceManager(
 		repoManager,
 		mapper,
 		authorizationService,
+		paymentProvider,
+		geocoder,
+		geo.RadiusLimits{DefaultKm: cfg.Geo.DefaultRadiusKM, MaxKm: cfg.Geo.MaxRadiusKM},
+		auditSigningKey,
+		services.PrivacyRetention{
+			ExportRetention:    cfg.Privacy.ExportRetention,
+			ErasureGracePeriod: cfg.Privacy.ErasureGracePeriod,
+		},
+		services.JobSettings{
+			TokenRetention:       cfg.Jobs.TokenRetention,
+			AuditLogRetention:    cfg.Jobs.AuditLogRetention,
+			SoftDeleteRetention:  cfg.Jobs.SoftDeleteRetention,
+			EventCompletionDelay: cfg.Jobs.EventCompletionDelay,
+		},
+		services.ModerationSettings{FirstEventApproval: cfg.Moderation.FirstEventApproval},
+		services.MediaSettings{
+			Storage: store,
+			Policy:  storage.Policy{MaxSize: maxUploadSize, AllowedExtensions: cfg.Upload.AllowedExtensions},
+			Processing: services.MediaProcessingSettings{
+				Workers:   cfg.Upload.ImageWorkers,
+				Quality:   cfg.Upload.ImageQuality,
+				MaxPixels: cfg.Upload.ImageMaxPixels,
+			},
+		},
 	)
 
-	// 6. Container de servicios
+	// 10. Container de servicios
 	serviceContainer := &ServiceContainer{
-		Auth:          authService,
-		Authorization: authorizationService,
-		Events:        serviceManager.Events,
-		Organizations: serviceManager.Organizations,
-		Users:         serviceManager.Users,
+		Auth:            authService,
+		Authorization:   authorizationService,
+		Events:          serviceManager.Events,
+		Organizations:   serviceManager.Organizations,
+		Users:           serviceManager.Users,
+		Calendars:       serviceManager.Calendars,
+		EventSeries:     serviceManager.EventSeries,
+		Agenda:          serviceManager.Agenda,
+		Notifications:   serviceManager.Notifications,
+		CFP:             serviceManager.CFP,
+		Ticketing:       serviceManager.Ticketing,
+		Payments:        serviceManager.Payments,
+		Invoices:        serviceManager.Invoices,
+		Search:          serviceManager.Search,
+		Recommendations: serviceManager.Recommendations,
+		Audit:           serviceManager.Audit,
+		Versions:        serviceManager.Versions,
+		Privacy:         serviceManager.Privacy,
+		Jobs:            serviceManager.Jobs,
+		Moderation:      serviceManager.Moderation,
+		Verification:    serviceManager.Verification,
+		Media:           serviceManager.Media,
+		MediaProcessing: serviceManager.MediaProcessing,
+		EventLifecycle:  serviceManager.EventLifecycle,
 	}
 
-	// 7. Crear handlers
+	// 11. Crear handlers
 	handlerContainer := &HandlerContainer{
 		Auth: handlers.NewAuthHandler(
 			authService,
@@ -108,6 +244,63 @@ func InitializeApplication(cfg *config.Config, jwtManager *auth.JWTManager) *App
 			serviceManager.Users,
 			mapper,
 		),
+		Calendars: handlers.NewCalendarHandler(
+			serviceManager.Calendars,
+			mapper,
+		),
+		EventSeries: handlers.NewEventSeriesHandler(
+			serviceManager.EventSeries,
+			mapper,
+		),
+		Agenda: handlers.NewAgendaHandler(
+			serviceManager.Agenda,
+			mapper,
+		),
+		Notifications: handlers.NewNotificationHandler(
+			serviceManager.Notifications,
+			mapper,
+		),
+		CFP: handlers.NewCFPHandler(
+			serviceManager.CFP,
+			mapper,
+		),
+		Ticketing: handlers.NewTicketingHandler(
+			serviceManager.Ticketing,
+			mapper,
+		),
+		Payments: handlers.NewPaymentHandler(
+			serviceManager.Payments,
+			serviceManager.Invoices,
+			mapper,
+		),
+		Search: handlers.NewSearchHandler(
+			serviceManager.Search,
+			mapper,
+		),
+		Recommendations: handlers.NewRecommendationHandler(
+			serviceManager.Recommendations,
+			mapper,
+		),
+		Audit:    handlers.NewAuditHandler(serviceManager.Audit),
+		Versions: handlers.NewVersionHandler(serviceManager.Versions),
+		Privacy: handlers.NewPrivacyHandler(
+			serviceManager.Privacy,
+			mapper,
+		),
+		Jobs: handlers.NewJobHandler(serviceManager.Jobs),
+		Moderation: handlers.NewModerationHandler(
+			serviceManager.Moderation,
+			mapper,
+		),
+		Verification: handlers.NewVerificationHandler(
+			serviceManager.Verification,
+			mapper,
+		),
+		Media: handlers.NewMediaHandler(
+			serviceManager.Media,
+			mapper,
+			maxUploadSize,
+		),
 	}
 
 	return &Application{
@@ -168,11 +361,32 @@ func setupPublicRoutes(v1 *gin.RouterGroup, cfg *config.Config, authMiddleware *
 		// Ping endpoint con información opcional de usuario
 		public.GET("/ping", pingEndpoint(cfg))
 
+		// Búsqueda full-text
+		public.GET("/search", app.Handlers.Search.Search)
+
 		// Eventos públicos
-		public.GET("/events", app.Handlers.Events.GetAll)
+		public.GET("/events", app.Handlers.Events.ListPublicEvents)
+		public.GET("/events/nearby", app.Handlers.Events.GetNearbyEvents)
 		public.GET("/events/:id", app.Handlers.Events.GetByID)
 		public.GET("/events/featured", app.Handlers.Events.GetFeaturedEvents)
 		public.GET("/events/upcoming", app.Handlers.Events.GetUpcomingEvents)
+		public.GET("/events/:id/ical", app.Handlers.Calendars.ExportEvent)
+		public.GET("/events/:id/agenda", app.Handlers.Agenda.GetAgenda)
+		public.GET("/speakers/:id", app.Handlers.Agenda.GetSpeaker)
+		public.GET("/events/:id/tickets", app.Handlers.Ticketing.ListTicketTypes)
+
+		// Feeds iCalendar suscribibles (protegidos por token secreto)
+		public.GET("/calendars/:token", app.Handlers.Calendars.GetFeed)
+
+		// Notificaciones de los proveedores de pago (protegidas por firma)
+		public.POST("/payments/:provider/webhook", app.Handlers.Payments.HandleWebhook)
+		if cfg.Payments.Provider == payments.FakeProviderName && cfg.Monitoring.Environment != "production" {
+			public.POST("/payments/fake/checkout/:paymentId", app.Handlers.Payments.FakeCheckout)
+		}
+
+		// Ficheros públicos (imágenes de eventos y organizaciones)
+		public.GET("/media/:id", app.Handlers.Media.GetPublicMedia)
+		public.GET("/media/:id/variants/:name", app.Handlers.Media.GetPublicMediaVariant)
 
 		// Organizaciones públicas
 		public.GET("/organizations", app.Handlers.Organizations.GetAll)
@@ -214,6 +428,47 @@ func setupProtectedRoutes(v1 *gin.RouterGroup, authMiddleware *middleware.AuthMi
 			userGroup.DELETE("/sessions/:sessionId", app.Handlers.Capabilities.RevokeSession)
 			userGroup.GET("/roles", app.Handlers.Capabilities.GetRoleInfo)
 			userGroup.GET("/profile", app.Handlers.Users.GetUserProfile)
+
+			// Feeds de calendario
+			userGroup.GET("/calendar-feeds", app.Handlers.Calendars.ListFeeds)
+			userGroup.POST("/calendar-feeds", app.Handlers.Calendars.CreateFeed)
+			userGroup.DELETE("/calendar-feeds/:feedId", app.Handlers.Calendars.RevokeFeed)
+
+			// Agenda personal
+			userGroup.GET("/schedule", app.Handlers.Agenda.GetSchedule)
+
+			// Call for papers: propuestas propias y revisiones asignadas
+			userGroup.GET("/submissions", app.Handlers.CFP.ListMySubmissions)
+			userGroup.GET("/reviews", app.Handlers.CFP.ListMyReviews)
+			userGroup.PUT("/reviews/:reviewId", app.Handlers.CFP.SubmitReview)
+
+			// Inscripciones a eventos
+			userGroup.GET("/registrations", app.Handlers.Ticketing.ListMyRegistrations)
+
+			// Pedidos
+			userGroup.GET("/orders", app.Handlers.Payments.ListMyOrders)
+			userGroup.GET("/orders/:orderId", app.Handlers.Payments.GetOrder)
+			userGroup.GET("/orders/:orderId/invoices", app.Handlers.Payments.ListOrderInvoices)
+
+			// Notificaciones
+			userGroup.GET("/notifications", app.Handlers.Notifications.ListNotifications)
+			userGroup.POST("/notifications/read-all", app.Handlers.Notifications.MarkAllAsRead)
+			userGroup.POST("/notifications/:notificationId/read", app.Handlers.Notifications.MarkAsRead)
+
+			// Recomendaciones personalizadas
+			userGroup.GET("/recommendations", app.Handlers.Recommendations.GetRecommendations)
+
+			// Denuncias presentadas
+			userGroup.GET("/reports", app.Handlers.Moderation.ListOwnReports)
+
+			// Privacidad: exportación de datos y supresión de la cuenta
+			userGroup.GET("/data-export", app.Handlers.Privacy.ListDataExports)
+			userGroup.POST("/data-export", app.Handlers.Privacy.RequestDataExport)
+			userGroup.GET("/data-export/:exportId", app.Handlers.Privacy.GetDataExport)
+			userGroup.GET("/data-export/:exportId/download", app.Handlers.Privacy.DownloadDataExport)
+			userGroup.DELETE("/account", app.Handlers.Privacy.RequestErasure)
+			userGroup.GET("/account/erasure", app.Handlers.Privacy.GetErasureRequest)
+			userGroup.DELETE("/account/erasure", app.Handlers.Privacy.CancelOwnErasure)
 		}
 
 		// Events - CRUD con BaseHandler
@@ -241,16 +496,136 @@ func setupProtectedRoutes(v1 *gin.RouterGroup, authMiddleware *middleware.AuthMi
 				authMiddleware.GuardEvent(permissions.PublishEvent),
 				app.Handlers.Events.PublishEvent)
 
+			eventsGroup.POST("/:id/schedule-publish",
+				authMiddleware.GuardEvent(permissions.PublishEvent),
+				app.Handlers.Events.SchedulePublication)
+
+			eventsGroup.DELETE("/:id/schedule-publish",
+				authMiddleware.GuardEvent(permissions.PublishEvent),
+				app.Handlers.Events.UnschedulePublication)
+
 			eventsGroup.POST("/:id/cancel",
 				authMiddleware.GuardEvent(permissions.WriteEvent),
 				app.Handlers.Events.CancelEvent)
 
+			// Imagen y banner a partir de ficheros subidos
+			eventsGroup.PUT("/:id/media/:field",
+				authMiddleware.GuardEvent(permissions.WriteEvent),
+				app.Handlers.Media.AttachEventMedia)
+
+			// Denunciar el evento a moderación
+			eventsGroup.POST("/:id/report", app.Handlers.Moderation.ReportEvent)
+
+			// Historial de versiones (solo quien puede editar el evento)
+			eventsGroup.GET("/:id/versions",
+				authMiddleware.GuardEvent(permissions.WriteEvent),
+				app.Handlers.Versions.List(models.AuditResourceEvent))
+			eventsGroup.GET("/:id/versions/diff",
+				authMiddleware.GuardEvent(permissions.WriteEvent),
+				app.Handlers.Versions.Diff(models.AuditResourceEvent))
+			eventsGroup.GET("/:id/versions/:version",
+				authMiddleware.GuardEvent(permissions.WriteEvent),
+				app.Handlers.Versions.Get(models.AuditResourceEvent))
+			eventsGroup.POST("/:id/versions/:version/restore",
+				authMiddleware.GuardEvent(permissions.WriteEvent),
+				app.Handlers.Versions.Restore(models.AuditResourceEvent))
+
 			// Favoritos (cualquier usuario autenticado)
 			eventsGroup.POST("/:id/favorite", app.Handlers.Events.AddToFavorites)
 			eventsGroup.DELETE("/:id/favorite", app.Handlers.Events.RemoveFromFavorites)
 
 			// Eventos por organización
 			eventsGroup.GET("/organization/:orgId", app.Handlers.Events.GetEventsByOrganization)
+
+			// Series recurrentes (la pertenencia a la organización se verifica en el servicio)
+			eventsGroup.POST("/series",
+				authMiddleware.RequirePermissionEnhanced(permissions.WriteEvent),
+				app.Handlers.EventSeries.CreateSeries)
+			eventsGroup.GET("/series/:seriesId",
+				authMiddleware.RequirePermissionEnhanced(permissions.WriteEvent),
+				app.Handlers.EventSeries.GetSeries)
+			eventsGroup.PUT("/series/:seriesId/occurrences/:occurrenceId",
+				authMiddleware.RequirePermissionEnhanced(permissions.WriteEvent),
+				app.Handlers.EventSeries.UpdateOccurrence)
+			eventsGroup.POST("/series/:seriesId/occurrences/:occurrenceId/cancel",
+				authMiddleware.RequirePermissionEnhanced(permissions.WriteEvent),
+				app.Handlers.EventSeries.CancelOccurrence)
+
+			// Agenda del evento (la pertenencia a la organización se verifica en el servicio)
+			eventsGroup.POST("/:id/sessions",
+				authMiddleware.RequirePermissionEnhanced(permissions.WriteEvent),
+				app.Handlers.Agenda.CreateSession)
+			eventsGroup.PUT("/:id/sessions/:sessionId",
+				authMiddleware.RequirePermissionEnhanced(permissions.WriteEvent),
+				app.Handlers.Agenda.UpdateSession)
+			eventsGroup.DELETE("/:id/sessions/:sessionId",
+				authMiddleware.RequirePermissionEnhanced(permissions.WriteEvent),
+				app.Handlers.Agenda.DeleteSession)
+
+			// Agenda personal (cualquier usuario autenticado)
+			eventsGroup.POST("/:id/sessions/:sessionId/favorite", app.Handlers.Agenda.AddSessionFavorite)
+			eventsGroup.DELETE("/:id/sessions/:sessionId/favorite", app.Handlers.Agenda.RemoveSessionFavorite)
+
+			// Call for papers: envío abierto a cualquier usuario autenticado
+			eventsGroup.POST("/:id/submissions", app.Handlers.CFP.SubmitTalk)
+
+			// Revisión y decisión (la pertenencia a la organización se verifica en el servicio)
+			eventsGroup.GET("/:id/submissions",
+				authMiddleware.RequirePermissionEnhanced(permissions.WriteEvent),
+				app.Handlers.CFP.ListSubmissions)
+			eventsGroup.GET("/:id/submissions/export",
+				authMiddleware.RequirePermissionEnhanced(permissions.WriteEvent),
+				app.Handlers.CFP.ExportReviewSummary)
+			eventsGroup.GET("/:id/submissions/:submissionId",
+				authMiddleware.RequirePermissionEnhanced(permissions.WriteEvent),
+				app.Handlers.CFP.GetSubmission)
+			eventsGroup.POST("/:id/submissions/:submissionId/reviewers",
+				authMiddleware.RequirePermissionEnhanced(permissions.WriteEvent),
+				app.Handlers.CFP.AssignReviewers)
+			eventsGroup.POST("/:id/submissions/:submissionId/decision",
+				authMiddleware.RequirePermissionEnhanced(permissions.WriteEvent),
+				app.Handlers.CFP.DecideSubmission)
+
+			// Inscripción (cualquier usuario autenticado)
+			eventsGroup.POST("/:id/price-quote", app.Handlers.Ticketing.QuotePrice)
+			eventsGroup.POST("/:id/register", app.Handlers.Ticketing.Register)
+			eventsGroup.DELETE("/:id/register", app.Handlers.Ticketing.CancelRegistration)
+
+			// Entradas, descuentos y asistentes (la pertenencia a la organización se verifica en el servicio)
+			eventsGroup.GET("/:id/attendees",
+				authMiddleware.RequirePermissionEnhanced(permissions.WriteEvent),
+				app.Handlers.Ticketing.ListAttendees)
+			eventsGroup.POST("/:id/ticket-types",
+				authMiddleware.RequirePermissionEnhanced(permissions.WriteEvent),
+				app.Handlers.Ticketing.CreateTicketType)
+			eventsGroup.PUT("/:id/ticket-types/:ticketTypeId",
+				authMiddleware.RequirePermissionEnhanced(permissions.WriteEvent),
+				app.Handlers.Ticketing.UpdateTicketType)
+			eventsGroup.DELETE("/:id/ticket-types/:ticketTypeId",
+				authMiddleware.RequirePermissionEnhanced(permissions.WriteEvent),
+				app.Handlers.Ticketing.DeleteTicketType)
+			eventsGroup.GET("/:id/promo-codes",
+				authMiddleware.RequirePermissionEnhanced(permissions.WriteEvent),
+				app.Handlers.Ticketing.ListPromoCodes)
+			eventsGroup.POST("/:id/promo-codes",
+				authMiddleware.RequirePermissionEnhanced(permissions.WriteEvent),
+				app.Handlers.Ticketing.CreatePromoCode)
+			eventsGroup.PUT("/:id/promo-codes/:promoCodeId",
+				authMiddleware.RequirePermissionEnhanced(permissions.WriteEvent),
+				app.Handlers.Ticketing.UpdatePromoCode)
+			eventsGroup.DELETE("/:id/promo-codes/:promoCodeId",
+				authMiddleware.RequirePermissionEnhanced(permissions.WriteEvent),
+				app.Handlers.Ticketing.DeletePromoCode)
+		}
+
+		// Ponentes (la pertenencia a la organización se verifica en el servicio)
+		speakersGroup := protected.Group("/speakers")
+		speakersGroup.Use(authMiddleware.RequirePermissionEnhanced(permissions.WriteEvent))
+		{
+			speakersGroup.GET("", app.Handlers.Agenda.ListSpeakers)
+			speakersGroup.POST("", app.Handlers.Agenda.CreateSpeaker)
+			speakersGroup.PUT("/:id", app.Handlers.Agenda.UpdateSpeaker)
+			speakersGroup.DELETE("/:id", app.Handlers.Agenda.DeleteSpeaker)
 		}
 
 		// Organizations - CRUD con BaseHandler
@@ -279,10 +654,67 @@ func setupProtectedRoutes(v1 *gin.RouterGroup, authMiddleware *middleware.AuthMi
 				authMiddleware.ForAdminOnly(),
 				app.Handlers.Organizations.VerifyOrganization)
 
+			// Solicitud de verificación con documentación (quien gestiona la organización)
+			orgsGroup.POST("/:id/verification",
+				authMiddleware.GuardOrganization(permissions.WriteOrganization),
+				app.Handlers.Verification.SubmitVerification)
+			orgsGroup.GET("/:id/verification",
+				authMiddleware.GuardOrganization(permissions.WriteOrganization),
+				app.Handlers.Verification.GetVerificationStatus)
+
+			// Logo y banner a partir de ficheros subidos
+			orgsGroup.PUT("/:id/media/:field",
+				authMiddleware.GuardOrganization(permissions.WriteOrganization),
+				app.Handlers.Media.AttachOrganizationMedia)
+
 			// Miembros (solo miembros de la org o admin)
 			orgsGroup.GET("/:id/members",
 				authMiddleware.GuardOrganization(permissions.ReadOrganization),
 				app.Handlers.Organizations.GetMembers)
+
+			// Historial de versiones (solo quien puede editar la organización)
+			orgsGroup.GET("/:id/versions",
+				authMiddleware.GuardOrganization(permissions.WriteOrganization),
+				app.Handlers.Versions.List(models.AuditResourceOrganization))
+			orgsGroup.GET("/:id/versions/diff",
+				authMiddleware.GuardOrganization(permissions.WriteOrganization),
+				app.Handlers.Versions.Diff(models.AuditResourceOrganization))
+			orgsGroup.GET("/:id/versions/:version",
+				authMiddleware.GuardOrganization(permissions.WriteOrganization),
+				app.Handlers.Versions.Get(models.AuditResourceOrganization))
+			orgsGroup.POST("/:id/versions/:version/restore",
+				authMiddleware.GuardOrganization(permissions.WriteOrganization),
+				app.Handlers.Versions.Restore(models.AuditResourceOrganization))
+
+			// Seguir organizaciones (cualquier usuario autenticado)
+			orgsGroup.POST("/:id/follow", app.Handlers.Organizations.Follow)
+			orgsGroup.DELETE("/:id/follow", app.Handlers.Organizations.Unfollow)
+
+			// Denunciar la organización a moderación
+			orgsGroup.POST("/:id/report", app.Handlers.Moderation.ReportOrganization)
+
+			// Facturas emitidas (solo gestores de la org o admin)
+			orgsGroup.GET("/:id/invoices",
+				authMiddleware.GuardOrganization(permissions.WriteOrganization),
+				app.Handlers.Payments.ListOrganizationInvoices)
+		}
+
+		// Ficheros subidos (la pertenencia a la organización se verifica en el servicio)
+		mediaGroup := protected.Group("/media")
+		{
+			mediaGroup.POST("", app.Handlers.Media.Upload)
+			mediaGroup.GET("", app.Handlers.Media.ListMedia)
+			mediaGroup.GET("/:id", app.Handlers.Media.GetMedia)
+			mediaGroup.GET("/:id/file", app.Handlers.Media.DownloadMedia)
+			mediaGroup.POST("/:id/process", app.Handlers.Media.ReprocessMedia)
+			mediaGroup.DELETE("/:id", app.Handlers.Media.DeleteMedia)
+		}
+
+		// Facturas (solo el comprador y la organización emisora, verificado en el servicio)
+		invoicesGroup := protected.Group("/invoices")
+		{
+			invoicesGroup.GET("/:invoiceId", app.Handlers.Payments.GetInvoice)
+			invoicesGroup.GET("/:invoiceId/pdf", app.Handlers.Payments.DownloadInvoice)
 		}
 
 		// Users management
@@ -338,6 +770,8 @@ func setupAdminRoutes(v1 *gin.RouterGroup, authMiddleware *middleware.AuthMiddle
 
 		// Logs de auditoría
 		admin.GET("/audit-logs", auditLogsEndpoint)
+		admin.GET("/audit-logs/verify", app.Handlers.Audit.VerifyChain)
+		admin.GET("/audit-logs/export", app.Handlers.Audit.ExportBundle)
 
 		// Configuración del sistema
 		admin.GET("/system/config", systemConfigEndpoint)
@@ -345,11 +779,41 @@ func setupAdminRoutes(v1 *gin.RouterGroup, authMiddleware *middleware.AuthMiddle
 		// Gestión masiva de usuarios
 		admin.GET("/users/export", app.Handlers.Users.GetAll)
 
+		// Solicitudes de supresión de cuentas
+		admin.GET("/erasure-requests", app.Handlers.Privacy.ListErasureRequests)
+		admin.POST("/erasure-requests/:requestId/execute", app.Handlers.Privacy.ExecuteErasure)
+		admin.POST("/erasure-requests/:requestId/cancel", app.Handlers.Privacy.CancelErasure)
+
+		// Tareas programadas
+		admin.GET("/jobs", app.Handlers.Jobs.ListJobs)
+		admin.POST("/jobs/:name/run", app.Handlers.Jobs.RunJob)
+
+		// Moderación: cola de denuncias, restauración y aprobación de eventos
+		admin.GET("/moderation/reports", app.Handlers.Moderation.ListReports)
+		admin.GET("/moderation/reports/:reportId", app.Handlers.Moderation.GetReport)
+		admin.POST("/moderation/reports/:reportId/review", app.Handlers.Moderation.StartReview)
+		admin.POST("/moderation/reports/:reportId/notes", app.Handlers.Moderation.AddNote)
+		admin.POST("/moderation/reports/:reportId/resolve", app.Handlers.Moderation.ResolveReport)
+		admin.POST("/moderation/reports/:reportId/dismiss", app.Handlers.Moderation.DismissReport)
+		admin.POST("/moderation/events/:id/restore", app.Handlers.Moderation.RestoreEvent)
+		admin.POST("/moderation/organizations/:id/restore", app.Handlers.Moderation.RestoreOrganization)
+		admin.GET("/moderation/events", app.Handlers.Moderation.ListPendingEvents)
+		admin.POST("/moderation/events/:id/approve", app.Handlers.Moderation.ApproveEvent)
+		admin.POST("/moderation/events/:id/reject", app.Handlers.Moderation.RejectEvent)
+
+		// Verificación de organizaciones
+		admin.GET("/verifications", app.Handlers.Verification.ListVerifications)
+		admin.GET("/verifications/:verificationId", app.Handlers.Verification.GetVerification)
+		admin.POST("/verifications/:verificationId/review", app.Handlers.Verification.StartReview)
+		admin.POST("/verifications/:verificationId/approve", app.Handlers.Verification.ApproveVerification)
+		admin.POST("/verifications/:verificationId/reject", app.Handlers.Verification.RejectVerification)
+		admin.POST("/verifications/:verificationId/request-info", app.Handlers.Verification.RequestInfo)
+
 		// Gestión masiva de organizaciones
-		admin.POST("/organizations/bulk-verify", bulkVerifyOrganizations)
+		admin.POST("/organizations/bulk-verify", bulkVerifyOrganizations(app.Services.Verification))
 
 		// Gestión masiva de eventos
-		admin.POST("/events/bulk-moderate", bulkModerateEvents)
+		admin.POST("/events/bulk-moderate", bulkModerateEvents(app.Services.EventLifecycle))
 	}
 }
 
@@ -407,40 +871,154 @@ func documentationEndpoint(cfg *config.Config) gin.HandlerFunc {
 					"GET  /api/v1/auth/me":         "Información del usuario actual",
 				},
 				"public": gin.H{
-					"GET /api/v1/public/ping":                 "Ping test",
-					"GET /health":                             "Health check",
-					"GET /api/v1/public/events":               "Lista de eventos públicos",
-					"GET /api/v1/public/events/:id":           "Detalle de evento público",
-					"GET /api/v1/public/events/featured":      "Eventos destacados",
-					"GET /api/v1/public/events/upcoming":      "Próximos eventos",
-					"GET /api/v1/public/organizations":        "Lista de organizaciones públicas",
-					"GET /api/v1/public/organizations/:id":    "Detalle de organización",
-					"GET /api/v1/public/organizations/active": "Organizaciones activas",
-					"GET /api/v1/public/stats":                "Estadísticas públicas",
+					"GET /api/v1/public/ping":                        "Ping test",
+					"GET /health":                                    "Health check",
+					"GET /api/v1/public/search":                      "Búsqueda full-text de eventos, organizaciones y tags",
+					"GET /api/v1/public/events":                      "Catálogo de eventos públicos con filtros y facetas",
+					"GET /api/v1/public/events/:id":                  "Detalle de evento público",
+					"GET /api/v1/public/events/featured":             "Eventos destacados",
+					"GET /api/v1/public/events/upcoming":             "Próximos eventos",
+					"GET /api/v1/public/events/nearby":               "Eventos presenciales cercanos (?lat&lng&radius_km o ubicación del perfil)",
+					"GET /api/v1/public/events/:id/ical":             "Exportar evento a iCalendar (.ics)",
+					"GET /api/v1/public/calendars/:token":            "Feed iCalendar suscribible",
+					"POST /api/v1/public/payments/:provider/webhook": "Notificación firmada del proveedor de pagos",
+					"GET /api/v1/public/events/:id/agenda":           "Agenda del evento (?tz= para convertir horas)",
+					"GET /api/v1/public/speakers/:id":                "Perfil público de ponente",
+					"GET /api/v1/public/events/:id/tickets":          "Tipos de entrada del evento",
+					"GET /api/v1/public/media/:id":                   "Contenido de un fichero público (imágenes)",
+					"GET /api/v1/public/media/:id/variants/:name":    "Versión redimensionada de una imagen (thumbnail, card, banner)",
+					"GET /api/v1/public/organizations":               "Lista de organizaciones públicas",
+					"GET /api/v1/public/organizations/:id":           "Detalle de organización",
+					"GET /api/v1/public/organizations/active":        "Organizaciones activas",
+					"GET /api/v1/public/stats":                       "Estadísticas públicas",
 				},
 				"protected": gin.H{
-					"GET /api/v1/user/capabilities":         "Capacidades del usuario",
-					"GET /api/v1/user/profile":              "Perfil del usuario actual",
-					"GET /api/v1/user/sessions":             "Sesiones activas",
-					"GET /api/v1/user/roles":                "Información de roles",
-					"GET /api/v1/events":                    "Lista de eventos",
-					"POST /api/v1/events":                   "Crear evento",
-					"PUT /api/v1/events/:id":                "Actualizar evento",
-					"DELETE /api/v1/events/:id":             "Eliminar evento",
-					"POST /api/v1/events/:id/publish":       "Publicar evento",
-					"POST /api/v1/events/:id/cancel":        "Cancelar evento",
-					"GET /api/v1/organizations":             "Lista de organizaciones",
-					"POST /api/v1/organizations":            "Crear organización",
-					"PUT /api/v1/organizations/:id":         "Actualizar organización",
-					"GET /api/v1/organizations/:id/members": "Miembros de organización",
+					"GET /api/v1/user/capabilities":                                         "Capacidades del usuario",
+					"GET /api/v1/user/profile":                                              "Perfil del usuario actual",
+					"GET /api/v1/user/sessions":                                             "Sesiones activas",
+					"GET /api/v1/user/roles":                                                "Información de roles",
+					"GET /api/v1/user/calendar-feeds":                                       "Feeds de calendario del usuario",
+					"POST /api/v1/user/calendar-feeds":                                      "Crear feed de calendario",
+					"DELETE /api/v1/user/calendar-feeds/:feedId":                            "Revocar feed de calendario",
+					"GET /api/v1/user/schedule":                                             "Agenda personal (sesiones favoritas)",
+					"GET /api/v1/user/submissions":                                          "Propuestas enviadas a CFPs",
+					"GET /api/v1/user/reviews":                                              "Propuestas asignadas para revisión ciega",
+					"PUT /api/v1/user/reviews/:reviewId":                                    "Puntuar propuesta asignada",
+					"GET /api/v1/user/registrations":                                        "Inscripciones del usuario",
+					"GET /api/v1/user/orders":                                               "Pedidos del usuario",
+					"GET /api/v1/user/orders/:orderId":                                      "Detalle de pedido",
+					"GET /api/v1/user/orders/:orderId/invoices":                             "Facturas y rectificativas del pedido",
+					"POST /api/v1/media":                                                    "Subir fichero (multipart: file, purpose, organization_id)",
+					"GET /api/v1/media":                                                     "Ficheros subidos por el usuario o de una organización",
+					"GET /api/v1/media/:id":                                                 "Datos de un fichero y recursos que lo usan",
+					"GET /api/v1/media/:id/file":                                            "Descargar fichero (también los privados)",
+					"POST /api/v1/media/:id/process":                                        "Volver a generar las versiones de una imagen",
+					"DELETE /api/v1/media/:id":                                              "Borrar fichero que no está en uso",
+					"GET /api/v1/invoices/:invoiceId":                                       "Factura en JSON (comprador u organización)",
+					"GET /api/v1/invoices/:invoiceId/pdf":                                   "Descargar factura en PDF",
+					"GET /api/v1/user/notifications":                                        "Notificaciones del usuario",
+					"GET /api/v1/user/recommendations":                                      "Eventos recomendados con sus motivos",
+					"GET /api/v1/user/reports":                                              "Denuncias presentadas por el usuario",
+					"POST /api/v1/user/data-export":                                         "Solicitar exportación de datos personales",
+					"GET /api/v1/user/data-export":                                          "Exportaciones de datos del usuario",
+					"GET /api/v1/user/data-export/:exportId":                                "Estado de una exportación",
+					"GET /api/v1/user/data-export/:exportId/download":                       "Descargar archivo ZIP con los datos",
+					"DELETE /api/v1/user/account":                                           "Solicitar supresión de la cuenta",
+					"GET /api/v1/user/account/erasure":                                      "Estado de la solicitud de supresión",
+					"DELETE /api/v1/user/account/erasure":                                   "Retirar la solicitud de supresión",
+					"POST /api/v1/user/notifications/:notificationId/read":                  "Marcar notificación como leída",
+					"POST /api/v1/user/notifications/read-all":                              "Marcar todas las notificaciones como leídas",
+					"GET /api/v1/events":                                                    "Lista de eventos",
+					"POST /api/v1/events":                                                   "Crear evento",
+					"PUT /api/v1/events/:id":                                                "Actualizar evento",
+					"DELETE /api/v1/events/:id":                                             "Eliminar evento",
+					"POST /api/v1/events/:id/publish":                                       "Publicar evento",
+					"POST /api/v1/events/:id/schedule-publish":                              "Programar la publicación de un borrador",
+					"DELETE /api/v1/events/:id/schedule-publish":                            "Anular la publicación programada",
+					"POST /api/v1/events/:id/cancel":                                        "Cancelar evento",
+					"POST /api/v1/events/:id/report":                                        "Denunciar evento a moderación",
+					"PUT /api/v1/events/:id/media/:field":                                   "Usar un fichero subido como imagen o banner del evento",
+					"POST /api/v1/events/series":                                            "Crear serie de eventos recurrentes (RRULE)",
+					"GET /api/v1/events/series/:seriesId":                                   "Detalle de serie con sus ocurrencias",
+					"PUT /api/v1/events/series/:seriesId/occurrences/:occurrenceId":         "Modificar ocurrencia (this, following, all)",
+					"POST /api/v1/events/series/:seriesId/occurrences/:occurrenceId/cancel": "Cancelar ocurrencias (this, following, all)",
+					"POST /api/v1/events/:id/sessions":                                      "Crear sesión de agenda",
+					"PUT /api/v1/events/:id/sessions/:sessionId":                            "Actualizar sesión de agenda",
+					"DELETE /api/v1/events/:id/sessions/:sessionId":                         "Eliminar sesión de agenda",
+					"POST /api/v1/events/:id/sessions/:sessionId/favorite":                  "Añadir sesión a la agenda personal",
+					"DELETE /api/v1/events/:id/sessions/:sessionId/favorite":                "Quitar sesión de la agenda personal",
+					"POST /api/v1/events/:id/submissions":                                   "Enviar propuesta al call for papers",
+					"GET /api/v1/events/:id/submissions":                                    "Propuestas del CFP con puntuaciones",
+					"GET /api/v1/events/:id/submissions/export":                             "Exportar resumen de revisión (CSV)",
+					"GET /api/v1/events/:id/submissions/:submissionId":                      "Detalle de propuesta con revisiones",
+					"POST /api/v1/events/:id/submissions/:submissionId/reviewers":           "Asignar revisores",
+					"POST /api/v1/events/:id/submissions/:submissionId/decision":            "Aceptar, rechazar o poner en espera",
+					"POST /api/v1/events/:id/price-quote":                                   "Calcular precio de una entrada con código de descuento",
+					"POST /api/v1/events/:id/register":                                      "Inscribirse en un evento",
+					"DELETE /api/v1/events/:id/register":                                    "Cancelar inscripción",
+					"GET /api/v1/events/:id/attendees":                                      "Asistentes del evento",
+					"POST /api/v1/events/:id/ticket-types":                                  "Crear tipo de entrada",
+					"PUT /api/v1/events/:id/ticket-types/:ticketTypeId":                     "Actualizar tipo de entrada",
+					"DELETE /api/v1/events/:id/ticket-types/:ticketTypeId":                  "Eliminar tipo de entrada",
+					"GET /api/v1/events/:id/promo-codes":                                    "Códigos de descuento del evento",
+					"POST /api/v1/events/:id/promo-codes":                                   "Crear código de descuento",
+					"PUT /api/v1/events/:id/promo-codes/:promoCodeId":                       "Actualizar código de descuento",
+					"DELETE /api/v1/events/:id/promo-codes/:promoCodeId":                    "Eliminar código de descuento",
+					"GET /api/v1/events/:id/versions":                                       "Historial de versiones del evento",
+					"GET /api/v1/events/:id/versions/diff":                                  "Comparar dos versiones del evento (?from=&to=)",
+					"GET /api/v1/events/:id/versions/:version":                              "Estado completo de una versión del evento",
+					"POST /api/v1/events/:id/versions/:version/restore":                     "Restaurar una versión del evento",
+					"GET /api/v1/speakers":                                                  "Ponentes de la organización",
+					"POST /api/v1/speakers":                                                 "Crear ponente",
+					"PUT /api/v1/speakers/:id":                                              "Actualizar ponente",
+					"DELETE /api/v1/speakers/:id":                                           "Eliminar ponente",
+					"GET /api/v1/organizations":                                             "Lista de organizaciones",
+					"POST /api/v1/organizations":                                            "Crear organización",
+					"PUT /api/v1/organizations/:id":                                         "Actualizar organización",
+					"GET /api/v1/organizations/:id/members":                                 "Miembros de organización",
+					"GET /api/v1/organizations/:id/invoices":                                "Facturas emitidas por la organización",
+					"POST /api/v1/organizations/:id/follow":                                 "Seguir organización",
+					"DELETE /api/v1/organizations/:id/follow":                               "Dejar de seguir organización",
+					"POST /api/v1/organizations/:id/report":                                 "Denunciar organización a moderación",
+					"POST /api/v1/organizations/:id/verification":                           "Solicitar la verificación con documentación (NIF/CIF)",
+					"GET /api/v1/organizations/:id/verification":                            "Estado de verificación e historial de solicitudes",
+					"PUT /api/v1/organizations/:id/media/:field":                            "Usar un fichero subido como logo o banner de la organización",
+					"GET /api/v1/organizations/:id/versions":                                "Historial de versiones de la organización",
+					"GET /api/v1/organizations/:id/versions/diff":                           "Comparar dos versiones de la organización (?from=&to=)",
+					"GET /api/v1/organizations/:id/versions/:version":                       "Estado completo de una versión de la organización",
+					"POST /api/v1/organizations/:id/versions/:version/restore":              "Restaurar una versión de la organización",
 				},
 				"admin": gin.H{
-					"GET /api/v1/admin/dashboard":           "Dashboard de administrador",
-					"GET /api/v1/admin/system/stats":        "Estadísticas del sistema",
-					"GET /api/v1/admin/audit-logs":          "Logs de auditoría",
-					"GET /api/v1/admin/system/config":       "Configuración del sistema",
-					"POST /api/v1/organizations/:id/verify": "Verificar organización",
-					"PUT /api/v1/users/:id/role":            "Cambiar rol de usuario",
+					"GET /api/v1/admin/dashboard":                                   "Dashboard de administrador",
+					"GET /api/v1/admin/system/stats":                                "Estadísticas del sistema",
+					"GET /api/v1/admin/audit-logs":                                  "Logs de auditoría",
+					"GET /api/v1/admin/audit-logs/verify":                           "Verificar la cadena de auditoría",
+					"GET /api/v1/admin/audit-logs/export":                           "Exportar tramo firmado de la auditoría",
+					"GET /api/v1/admin/erasure-requests":                            "Solicitudes de supresión de cuentas",
+					"POST /api/v1/admin/erasure-requests/:requestId/execute":        "Ejecutar supresión antes de plazo",
+					"POST /api/v1/admin/erasure-requests/:requestId/cancel":         "Retirar solicitud de supresión",
+					"GET /api/v1/admin/jobs":                                        "Tareas programadas y su última ejecución",
+					"POST /api/v1/admin/jobs/:name/run":                             "Ejecutar una tarea programada",
+					"GET /api/v1/admin/moderation/reports":                          "Cola de moderación (abiertas y en revisión por defecto)",
+					"GET /api/v1/admin/moderation/reports/:reportId":                "Denuncia con sus notas de moderación",
+					"POST /api/v1/admin/moderation/reports/:reportId/review":        "Asignarse una denuncia y pasarla a revisión",
+					"POST /api/v1/admin/moderation/reports/:reportId/notes":         "Añadir nota de moderación",
+					"POST /api/v1/admin/moderation/reports/:reportId/resolve":       "Resolver denuncia ocultando el contenido o avisando",
+					"POST /api/v1/admin/moderation/reports/:reportId/dismiss":       "Descartar denuncia",
+					"POST /api/v1/admin/moderation/events/:id/restore":              "Volver a mostrar un evento oculto",
+					"POST /api/v1/admin/moderation/organizations/:id/restore":       "Reactivar una organización suspendida",
+					"GET /api/v1/admin/moderation/events":                           "Eventos pendientes de aprobación",
+					"POST /api/v1/admin/moderation/events/:id/approve":              "Aprobar y publicar evento",
+					"POST /api/v1/admin/moderation/events/:id/reject":               "Rechazar evento pendiente",
+					"GET /api/v1/admin/verifications":                               "Cola de verificación (enviadas y en revisión por defecto)",
+					"GET /api/v1/admin/verifications/:verificationId":               "Solicitud de verificación con el historial de la organización",
+					"POST /api/v1/admin/verifications/:verificationId/review":       "Asignarse una solicitud y pasarla a revisión",
+					"POST /api/v1/admin/verifications/:verificationId/approve":      "Aprobar y verificar la organización",
+					"POST /api/v1/admin/verifications/:verificationId/reject":       "Rechazar solicitud con motivo",
+					"POST /api/v1/admin/verifications/:verificationId/request-info": "Pedir más documentación",
+					"GET /api/v1/admin/system/config":                               "Configuración del sistema",
+					"POST /api/v1/organizations/:id/verify":                         "Verificar organización",
+					"PUT /api/v1/users/:id/role":                                    "Cambiar rol de usuario",
 				},
 				"organizer": gin.H{
 					"GET /api/v1/organizer/dashboard": "Dashboard de organizador",
@@ -747,6 +1325,32 @@ func auditLogsEndpoint(c *gin.Context) {
 		query = query.Where("action = ?", action)
 	}
 
+	// Filtrar por recurso (event, organization, user...) y registro
+	if resource := c.Query("resource"); resource != "" {
+		query = query.Where("resource = ?", resource)
+	}
+	if resourceID := c.Query("resource_id"); resourceID != "" {
+		query = query.Where("resource_id = ?", resourceID)
+	}
+
+	// Filtrar por rango de fechas (RFC3339 o AAAA-MM-DD; "to" incluye el día completo)
+	if from := c.Query("from"); from != "" {
+		since, err := parseAuditDate(from, false)
+		if err != nil {
+			helpers.FormatValidationErrorResponse(c, "from: usa RFC3339 o AAAA-MM-DD")
+			return
+		}
+		query = query.Where("timestamp >= ?", since)
+	}
+	if to := c.Query("to"); to != "" {
+		until, err := parseAuditDate(to, true)
+		if err != nil {
+			helpers.FormatValidationErrorResponse(c, "to: usa RFC3339 o AAAA-MM-DD")
+			return
+		}
+		query = query.Where("timestamp < ?", until)
+	}
+
 	// Contar total
 	query.Count(&total)
 
@@ -758,6 +1362,26 @@ func auditLogsEndpoint(c *gin.Context) {
 	helpers.FormatPaginationResponse(c, logs, meta, "Audit logs retrieved")
 }
 
+// parseAuditDate interpreta una fecha de filtro; con endExclusive una fecha sin
+// hora se convierte en el inicio del día siguiente
+func parseAuditDate(value string, endExclusive bool) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		if endExclusive {
+			return t.Add(time.Nanosecond), nil
+		}
+		return t, nil
+	}
+
+	day, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if endExclusive {
+		return day.AddDate(0, 0, 1), nil
+	}
+	return day, nil
+}
+
 func systemConfigEndpoint(c *gin.Context) {
 	config := gin.H{
 		"version":     "0.1.0",
@@ -789,93 +1413,101 @@ func systemConfigEndpoint(c *gin.Context) {
 	}, "System configuration retrieved")
 }
 
-// bulkVerifyOrganizations verifica organizaciones en lote (admin)
-func bulkVerifyOrganizations(c *gin.Context) {
-	var req struct {
-		OrganizationIDs []string `json:"organization_ids" binding:"required"`
-	}
+// bulkVerifyOrganizations aprueba en lote la solicitud de verificación abierta
+// de cada organización (admin). Las que no tienen ninguna se omiten
+func bulkVerifyOrganizations(verification services.VerificationService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req struct {
+			OrganizationIDs []string `json:"organization_ids" binding:"required"`
+			Message         string   `json:"message" binding:"omitempty,max=1000"`
+		}
 
-	if err := c.ShouldBindJSON(&req); err != nil {
-		helpers.FormatValidationErrorResponse(c, err.Error())
-		return
-	}
+		if err := c.ShouldBindJSON(&req); err != nil {
+			helpers.FormatValidationErrorResponse(c, err.Error())
+			return
+		}
 
-	db := database.GetDB()
-	userCtx := handlers.GetUserContext(c)
+		ctx := c.Request.Context()
+		userCtx := handlers.GetUserContext(c)
 
-	var updated int64
-	result := db.Model(&models.Organization{}).
-		Where("id IN ? AND is_verified = false", req.OrganizationIDs).
-		Updates(map[string]interface{}{
-			"is_verified": true,
-			"verified_at": time.Now(),
-			"verified_by": userCtx.ID,
-			"status":      models.OrgStatusActive,
-		})
+		verified := 0
+		skipped := map[string]string{}
+		for _, organizationID := range req.OrganizationIDs {
+			_, history, err := verification.GetVerificationStatus(ctx, organizationID, userCtx)
+			if err != nil {
+				skipped[organizationID] = err.Error()
+				continue
+			}
 
-	updated = result.RowsAffected
+			var open *models.OrganizationVerification
+			for _, request := range history {
+				if request.IsOpen() {
+					open = request
+					break
+				}
+			}
+			if open == nil {
+				skipped[organizationID] = "La organización no tiene una solicitud de verificación pendiente"
+				continue
+			}
 
-	helpers.FormatSuccessResponse(c, gin.H{
-		"requested": len(req.OrganizationIDs),
-		"verified":  updated,
-		"timestamp": time.Now().UTC(),
-	}, "Organizations verified successfully")
-}
+			approve := dto.ApproveVerificationRequest{Message: req.Message}
+			if _, err := verification.ApproveVerification(ctx, open.ID.String(), approve, userCtx); err != nil {
+				skipped[organizationID] = err.Error()
+				continue
+			}
+			verified++
+		}
 
-// bulkModerateEvents modera eventos en lote (admin)
-func bulkModerateEvents(c *gin.Context) {
-	var req struct {
-		EventIDs []string `json:"event_ids" binding:"required"`
-		Action   string   `json:"action" binding:"required,oneof=publish unpublish cancel"`
-		Reason   string   `json:"reason,omitempty"`
+		helpers.FormatSuccessResponse(c, gin.H{
+			"requested": len(req.OrganizationIDs),
+			"verified":  verified,
+			"skipped":   skipped,
+			"timestamp": time.Now().UTC(),
+		}, "Organizations verified successfully")
 	}
+}
 
-	if err := c.ShouldBindJSON(&req); err != nil {
-		helpers.FormatValidationErrorResponse(c, err.Error())
-		return
+// bulkModerateEvents modera eventos en lote (admin). Cada evento pasa por la
+// máquina de estados del ciclo de vida; los que no admiten la acción se omiten
+func bulkModerateEvents(lifecycle services.EventLifecycleService) gin.HandlerFunc {
+	transitions := map[string]models.EventTransition{
+		"publish":   models.EventTransitionPublish,
+		"unpublish": models.EventTransitionUnpublish,
+		"cancel":    models.EventTransitionCancel,
 	}
 
-	db := database.GetDB()
-	userCtx := handlers.GetUserContext(c)
-
-	var updated int64
-	var updates map[string]interface{}
-
-	switch req.Action {
-	case "publish":
-		updates = map[string]interface{}{
-			"status":       models.EventStatusPublished,
-			"published_at": time.Now(),
-			"published_by": userCtx.ID,
-		}
-	case "unpublish":
-		updates = map[string]interface{}{
-			"status": models.EventStatusDraft,
-		}
-	case "cancel":
-		updates = map[string]interface{}{
-			"status":          models.EventStatusCanceled,
-			"canceled_at":     time.Now(),
-			"canceled_by":     userCtx.ID,
-			"canceled_reason": req.Reason,
+	return func(c *gin.Context) {
+		var req struct {
+			EventIDs []string `json:"event_ids" binding:"required"`
+			Action   string   `json:"action" binding:"required,oneof=publish unpublish cancel"`
 		}
-	}
 
-	result := db.Model(&models.Event{}).
-		Where("id IN ?", req.EventIDs).
-		Updates(updates)
+		if err := c.ShouldBindJSON(&req); err != nil {
+			helpers.FormatValidationErrorResponse(c, err.Error())
+			return
+		}
 
-	updated = result.RowsAffected
+		userCtx := handlers.GetUserContext(c)
 
-	// Log de auditoría para acciones masivas cuando esté implementado
-	// go logBulkAction(userCtx.ID, "bulk_moderate_events", req.Action, len(req.EventIDs))
+		updated := 0
+		skipped := map[string]string{}
+		for _, eventID := range req.EventIDs {
+			if _, err := lifecycle.Transition(c.Request.Context(), eventID, transitions[req.Action], userCtx); err != nil {
+				skipped[eventID] = err.Error()
+				continue
+			}
+			updated++
+		}
 
-	helpers.FormatSuccessResponse(c, gin.H{
-		"action":    req.Action,
-		"requested": len(req.EventIDs),
-		"updated":   updated,
-		"timestamp": time.Now().UTC(),
-	}, "Events moderated successfully")
+		helpers.FormatSuccessResponse(c, gin.H{
+			"action":    req.Action,
+			"requested": len(req.EventIDs),
+			"updated":   updated,
+			"skipped":   skipped,
+			"timestamp": time.Now().UTC(),
+		}, "Events moderated successfully")
+	}
 }
 
 // ============================================
@@ -55,17 +55,22 @@ func (ds *DemoDataSeeder) Seed(db *gorm.DB) error {
 		return err
 	}
 
-	// 2. Actualizar contadores de eventos en organizaciones
+	// 2. Crear organizaciones seguidas
+	if err := ds.createFollowRelations(db); err != nil {
+		return err
+	}
+
+	// 3. Actualizar contadores de eventos en organizaciones
 	if err := ds.updateEventCounters(db); err != nil {
 		return err
 	}
 
-	// 3. Simular algunas visualizaciones de eventos
+	// 4. Simular algunas visualizaciones de eventos
 	if err := ds.simulateEventViews(db); err != nil {
 		return err
 	}
 
-	// 4. Crear algunos refresh tokens de ejemplo (para testing de sesiones)
+	// 5. Crear algunos refresh tokens de ejemplo (para testing de sesiones)
 	if err := ds.createSampleRefreshTokens(db); err != nil {
 		return err
 	}
@@ -128,6 +133,49 @@ func (ds *DemoDataSeeder) createFavoriteRelations(db *gorm.DB) error {
 	return nil
 }
 
+// createFollowRelations hace que los usuarios sigan algunas organizaciones activas
+func (ds *DemoDataSeeder) createFollowRelations(db *gorm.DB) error {
+	logger.Debug("Creando organizaciones seguidas...")
+
+	var users []models.User
+	if err := db.Where("is_active = ? AND role != ?", true, models.RoleAdmin).Find(&users).Error; err != nil {
+		return err
+	}
+
+	var organizations []models.Organization
+	if err := db.Where("status = ?", models.OrgStatusActive).Find(&organizations).Error; err != nil {
+		return err
+	}
+
+	if len(users) == 0 || len(organizations) == 0 {
+		logger.Debug("No hay usuarios u organizaciones suficientes para crear seguimientos")
+		return nil
+	}
+
+	// Cada usuario sigue entre 0 y 2 organizaciones
+	for _, user := range users {
+		numFollowed := min(utils.SecureRandInt(3), len(organizations))
+
+		selected := make([]models.Organization, 0, numFollowed)
+		usedIndices := make(map[int]bool)
+		for len(selected) < numFollowed {
+			index := utils.SecureRandInt(len(organizations))
+			if !usedIndices[index] {
+				selected = append(selected, organizations[index])
+				usedIndices[index] = true
+			}
+		}
+
+		if len(selected) > 0 {
+			if err := db.Model(&user).Association("FollowedOrganizations").Append(selected); err != nil {
+				logger.Warnf("Error creando seguimientos para usuario %s: %v", user.Email, err)
+			}
+		}
+	}
+
+	return nil
+}
+
 // updateEventCounters actualiza los contadores de eventos en las organizaciones
 func (ds *DemoDataSeeder) updateEventCounters(db *gorm.DB) error {
 	logger.Debug("Actualizando contadores de eventos...")
@@ -55,7 +55,7 @@ func (os *OrganizationSeeder) Seed(db *gorm.DB) error {
 			IsVerified:  true,
 			LinkedIn:    "https://linkedin.com/company/cybersecurity-spain",
 			Twitter:     "https://twitter.com/cybersec_spain",
-			TaxID:       "G12345678",
+			TaxID:       "G12345674",
 			LegalName:   "Asociación CyberSecurity Spain",
 		},
 		{
@@ -73,7 +73,7 @@ func (os *OrganizationSeeder) Seed(db *gorm.DB) error {
 			LinkedIn:    "https://linkedin.com/company/hackingetic",
 			Twitter:     "https://twitter.com/hackingetic",
 			Facebook:    "https://facebook.com/hackingetic",
-			TaxID:       "B98765432",
+			TaxID:       "B98765431",
 			LegalName:   "Hackingétic Formación S.L.",
 		},
 		{
@@ -105,7 +105,7 @@ func (os *OrganizationSeeder) Seed(db *gorm.DB) error {
 			Status:      models.OrgStatusActive,
 			IsVerified:  true,
 			LinkedIn:    "https://linkedin.com/school/universidad-complutense-madrid",
-			TaxID:       "Q2818018D",
+			TaxID:       "Q2818018J",
 			LegalName:   "Universidad Complutense de Madrid",
 		},
 	}
@@ -137,7 +137,7 @@ func (os *OrganizationSeeder) Seed(db *gorm.DB) error {
 		}
 
 		// Marcar como verificadas con fecha
-		now := time.Now().Format(time.RFC3339)
+		now := time.Now()
 		org.VerifiedAt = &now
 		adminUserID := "admin-user-id" // Se actualizará después
 		org.VerifiedBy = &adminUserID
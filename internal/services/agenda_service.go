@@ -0,0 +1,553 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/dto"
+	"cybesphere-backend/internal/models"
+	"cybesphere-backend/internal/repositories"
+)
+
+// AgendaServiceImpl implementación del servicio de agenda y ponentes
+type AgendaServiceImpl struct {
+	sessionRepo *repositories.EventSessionRepository
+	speakerRepo *repositories.SpeakerRepository
+	eventRepo   *repositories.EventRepository
+	userRepo    *repositories.UserRepository
+}
+
+// Verificación en tiempo de compilación de que AgendaServiceImpl implementa AgendaService
+var _ AgendaService = (*AgendaServiceImpl)(nil)
+
+// NewAgendaService crea una nueva instancia del servicio de agenda
+func NewAgendaService(
+	sessionRepo *repositories.EventSessionRepository,
+	speakerRepo *repositories.SpeakerRepository,
+	eventRepo *repositories.EventRepository,
+	userRepo *repositories.UserRepository,
+) AgendaService {
+	return &AgendaServiceImpl{
+		sessionRepo: sessionRepo,
+		speakerRepo: speakerRepo,
+		eventRepo:   eventRepo,
+		userRepo:    userRepo,
+	}
+}
+
+// =============================================================================
+// PONENTES
+// =============================================================================
+
+// ListSpeakers lista los ponentes de la organización del usuario (todos para admin)
+func (s *AgendaServiceImpl) ListSpeakers(ctx context.Context, opts common.QueryOptions, userCtx *common.UserContext) ([]*models.Speaker, *common.PaginationMeta, error) {
+	if userCtx == nil {
+		return nil, nil, common.ErrUnauthorized
+	}
+
+	if userCtx.IsAdmin() {
+		return s.speakerRepo.GetAll(ctx, opts)
+	}
+
+	if userCtx.OrganizationID == nil {
+		return nil, nil, common.ErrForbidden
+	}
+
+	return s.speakerRepo.GetByOrganization(ctx, *userCtx.OrganizationID, opts)
+}
+
+// GetSpeaker obtiene el perfil público de un ponente.
+// Solo se listan sesiones de eventos visibles para el usuario.
+func (s *AgendaServiceImpl) GetSpeaker(ctx context.Context, speakerID string, userCtx *common.UserContext) (*models.Speaker, error) {
+	speaker, err := s.speakerRepo.GetWithSessions(ctx, speakerID)
+	if err != nil {
+		return nil, err
+	}
+
+	visible := make([]models.EventSession, 0, len(speaker.Sessions))
+	for _, session := range speaker.Sessions {
+		if session.Event != nil && isEventVisible(session.Event, userCtx) {
+			visible = append(visible, session)
+		}
+	}
+	speaker.Sessions = visible
+
+	return speaker, nil
+}
+
+// CreateSpeaker crea un perfil de ponente en la organización del usuario
+func (s *AgendaServiceImpl) CreateSpeaker(ctx context.Context, req dto.CreateSpeakerRequest, userCtx *common.UserContext) (*models.Speaker, error) {
+	if userCtx == nil {
+		return nil, common.ErrUnauthorized
+	}
+
+	var organizationID string
+	if userCtx.IsAdmin() && req.OrganizationID != "" {
+		organizationID = req.OrganizationID
+	} else if userCtx.IsOrganizer() && userCtx.OrganizationID != nil {
+		organizationID = *userCtx.OrganizationID
+	} else {
+		return nil, common.NewBusinessError("no_organization", "Se requiere una organización para gestionar ponentes")
+	}
+
+	if err := s.validateLinkedUser(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+
+	speaker := &models.Speaker{
+		OrganizationID: organizationID,
+		UserID:         req.UserID,
+		Name:           req.Name,
+		Position:       req.Position,
+		Company:        req.Company,
+		Bio:            req.Bio,
+		PhotoURL:       req.PhotoURL,
+		Website:        req.Website,
+		LinkedIn:       req.LinkedIn,
+		Twitter:        req.Twitter,
+		GitHub:         req.GitHub,
+		Email:          req.Email,
+	}
+
+	if err := s.speakerRepo.Create(ctx, speaker); err != nil {
+		return nil, err
+	}
+
+	return speaker, nil
+}
+
+// UpdateSpeaker actualiza un perfil de ponente
+func (s *AgendaServiceImpl) UpdateSpeaker(ctx context.Context, speakerID string, req dto.UpdateSpeakerRequest, userCtx *common.UserContext) (*models.Speaker, error) {
+	speaker, err := s.getManagedSpeaker(ctx, speakerID, userCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.UserID != nil {
+		if err := s.validateLinkedUser(ctx, req.UserID); err != nil {
+			return nil, err
+		}
+		speaker.UserID = req.UserID
+	}
+
+	if req.Name != nil {
+		speaker.Name = *req.Name
+	}
+	if req.Position != nil {
+		speaker.Position = *req.Position
+	}
+	if req.Company != nil {
+		speaker.Company = *req.Company
+	}
+	if req.Bio != nil {
+		speaker.Bio = *req.Bio
+	}
+	if req.PhotoURL != nil {
+		speaker.PhotoURL = *req.PhotoURL
+	}
+	if req.Website != nil {
+		speaker.Website = *req.Website
+	}
+	if req.LinkedIn != nil {
+		speaker.LinkedIn = *req.LinkedIn
+	}
+	if req.Twitter != nil {
+		speaker.Twitter = *req.Twitter
+	}
+	if req.GitHub != nil {
+		speaker.GitHub = *req.GitHub
+	}
+	if req.Email != nil {
+		speaker.Email = *req.Email
+	}
+
+	if err := s.speakerRepo.Update(ctx, speaker); err != nil {
+		return nil, err
+	}
+
+	return speaker, nil
+}
+
+// DeleteSpeaker elimina un perfil de ponente
+func (s *AgendaServiceImpl) DeleteSpeaker(ctx context.Context, speakerID string, userCtx *common.UserContext) error {
+	if _, err := s.getManagedSpeaker(ctx, speakerID, userCtx); err != nil {
+		return err
+	}
+
+	return s.speakerRepo.Delete(ctx, speakerID)
+}
+
+// =============================================================================
+// SESIONES
+// =============================================================================
+
+// GetAgenda obtiene la agenda de un evento y, si hay usuario, sus sesiones favoritas
+func (s *AgendaServiceImpl) GetAgenda(ctx context.Context, eventID string, userCtx *common.UserContext) (*models.Event, []*models.EventSession, map[string]bool, error) {
+	event, err := s.getVisibleEvent(ctx, eventID, userCtx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	sessions, err := s.sessionRepo.GetByEvent(ctx, eventID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	favorites := map[string]bool{}
+	if userCtx != nil {
+		favorites, err = s.sessionRepo.GetFavoriteIDs(ctx, userCtx.ID, eventID)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	return event, sessions, favorites, nil
+}
+
+// CreateSession añade una sesión a la agenda de un evento
+func (s *AgendaServiceImpl) CreateSession(ctx context.Context, eventID string, req dto.CreateSessionRequest, userCtx *common.UserContext) (*models.EventSession, error) {
+	event, err := s.getManagedEvent(ctx, eventID, userCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	session, speakers, err := s.BuildSession(ctx, event, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.sessionRepo.Create(ctx, session); err != nil {
+		return nil, err
+	}
+
+	if len(speakers) > 0 {
+		if err := s.sessionRepo.ReplaceSpeakers(ctx, session, speakers); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.sessionRepo.GetWithSpeakers(ctx, session.ID.String())
+}
+
+// BuildSession valida una sesión nueva del evento y sus ponentes sin guardarla.
+// Quien la llama comprueba los permisos sobre el evento y la guarda
+func (s *AgendaServiceImpl) BuildSession(ctx context.Context, event *models.Event, req dto.CreateSessionRequest) (*models.EventSession, []*models.Speaker, error) {
+	session := &models.EventSession{
+		EventID:     event.ID.String(),
+		Title:       req.Title,
+		Description: req.Description,
+		StartTime:   req.StartTime,
+		EndTime:     req.EndTime,
+		Room:        strings.TrimSpace(req.Room),
+		Track:       strings.TrimSpace(req.Track),
+		Level:       models.SessionLevel(req.Level),
+	}
+	if err := session.SetTags(req.Tags); err != nil {
+		return nil, nil, common.NewValidationError("tags", "Tags inválidos")
+	}
+
+	if err := s.validateSchedule(ctx, event, session); err != nil {
+		return nil, nil, err
+	}
+
+	speakers, err := s.resolveSpeakers(ctx, event, req.SpeakerIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return session, speakers, nil
+}
+
+// UpdateSession modifica una sesión de la agenda
+func (s *AgendaServiceImpl) UpdateSession(ctx context.Context, eventID, sessionID string, req dto.UpdateSessionRequest, userCtx *common.UserContext) (*models.EventSession, error) {
+	event, err := s.getManagedEvent(ctx, eventID, userCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := s.getEventSession(ctx, eventID, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Title != nil {
+		session.Title = *req.Title
+	}
+	if req.Description != nil {
+		session.Description = *req.Description
+	}
+	if req.StartTime != nil {
+		session.StartTime = *req.StartTime
+	}
+	if req.EndTime != nil {
+		session.EndTime = *req.EndTime
+	}
+	if req.Room != nil {
+		session.Room = strings.TrimSpace(*req.Room)
+	}
+	if req.Track != nil {
+		session.Track = strings.TrimSpace(*req.Track)
+	}
+	if req.Level != nil {
+		session.Level = models.SessionLevel(*req.Level)
+	}
+	if req.Tags != nil {
+		if err := session.SetTags(req.Tags); err != nil {
+			return nil, common.NewValidationError("tags", "Tags inválidos")
+		}
+	}
+
+	if !session.EndTime.After(session.StartTime) {
+		return nil, common.NewBusinessError("invalid_dates", "La hora de fin debe ser posterior a la de inicio")
+	}
+
+	if err := s.validateSchedule(ctx, event, session); err != nil {
+		return nil, err
+	}
+
+	var speakers []*models.Speaker
+	if req.SpeakerIDs != nil {
+		speakers, err = s.resolveSpeakers(ctx, event, *req.SpeakerIDs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Los ponentes se gestionan aparte para no reescribir la asociación al guardar
+	session.Speakers = nil
+	if err := s.sessionRepo.Update(ctx, session); err != nil {
+		return nil, err
+	}
+
+	if req.SpeakerIDs != nil {
+		if err := s.sessionRepo.ReplaceSpeakers(ctx, session, speakers); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.sessionRepo.GetWithSpeakers(ctx, sessionID)
+}
+
+// DeleteSession elimina una sesión de la agenda
+func (s *AgendaServiceImpl) DeleteSession(ctx context.Context, eventID, sessionID string, userCtx *common.UserContext) error {
+	if _, err := s.getManagedEvent(ctx, eventID, userCtx); err != nil {
+		return err
+	}
+
+	if _, err := s.getEventSession(ctx, eventID, sessionID); err != nil {
+		return err
+	}
+
+	return s.sessionRepo.Delete(ctx, sessionID)
+}
+
+// =============================================================================
+// AGENDA PERSONAL
+// =============================================================================
+
+// AddSessionFavorite añade una sesión a la agenda personal del usuario
+func (s *AgendaServiceImpl) AddSessionFavorite(ctx context.Context, eventID, sessionID string, userCtx *common.UserContext) error {
+	if userCtx == nil {
+		return common.ErrUnauthorized
+	}
+
+	if _, err := s.getVisibleEvent(ctx, eventID, userCtx); err != nil {
+		return err
+	}
+
+	if _, err := s.getEventSession(ctx, eventID, sessionID); err != nil {
+		return err
+	}
+
+	return s.sessionRepo.AddFavorite(ctx, userCtx.ID, sessionID)
+}
+
+// RemoveSessionFavorite quita una sesión de la agenda personal del usuario
+func (s *AgendaServiceImpl) RemoveSessionFavorite(ctx context.Context, eventID, sessionID string, userCtx *common.UserContext) error {
+	if userCtx == nil {
+		return common.ErrUnauthorized
+	}
+
+	if _, err := s.getEventSession(ctx, eventID, sessionID); err != nil {
+		return err
+	}
+
+	return s.sessionRepo.RemoveFavorite(ctx, userCtx.ID, sessionID)
+}
+
+// GetSchedule obtiene la agenda personal del usuario
+func (s *AgendaServiceImpl) GetSchedule(ctx context.Context, userCtx *common.UserContext) ([]*models.EventSession, error) {
+	if userCtx == nil {
+		return nil, common.ErrUnauthorized
+	}
+
+	sessions, err := s.sessionRepo.GetFavoritesForUser(ctx, userCtx.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Se omiten sesiones de eventos que han dejado de ser visibles
+	visible := make([]*models.EventSession, 0, len(sessions))
+	for _, session := range sessions {
+		if session.Event != nil && isEventVisible(session.Event, userCtx) {
+			visible = append(visible, session)
+		}
+	}
+
+	return visible, nil
+}
+
+// =============================================================================
+// MÉTODOS AUXILIARES
+// =============================================================================
+
+// isEventVisible verifica si el usuario puede ver un evento y su agenda.
+// Los borradores, los pendientes de aprobación y los eventos privados solo los
+// ve quien gestiona la organización.
+func isEventVisible(event *models.Event, userCtx *common.UserContext) bool {
+	if event.Status == models.EventStatusDraft || event.Status == models.EventStatusPendingReview || !event.IsPublic {
+		return userCtx != nil && userCtx.CanManageOrganization(event.OrganizationID)
+	}
+	return true
+}
+
+// getVisibleEvent obtiene un evento visible para el usuario
+func (s *AgendaServiceImpl) getVisibleEvent(ctx context.Context, eventID string, userCtx *common.UserContext) (*models.Event, error) {
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isEventVisible(event, userCtx) {
+		return nil, common.ErrNotFound
+	}
+
+	return event, nil
+}
+
+// getManagedEvent obtiene un evento cuya agenda puede gestionar el usuario
+func (s *AgendaServiceImpl) getManagedEvent(ctx context.Context, eventID string, userCtx *common.UserContext) (*models.Event, error) {
+	if userCtx == nil {
+		return nil, common.ErrUnauthorized
+	}
+
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !userCtx.CanManageOrganization(event.OrganizationID) {
+		return nil, common.ErrForbidden
+	}
+
+	return event, nil
+}
+
+// getEventSession obtiene una sesión verificando que pertenece al evento
+func (s *AgendaServiceImpl) getEventSession(ctx context.Context, eventID, sessionID string) (*models.EventSession, error) {
+	session, err := s.sessionRepo.GetWithSpeakers(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if session.EventID != eventID {
+		return nil, common.ErrNotFound
+	}
+
+	return session, nil
+}
+
+// getManagedSpeaker obtiene un ponente que el usuario puede gestionar
+func (s *AgendaServiceImpl) getManagedSpeaker(ctx context.Context, speakerID string, userCtx *common.UserContext) (*models.Speaker, error) {
+	if userCtx == nil {
+		return nil, common.ErrUnauthorized
+	}
+
+	speaker, err := s.speakerRepo.GetByID(ctx, speakerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !userCtx.CanManageOrganization(speaker.OrganizationID) {
+		return nil, common.ErrForbidden
+	}
+
+	return speaker, nil
+}
+
+// validateLinkedUser verifica que el usuario vinculado existe
+func (s *AgendaServiceImpl) validateLinkedUser(ctx context.Context, userID *string) error {
+	if userID == nil || *userID == "" {
+		return nil
+	}
+
+	if _, err := s.userRepo.GetByID(ctx, *userID); err != nil {
+		if errors.Is(err, common.ErrNotFound) {
+			return common.NewValidationError("user_id", "El usuario vinculado no existe")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// validateSchedule verifica que la sesión cae dentro del evento y no ocupa una sala ya reservada
+func (s *AgendaServiceImpl) validateSchedule(ctx context.Context, event *models.Event, session *models.EventSession) error {
+	if !session.IsWithinEvent(event) {
+		return common.NewBusinessError("session_outside_event",
+			"La sesión debe desarrollarse dentro del horario del evento")
+	}
+
+	if session.Room == "" {
+		return nil
+	}
+
+	sessions, err := s.sessionRepo.GetByEvent(ctx, event.ID.String())
+	if err != nil {
+		return err
+	}
+
+	for _, other := range sessions {
+		if other.ID == session.ID || other.Room != session.Room {
+			continue
+		}
+		if session.Overlaps(other) {
+			return common.NewBusinessError("room_conflict",
+				"La sala ya está ocupada por la sesión \""+other.Title+"\" en ese horario")
+		}
+	}
+
+	return nil
+}
+
+// resolveSpeakers obtiene los ponentes indicados verificando que son de la organización del evento
+func (s *AgendaServiceImpl) resolveSpeakers(ctx context.Context, event *models.Event, ids []string) ([]*models.Speaker, error) {
+	unique := make([]string, 0, len(ids))
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+
+	speakers, err := s.speakerRepo.GetByIDs(ctx, unique)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(speakers) != len(unique) {
+		return nil, common.NewValidationError("speaker_ids", "Alguno de los ponentes no existe")
+	}
+
+	for _, speaker := range speakers {
+		if speaker.OrganizationID != event.OrganizationID {
+			return nil, common.NewBusinessError("speaker_not_in_organization",
+				"Los ponentes deben pertenecer a la organización del evento")
+		}
+	}
+
+	return speakers, nil
+}
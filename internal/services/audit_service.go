@@ -0,0 +1,289 @@
+// internal/services/audit_service.go
+package services
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"time"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/models"
+	"cybesphere-backend/internal/repositories"
+	"cybesphere-backend/pkg/audit"
+)
+
+// auditVerifyBatchSize registros que se leen en cada paso de la verificación
+const auditVerifyBatchSize = 1000
+
+// AuditBundleMaxLinks registros máximos por paquete exportado
+const AuditBundleMaxLinks = 10000
+
+// AuditChainReport resultado de verificar la cadena de auditoría
+type AuditChainReport struct {
+	Valid         bool
+	Checked       int64
+	HeadSequence  int64
+	HeadHash      string
+	Unchained     int64 // Registros anteriores al encadenado, sin verificar
+	PrunedThrough int64 // Última posición borrada por la retención (0 si ninguna)
+	Break         *audit.Break
+	BrokenLogID   string
+	PublicKey     string // Clave para verificar los paquetes exportados ("" si no se firman)
+	VerifiedAt    time.Time
+}
+
+// AuditRetentionReport resultado de aplicar la retención a la auditoría
+type AuditRetentionReport struct {
+	Pruned        int64 // Registros encadenados borrados
+	PrunedThrough int64 // Nueva posición del punto de retención
+	Unchained     int64 // Registros anteriores al encadenado borrados
+}
+
+// AuditServiceImpl verifica la cadena de auditoría y exporta tramos firmados
+type AuditServiceImpl struct {
+	auditRepo  *repositories.AuditLogRepository
+	signingKey ed25519.PrivateKey
+}
+
+// Verificación en tiempo de compilación
+var _ AuditService = (*AuditServiceImpl)(nil)
+
+// NewAuditService crea el servicio de auditoría; sin clave de firma no se
+// pueden exportar paquetes
+func NewAuditService(auditRepo *repositories.AuditLogRepository, signingKey ed25519.PrivateKey) AuditService {
+	return &AuditServiceImpl{
+		auditRepo:  auditRepo,
+		signingKey: signingKey,
+	}
+}
+
+// VerifyChain recorre la cadena desde el principio, o desde el último punto de
+// retención, recalculando cada hash y devuelve el primer eslabón roto
+func (s *AuditServiceImpl) VerifyChain(ctx context.Context) (*AuditChainReport, error) {
+	unchained, err := s.auditRepo.CountUnchained(ctx)
+	if err != nil {
+		return nil, err
+	}
+	checkpoint, err := s.auditRepo.GetLatestCheckpoint(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &AuditChainReport{Unchained: unchained, PublicKey: s.publicKey()}
+	verifier := audit.NewVerifier()
+
+	var after int64
+	if checkpoint != nil {
+		verifier = audit.ResumeVerifier(checkpoint.Sequence, checkpoint.Hash)
+		after = checkpoint.Sequence
+		report.PrunedThrough = checkpoint.Sequence
+	}
+	for {
+		logs, err := s.auditRepo.GetChainAfter(ctx, after, auditVerifyBatchSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, log := range logs {
+			link, _, err := log.ChainLink()
+			if err != nil {
+				return nil, fmt.Errorf("audit log %s: %w", log.ID, err)
+			}
+			if brk := verifier.Check(link); brk != nil {
+				report.Break = brk
+				report.BrokenLogID = log.ID.String()
+				break
+			}
+			after = link.Sequence
+		}
+
+		if report.Break != nil || len(logs) < auditVerifyBatchSize {
+			break
+		}
+	}
+
+	// Los últimos registros borrados no dejan hueco entre los leídos
+	if report.Break == nil {
+		head, err := s.auditRepo.GetChainHead(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if sequence, _ := verifier.Head(); head != nil && *head.Sequence > sequence {
+			report.Break = &audit.Break{
+				Sequence: sequence + 1,
+				Reason:   audit.BreakSequenceGap,
+				Expected: strconv.FormatInt(sequence+1, 10),
+				Actual:   strconv.FormatInt(*head.Sequence, 10),
+			}
+		}
+	}
+
+	report.Valid = report.Break == nil
+	report.Checked = verifier.Checked()
+	report.HeadSequence, report.HeadHash = verifier.Head()
+	report.VerifiedAt = time.Now()
+	return report, nil
+}
+
+// ExportBundle firma un tramo de la cadena para archivarlo. Sin límites se
+// exportan los últimos registros; un tramo que no verifica no se firma
+func (s *AuditServiceImpl) ExportBundle(ctx context.Context, fromSequence, toSequence int64) (*audit.Bundle, error) {
+	if s.signingKey == nil {
+		return nil, common.NewBusinessError("audit_signing_disabled", "La exportación firmada no está configurada (AUDIT_SIGNING_KEY)")
+	}
+
+	head, err := s.auditRepo.GetChainHead(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if head == nil {
+		return nil, common.ErrNotFound
+	}
+
+	// Los registros anteriores al punto de retención ya no existen
+	first := int64(1)
+	checkpoint, err := s.auditRepo.GetLatestCheckpoint(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if checkpoint != nil {
+		first = checkpoint.Sequence + 1
+	}
+
+	if toSequence == 0 || toSequence > *head.Sequence {
+		toSequence = *head.Sequence
+	}
+	if fromSequence == 0 {
+		fromSequence = max(toSequence-AuditBundleMaxLinks+1, first)
+	}
+	if fromSequence < first || fromSequence > toSequence {
+		return nil, common.NewValidationError("from_sequence", fmt.Sprintf("Debe estar entre %d y %d", first, toSequence))
+	}
+	if toSequence-fromSequence+1 > AuditBundleMaxLinks {
+		return nil, common.NewValidationError("to_sequence", fmt.Sprintf("Un paquete admite como máximo %d registros", AuditBundleMaxLinks))
+	}
+
+	logs, err := s.auditRepo.GetChainRange(ctx, fromSequence, toSequence)
+	if err != nil {
+		return nil, err
+	}
+	if len(logs) == 0 {
+		return nil, common.ErrNotFound
+	}
+
+	// El primer eslabón enlaza con el tramo anterior, que no se exporta
+	links := make([]audit.Link, 0, len(logs))
+	var verifier *audit.Verifier
+	for _, log := range logs {
+		link, _, err := log.ChainLink()
+		if err != nil {
+			return nil, fmt.Errorf("audit log %s: %w", log.ID, err)
+		}
+		if verifier == nil {
+			verifier = audit.ResumeVerifier(link.Sequence-1, link.PrevHash)
+		}
+		if brk := verifier.Check(link); brk != nil {
+			return nil, brokenChainError(brk)
+		}
+		links = append(links, link)
+	}
+	// Huecos al principio o al final del tramo
+	if first, last := links[0].Sequence, links[len(links)-1].Sequence; first != fromSequence || last != toSequence {
+		return nil, brokenChainError(&audit.Break{Sequence: fromSequence, Reason: audit.BreakSequenceGap})
+	}
+
+	bundle, err := audit.NewBundle(links, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if err := bundle.Sign(s.signingKey); err != nil {
+		return nil, err
+	}
+	return bundle, nil
+}
+
+// ApplyRetention borra los registros anteriores a before. Antes de borrar un
+// tramo de la cadena se verifica y se guarda su último eslabón como punto de
+// retención, desde el que continúa la verificación. Si el tramo no verifica no
+// se borra nada, para no destruir la evidencia. La cabeza de la cadena nunca
+// se borra porque los registros nuevos enlazan con ella
+func (s *AuditServiceImpl) ApplyRetention(ctx context.Context, before time.Time) (*AuditRetentionReport, error) {
+	report := &AuditRetentionReport{}
+
+	checkpoint, err := s.auditRepo.GetLatestCheckpoint(ctx)
+	if err != nil {
+		return nil, err
+	}
+	verifier := audit.NewVerifier()
+	if checkpoint != nil {
+		verifier = audit.ResumeVerifier(checkpoint.Sequence, checkpoint.Hash)
+		report.PrunedThrough = checkpoint.Sequence
+	}
+
+	through, err := s.auditRepo.GetLastSequenceBefore(ctx, before)
+	if err != nil {
+		return nil, err
+	}
+	head, err := s.auditRepo.GetChainHead(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if head != nil {
+		through = min(through, *head.Sequence-1)
+	}
+
+	for report.PrunedThrough < through {
+		to := min(report.PrunedThrough+AuditBundleMaxLinks, through)
+		logs, err := s.auditRepo.GetChainRange(ctx, report.PrunedThrough+1, to)
+		if err != nil {
+			return report, err
+		}
+		for _, log := range logs {
+			link, _, err := log.ChainLink()
+			if err != nil {
+				return report, fmt.Errorf("audit log %s: %w", log.ID, err)
+			}
+			if brk := verifier.Check(link); brk != nil {
+				return report, brokenChainError(brk)
+			}
+		}
+		if sequence, _ := verifier.Head(); sequence != to {
+			return report, brokenChainError(&audit.Break{Sequence: sequence + 1, Reason: audit.BreakSequenceGap})
+		}
+
+		sequence, hash := verifier.Head()
+		next := &models.AuditCheckpoint{Sequence: sequence, Hash: hash, PrunedBefore: before}
+		if err := s.auditRepo.PruneChain(ctx, next); err != nil {
+			return report, err
+		}
+		report.Pruned += next.PrunedCount
+		report.PrunedThrough = sequence
+	}
+
+	unchained, err := s.auditRepo.DeleteUnchainedBefore(ctx, before)
+	if err != nil {
+		return report, err
+	}
+	report.Unchained = unchained
+	return report, nil
+}
+
+// publicKey clave pública de firma en base64
+func (s *AuditServiceImpl) publicKey() string {
+	if s.signingKey == nil {
+		return ""
+	}
+	return base64.StdEncoding.EncodeToString(s.signingKey.Public().(ed25519.PublicKey))
+}
+
+// brokenChainError error de un tramo que no se puede firmar
+func brokenChainError(brk *audit.Break) error {
+	return &common.BusinessError{
+		Code:    "audit_chain_broken",
+		Message: "La cadena de auditoría no verifica en el tramo solicitado",
+		Details: fmt.Sprintf("sequence %d: %s", brk.Sequence, brk.Reason),
+	}
+}
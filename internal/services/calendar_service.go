@@ -0,0 +1,268 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/dto"
+	"cybesphere-backend/internal/models"
+	"cybesphere-backend/internal/repositories"
+	"cybesphere-backend/pkg/auth"
+	"cybesphere-backend/pkg/ical"
+	"cybesphere-backend/pkg/logger"
+)
+
+const (
+	// calendarFeedHistory eventos pasados que se mantienen en los feeds
+	calendarFeedHistory = 90 * 24 * time.Hour
+
+	// calendarFeedRefresh intervalo de refresco sugerido a los clientes
+	calendarFeedRefresh = 6 * time.Hour
+
+	// calendarFeedTokenBytes bytes aleatorios de cada token de feed
+	calendarFeedTokenBytes = 32
+
+	// calendarUIDDomain dominio usado en los UID de iCalendar
+	calendarUIDDomain = "cybesphere"
+)
+
+// CalendarServiceImpl implementación del servicio de calendarios
+type CalendarServiceImpl struct {
+	eventRepo *repositories.EventRepository
+	orgRepo   *repositories.OrganizationRepository
+	feedRepo  *repositories.CalendarFeedRepository
+}
+
+// Verificación en tiempo de compilación de que CalendarServiceImpl implementa CalendarService
+var _ CalendarService = (*CalendarServiceImpl)(nil)
+
+// NewCalendarService crea una nueva instancia del servicio de calendarios
+func NewCalendarService(
+	eventRepo *repositories.EventRepository,
+	orgRepo *repositories.OrganizationRepository,
+	feedRepo *repositories.CalendarFeedRepository,
+) CalendarService {
+	return &CalendarServiceImpl{
+		eventRepo: eventRepo,
+		orgRepo:   orgRepo,
+		feedRepo:  feedRepo,
+	}
+}
+
+// ExportEvent genera el .ics de un único evento
+func (s *CalendarServiceImpl) ExportEvent(ctx context.Context, eventID string, userCtx *common.UserContext) (*models.Event, []byte, error) {
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Los borradores, los pendientes de aprobación y los eventos privados solo
+	// los exporta quien gestiona la organización
+	if event.Status == models.EventStatusDraft || event.Status == models.EventStatusPendingReview || !event.IsPublic {
+		if userCtx == nil || !userCtx.CanManageOrganization(event.OrganizationID) {
+			return nil, nil, common.ErrNotFound
+		}
+	}
+
+	cal := &ical.Calendar{
+		Name:   event.Title,
+		Events: []ical.Event{eventToICal(event)},
+	}
+
+	return event, cal.Bytes(), nil
+}
+
+// RenderFeed genera el contenido de un feed a partir de su token secreto
+func (s *CalendarServiceImpl) RenderFeed(ctx context.Context, token string) ([]byte, error) {
+	token = strings.TrimSuffix(strings.TrimSpace(token), ".ics")
+	if token == "" {
+		return nil, common.ErrNotFound
+	}
+
+	feed, err := s.feedRepo.GetByTokenHash(ctx, hashFeedToken(token))
+	if err != nil {
+		return nil, err
+	}
+
+	since := time.Now().Add(-calendarFeedHistory)
+
+	var events []*models.Event
+	switch feed.Scope {
+	case models.CalendarFeedScopeOrganization:
+		events, err = s.eventRepo.GetCalendarEventsByOrganization(ctx, feed.TargetID, since)
+	case models.CalendarFeedScopeTag:
+		events, err = s.eventRepo.GetCalendarEventsByTag(ctx, feed.TargetID, since)
+	case models.CalendarFeedScopeUser:
+		events, err = s.eventRepo.GetCalendarEventsForUser(ctx, feed.UserID, since)
+	default:
+		return nil, common.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	cal := &ical.Calendar{
+		Name:            feed.Name,
+		RefreshInterval: calendarFeedRefresh,
+		Events:          make([]ical.Event, 0, len(events)),
+	}
+	for _, event := range events {
+		cal.Events = append(cal.Events, eventToICal(event))
+	}
+
+	// Registrar acceso sin bloquear la respuesta
+	go func() {
+		if err := s.feedRepo.TouchLastAccessed(context.Background(), feed.ID.String()); err != nil {
+			logger.Error("Error actualizando último acceso del feed de calendario: ", err)
+		}
+	}()
+
+	return cal.Bytes(), nil
+}
+
+// CreateFeed crea un feed suscribible y devuelve el token en claro (solo esta vez)
+func (s *CalendarServiceImpl) CreateFeed(ctx context.Context, req dto.CreateCalendarFeedRequest, userCtx *common.UserContext) (*models.CalendarFeed, string, error) {
+	if userCtx == nil {
+		return nil, "", common.ErrUnauthorized
+	}
+
+	feed := &models.CalendarFeed{
+		UserID:   userCtx.ID,
+		Name:     req.Name,
+		Scope:    models.CalendarFeedScope(req.Scope),
+		TargetID: strings.TrimSpace(req.TargetID),
+	}
+
+	switch feed.Scope {
+	case models.CalendarFeedScopeOrganization:
+		if feed.TargetID == "" {
+			return nil, "", common.NewValidationError("target_id", "ID de organización requerido")
+		}
+		org, err := s.orgRepo.GetByID(ctx, feed.TargetID)
+		if err != nil {
+			return nil, "", err
+		}
+		if feed.Name == "" {
+			feed.Name = org.Name
+		}
+	case models.CalendarFeedScopeTag:
+		if feed.TargetID == "" {
+			return nil, "", common.NewValidationError("target_id", "Tag requerido")
+		}
+		if feed.Name == "" {
+			feed.Name = "#" + strings.ToLower(feed.TargetID)
+		}
+	case models.CalendarFeedScopeUser:
+		// Los feeds personales siempre apuntan al propio usuario
+		feed.TargetID = userCtx.ID
+		if feed.Name == "" {
+			feed.Name = "Mis eventos"
+		}
+	default:
+		return nil, "", common.NewValidationError("scope", "Alcance de feed inválido")
+	}
+
+	token, err := auth.GenerateSecureRandomString(calendarFeedTokenBytes)
+	if err != nil {
+		return nil, "", common.ErrInternalError
+	}
+	feed.TokenHash = hashFeedToken(token)
+
+	if err := s.feedRepo.Create(ctx, feed); err != nil {
+		return nil, "", err
+	}
+
+	return feed, token, nil
+}
+
+// ListFeeds lista los feeds activos del usuario
+func (s *CalendarServiceImpl) ListFeeds(ctx context.Context, userCtx *common.UserContext) ([]*models.CalendarFeed, error) {
+	if userCtx == nil {
+		return nil, common.ErrUnauthorized
+	}
+	return s.feedRepo.GetByUserID(ctx, userCtx.ID)
+}
+
+// RevokeFeed revoca un feed del usuario
+func (s *CalendarServiceImpl) RevokeFeed(ctx context.Context, feedID string, userCtx *common.UserContext) error {
+	if userCtx == nil {
+		return common.ErrUnauthorized
+	}
+
+	feed, err := s.feedRepo.GetByID(ctx, feedID)
+	if err != nil {
+		return err
+	}
+
+	if feed.UserID != userCtx.ID && !userCtx.IsAdmin() {
+		return common.ErrNotFound
+	}
+
+	return s.feedRepo.Revoke(ctx, feedID)
+}
+
+// eventToICal convierte un evento en un VEVENT
+func eventToICal(event *models.Event) ical.Event {
+	loc, err := time.LoadLocation(event.Timezone)
+	if err != nil || event.Timezone == "" {
+		loc = time.UTC
+	}
+
+	status := ical.StatusConfirmed
+	switch event.Status {
+	case models.EventStatusCanceled:
+		status = ical.StatusCancelled
+	case models.EventStatusDraft, models.EventStatusPendingReview:
+		status = ical.StatusTentative
+	}
+
+	location := event.OnlineURL
+	if !event.IsOnline {
+		parts := make([]string, 0, 3)
+		for _, part := range []string{event.VenueName, event.VenueAddress, event.VenueCity} {
+			if strings.TrimSpace(part) != "" {
+				parts = append(parts, part)
+			}
+		}
+		location = strings.Join(parts, ", ")
+	}
+
+	description := event.ShortDesc
+	if description == "" {
+		description = event.Description
+	}
+
+	// SEQUENCE creciente para que los clientes apliquen las modificaciones
+	sequence := int(event.UpdatedAt.Sub(event.CreatedAt) / time.Second)
+	if sequence < 0 {
+		sequence = 0
+	}
+
+	return ical.Event{
+		UID:          event.ID.String() + "@" + calendarUIDDomain,
+		Summary:      event.Title,
+		Description:  description,
+		Location:     location,
+		URL:          event.RegistrationURL,
+		Start:        event.StartDate,
+		End:          event.EndDate,
+		TimeZone:     loc,
+		Status:       status,
+		Sequence:     sequence,
+		Created:      event.CreatedAt,
+		LastModified: event.UpdatedAt,
+		Categories:   event.GetTags(),
+		Latitude:     event.Latitude,
+		Longitude:    event.Longitude,
+	}
+}
+
+// hashFeedToken calcula el hash almacenado de un token de feed
+func hashFeedToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
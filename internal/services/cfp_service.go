@@ -0,0 +1,548 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/dto"
+	"cybesphere-backend/internal/models"
+	"cybesphere-backend/internal/repositories"
+	"cybesphere-backend/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// cfpMaxSubmissionsPerUser máximo de propuestas de un usuario por evento
+	cfpMaxSubmissionsPerUser = 5
+
+	// cfpDefaultDurationMinutes duración por defecto de una propuesta
+	cfpDefaultDurationMinutes = 45
+)
+
+// CFPServiceImpl implementación del servicio de call for papers
+type CFPServiceImpl struct {
+	submissionRepo *repositories.TalkSubmissionRepository
+	reviewRepo     *repositories.SubmissionReviewRepository
+	eventRepo      *repositories.EventRepository
+	userRepo       *repositories.UserRepository
+	speakerRepo    *repositories.SpeakerRepository
+	agenda         AgendaService
+	notifications  NotificationService
+}
+
+// Verificación en tiempo de compilación de que CFPServiceImpl implementa CFPService
+var _ CFPService = (*CFPServiceImpl)(nil)
+
+// NewCFPService crea una nueva instancia del servicio de call for papers
+func NewCFPService(
+	submissionRepo *repositories.TalkSubmissionRepository,
+	reviewRepo *repositories.SubmissionReviewRepository,
+	eventRepo *repositories.EventRepository,
+	userRepo *repositories.UserRepository,
+	speakerRepo *repositories.SpeakerRepository,
+	agenda AgendaService,
+	notifications NotificationService,
+) CFPService {
+	return &CFPServiceImpl{
+		submissionRepo: submissionRepo,
+		reviewRepo:     reviewRepo,
+		eventRepo:      eventRepo,
+		userRepo:       userRepo,
+		speakerRepo:    speakerRepo,
+		agenda:         agenda,
+		notifications:  notifications,
+	}
+}
+
+// =============================================================================
+// AUTORES
+// =============================================================================
+
+// SubmitTalk envía una propuesta al CFP de un evento
+func (s *CFPServiceImpl) SubmitTalk(ctx context.Context, eventID string, req dto.CreateSubmissionRequest, userCtx *common.UserContext) (*models.TalkSubmission, error) {
+	if userCtx == nil {
+		return nil, common.ErrUnauthorized
+	}
+
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isEventVisible(event, userCtx) {
+		return nil, common.ErrNotFound
+	}
+
+	if !event.HasCFP() {
+		return nil, common.NewBusinessError("cfp_not_available", "El evento no tiene call for papers")
+	}
+
+	if !event.IsCFPOpen() {
+		return nil, common.NewBusinessError("cfp_closed", "El call for papers no está abierto")
+	}
+
+	count, err := s.submissionRepo.CountBySubmitter(ctx, eventID, userCtx.ID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= cfpMaxSubmissionsPerUser {
+		return nil, common.NewBusinessError("submission_limit_reached",
+			fmt.Sprintf("Solo se permiten %d propuestas por evento", cfpMaxSubmissionsPerUser))
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userCtx.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	submission := &models.TalkSubmission{
+		EventID:         eventID,
+		SubmitterID:     userCtx.ID,
+		Title:           req.Title,
+		Abstract:        req.Abstract,
+		Notes:           strings.TrimSpace(req.Notes),
+		Level:           models.SessionLevel(req.Level),
+		Track:           req.Track,
+		DurationMinutes: req.DurationMinutes,
+		SpeakerName:     firstNonEmpty(req.SpeakerName, user.GetFullName()),
+		SpeakerBio:      firstNonEmpty(req.SpeakerBio, user.Bio),
+		SpeakerCompany:  firstNonEmpty(req.SpeakerCompany, user.Company),
+		SpeakerPosition: firstNonEmpty(req.SpeakerPosition, user.Position),
+		Status:          models.SubmissionStatusSubmitted,
+	}
+	if submission.DurationMinutes == 0 {
+		submission.DurationMinutes = cfpDefaultDurationMinutes
+	}
+	if err := submission.SetTags(req.Tags); err != nil {
+		return nil, common.NewValidationError("tags", "Tags inválidos")
+	}
+
+	if err := s.submissionRepo.Create(ctx, submission); err != nil {
+		return nil, err
+	}
+
+	submission.Event = event
+	return submission, nil
+}
+
+// ListMySubmissions lista las propuestas enviadas por el usuario
+func (s *CFPServiceImpl) ListMySubmissions(ctx context.Context, userCtx *common.UserContext) ([]*models.TalkSubmission, error) {
+	if userCtx == nil {
+		return nil, common.ErrUnauthorized
+	}
+
+	return s.submissionRepo.GetBySubmitter(ctx, userCtx.ID)
+}
+
+// =============================================================================
+// ORGANIZACIÓN
+// =============================================================================
+
+// ListSubmissions lista las propuestas de un evento con sus revisiones
+func (s *CFPServiceImpl) ListSubmissions(ctx context.Context, eventID string, status models.SubmissionStatus, userCtx *common.UserContext) (*models.Event, []*models.TalkSubmission, error) {
+	event, err := s.getManagedEvent(ctx, eventID, userCtx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	submissions, err := s.submissionRepo.GetByEvent(ctx, eventID, status)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return event, submissions, nil
+}
+
+// GetSubmission obtiene una propuesta con sus revisiones
+func (s *CFPServiceImpl) GetSubmission(ctx context.Context, eventID, submissionID string, userCtx *common.UserContext) (*models.TalkSubmission, error) {
+	if _, err := s.getManagedEvent(ctx, eventID, userCtx); err != nil {
+		return nil, err
+	}
+
+	return s.getEventSubmission(ctx, eventID, submissionID)
+}
+
+// AssignReviewers asigna revisores a una propuesta y les notifica
+func (s *CFPServiceImpl) AssignReviewers(ctx context.Context, eventID, submissionID string, req dto.AssignReviewersRequest, userCtx *common.UserContext) (*models.TalkSubmission, error) {
+	event, err := s.getManagedEvent(ctx, eventID, userCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	submission, err := s.getEventSubmission(ctx, eventID, submissionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !submission.IsPending() {
+		return nil, common.NewBusinessError("submission_already_decided", "La propuesta ya tiene una decisión definitiva")
+	}
+
+	assigned, err := s.reviewRepo.GetAssignedReviewerIDs(ctx, submissionID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, reviewerID := range req.ReviewerIDs {
+		if assigned[reviewerID] {
+			continue
+		}
+
+		if reviewerID == submission.SubmitterID {
+			return nil, common.NewBusinessError("reviewer_is_submitter",
+				"El autor de una propuesta no puede revisarla")
+		}
+
+		if _, err := s.userRepo.GetByID(ctx, reviewerID); err != nil {
+			if errors.Is(err, common.ErrNotFound) {
+				return nil, common.NewValidationError("reviewer_ids", "El revisor "+reviewerID+" no existe")
+			}
+			return nil, err
+		}
+
+		review := &models.SubmissionReview{
+			SubmissionID: submissionID,
+			ReviewerID:   reviewerID,
+		}
+		if err := s.reviewRepo.Create(ctx, review); err != nil {
+			return nil, err
+		}
+		assigned[reviewerID] = true
+
+		s.notify(ctx, reviewerID, models.NotificationTypeReviewAssigned,
+			"Nueva propuesta para revisar",
+			fmt.Sprintf("Se te ha asignado la propuesta \"%s\" del evento \"%s\"", submission.Title, event.Title),
+			map[string]interface{}{"event_id": eventID, "review_id": review.ID.String()})
+	}
+
+	return s.submissionRepo.GetWithReviews(ctx, submissionID)
+}
+
+// DecideSubmission acepta, rechaza o pone en lista de espera una propuesta.
+// Aceptar crea el ponente (o reutiliza el perfil del autor) y la sesión en la
+// agenda en la misma transacción que la decisión, que solo se aplica si la
+// propuesta sigue en el estado en que se leyó.
+func (s *CFPServiceImpl) DecideSubmission(ctx context.Context, eventID, submissionID string, req dto.SubmissionDecisionRequest, userCtx *common.UserContext) (*models.TalkSubmission, error) {
+	event, err := s.getManagedEvent(ctx, eventID, userCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	submission, err := s.getEventSubmission(ctx, eventID, submissionID)
+	if err != nil {
+		return nil, err
+	}
+
+	status := models.SubmissionStatus(req.Status)
+	if !submission.CanTransitionTo(status) {
+		return nil, common.NewBusinessError("invalid_transition",
+			fmt.Sprintf("La propuesta no puede pasar de %s a %s", submission.Status, status))
+	}
+
+	var acceptance *repositories.SubmissionAcceptance
+	if status == models.SubmissionStatusAccepted {
+		if req.Session == nil {
+			return nil, common.NewValidationError("session", "Se requiere el horario de la sesión para aceptar la propuesta")
+		}
+
+		speaker, isNew, err := s.ensureSpeaker(ctx, event, submission)
+		if err != nil {
+			return nil, err
+		}
+
+		session, _, err := s.agenda.BuildSession(ctx, event, dto.CreateSessionRequest{
+			Title:       submission.Title,
+			Description: submission.Abstract,
+			StartTime:   req.Session.StartTime,
+			EndTime:     req.Session.EndTime,
+			Room:        req.Session.Room,
+			Track:       firstNonEmpty(req.Session.Track, submission.Track),
+			Level:       string(submission.Level),
+			Tags:        submission.GetTags(),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		acceptance = &repositories.SubmissionAcceptance{Speaker: speaker, NewSpeaker: isNew, Session: session}
+	}
+
+	from := submission.Status
+	if err := submission.Decide(status, req.Note, userCtx.ID); err != nil {
+		return nil, common.NewBusinessError("invalid_transition", err.Error())
+	}
+
+	// Las relaciones precargadas no se guardan con la propuesta
+	submission.Event = nil
+	submission.Submitter = nil
+	submission.Reviews = nil
+	if err := s.submissionRepo.Decide(ctx, submission, from, acceptance); err != nil {
+		if errors.Is(err, repositories.ErrSubmissionStatusChanged) {
+			return nil, common.NewBusinessError("submission_changed",
+				"La propuesta ha cambiado mientras se decidía; vuelve a cargarla")
+		}
+		return nil, err
+	}
+
+	s.notifyDecision(ctx, event, submission)
+
+	return s.submissionRepo.GetWithReviews(ctx, submissionID)
+}
+
+// ExportReviewSummary genera un CSV con el resumen de revisión de las propuestas,
+// ordenado por puntuación media descendente
+func (s *CFPServiceImpl) ExportReviewSummary(ctx context.Context, eventID string, userCtx *common.UserContext) (*models.Event, []byte, error) {
+	event, submissions, err := s.ListSubmissions(ctx, eventID, "", userCtx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sort.SliceStable(submissions, func(i, j int) bool {
+		avgI, _ := submissions[i].ScoreSummary()
+		avgJ, _ := submissions[j].ScoreSummary()
+		return avgI > avgJ
+	})
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	header := []string{
+		"submission_id", "title", "speaker", "submitter_email", "track", "level",
+		"duration_minutes", "status", "assigned_reviews", "completed_reviews",
+		"average_score", "min_score", "max_score", "comments",
+	}
+	if err := w.Write(header); err != nil {
+		return nil, nil, common.ErrInternalError
+	}
+
+	for _, submission := range submissions {
+		average, completed := submission.ScoreSummary()
+		minScore, maxScore, comments := reviewStats(submission.Reviews)
+
+		email := ""
+		if submission.Submitter != nil {
+			email = submission.Submitter.Email
+		}
+
+		record := []string{
+			submission.ID.String(),
+			submission.Title,
+			submission.SpeakerName,
+			email,
+			submission.Track,
+			string(submission.Level),
+			strconv.Itoa(submission.DurationMinutes),
+			string(submission.Status),
+			strconv.Itoa(len(submission.Reviews)),
+			strconv.Itoa(completed),
+			strconv.FormatFloat(average, 'f', 2, 64),
+			minScore,
+			maxScore,
+			strings.Join(comments, " | "),
+		}
+		if err := w.Write(record); err != nil {
+			return nil, nil, common.ErrInternalError
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, nil, common.ErrInternalError
+	}
+
+	return event, buf.Bytes(), nil
+}
+
+// =============================================================================
+// REVISORES
+// =============================================================================
+
+// ListMyReviews lista las revisiones asignadas al usuario
+func (s *CFPServiceImpl) ListMyReviews(ctx context.Context, userCtx *common.UserContext) ([]*models.SubmissionReview, error) {
+	if userCtx == nil {
+		return nil, common.ErrUnauthorized
+	}
+
+	return s.reviewRepo.GetByReviewer(ctx, userCtx.ID)
+}
+
+// SubmitReview registra la puntuación de una revisión asignada al usuario
+func (s *CFPServiceImpl) SubmitReview(ctx context.Context, reviewID string, req dto.SubmitReviewRequest, userCtx *common.UserContext) (*models.SubmissionReview, error) {
+	if userCtx == nil {
+		return nil, common.ErrUnauthorized
+	}
+
+	review, err := s.reviewRepo.GetWithSubmission(ctx, reviewID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Las revisiones de otros usuarios no se revelan
+	if review.ReviewerID != userCtx.ID {
+		return nil, common.ErrNotFound
+	}
+
+	submission := review.Submission
+	if submission == nil || !submission.IsPending() {
+		return nil, common.NewBusinessError("submission_already_decided", "La propuesta ya tiene una decisión definitiva")
+	}
+
+	if err := review.Rate(req.Score, req.Comment); err != nil {
+		return nil, common.NewValidationError("score", err.Error())
+	}
+
+	review.Submission = nil
+	if err := s.reviewRepo.Update(ctx, review); err != nil {
+		return nil, err
+	}
+	review.Submission = submission
+
+	return review, nil
+}
+
+// =============================================================================
+// MÉTODOS AUXILIARES
+// =============================================================================
+
+// getManagedEvent obtiene un evento cuyo CFP puede gestionar el usuario
+func (s *CFPServiceImpl) getManagedEvent(ctx context.Context, eventID string, userCtx *common.UserContext) (*models.Event, error) {
+	if userCtx == nil {
+		return nil, common.ErrUnauthorized
+	}
+
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !userCtx.CanManageOrganization(event.OrganizationID) {
+		return nil, common.ErrForbidden
+	}
+
+	return event, nil
+}
+
+// getEventSubmission obtiene una propuesta verificando que pertenece al evento
+func (s *CFPServiceImpl) getEventSubmission(ctx context.Context, eventID, submissionID string) (*models.TalkSubmission, error) {
+	submission, err := s.submissionRepo.GetWithReviews(ctx, submissionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if submission.EventID != eventID {
+		return nil, common.ErrNotFound
+	}
+
+	return submission, nil
+}
+
+// ensureSpeaker reutiliza el perfil de ponente del autor en la organización o
+// prepara uno nuevo, que se guarda con la decisión. Indica si es nuevo
+func (s *CFPServiceImpl) ensureSpeaker(ctx context.Context, event *models.Event, submission *models.TalkSubmission) (*models.Speaker, bool, error) {
+	speaker, err := s.speakerRepo.GetByOrganizationAndUser(ctx, event.OrganizationID, submission.SubmitterID)
+	if err == nil {
+		return speaker, false, nil
+	}
+	if !errors.Is(err, common.ErrNotFound) {
+		return nil, false, err
+	}
+
+	submitterID := submission.SubmitterID
+	speaker = &models.Speaker{
+		BaseModel:      models.BaseModel{ID: uuid.New()},
+		OrganizationID: event.OrganizationID,
+		UserID:         &submitterID,
+		Name:           submission.SpeakerName,
+		Bio:            submission.SpeakerBio,
+		Company:        submission.SpeakerCompany,
+		Position:       submission.SpeakerPosition,
+	}
+	if submission.Submitter != nil {
+		speaker.Email = submission.Submitter.Email
+	}
+
+	return speaker, true, nil
+}
+
+// notifyDecision comunica al autor la decisión sobre su propuesta
+func (s *CFPServiceImpl) notifyDecision(ctx context.Context, event *models.Event, submission *models.TalkSubmission) {
+	var title string
+	switch submission.Status {
+	case models.SubmissionStatusAccepted:
+		title = "Tu propuesta ha sido aceptada"
+	case models.SubmissionStatusRejected:
+		title = "Tu propuesta no ha sido seleccionada"
+	case models.SubmissionStatusWaitlisted:
+		title = "Tu propuesta está en lista de espera"
+	default:
+		return
+	}
+
+	message := fmt.Sprintf("\"%s\" para el evento \"%s\"", submission.Title, event.Title)
+	if submission.DecisionNote != "" {
+		message += ": " + submission.DecisionNote
+	}
+
+	data := map[string]interface{}{
+		"event_id":      event.ID.String(),
+		"submission_id": submission.ID.String(),
+		"status":        submission.Status,
+	}
+	if submission.SessionID != nil {
+		data["session_id"] = *submission.SessionID
+	}
+
+	s.notify(ctx, submission.SubmitterID, models.NotificationTypeSubmissionDecision, title, message, data)
+}
+
+// notify envía una notificación sin interrumpir la operación si falla
+func (s *CFPServiceImpl) notify(ctx context.Context, userID string, notificationType models.NotificationType, title, message string, data map[string]interface{}) {
+	if err := s.notifications.Notify(ctx, userID, notificationType, title, message, data); err != nil {
+		logger.Warnf("Error enviando notificación %s al usuario %s: %v", notificationType, userID, err)
+	}
+}
+
+// reviewStats obtiene la puntuación mínima, máxima y los comentarios de las revisiones completadas
+func reviewStats(reviews []models.SubmissionReview) (string, string, []string) {
+	minScore, maxScore := 0, 0
+	comments := make([]string, 0, len(reviews))
+
+	for _, review := range reviews {
+		if !review.IsCompleted() {
+			continue
+		}
+		score := *review.Score
+		if minScore == 0 || score < minScore {
+			minScore = score
+		}
+		if score > maxScore {
+			maxScore = score
+		}
+		if review.Comment != "" {
+			comments = append(comments, review.Comment)
+		}
+	}
+
+	if maxScore == 0 {
+		return "", "", comments
+	}
+	return strconv.Itoa(minScore), strconv.Itoa(maxScore), comments
+}
+
+// firstNonEmpty devuelve el primer valor no vacío
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			return v
+		}
+	}
+	return ""
+}
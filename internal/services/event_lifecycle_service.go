@@ -0,0 +1,246 @@
+// internal/services/event_lifecycle_service.go
+package services
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/models"
+	"cybesphere-backend/internal/repositories"
+	"cybesphere-backend/pkg/logger"
+)
+
+// lifecycleBatchSize eventos por consulta al buscar transiciones pendientes
+const lifecycleBatchSize = 200
+
+// errTransitionConflict otro cambio de estado se ha adelantado
+var errTransitionConflict = common.NewBusinessError("transition_conflict", "El estado del evento ha cambiado, vuelve a intentarlo")
+
+// EventLifecycleHandler suscriptor de los eventos de dominio del ciclo de
+// vida. Se llama después de guardar la transición; sus errores no la deshacen
+type EventLifecycleHandler func(ctx context.Context, change models.EventLifecycleChange)
+
+// EventLifecycleReport transiciones automáticas aplicadas en una pasada
+type EventLifecycleReport struct {
+	Published           int
+	RegistrationsClosed int
+	Completed           int
+	Failed              int
+}
+
+// EventLifecycleServiceImpl aplica las transiciones de los eventos según la
+// máquina de estados del modelo y emite un evento de dominio por cada una
+type EventLifecycleServiceImpl struct {
+	eventRepo *repositories.EventRepository
+	auth      AuthorizationService
+
+	mu       sync.RWMutex
+	handlers []EventLifecycleHandler
+}
+
+// Verificación en tiempo de compilación
+var _ EventLifecycleService = (*EventLifecycleServiceImpl)(nil)
+
+// NewEventLifecycleService crea el servicio sin suscriptores
+func NewEventLifecycleService(eventRepo *repositories.EventRepository, auth AuthorizationService) EventLifecycleService {
+	return &EventLifecycleServiceImpl{eventRepo: eventRepo, auth: auth}
+}
+
+// Subscribe añade un suscriptor a los cambios del ciclo de vida
+func (s *EventLifecycleServiceImpl) Subscribe(handler EventLifecycleHandler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers = append(s.handlers, handler)
+}
+
+// Transition aplica una transición a petición de un usuario con permiso sobre el evento
+func (s *EventLifecycleServiceImpl) Transition(ctx context.Context, eventID string, transition models.EventTransition, userCtx *common.UserContext) (*models.Event, error) {
+	if !models.IsValidEventTransition(transition) {
+		return nil, common.NewValidationError("transition", "Transición desconocida")
+	}
+	if err := s.auth.CheckUpdatePermission(userCtx, "event", eventID); err != nil {
+		return nil, err
+	}
+
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Apply(ctx, event, transition, userCtx.ID); err != nil {
+		return nil, err
+	}
+	return s.eventRepo.GetByID(ctx, eventID)
+}
+
+// TransitionTo lleva el evento al estado indicado con la transición que lo
+// permita; sin cambios si ya está en ese estado
+func (s *EventLifecycleServiceImpl) TransitionTo(ctx context.Context, eventID string, status models.EventStatus, userCtx *common.UserContext) (*models.Event, error) {
+	if err := s.auth.CheckUpdatePermission(userCtx, "event", eventID); err != nil {
+		return nil, err
+	}
+
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if event.Status == status {
+		return event, nil
+	}
+
+	transition, ok := models.EventTransitionTo(event.Status, status)
+	if !ok {
+		return nil, common.NewBusinessError("invalid_transition",
+			"No se puede pasar un evento de "+string(event.Status)+" a "+string(status))
+	}
+	if err := s.Apply(ctx, event, transition, userCtx.ID); err != nil {
+		return nil, err
+	}
+	return s.eventRepo.GetByID(ctx, eventID)
+}
+
+// SchedulePublication programa la publicación de un borrador
+func (s *EventLifecycleServiceImpl) SchedulePublication(ctx context.Context, eventID string, publishAt time.Time, userCtx *common.UserContext) (*models.Event, error) {
+	if err := s.auth.CheckUpdatePermission(userCtx, "event", eventID); err != nil {
+		return nil, err
+	}
+
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	change, err := event.SchedulePublication(publishAt, time.Now())
+	if err != nil {
+		return nil, common.NewBusinessError("schedule_publish_failed", err.Error())
+	}
+	if err := s.save(ctx, event, change, userCtx.ID); err != nil {
+		return nil, err
+	}
+	return s.eventRepo.GetByID(ctx, eventID)
+}
+
+// Apply aplica la transición a un evento ya cargado sin comprobar permisos,
+// para servicios que ya los han verificado y para las tareas programadas
+func (s *EventLifecycleServiceImpl) Apply(ctx context.Context, event *models.Event, transition models.EventTransition, actorID string) error {
+	change, err := event.ApplyTransition(transition, time.Now())
+	if err != nil {
+		return common.NewBusinessError(string(transition)+"_failed", err.Error())
+	}
+	return s.save(ctx, event, change, actorID)
+}
+
+// Hide oculta un evento ya cargado por moderación con el motivo que se
+// comunica al organizador, sin comprobar permisos
+func (s *EventLifecycleServiceImpl) Hide(ctx context.Context, event *models.Event, reason, actorID string) error {
+	change, err := event.Hide(reason, time.Now())
+	if err != nil {
+		return common.NewBusinessError("hide_failed", err.Error())
+	}
+	return s.save(ctx, event, change, actorID)
+}
+
+// RunDue publica los borradores programados, cierra las inscripciones
+// vencidas y completa los eventos terminados hace más de completionDelay
+func (s *EventLifecycleServiceImpl) RunDue(ctx context.Context, completionDelay time.Duration) (*EventLifecycleReport, error) {
+	now := time.Now()
+	report := &EventLifecycleReport{}
+
+	steps := []struct {
+		transition models.EventTransition
+		due        time.Time
+		count      *int
+	}{
+		{models.EventTransitionPublish, now, &report.Published},
+		{models.EventTransitionCloseRegistration, now, &report.RegistrationsClosed},
+		{models.EventTransitionComplete, now.Add(-completionDelay), &report.Completed},
+	}
+
+	for _, step := range steps {
+		afterID := ""
+		for {
+			events, err := s.eventRepo.GetDueForTransition(ctx, step.transition, step.due, afterID, lifecycleBatchSize)
+			if err != nil {
+				return report, err
+			}
+
+			for _, event := range events {
+				if err := s.Apply(ctx, event, step.transition, models.SystemActor); err != nil {
+					logger.Errorf("Error aplicando %s al evento %s: %v", step.transition, event.ID, err)
+					report.Failed++
+					continue
+				}
+				*step.count++
+			}
+
+			if len(events) < lifecycleBatchSize {
+				break
+			}
+			afterID = events[len(events)-1].ID.String()
+		}
+	}
+
+	return report, nil
+}
+
+// save guarda la transición y, si se ha aplicado, avisa a los suscriptores
+func (s *EventLifecycleServiceImpl) save(ctx context.Context, event *models.Event, change models.EventLifecycleChange, actorID string) error {
+	applied, err := s.eventRepo.ApplyTransition(ctx, event, change)
+	if err != nil {
+		return err
+	}
+	if !applied {
+		return errTransitionConflict
+	}
+
+	change.ActorID = actorID
+	logger.Infof("Evento %s: %s (%s -> %s) por %s", change.EventID, change.Transition, change.From, change.To, actorID)
+
+	s.mu.RLock()
+	handlers := append([]EventLifecycleHandler(nil), s.handlers...)
+	s.mu.RUnlock()
+	for _, handler := range handlers {
+		handler(ctx, change)
+	}
+	return nil
+}
+
+// refundCanceledEvents reembolsa los pedidos de los eventos cancelados. Los
+// que no se puedan reembolsar ahora los reintenta la tarea order_refunds
+func refundCanceledEvents(payments PaymentService) EventLifecycleHandler {
+	return func(ctx context.Context, change models.EventLifecycleChange) {
+		if change.Transition != models.EventTransitionCancel {
+			return
+		}
+		if refunded, err := payments.RefundEvent(ctx, change.EventID); err != nil {
+			logger.Errorf("Error reembolsando los pedidos del evento cancelado %s: %v", change.EventID, err)
+		} else if refunded > 0 {
+			logger.Infof("Cerrados %d pedidos del evento cancelado %s", refunded, change.EventID)
+		}
+	}
+}
+
+// notifyCanceledEvents avisa a los inscritos de que el evento se ha cancelado
+func notifyCanceledEvents(registrationRepo *repositories.EventRegistrationRepository, notifications NotificationService) EventLifecycleHandler {
+	return func(ctx context.Context, change models.EventLifecycleChange) {
+		if change.Transition != models.EventTransitionCancel {
+			return
+		}
+
+		userIDs, err := registrationRepo.GetActiveUserIDsByEvent(ctx, change.EventID)
+		if err != nil {
+			logger.Errorf("Error obteniendo los inscritos del evento cancelado %s: %v", change.EventID, err)
+			return
+		}
+
+		data := map[string]interface{}{"event_id": change.EventID}
+		for _, userID := range userIDs {
+			err := notifications.Notify(ctx, userID, models.NotificationTypeEventCanceled,
+				"Evento cancelado", "Se ha cancelado el evento \""+change.Title+"\"", data)
+			if err != nil {
+				logger.Errorf("Error avisando a %s de la cancelación del evento %s: %v", userID, change.EventID, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,650 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/dto"
+	"cybesphere-backend/internal/models"
+	"cybesphere-backend/internal/repositories"
+	"cybesphere-backend/pkg/logger"
+	"cybesphere-backend/pkg/rrule"
+)
+
+const (
+	// seriesGenerationHorizon ventana futura en la que se materializan ocurrencias
+	seriesGenerationHorizon = 365 * 24 * time.Hour
+
+	// seriesMaxOccurrences máximo de ocurrencias creadas por pasada de generación
+	seriesMaxOccurrences = 100
+
+	// seriesMaxSlugAttempts sufijos probados antes de rendirse con un slug duplicado
+	seriesMaxSlugAttempts = 50
+)
+
+// seriesOccurrenceColumns columnas de una ocurrencia que cambia la edición de
+// la serie o de la propia ocurrencia. Los contadores (inscritos, visitas) y el
+// resto de columnas los cambian otros procesos y no se sobrescriben
+var seriesOccurrenceColumns = []string{
+	"title", "description", "short_desc", "category", "level",
+	"start_date", "end_date", "timezone", "occurrence_date", "is_series_exception",
+	"is_online", "venue_address", "venue_name", "venue_city", "venue_country",
+	"latitude", "longitude", "coordinates_source", "online_url", "streaming_url",
+	"max_attendees", "is_free", "price", "currency", "registration_url",
+	"image_url", "banner_url", "tags", "requirements", "agenda",
+	"registration_start_date", "registration_end_date", "cfp_start_date", "cfp_end_date",
+	"contact_email", "contact_phone", "meta_title", "meta_description",
+	"status", "is_public", "is_featured",
+}
+
+// EventSeriesServiceImpl implementación del servicio de series recurrentes
+type EventSeriesServiceImpl struct {
+	seriesRepo *repositories.EventSeriesRepository
+	eventRepo  *repositories.EventRepository
+	orgRepo    *repositories.OrganizationRepository
+	mapper     ResponseMapper
+	lifecycle  EventLifecycleService
+}
+
+// Verificación en tiempo de compilación de que EventSeriesServiceImpl implementa EventSeriesService
+var _ EventSeriesService = (*EventSeriesServiceImpl)(nil)
+
+// NewEventSeriesService crea una nueva instancia del servicio de series
+func NewEventSeriesService(
+	seriesRepo *repositories.EventSeriesRepository,
+	eventRepo *repositories.EventRepository,
+	orgRepo *repositories.OrganizationRepository,
+	mapper ResponseMapper,
+	lifecycle EventLifecycleService,
+) EventSeriesService {
+	return &EventSeriesServiceImpl{
+		seriesRepo: seriesRepo,
+		eventRepo:  eventRepo,
+		orgRepo:    orgRepo,
+		mapper:     mapper,
+		lifecycle:  lifecycle,
+	}
+}
+
+// CreateSeries crea una serie y materializa sus primeras ocurrencias
+func (s *EventSeriesServiceImpl) CreateSeries(ctx context.Context, req dto.CreateEventSeriesRequest, userCtx *common.UserContext) (*models.EventSeries, []*models.Event, error) {
+	if userCtx == nil {
+		return nil, nil, common.ErrUnauthorized
+	}
+
+	rule, err := rrule.Parse(req.RRule)
+	if err != nil {
+		return nil, nil, common.NewValidationError("rrule", "Regla de recurrencia inválida: "+err.Error())
+	}
+
+	if err := s.validateOrganization(ctx, &req.Event, userCtx); err != nil {
+		return nil, nil, err
+	}
+
+	entity, err := s.mapper.DTOToEntity(&req.Event, userCtx)
+	if err != nil {
+		return nil, nil, err
+	}
+	template := entity.(*models.Event)
+
+	loc, err := time.LoadLocation(template.Timezone)
+	if err != nil {
+		return nil, nil, common.NewValidationError("timezone", "Zona horaria inválida")
+	}
+
+	series := &models.EventSeries{
+		OrganizationID:  template.OrganizationID,
+		Title:           template.Title,
+		RRule:           rule.String(),
+		Timezone:        template.Timezone,
+		StartDate:       template.StartDate.In(loc),
+		DurationMinutes: int(template.EndDate.Sub(template.StartDate).Minutes()),
+		Status:          models.EventSeriesStatusActive,
+	}
+	if err := series.SetTemplate(template); err != nil {
+		return nil, nil, common.ErrInternalError
+	}
+
+	if err := s.seriesRepo.Create(ctx, series); err != nil {
+		return nil, nil, err
+	}
+
+	if _, err := s.generate(ctx, series); err != nil {
+		return nil, nil, err
+	}
+
+	return s.loadSeries(ctx, series.ID.String())
+}
+
+// GetSeries obtiene una serie con sus ocurrencias
+func (s *EventSeriesServiceImpl) GetSeries(ctx context.Context, seriesID string, userCtx *common.UserContext) (*models.EventSeries, []*models.Event, error) {
+	series, err := s.seriesRepo.GetByID(ctx, seriesID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := s.authorize(series, userCtx); err != nil {
+		return nil, nil, err
+	}
+
+	return s.loadSeries(ctx, seriesID)
+}
+
+// UpdateOccurrence modifica una ocurrencia con alcance this, following o all
+func (s *EventSeriesServiceImpl) UpdateOccurrence(ctx context.Context, seriesID, occurrenceID string, req dto.UpdateOccurrenceRequest, userCtx *common.UserContext) (*models.EventSeries, []*models.Event, error) {
+	series, occurrence, err := s.getOccurrence(ctx, seriesID, occurrenceID, userCtx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scope := models.EditScope(req.Scope)
+	if !models.IsValidEditScope(scope) {
+		return nil, nil, common.NewValidationError("scope", "Alcance inválido")
+	}
+
+	// Editar "esta y siguientes" desde la primera ocurrencia equivale a editar toda la serie
+	if scope == models.EditScopeFollowing && !occurrence.OccurrenceDate.After(series.StartDate) {
+		scope = models.EditScopeAll
+	}
+
+	switch scope {
+	case models.EditScopeThis:
+		if _, err := s.mapper.ApplyUpdateDTO(occurrence, &req.Changes, userCtx); err != nil {
+			return nil, nil, err
+		}
+		occurrence.IsSeriesException = true
+		if err := s.eventRepo.UpdateColumns(ctx, occurrence, seriesOccurrenceColumns); err != nil {
+			return nil, nil, err
+		}
+
+	case models.EditScopeFollowing:
+		newSeries, err := s.splitSeries(ctx, series, *occurrence.OccurrenceDate)
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := s.updateSeries(ctx, newSeries, occurrence, req.Changes, userCtx); err != nil {
+			return nil, nil, err
+		}
+		series = newSeries
+
+	case models.EditScopeAll:
+		if err := s.updateSeries(ctx, series, occurrence, req.Changes, userCtx); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return s.loadSeries(ctx, series.ID.String())
+}
+
+// CancelOccurrence cancela una ocurrencia, las siguientes o toda la serie.
+// Las ocurrencias canceladas se conservan para que los calendarios reciban la cancelación.
+func (s *EventSeriesServiceImpl) CancelOccurrence(ctx context.Context, seriesID, occurrenceID string, scope models.EditScope, userCtx *common.UserContext) (*models.EventSeries, []*models.Event, error) {
+	series, occurrence, err := s.getOccurrence(ctx, seriesID, occurrenceID, userCtx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !models.IsValidEditScope(scope) {
+		return nil, nil, common.NewValidationError("scope", "Alcance inválido")
+	}
+
+	if scope == models.EditScopeFollowing && !occurrence.OccurrenceDate.After(series.StartDate) {
+		scope = models.EditScopeAll
+	}
+
+	switch scope {
+	case models.EditScopeThis:
+		if err := s.lifecycle.Apply(ctx, occurrence, models.EventTransitionCancel, userCtx.ID); err != nil {
+			return nil, nil, err
+		}
+
+	case models.EditScopeFollowing:
+		if _, err := series.EndBefore(*occurrence.OccurrenceDate); err != nil {
+			return nil, nil, common.NewBusinessError("cancel_failed", err.Error())
+		}
+		series.Status = models.EventSeriesStatusEnded
+		if err := s.seriesRepo.Update(ctx, series); err != nil {
+			return nil, nil, err
+		}
+		if err := s.cancelOccurrences(ctx, seriesID, *occurrence.OccurrenceDate, userCtx.ID); err != nil {
+			return nil, nil, err
+		}
+
+	case models.EditScopeAll:
+		series.Status = models.EventSeriesStatusCanceled
+		if err := s.seriesRepo.Update(ctx, series); err != nil {
+			return nil, nil, err
+		}
+		// Las ocurrencias ya celebradas no se modifican
+		if err := s.cancelOccurrences(ctx, seriesID, time.Now(), userCtx.ID); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return s.loadSeries(ctx, seriesID)
+}
+
+// cancelOccurrences cancela una a una las ocurrencias activas desde una fecha
+// original. Los suscriptores del ciclo de vida reembolsan sus pedidos
+func (s *EventSeriesServiceImpl) cancelOccurrences(ctx context.Context, seriesID string, since time.Time, actorID string) error {
+	occurrences, err := s.eventRepo.GetCancelableSeriesOccurrences(ctx, seriesID, since)
+	if err != nil {
+		return err
+	}
+
+	for _, occurrence := range occurrences {
+		if err := s.lifecycle.Apply(ctx, occurrence, models.EventTransitionCancel, actorID); err != nil {
+			logger.Errorf("Error cancelando la ocurrencia %s: %v", occurrence.ID, err)
+		}
+	}
+	return nil
+}
+
+// GenerateUpcoming materializa las ocurrencias pendientes de todas las series activas
+func (s *EventSeriesServiceImpl) GenerateUpcoming(ctx context.Context) (int, error) {
+	seriesList, err := s.seriesRepo.GetActive(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	total := 0
+	for _, series := range seriesList {
+		created, err := s.generate(ctx, series)
+		if err != nil {
+			logger.Errorf("Error generando ocurrencias de la serie %s: %v", series.ID, err)
+			continue
+		}
+		total += len(created)
+	}
+
+	return total, nil
+}
+
+// generate crea las ocurrencias que faltan dentro del horizonte de generación
+func (s *EventSeriesServiceImpl) generate(ctx context.Context, series *models.EventSeries) ([]*models.Event, error) {
+	if !series.IsActive() {
+		return nil, nil
+	}
+
+	from := series.StartDate
+	if series.GeneratedUntil != nil && series.GeneratedUntil.After(from) {
+		from = series.GeneratedUntil.Add(time.Second)
+	}
+	to := time.Now().Add(seriesGenerationHorizon)
+
+	dates, err := series.Occurrences(from, to, seriesMaxOccurrences)
+	if err != nil {
+		return nil, common.NewBusinessError("invalid_rrule", err.Error())
+	}
+
+	// Fechas ya materializadas (incluidas las eliminadas, que no deben reaparecer)
+	existingDates, err := s.eventRepo.GetSeriesOccurrenceDates(ctx, series.ID.String())
+	if err != nil {
+		return nil, err
+	}
+	existing := make(map[int64]bool, len(existingDates))
+	for _, d := range existingDates {
+		existing[d.Unix()] = true
+	}
+
+	created := make([]*models.Event, 0, len(dates))
+	for _, date := range dates {
+		if existing[date.Unix()] {
+			continue
+		}
+
+		event, err := series.BuildOccurrence(date)
+		if err != nil {
+			return created, common.ErrInternalError
+		}
+
+		event.Slug, err = s.uniqueSlug(ctx, event.Slug)
+		if err != nil {
+			return created, err
+		}
+
+		if err := s.eventRepo.Create(ctx, event); err != nil {
+			return created, err
+		}
+		created = append(created, event)
+	}
+
+	// Si se alcanzó el límite, la próxima pasada continúa tras la última ocurrencia
+	generatedUntil := to
+	if len(dates) == seriesMaxOccurrences {
+		generatedUntil = dates[len(dates)-1]
+	}
+	series.GeneratedUntil = &generatedUntil
+
+	if err := s.seriesRepo.Update(ctx, series); err != nil {
+		return created, err
+	}
+
+	if len(created) > 0 {
+		go func(orgID string, count int) {
+			for i := 0; i < count; i++ {
+				if err := s.orgRepo.IncrementEventsCount(context.Background(), orgID); err != nil {
+					logger.Error("Error incrementando contador de eventos de la organización: ", err)
+					return
+				}
+			}
+		}(series.OrganizationID, len(created))
+	}
+
+	return created, nil
+}
+
+// splitSeries corta la serie en una fecha original y crea una nueva serie desde ella,
+// moviendo las ocurrencias posteriores
+func (s *EventSeriesServiceImpl) splitSeries(ctx context.Context, series *models.EventSeries, at time.Time) (*models.EventSeries, error) {
+	rule, err := series.Rule()
+	if err != nil {
+		return nil, common.NewBusinessError("invalid_rrule", err.Error())
+	}
+
+	template, err := series.GetTemplate()
+	if err != nil {
+		return nil, common.ErrInternalError
+	}
+
+	remaining, err := series.EndBefore(at)
+	if err != nil {
+		return nil, common.NewBusinessError("split_failed", err.Error())
+	}
+	if rule.Count > 0 {
+		rule.Count = remaining
+	}
+
+	// La plantilla de la nueva serie arranca en la fecha de corte
+	loc := series.Location()
+	wallClockShift(template.StartDate, at, loc).apply(template, series.Duration(), loc)
+
+	newSeries := &models.EventSeries{
+		OrganizationID:  series.OrganizationID,
+		Title:           series.Title,
+		RRule:           rule.String(),
+		Timezone:        series.Timezone,
+		StartDate:       at.In(loc),
+		DurationMinutes: series.DurationMinutes,
+		Status:          models.EventSeriesStatusActive,
+		GeneratedUntil:  series.GeneratedUntil,
+	}
+	if err := newSeries.SetTemplate(template); err != nil {
+		return nil, common.ErrInternalError
+	}
+
+	// Repartir las excepciones entre ambas series
+	var before, after []time.Time
+	for _, d := range series.GetExDates() {
+		if d.Before(at) {
+			before = append(before, d)
+		} else {
+			after = append(after, d)
+		}
+	}
+	if err := series.SetExDates(before); err != nil {
+		return nil, common.ErrInternalError
+	}
+	if err := newSeries.SetExDates(after); err != nil {
+		return nil, common.ErrInternalError
+	}
+
+	series.Status = models.EventSeriesStatusEnded
+
+	if err := s.seriesRepo.Create(ctx, newSeries); err != nil {
+		return nil, err
+	}
+	if err := s.seriesRepo.Update(ctx, series); err != nil {
+		return nil, err
+	}
+	if err := s.eventRepo.MoveSeriesOccurrences(ctx, series.ID.String(), newSeries.ID.String(), at); err != nil {
+		return nil, err
+	}
+
+	return newSeries, nil
+}
+
+// updateSeries aplica cambios a la plantilla y a las ocurrencias futuras no modificadas.
+// Los cambios de fecha se expresan respecto a la ocurrencia de referencia y se trasladan
+// a cada ocurrencia conservando su hora local.
+func (s *EventSeriesServiceImpl) updateSeries(ctx context.Context, series *models.EventSeries, ref *models.Event, changes dto.UpdateEventRequest, userCtx *common.UserContext) error {
+	loc := series.Location()
+
+	newStart := ref.StartDate
+	if changes.StartDate != nil {
+		newStart = *changes.StartDate
+	}
+	newEnd := newStart.Add(ref.EndDate.Sub(ref.StartDate))
+	if changes.EndDate != nil {
+		newEnd = *changes.EndDate
+	}
+	duration := newEnd.Sub(newStart)
+	if duration < 0 {
+		return common.NewBusinessError("invalid_dates", "La fecha de fin debe ser posterior a la de inicio")
+	}
+
+	shift := wallClockShift(ref.StartDate, newStart, loc)
+	if shift.days != 0 {
+		rule, err := series.Rule()
+		if err != nil {
+			return common.NewBusinessError("invalid_rrule", err.Error())
+		}
+		// Con BYDAY/BYMONTHDAY/BYMONTH la regla fija el día: moverlo requiere cambiar la regla
+		if len(rule.ByDay) > 0 || len(rule.ByMonthDay) > 0 || len(rule.ByMonth) > 0 {
+			return common.NewBusinessError("date_change_requires_rule",
+				"La regla de la serie fija el día de cada ocurrencia; solo se puede cambiar la hora")
+		}
+	}
+
+	// Los cambios de fecha se aplican aparte
+	shared := changes
+	shared.StartDate = nil
+	shared.EndDate = nil
+
+	occurrences, err := s.eventRepo.GetSeriesOccurrences(ctx, series.ID.String())
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, occurrence := range occurrences {
+		// Las ocurrencias pasadas y las excepciones se conservan tal cual
+		if occurrence.ID != ref.ID && (occurrence.IsSeriesException || occurrence.StartDate.Before(now)) {
+			continue
+		}
+
+		if _, err := s.mapper.ApplyUpdateDTO(occurrence, &shared, userCtx); err != nil {
+			return err
+		}
+		shift.apply(occurrence, duration, loc)
+
+		if err := s.eventRepo.UpdateColumns(ctx, occurrence, seriesOccurrenceColumns); err != nil {
+			return err
+		}
+	}
+
+	// Actualizar plantilla y definición de la serie
+	template, err := series.GetTemplate()
+	if err != nil {
+		return err
+	}
+	if _, err := s.mapper.ApplyUpdateDTO(template, &shared, userCtx); err != nil {
+		return err
+	}
+	shift.apply(template, duration, loc)
+	if err := series.SetTemplate(template); err != nil {
+		return common.ErrInternalError
+	}
+
+	exDates := series.GetExDates()
+	for i := range exDates {
+		exDates[i] = shift.shiftTime(exDates[i], loc)
+	}
+	if err := series.SetExDates(exDates); err != nil {
+		return common.ErrInternalError
+	}
+
+	series.Title = template.Title
+	series.Timezone = template.Timezone
+	series.StartDate = shift.shiftTime(series.StartDate, loc)
+	series.DurationMinutes = int(duration.Minutes())
+
+	return s.seriesRepo.Update(ctx, series)
+}
+
+// loadSeries obtiene la serie con sus ocurrencias
+func (s *EventSeriesServiceImpl) loadSeries(ctx context.Context, seriesID string) (*models.EventSeries, []*models.Event, error) {
+	series, err := s.seriesRepo.GetByID(ctx, seriesID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	occurrences, err := s.eventRepo.GetSeriesOccurrences(ctx, seriesID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return series, occurrences, nil
+}
+
+// getOccurrence obtiene serie y ocurrencia verificando permisos y pertenencia
+func (s *EventSeriesServiceImpl) getOccurrence(ctx context.Context, seriesID, occurrenceID string, userCtx *common.UserContext) (*models.EventSeries, *models.Event, error) {
+	series, err := s.seriesRepo.GetByID(ctx, seriesID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := s.authorize(series, userCtx); err != nil {
+		return nil, nil, err
+	}
+
+	occurrence, err := s.eventRepo.GetByID(ctx, occurrenceID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if occurrence.SeriesID == nil || *occurrence.SeriesID != seriesID || occurrence.OccurrenceDate == nil {
+		return nil, nil, common.ErrNotFound
+	}
+
+	return series, occurrence, nil
+}
+
+// authorize verifica que el usuario gestiona la organización de la serie
+func (s *EventSeriesServiceImpl) authorize(series *models.EventSeries, userCtx *common.UserContext) error {
+	if userCtx == nil {
+		return common.ErrUnauthorized
+	}
+	if !userCtx.CanManageOrganization(series.OrganizationID) {
+		return common.ErrForbidden
+	}
+	return nil
+}
+
+// validateOrganization determina la organización de la serie y verifica que puede crear eventos
+func (s *EventSeriesServiceImpl) validateOrganization(ctx context.Context, req *dto.CreateEventRequest, userCtx *common.UserContext) error {
+	var organizationID string
+	if userCtx.IsAdmin() && req.OrganizationID != "" {
+		organizationID = req.OrganizationID
+	} else if userCtx.IsOrganizer() && userCtx.OrganizationID != nil {
+		organizationID = *userCtx.OrganizationID
+	} else {
+		return common.NewBusinessError("no_organization", "Se requiere una organización para crear eventos")
+	}
+
+	org, err := s.orgRepo.GetByID(ctx, organizationID)
+	if err != nil {
+		return err
+	}
+
+	if !org.CanCreateEvent() {
+		return common.NewBusinessError("organization_cannot_create_events",
+			"La organización no puede crear eventos en este momento")
+	}
+
+	req.OrganizationID = organizationID
+
+	if req.EndDate.Before(req.StartDate) {
+		return common.NewBusinessError("invalid_dates", "La fecha de fin debe ser posterior a la de inicio")
+	}
+
+	return nil
+}
+
+// uniqueSlug añade sufijos numéricos hasta encontrar un slug libre
+func (s *EventSeriesServiceImpl) uniqueSlug(ctx context.Context, base string) (string, error) {
+	candidate := base
+	for i := 2; i <= seriesMaxSlugAttempts; i++ {
+		exists, err := s.eventRepo.SlugExists(ctx, candidate)
+		if err != nil {
+			return "", err
+		}
+		if !exists {
+			return candidate, nil
+		}
+		candidate = fmt.Sprintf("%s-%d", base, i)
+	}
+	return "", common.NewBusinessError("slug_conflict", "No se pudo generar un slug único para la ocurrencia")
+}
+
+// seriesShift desplazamiento expresado en hora local: días naturales y hora del reloj
+type seriesShift struct {
+	days  int
+	clock time.Duration
+}
+
+// wallClockShift calcula el desplazamiento local entre dos instantes
+func wallClockShift(from, to time.Time, loc *time.Location) seriesShift {
+	f := from.In(loc)
+	t := to.In(loc)
+
+	fromDay := time.Date(f.Year(), f.Month(), f.Day(), 0, 0, 0, 0, time.UTC)
+	toDay := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+
+	return seriesShift{
+		days:  int(toDay.Sub(fromDay).Hours() / 24),
+		clock: clockOf(t) - clockOf(f),
+	}
+}
+
+// shiftTime desplaza un instante conservando la hora local ante cambios de horario
+func (sh seriesShift) shiftTime(t time.Time, loc *time.Location) time.Time {
+	l := t.In(loc)
+	return time.Date(l.Year(), l.Month(), l.Day()+sh.days,
+		l.Hour(), l.Minute(), l.Second()+int(sh.clock/time.Second), l.Nanosecond(), loc)
+}
+
+// apply desplaza las fechas de un evento y recalcula su fin con la nueva duración
+func (sh seriesShift) apply(event *models.Event, duration time.Duration, loc *time.Location) {
+	event.StartDate = sh.shiftTime(event.StartDate, loc)
+	event.EndDate = event.StartDate.Add(duration)
+
+	if event.OccurrenceDate != nil {
+		shifted := sh.shiftTime(*event.OccurrenceDate, loc)
+		event.OccurrenceDate = &shifted
+	}
+	if event.RegistrationStartDate != nil {
+		shifted := sh.shiftTime(*event.RegistrationStartDate, loc)
+		event.RegistrationStartDate = &shifted
+	}
+	if event.RegistrationEndDate != nil {
+		shifted := sh.shiftTime(*event.RegistrationEndDate, loc)
+		event.RegistrationEndDate = &shifted
+	}
+	if event.CFPStartDate != nil {
+		shifted := sh.shiftTime(*event.CFPStartDate, loc)
+		event.CFPStartDate = &shifted
+	}
+	if event.CFPEndDate != nil {
+		shifted := sh.shiftTime(*event.CFPEndDate, loc)
+		event.CFPEndDate = &shifted
+	}
+}
+
+// clockOf devuelve la hora del reloj como duración desde medianoche
+func clockOf(t time.Time) time.Duration {
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute +
+		time.Duration(t.Second())*time.Second
+}
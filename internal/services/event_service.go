@@ -3,11 +3,15 @@ package services
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"cybesphere-backend/internal/common"
 	"cybesphere-backend/internal/dto"
 	"cybesphere-backend/internal/models"
+	"cybesphere-backend/internal/query"
 	"cybesphere-backend/internal/repositories"
+	"cybesphere-backend/pkg/geo"
 	"cybesphere-backend/pkg/logger"
 )
 
@@ -18,6 +22,13 @@ type EventServiceImpl struct {
 	orgRepo   *repositories.OrganizationRepository
 	userRepo  *repositories.UserRepository
 	auth      AuthorizationService
+	lifecycle EventLifecycleService
+	geocoding GeocodingService
+	nearby    geo.RadiusLimits
+
+	// firstEventApproval envía a aprobación los eventos de organizaciones que
+	// aún no han publicado ninguno
+	firstEventApproval bool
 }
 
 // Verificación en tiempo de compilación de que EventServiceImpl implementa EventService
@@ -30,6 +41,10 @@ func NewEventService(
 	userRepo *repositories.UserRepository,
 	mapper ResponseMapper,
 	auth AuthorizationService,
+	lifecycle EventLifecycleService,
+	geocoding GeocodingService,
+	nearby geo.RadiusLimits,
+	firstEventApproval bool,
 ) EventService {
 	base := NewBaseService[models.Event, dto.CreateEventRequest, dto.UpdateEventRequest](
 		eventRepo, mapper, auth,
@@ -40,6 +55,11 @@ func NewEventService(
 		orgRepo:     orgRepo,
 		userRepo:    userRepo,
 		auth:        auth,
+		lifecycle:   lifecycle,
+		geocoding:   geocoding,
+		nearby:      nearby,
+
+		firstEventApproval: firstEventApproval,
 	}
 }
 
@@ -66,11 +86,84 @@ func (s *EventServiceImpl) CreateEvent(ctx context.Context, req dto.CreateEventR
 	return event, nil
 }
 
+// Create crea el evento y, si tiene dirección pero no coordenadas, las
+// calcula en segundo plano
+func (s *EventServiceImpl) Create(ctx context.Context, req dto.CreateEventRequest, userCtx *common.UserContext) (*models.Event, error) {
+	event, err := s.BaseService.Create(ctx, req, userCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.geocoding.ScheduleEvent(event)
+	return event, nil
+}
+
+// Update actualiza el evento y vuelve a calcular sus coordenadas si ha
+// cambiado la dirección y no son manuales. Un cambio de estado se aplica
+// después como transición del ciclo de vida, comprobada antes de guardar
+func (s *EventServiceImpl) Update(ctx context.Context, id string, req dto.UpdateEventRequest, userCtx *common.UserContext) (*models.Event, error) {
+	var status models.EventStatus
+	if req.Status != nil && userCtx.IsAdmin() {
+		current, err := s.eventRepo.GetByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		status = models.EventStatus(*req.Status)
+		if status != current.Status {
+			if _, ok := models.EventTransitionTo(current.Status, status); !ok {
+				return nil, common.NewBusinessError("invalid_transition",
+					"No se puede pasar un evento de "+string(current.Status)+" a "+string(status))
+			}
+		}
+	}
+	req.Status = nil
+
+	event, err := s.BaseService.Update(ctx, id, req, userCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	if status != "" && status != event.Status {
+		if event, err = s.lifecycle.TransitionTo(ctx, id, status, userCtx); err != nil {
+			return nil, err
+		}
+	}
+
+	// Ampliar el plazo de inscripción reabre las inscripciones cerradas
+	if event.RegistrationClosedAt != nil && event.CheckTransition(models.EventTransitionReopenRegistration) == nil {
+		if err := s.lifecycle.Apply(ctx, event, models.EventTransitionReopenRegistration, userCtx.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	s.geocoding.ScheduleEvent(event)
+	return event, nil
+}
+
 // GetPublicEvents obtiene eventos públicos (para usuarios no autenticados)
 func (s *EventServiceImpl) GetPublicEvents(ctx context.Context, opts common.QueryOptions) ([]*models.Event, *common.PaginationMeta, error) {
 	return s.eventRepo.GetPublicEvents(ctx, opts)
 }
 
+// GetEventCatalog obtiene el catálogo filtrado de eventos junto con los
+// recuentos por faceta para construir los filtros
+func (s *EventServiceImpl) GetEventCatalog(ctx context.Context, opts common.QueryOptions, userCtx *common.UserContext) ([]*models.Event, *common.PaginationMeta, map[string][]query.FacetCount, error) {
+	// Aplicar filtros de seguridad
+	s.auth.ApplySecurityFilters(&opts, userCtx, "event")
+
+	events, pagination, err := s.eventRepo.GetAll(ctx, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	facets, err := s.eventRepo.Facets(ctx, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return events, pagination, facets, nil
+}
+
 // GetUpcomingEvents obtiene eventos futuros
 func (s *EventServiceImpl) GetUpcomingEvents(ctx context.Context, opts common.QueryOptions, userCtx *common.UserContext) ([]*models.Event, *common.PaginationMeta, error) {
 	// Aplicar filtros de seguridad
@@ -93,57 +186,64 @@ func (s *EventServiceImpl) GetEventsByOrganization(ctx context.Context, organiza
 	return s.eventRepo.GetByOrganization(ctx, organizationID, opts)
 }
 
-// PublishEvent publica un evento
+// PublishEvent publica un evento. Si la organización necesita aprobación, el
+// evento queda pendiente de revisión por moderación
 func (s *EventServiceImpl) PublishEvent(ctx context.Context, id string, userCtx *common.UserContext) (*models.Event, error) {
-	// Verificar permisos
-	if err := s.auth.CheckUpdatePermission(userCtx, "event", id); err != nil {
-		return nil, err
-	}
-
-	// Obtener evento
-	event, err := s.eventRepo.GetByID(ctx, id)
+	requiresApproval, err := s.requiresApproval(ctx, id, userCtx)
 	if err != nil {
 		return nil, err
 	}
-
-	// Validar que se puede publicar
-	if err := event.Publish(); err != nil {
-		return nil, common.NewBusinessError("publish_failed", err.Error())
+	if requiresApproval {
+		return s.lifecycle.Transition(ctx, id, models.EventTransitionSubmitReview, userCtx)
 	}
+	return s.lifecycle.Transition(ctx, id, models.EventTransitionPublish, userCtx)
+}
+
+// CancelEvent cancela un evento. Los suscriptores del ciclo de vida
+// reembolsan sus pedidos y avisan a los inscritos
+func (s *EventServiceImpl) CancelEvent(ctx context.Context, id string, userCtx *common.UserContext) (*models.Event, error) {
+	return s.lifecycle.Transition(ctx, id, models.EventTransitionCancel, userCtx)
+}
 
-	// Actualizar en base de datos
-	if err := s.eventRepo.UpdateStatus(ctx, id, models.EventStatusPublished); err != nil {
+// SchedulePublication programa la publicación de un borrador. No se admite
+// mientras la organización necesite aprobación
+func (s *EventServiceImpl) SchedulePublication(ctx context.Context, id string, publishAt time.Time, userCtx *common.UserContext) (*models.Event, error) {
+	requiresApproval, err := s.requiresApproval(ctx, id, userCtx)
+	if err != nil {
 		return nil, err
 	}
-
-	// Retornar evento actualizado
-	return s.eventRepo.GetByID(ctx, id)
+	if requiresApproval {
+		return nil, common.NewBusinessError("approval_required",
+			"El primer evento de la organización necesita aprobación; publícalo para enviarlo a revisión")
+	}
+	return s.lifecycle.SchedulePublication(ctx, id, publishAt, userCtx)
 }
 
-// CancelEvent cancela un evento
-func (s *EventServiceImpl) CancelEvent(ctx context.Context, id string, userCtx *common.UserContext) (*models.Event, error) {
-	// Verificar permisos
+// requiresApproval verifica si publicar el evento necesita aprobación: está
+// activada, quien publica no es administrador y la organización aún no ha
+// publicado ningún evento
+func (s *EventServiceImpl) requiresApproval(ctx context.Context, id string, userCtx *common.UserContext) (bool, error) {
+	if !s.firstEventApproval || userCtx.IsAdmin() {
+		return false, nil
+	}
 	if err := s.auth.CheckUpdatePermission(userCtx, "event", id); err != nil {
-		return nil, err
+		return false, err
 	}
 
-	// Obtener evento
 	event, err := s.eventRepo.GetByID(ctx, id)
 	if err != nil {
-		return nil, err
-	}
-
-	// Validar que se puede cancelar
-	if err := event.Cancel(); err != nil {
-		return nil, common.NewBusinessError("cancel_failed", err.Error())
+		return false, err
 	}
-
-	// Actualizar en base de datos
-	if err := s.eventRepo.UpdateStatus(ctx, id, models.EventStatusCanceled); err != nil {
-		return nil, err
+	published, err := s.eventRepo.HasPublishedEvents(ctx, event.OrganizationID)
+	if err != nil {
+		return false, err
 	}
+	return !published, nil
+}
 
-	return s.eventRepo.GetByID(ctx, id)
+// UnschedulePublication anula la publicación programada de un borrador
+func (s *EventServiceImpl) UnschedulePublication(ctx context.Context, id string, userCtx *common.UserContext) (*models.Event, error) {
+	return s.lifecycle.Transition(ctx, id, models.EventTransitionUnschedulePublish, userCtx)
 }
 
 // IncrementViews incrementa las visualizaciones de un evento
@@ -162,14 +262,71 @@ func (s *EventServiceImpl) GetEventsByTags(ctx context.Context, tags []string, o
 	return s.eventRepo.GetEventsByTags(ctx, tags, opts)
 }
 
-// GetNearEvents busca eventos cerca de una ubicación
-func (s *EventServiceImpl) GetNearEvents(ctx context.Context, latitude, longitude float64, radiusKm int, opts common.QueryOptions, userCtx *common.UserContext) ([]*models.Event, *common.PaginationMeta, error) {
-	s.auth.ApplySecurityFilters(&opts, userCtx, "event")
-	return s.eventRepo.GetNearEvents(ctx, latitude, longitude, radiusKm, opts)
+// NearbyEventsResult eventos presenciales cercanos a un punto, por distancia
+type NearbyEventsResult struct {
+	Center      geo.Point
+	RadiusKm    int
+	FromProfile bool // El punto es la ubicación guardada del usuario
+	Events      []*repositories.EventDistance
+	Pagination  *common.PaginationMeta
+}
+
+// GetNearbyEvents busca eventos cerca del punto indicado o, si no se indica,
+// de la ubicación guardada en el perfil del usuario autenticado
+func (s *EventServiceImpl) GetNearbyEvents(ctx context.Context, req dto.NearbyEventsRequest, opts common.QueryOptions, userCtx *common.UserContext) (*NearbyEventsResult, error) {
+	result := &NearbyEventsResult{RadiusKm: req.RadiusKm}
+	if result.RadiusKm == 0 {
+		result.RadiusKm = s.nearby.DefaultKm
+	}
+	if result.RadiusKm > s.nearby.MaxKm {
+		return nil, common.NewValidationError("radius_km", fmt.Sprintf("El radio máximo es de %d km", s.nearby.MaxKm))
+	}
+
+	switch {
+	case req.Latitude != nil && req.Longitude != nil:
+		result.Center = geo.Point{Latitude: *req.Latitude, Longitude: *req.Longitude}
+	case req.Latitude != nil || req.Longitude != nil:
+		return nil, common.NewValidationError("lat", "Indica latitud y longitud")
+	default:
+		if userCtx == nil || userCtx.ID == "" {
+			return nil, common.NewValidationError("lat", "Indica latitud y longitud o inicia sesión para usar tu ubicación")
+		}
+		user, err := s.userRepo.GetByID(ctx, userCtx.ID)
+		if err != nil {
+			return nil, err
+		}
+		if !user.HasLocation() {
+			return nil, common.NewValidationError("lat", "Tu perfil no tiene ubicación; indica latitud y longitud")
+		}
+		result.Center = geo.Point{Latitude: *user.Latitude, Longitude: *user.Longitude}
+		result.FromProfile = true
+	}
+
+	if err := result.Center.Validate(); err != nil {
+		return nil, common.NewValidationError("lat", "Coordenadas fuera de rango")
+	}
+
+	events, total, err := s.eventRepo.GetNearbyEvents(ctx, repositories.NearbyParams{
+		Center:   result.Center,
+		RadiusKm: float64(result.RadiusKm),
+		Limit:    opts.Limit,
+		Offset:   opts.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result.Events = events
+	result.Pagination = common.NewPaginationMeta(opts.Page, opts.Limit, total)
+	return result, nil
 }
 
 // AddToFavorites agrega evento a favoritos del usuario
 func (s *EventServiceImpl) AddToFavorites(ctx context.Context, eventID string, userCtx *common.UserContext) error {
+	if userCtx == nil {
+		return common.ErrUnauthorized
+	}
+
 	// Verificar que el evento existe y es público
 	event, err := s.eventRepo.GetByID(ctx, eventID)
 	if err != nil {
@@ -180,15 +337,16 @@ func (s *EventServiceImpl) AddToFavorites(ctx context.Context, eventID string, u
 		return common.NewBusinessError("event_not_available", "El evento no está disponible")
 	}
 
-	// Agregar a favoritos (implementación simplificada)
-	// En la implementación real, tendrías una tabla many-to-many
-	return nil
+	return s.eventRepo.AddFavorite(ctx, userCtx.ID, eventID)
 }
 
 // RemoveFromFavorites remueve evento de favoritos
 func (s *EventServiceImpl) RemoveFromFavorites(ctx context.Context, eventID string, userCtx *common.UserContext) error {
-	// Implementación de remoción de favoritos
-	return nil
+	if userCtx == nil {
+		return common.ErrUnauthorized
+	}
+
+	return s.eventRepo.RemoveFavorite(ctx, userCtx.ID, eventID)
 }
 
 // validateEventCreation valida reglas de negocio para creación de eventos
@@ -0,0 +1,236 @@
+// internal/services/geocoding_service.go
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cybesphere-backend/internal/models"
+	"cybesphere-backend/internal/repositories"
+	"cybesphere-backend/pkg/geocoding"
+	"cybesphere-backend/pkg/logger"
+)
+
+// geocodingTimeout tiempo máximo para geocodificar una dirección en segundo plano
+const geocodingTimeout = 30 * time.Second
+
+// defaultBackfillBatchSize registros que se leen por lote al rellenar coordenadas
+const defaultBackfillBatchSize = 100
+
+// GeocodingBackfillOptions qué registros se rellenan y en lotes de qué tamaño
+type GeocodingBackfillOptions struct {
+	Events        bool
+	Organizations bool
+	BatchSize     int
+}
+
+// GeocodingBackfillStats recuento del rellenado de un tipo de registro
+type GeocodingBackfillStats struct {
+	Processed int
+	Updated   int
+	NotFound  int
+	Failed    int
+}
+
+// GeocodingBackfillResult recuento del rellenado de coordenadas
+type GeocodingBackfillResult struct {
+	Events        GeocodingBackfillStats
+	Organizations GeocodingBackfillStats
+}
+
+// GeocodingServiceImpl calcula las coordenadas de eventos y organizaciones a
+// partir de su dirección. Sin geocodificador configurado no hace nada
+type GeocodingServiceImpl struct {
+	geocoder  geocoding.Geocoder
+	eventRepo *repositories.EventRepository
+	orgRepo   *repositories.OrganizationRepository
+}
+
+// Verificación en tiempo de compilación
+var _ GeocodingService = (*GeocodingServiceImpl)(nil)
+
+// NewGeocodingService crea el servicio de geocodificación; geocoder puede ser nil
+func NewGeocodingService(
+	geocoder geocoding.Geocoder,
+	eventRepo *repositories.EventRepository,
+	orgRepo *repositories.OrganizationRepository,
+) GeocodingService {
+	return &GeocodingServiceImpl{
+		geocoder:  geocoder,
+		eventRepo: eventRepo,
+		orgRepo:   orgRepo,
+	}
+}
+
+// Enabled indica si hay un geocodificador configurado
+func (s *GeocodingServiceImpl) Enabled() bool {
+	return s.geocoder != nil
+}
+
+// ScheduleEvent geocodifica el evento en segundo plano si le faltan coordenadas
+func (s *GeocodingServiceImpl) ScheduleEvent(event *models.Event) {
+	if !s.Enabled() || !event.NeedsGeocoding() {
+		return
+	}
+
+	// Copia para no compartir el evento con quien lo sigue usando
+	snapshot := *event
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), geocodingTimeout)
+		defer cancel()
+
+		if _, err := s.GeocodeEvent(ctx, &snapshot); err != nil && !errors.Is(err, geocoding.ErrNotFound) {
+			logger.Warnf("Error geocodificando el evento %s: %v", snapshot.ID, err)
+		}
+	}()
+}
+
+// ScheduleOrganization geocodifica la organización en segundo plano si le faltan coordenadas
+func (s *GeocodingServiceImpl) ScheduleOrganization(org *models.Organization) {
+	if !s.Enabled() || !org.NeedsGeocoding() {
+		return
+	}
+
+	snapshot := *org
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), geocodingTimeout)
+		defer cancel()
+
+		if _, err := s.GeocodeOrganization(ctx, &snapshot); err != nil && !errors.Is(err, geocoding.ErrNotFound) {
+			logger.Warnf("Error geocodificando la organización %s: %v", snapshot.ID, err)
+		}
+	}()
+}
+
+// GeocodeEvent calcula y guarda las coordenadas del evento. Indica si se
+// guardaron: no se guardan si mientras tanto cambió la dirección o se fijaron
+// coordenadas manuales
+func (s *GeocodingServiceImpl) GeocodeEvent(ctx context.Context, event *models.Event) (bool, error) {
+	if !s.Enabled() || !event.NeedsGeocoding() {
+		return false, nil
+	}
+
+	point, err := s.geocoder.Geocode(ctx, geocoding.Address{
+		Street:  event.VenueAddress,
+		City:    event.VenueCity,
+		Country: event.VenueCountry,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	updated, err := s.eventRepo.UpdateGeocodedLocation(ctx, event, point)
+	if err != nil {
+		return false, err
+	}
+	if updated {
+		event.SetGeocodedLocation(point.Latitude, point.Longitude)
+	}
+
+	return updated, nil
+}
+
+// GeocodeOrganization calcula y guarda las coordenadas de la organización
+func (s *GeocodingServiceImpl) GeocodeOrganization(ctx context.Context, org *models.Organization) (bool, error) {
+	if !s.Enabled() || !org.NeedsGeocoding() {
+		return false, nil
+	}
+
+	point, err := s.geocoder.Geocode(ctx, geocoding.Address{
+		Street:     org.Address,
+		City:       org.City,
+		PostalCode: org.PostalCode,
+		Country:    org.Country,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	updated, err := s.orgRepo.UpdateGeocodedLocation(ctx, org, point)
+	if err != nil {
+		return false, err
+	}
+	if updated {
+		org.SetGeocodedLocation(point.Latitude, point.Longitude)
+	}
+
+	return updated, nil
+}
+
+// Backfill geocodifica los registros existentes que tienen dirección pero no
+// coordenadas. Recorre cada tabla una vez en orden de ID, así que las
+// direcciones que no se encuentran no se reintentan en la misma ejecución
+func (s *GeocodingServiceImpl) Backfill(ctx context.Context, opts GeocodingBackfillOptions) (*GeocodingBackfillResult, error) {
+	if !s.Enabled() {
+		return nil, errors.New("geocoding is disabled")
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = defaultBackfillBatchSize
+	}
+
+	result := &GeocodingBackfillResult{}
+
+	if opts.Events {
+		err := backfill(ctx, opts.BatchSize, &result.Events,
+			s.eventRepo.GetPendingGeocoding,
+			func(e *models.Event) string { return e.ID.String() },
+			s.GeocodeEvent)
+		if err != nil {
+			return result, err
+		}
+	}
+
+	if opts.Organizations {
+		err := backfill(ctx, opts.BatchSize, &result.Organizations,
+			s.orgRepo.GetPendingGeocoding,
+			func(o *models.Organization) string { return o.ID.String() },
+			s.GeocodeOrganization)
+		if err != nil {
+			return result, err
+		}
+	}
+
+	return result, nil
+}
+
+// backfill recorre por lotes los registros pendientes y los geocodifica uno a uno
+func backfill[T any](
+	ctx context.Context,
+	batchSize int,
+	stats *GeocodingBackfillStats,
+	pending func(ctx context.Context, afterID string, limit int) ([]*T, error),
+	id func(*T) string,
+	geocode func(ctx context.Context, item *T) (bool, error),
+) error {
+	afterID := ""
+	for {
+		items, err := pending(ctx, afterID, batchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range items {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			stats.Processed++
+			updated, err := geocode(ctx, item)
+			switch {
+			case errors.Is(err, geocoding.ErrNotFound):
+				stats.NotFound++
+			case err != nil:
+				stats.Failed++
+				logger.Warnf("Error geocodificando %s: %v", id(item), err)
+			case updated:
+				stats.Updated++
+			}
+		}
+
+		if len(items) < batchSize {
+			return nil
+		}
+		afterID = id(items[len(items)-1])
+	}
+}
@@ -7,6 +7,10 @@ import (
 	"cybesphere-backend/internal/dto"
 	"cybesphere-backend/internal/models"
 	"cybesphere-backend/internal/permissions"
+	"cybesphere-backend/internal/query"
+	"cybesphere-backend/pkg/audit"
+	"io"
+	"time"
 )
 
 // ResponseMapper interfaz para mapeo de responses
@@ -44,9 +48,13 @@ type EventService interface {
 	CreateEvent(ctx context.Context, req dto.CreateEventRequest, userCtx *common.UserContext) (*models.Event, error)
 	PublishEvent(ctx context.Context, id string, userCtx *common.UserContext) (*models.Event, error)
 	CancelEvent(ctx context.Context, id string, userCtx *common.UserContext) (*models.Event, error)
+	SchedulePublication(ctx context.Context, id string, publishAt time.Time, userCtx *common.UserContext) (*models.Event, error)
+	UnschedulePublication(ctx context.Context, id string, userCtx *common.UserContext) (*models.Event, error)
 	GetFeaturedEvents(ctx context.Context, limit int) ([]*models.Event, error)
 	GetUpcomingEvents(ctx context.Context, opts common.QueryOptions, userCtx *common.UserContext) ([]*models.Event, *common.PaginationMeta, error)
+	GetEventCatalog(ctx context.Context, opts common.QueryOptions, userCtx *common.UserContext) ([]*models.Event, *common.PaginationMeta, map[string][]query.FacetCount, error)
 	GetEventsByOrganization(ctx context.Context, orgID string, opts common.QueryOptions, userCtx *common.UserContext) ([]*models.Event, *common.PaginationMeta, error)
+	GetNearbyEvents(ctx context.Context, req dto.NearbyEventsRequest, opts common.QueryOptions, userCtx *common.UserContext) (*NearbyEventsResult, error)
 	AddToFavorites(ctx context.Context, eventID string, userCtx *common.UserContext) error
 	RemoveFromFavorites(ctx context.Context, eventID string, userCtx *common.UserContext) error
 }
@@ -65,6 +73,8 @@ type OrganizationService interface {
 	GetActiveOrganizations(ctx context.Context, opts common.QueryOptions) ([]*models.Organization, *common.PaginationMeta, error)
 	VerifyOrganization(ctx context.Context, id string, userCtx *common.UserContext) (*models.Organization, error)
 	GetMembers(ctx context.Context, orgID string, opts common.QueryOptions, userCtx *common.UserContext) ([]*models.User, *common.PaginationMeta, error)
+	FollowOrganization(ctx context.Context, orgID string, userCtx *common.UserContext) error
+	UnfollowOrganization(ctx context.Context, orgID string, userCtx *common.UserContext) error
 }
 
 // UserService interfaz para servicio de usuarios
@@ -84,3 +94,237 @@ type UserService interface {
 	GetUserSessions(ctx context.Context, userID string, userCtx *common.UserContext) ([]*models.RefreshToken, error)
 	RevokeUserSession(ctx context.Context, sessionID string, userCtx *common.UserContext) error
 }
+
+// RecommendationService interfaz para recomendaciones personalizadas de eventos
+type RecommendationService interface {
+	GetRecommendations(ctx context.Context, opts common.QueryOptions, userCtx *common.UserContext) (*RecommendationsResult, error)
+	Evaluate(ctx context.Context, k int) (*RecommendationEvaluation, error)
+}
+
+// VersionService interfaz para el historial de versiones de eventos y organizaciones
+type VersionService interface {
+	ListVersions(ctx context.Context, resource, resourceID string, opts common.QueryOptions, userCtx *common.UserContext) ([]*models.EntityVersion, *common.PaginationMeta, error)
+	GetVersion(ctx context.Context, resource, resourceID string, version int, userCtx *common.UserContext) (*models.EntityVersion, error)
+	DiffVersions(ctx context.Context, resource, resourceID string, from, to int, userCtx *common.UserContext) (*VersionDiff, error)
+	RestoreVersion(ctx context.Context, resource, resourceID string, version int, userCtx *common.UserContext) (*models.EntityVersion, error)
+}
+
+// AuditService interfaz para la verificación y exportación de la cadena de auditoría
+type AuditService interface {
+	VerifyChain(ctx context.Context) (*AuditChainReport, error)
+	ExportBundle(ctx context.Context, fromSequence, toSequence int64) (*audit.Bundle, error)
+	ApplyRetention(ctx context.Context, before time.Time) (*AuditRetentionReport, error)
+}
+
+// PrivacyService interfaz para la exportación de datos personales y la supresión de cuentas
+type PrivacyService interface {
+	RequestDataExport(ctx context.Context, userCtx *common.UserContext) (*models.DataExport, error)
+	ListDataExports(ctx context.Context, userCtx *common.UserContext) ([]*models.DataExport, error)
+	GetDataExport(ctx context.Context, id string, userCtx *common.UserContext) (*models.DataExport, error)
+	DownloadDataExport(ctx context.Context, id string, userCtx *common.UserContext) (*models.DataExport, error)
+	PurgeExpiredExports(ctx context.Context) (int64, error)
+
+	RequestErasure(ctx context.Context, req dto.AccountErasureRequest, userCtx *common.UserContext) (*models.ErasureRequest, error)
+	GetErasureRequest(ctx context.Context, userCtx *common.UserContext) (*models.ErasureRequest, error)
+	CancelOwnErasure(ctx context.Context, userCtx *common.UserContext) (*models.ErasureRequest, error)
+	ListErasureRequests(ctx context.Context, status models.ErasureStatus, opts common.QueryOptions, userCtx *common.UserContext) ([]*models.ErasureRequest, *common.PaginationMeta, error)
+	ExecuteErasure(ctx context.Context, id string, userCtx *common.UserContext) (*models.ErasureRequest, error)
+	CancelErasure(ctx context.Context, id string, userCtx *common.UserContext) (*models.ErasureRequest, error)
+	ProcessDueErasures(ctx context.Context) (int, error)
+}
+
+// EventLifecycleService ciclo de vida de los eventos: transiciones manuales,
+// publicación programada y transiciones automáticas por fecha
+type EventLifecycleService interface {
+	Transition(ctx context.Context, eventID string, transition models.EventTransition, userCtx *common.UserContext) (*models.Event, error)
+	TransitionTo(ctx context.Context, eventID string, status models.EventStatus, userCtx *common.UserContext) (*models.Event, error)
+	SchedulePublication(ctx context.Context, eventID string, publishAt time.Time, userCtx *common.UserContext) (*models.Event, error)
+	Apply(ctx context.Context, event *models.Event, transition models.EventTransition, actorID string) error
+	Hide(ctx context.Context, event *models.Event, reason, actorID string) error
+	RunDue(ctx context.Context, completionDelay time.Duration) (*EventLifecycleReport, error)
+	Subscribe(handler EventLifecycleHandler)
+}
+
+// ModerationService interfaz para las denuncias de contenido, la cola de
+// moderación y la aprobación de eventos
+type ModerationService interface {
+	ReportContent(ctx context.Context, resourceType models.ReportResourceType, resourceID string, req dto.ReportContentRequest, userCtx *common.UserContext) (*models.ContentReport, error)
+	ListOwnReports(ctx context.Context, opts common.QueryOptions, userCtx *common.UserContext) ([]*models.ContentReport, *common.PaginationMeta, error)
+
+	ListReports(ctx context.Context, query dto.ContentReportsQuery, opts common.QueryOptions, userCtx *common.UserContext) ([]*models.ContentReport, *common.PaginationMeta, error)
+	GetReport(ctx context.Context, id string, userCtx *common.UserContext) (*models.ContentReport, error)
+	StartReview(ctx context.Context, id string, userCtx *common.UserContext) (*models.ContentReport, error)
+	AddNote(ctx context.Context, id string, req dto.ModerationNoteRequest, userCtx *common.UserContext) (*models.ContentReport, error)
+	ResolveReport(ctx context.Context, id string, req dto.ResolveReportRequest, userCtx *common.UserContext) (*models.ContentReport, error)
+	DismissReport(ctx context.Context, id string, req dto.DismissReportRequest, userCtx *common.UserContext) (*models.ContentReport, error)
+	RestoreContent(ctx context.Context, resourceType models.ReportResourceType, resourceID string, userCtx *common.UserContext) error
+
+	ListPendingEvents(ctx context.Context, opts common.QueryOptions, userCtx *common.UserContext) ([]*models.Event, *common.PaginationMeta, error)
+	ApproveEvent(ctx context.Context, eventID string, userCtx *common.UserContext) (*models.Event, error)
+	RejectEvent(ctx context.Context, eventID string, req dto.RejectEventRequest, userCtx *common.UserContext) (*models.Event, error)
+}
+
+// VerificationService interfaz para las solicitudes de verificación de organizaciones
+type VerificationService interface {
+	SubmitVerification(ctx context.Context, organizationID string, req dto.SubmitVerificationRequest, userCtx *common.UserContext) (*models.OrganizationVerification, error)
+	GetVerificationStatus(ctx context.Context, organizationID string, userCtx *common.UserContext) (*models.Organization, []*models.OrganizationVerification, error)
+
+	ListVerifications(ctx context.Context, query dto.VerificationsQuery, opts common.QueryOptions, userCtx *common.UserContext) ([]*models.OrganizationVerification, *common.PaginationMeta, error)
+	GetVerification(ctx context.Context, id string, userCtx *common.UserContext) (*models.OrganizationVerification, []*models.OrganizationVerification, error)
+	StartReview(ctx context.Context, id string, userCtx *common.UserContext) (*models.OrganizationVerification, error)
+	ApproveVerification(ctx context.Context, id string, req dto.ApproveVerificationRequest, userCtx *common.UserContext) (*models.OrganizationVerification, error)
+	RejectVerification(ctx context.Context, id string, req dto.RejectVerificationRequest, userCtx *common.UserContext) (*models.OrganizationVerification, error)
+	RequestInfo(ctx context.Context, id string, req dto.RequestVerificationInfoRequest, userCtx *common.UserContext) (*models.OrganizationVerification, error)
+}
+
+// MediaService interfaz para la subida de ficheros y su asignación a eventos y organizaciones
+type MediaService interface {
+	Upload(ctx context.Context, req dto.UploadMediaRequest, file UploadedFile, userCtx *common.UserContext) (*models.Media, error)
+	ListMedia(ctx context.Context, query dto.MediaQuery, opts common.QueryOptions, userCtx *common.UserContext) ([]*models.Media, *common.PaginationMeta, error)
+	GetMedia(ctx context.Context, id string, userCtx *common.UserContext) (*models.Media, error)
+	OpenContent(ctx context.Context, id string, userCtx *common.UserContext) (*models.Media, io.ReadCloser, error)
+	OpenPublicContent(ctx context.Context, id string) (*models.Media, io.ReadCloser, error)
+	OpenPublicVariant(ctx context.Context, id, name string) (*models.Media, *models.MediaVariant, io.ReadCloser, error)
+	ReprocessMedia(ctx context.Context, id string, userCtx *common.UserContext) (*models.Media, error)
+	DeleteMedia(ctx context.Context, id string, userCtx *common.UserContext) error
+
+	AttachEventMedia(ctx context.Context, eventID, field string, req dto.AttachMediaRequest, userCtx *common.UserContext) (*models.Media, error)
+	AttachOrganizationMedia(ctx context.Context, orgID, field string, req dto.AttachMediaRequest, userCtx *common.UserContext) (*models.Media, error)
+}
+
+// MediaProcessingService interfaz para el procesado de imágenes en segundo plano
+type MediaProcessingService interface {
+	Start(ctx context.Context) error
+	Stop()
+	Enqueue(mediaID string)
+	ProcessPending(ctx context.Context) (int, error)
+}
+
+// JobService interfaz para las tareas programadas de limpieza y conservación de datos
+type JobService interface {
+	Start(ctx context.Context) error
+	Stop()
+	ListJobs(ctx context.Context, userCtx *common.UserContext) ([]*models.ScheduledJob, error)
+	RunJob(ctx context.Context, name string, userCtx *common.UserContext) (*models.ScheduledJob, error)
+}
+
+// CalendarService interfaz para exportación iCalendar y feeds suscribibles
+type CalendarService interface {
+	ExportEvent(ctx context.Context, eventID string, userCtx *common.UserContext) (*models.Event, []byte, error)
+	RenderFeed(ctx context.Context, token string) ([]byte, error)
+	CreateFeed(ctx context.Context, req dto.CreateCalendarFeedRequest, userCtx *common.UserContext) (*models.CalendarFeed, string, error)
+	ListFeeds(ctx context.Context, userCtx *common.UserContext) ([]*models.CalendarFeed, error)
+	RevokeFeed(ctx context.Context, feedID string, userCtx *common.UserContext) error
+}
+
+// EventSeriesService interfaz para series de eventos recurrentes
+type EventSeriesService interface {
+	CreateSeries(ctx context.Context, req dto.CreateEventSeriesRequest, userCtx *common.UserContext) (*models.EventSeries, []*models.Event, error)
+	GetSeries(ctx context.Context, seriesID string, userCtx *common.UserContext) (*models.EventSeries, []*models.Event, error)
+	UpdateOccurrence(ctx context.Context, seriesID, occurrenceID string, req dto.UpdateOccurrenceRequest, userCtx *common.UserContext) (*models.EventSeries, []*models.Event, error)
+	CancelOccurrence(ctx context.Context, seriesID, occurrenceID string, scope models.EditScope, userCtx *common.UserContext) (*models.EventSeries, []*models.Event, error)
+	GenerateUpcoming(ctx context.Context) (int, error)
+}
+
+// AgendaService interfaz para agendas de eventos, ponentes y agendas personales
+type AgendaService interface {
+	ListSpeakers(ctx context.Context, opts common.QueryOptions, userCtx *common.UserContext) ([]*models.Speaker, *common.PaginationMeta, error)
+	GetSpeaker(ctx context.Context, speakerID string, userCtx *common.UserContext) (*models.Speaker, error)
+	CreateSpeaker(ctx context.Context, req dto.CreateSpeakerRequest, userCtx *common.UserContext) (*models.Speaker, error)
+	UpdateSpeaker(ctx context.Context, speakerID string, req dto.UpdateSpeakerRequest, userCtx *common.UserContext) (*models.Speaker, error)
+	DeleteSpeaker(ctx context.Context, speakerID string, userCtx *common.UserContext) error
+
+	GetAgenda(ctx context.Context, eventID string, userCtx *common.UserContext) (*models.Event, []*models.EventSession, map[string]bool, error)
+	CreateSession(ctx context.Context, eventID string, req dto.CreateSessionRequest, userCtx *common.UserContext) (*models.EventSession, error)
+	BuildSession(ctx context.Context, event *models.Event, req dto.CreateSessionRequest) (*models.EventSession, []*models.Speaker, error)
+	UpdateSession(ctx context.Context, eventID, sessionID string, req dto.UpdateSessionRequest, userCtx *common.UserContext) (*models.EventSession, error)
+	DeleteSession(ctx context.Context, eventID, sessionID string, userCtx *common.UserContext) error
+
+	AddSessionFavorite(ctx context.Context, eventID, sessionID string, userCtx *common.UserContext) error
+	RemoveSessionFavorite(ctx context.Context, eventID, sessionID string, userCtx *common.UserContext) error
+	GetSchedule(ctx context.Context, userCtx *common.UserContext) ([]*models.EventSession, error)
+}
+
+// NotificationService interfaz para notificaciones in-app
+type NotificationService interface {
+	Notify(ctx context.Context, userID string, notificationType models.NotificationType, title, message string, data map[string]interface{}) error
+	ListNotifications(ctx context.Context, opts common.QueryOptions, userCtx *common.UserContext) ([]*models.Notification, *common.PaginationMeta, int64, error)
+	MarkAsRead(ctx context.Context, notificationID string, userCtx *common.UserContext) error
+	MarkAllAsRead(ctx context.Context, userCtx *common.UserContext) error
+}
+
+// CFPService interfaz para el call for papers y la revisión de propuestas
+type CFPService interface {
+	SubmitTalk(ctx context.Context, eventID string, req dto.CreateSubmissionRequest, userCtx *common.UserContext) (*models.TalkSubmission, error)
+	ListMySubmissions(ctx context.Context, userCtx *common.UserContext) ([]*models.TalkSubmission, error)
+
+	ListSubmissions(ctx context.Context, eventID string, status models.SubmissionStatus, userCtx *common.UserContext) (*models.Event, []*models.TalkSubmission, error)
+	GetSubmission(ctx context.Context, eventID, submissionID string, userCtx *common.UserContext) (*models.TalkSubmission, error)
+	AssignReviewers(ctx context.Context, eventID, submissionID string, req dto.AssignReviewersRequest, userCtx *common.UserContext) (*models.TalkSubmission, error)
+	DecideSubmission(ctx context.Context, eventID, submissionID string, req dto.SubmissionDecisionRequest, userCtx *common.UserContext) (*models.TalkSubmission, error)
+	ExportReviewSummary(ctx context.Context, eventID string, userCtx *common.UserContext) (*models.Event, []byte, error)
+
+	ListMyReviews(ctx context.Context, userCtx *common.UserContext) ([]*models.SubmissionReview, error)
+	SubmitReview(ctx context.Context, reviewID string, req dto.SubmitReviewRequest, userCtx *common.UserContext) (*models.SubmissionReview, error)
+}
+
+// TicketingService interfaz para tipos de entrada, códigos de descuento e inscripciones
+type TicketingService interface {
+	ListTicketTypes(ctx context.Context, eventID string, userCtx *common.UserContext) (*models.Event, []*models.TicketType, error)
+	CreateTicketType(ctx context.Context, eventID string, req dto.CreateTicketTypeRequest, userCtx *common.UserContext) (*models.TicketType, error)
+	UpdateTicketType(ctx context.Context, eventID, ticketTypeID string, req dto.UpdateTicketTypeRequest, userCtx *common.UserContext) (*models.TicketType, error)
+	DeleteTicketType(ctx context.Context, eventID, ticketTypeID string, userCtx *common.UserContext) error
+
+	ListPromoCodes(ctx context.Context, eventID string, userCtx *common.UserContext) ([]*models.PromoCode, error)
+	CreatePromoCode(ctx context.Context, eventID string, req dto.CreatePromoCodeRequest, userCtx *common.UserContext) (*models.PromoCode, error)
+	UpdatePromoCode(ctx context.Context, eventID, promoCodeID string, req dto.UpdatePromoCodeRequest, userCtx *common.UserContext) (*models.PromoCode, error)
+	DeletePromoCode(ctx context.Context, eventID, promoCodeID string, userCtx *common.UserContext) error
+
+	QuotePrice(ctx context.Context, eventID string, req dto.TicketSelectionRequest, userCtx *common.UserContext) (*TicketSelection, error)
+	Register(ctx context.Context, eventID string, req dto.TicketSelectionRequest, userCtx *common.UserContext) (*models.EventRegistration, error)
+	CancelRegistration(ctx context.Context, eventID string, userCtx *common.UserContext) (*models.EventRegistration, error)
+	ListMyRegistrations(ctx context.Context, opts common.QueryOptions, userCtx *common.UserContext) ([]*models.EventRegistration, *common.PaginationMeta, error)
+	ListRegistrations(ctx context.Context, eventID string, opts common.QueryOptions, userCtx *common.UserContext) (*models.Event, []*models.EventRegistration, *common.PaginationMeta, map[models.RegistrationStatus]int, error)
+}
+
+// PaymentService interfaz para pedidos, pagos y reembolsos
+type PaymentService interface {
+	CreateOrder(ctx context.Context, registration *models.EventRegistration, event *models.Event, billing models.BillingDetails) (*models.Order, error)
+	GetOrder(ctx context.Context, orderID string, userCtx *common.UserContext) (*models.Order, error)
+	ListMyOrders(ctx context.Context, opts common.QueryOptions, userCtx *common.UserContext) ([]*models.Order, *common.PaginationMeta, error)
+
+	HandleWebhook(ctx context.Context, provider string, payload []byte, signature string) error
+	SimulateCheckout(ctx context.Context, paymentID string, succeed bool) error
+
+	CloseOrder(ctx context.Context, order *models.Order, registration *models.EventRegistration, reason string) error
+	RefundEvent(ctx context.Context, eventID string) (int, error)
+	RetryPendingRefunds(ctx context.Context) (RefundRetryReport, error)
+}
+
+// InvoiceService interfaz para facturas y facturas rectificativas
+type InvoiceService interface {
+	IssueInvoice(ctx context.Context, orderID string) (*models.Invoice, error)
+	IssueCreditNote(ctx context.Context, orderID, reason string) (*models.Invoice, error)
+
+	ListOrderInvoices(ctx context.Context, orderID string, userCtx *common.UserContext) ([]*models.Invoice, error)
+	ListOrganizationInvoices(ctx context.Context, organizationID string, opts common.QueryOptions, userCtx *common.UserContext) ([]*models.Invoice, *common.PaginationMeta, error)
+	GetInvoice(ctx context.Context, invoiceID string, userCtx *common.UserContext) (*models.Invoice, error)
+	RenderInvoicePDF(ctx context.Context, invoiceID string, userCtx *common.UserContext) (*models.Invoice, []byte, error)
+}
+
+// SearchService interfaz para la búsqueda full-text de eventos, organizaciones y tags
+type SearchService interface {
+	Search(ctx context.Context, req dto.SearchRequest) (*SearchResult, error)
+}
+
+// GeocodingService interfaz para calcular coordenadas a partir de direcciones
+type GeocodingService interface {
+	Enabled() bool
+
+	ScheduleEvent(event *models.Event)
+	ScheduleOrganization(org *models.Organization)
+
+	GeocodeEvent(ctx context.Context, event *models.Event) (bool, error)
+	GeocodeOrganization(ctx context.Context, org *models.Organization) (bool, error)
+	Backfill(ctx context.Context, opts GeocodingBackfillOptions) (*GeocodingBackfillResult, error)
+}
@@ -0,0 +1,356 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/models"
+	"cybesphere-backend/internal/repositories"
+	"cybesphere-backend/pkg/logger"
+	"cybesphere-backend/pkg/pdf"
+)
+
+// InvoiceServiceImpl implementación del servicio de facturación
+type InvoiceServiceImpl struct {
+	invoiceRepo *repositories.InvoiceRepository
+	orderRepo   *repositories.OrderRepository
+	eventRepo   *repositories.EventRepository
+	userRepo    *repositories.UserRepository
+}
+
+// Verificación en tiempo de compilación de que InvoiceServiceImpl implementa InvoiceService
+var _ InvoiceService = (*InvoiceServiceImpl)(nil)
+
+// NewInvoiceService crea una nueva instancia del servicio de facturación
+func NewInvoiceService(
+	invoiceRepo *repositories.InvoiceRepository,
+	orderRepo *repositories.OrderRepository,
+	eventRepo *repositories.EventRepository,
+	userRepo *repositories.UserRepository,
+) InvoiceService {
+	return &InvoiceServiceImpl{
+		invoiceRepo: invoiceRepo,
+		orderRepo:   orderRepo,
+		eventRepo:   eventRepo,
+		userRepo:    userRepo,
+	}
+}
+
+// IssueInvoice emite la factura de un pedido pagado (o reembolsado después de
+// pagarse). Es idempotente: si ya existe, la devuelve.
+func (s *InvoiceServiceImpl) IssueInvoice(ctx context.Context, orderID string) (*models.Invoice, error) {
+	order, err := s.orderRepo.GetWithRegistration(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if order.PaidAt == nil {
+		return nil, common.NewBusinessError("order_not_paid", "El pedido no está pagado")
+	}
+
+	if existing, err := s.invoiceRepo.GetByOrderAndKind(ctx, orderID, models.InvoiceKindInvoice); err == nil {
+		return existing, nil
+	} else if !errors.Is(err, common.ErrNotFound) {
+		return nil, err
+	}
+
+	event, err := s.eventRepo.GetWithPreloads(ctx, order.EventID, []string{"Organization"})
+	if err != nil {
+		return nil, err
+	}
+	if event.Organization == nil || !event.Organization.CanIssueInvoices() {
+		return nil, common.NewBusinessError("invoicing_not_configured",
+			"La organización no tiene configurados su razón social y NIF")
+	}
+
+	org := event.Organization
+	base, vat := models.SplitVAT(order.AmountCents, org.InvoiceVATRate())
+
+	invoice := &models.Invoice{
+		OrganizationID: org.ID.String(),
+		EventID:        order.EventID,
+		OrderID:        orderID,
+		UserID:         order.UserID,
+		Kind:           models.InvoiceKindInvoice,
+		IssuedAt:       time.Now(),
+		IssuerName:     org.LegalName,
+		IssuerTaxID:    org.TaxID,
+		IssuerAddress:  org.FiscalAddress(),
+		Customer:       order.Billing,
+		Description:    invoiceDescription(event, order.Registration),
+		BaseCents:      base,
+		VATRate:        org.InvoiceVATRate(),
+		VATCents:       vat,
+		TotalCents:     order.AmountCents,
+		Currency:       order.Currency,
+	}
+
+	if user, err := s.userRepo.GetByID(ctx, order.UserID); err == nil {
+		invoice.CustomerEmail = user.Email
+		if invoice.Customer.Name == "" {
+			invoice.Customer.Name = user.GetFullName()
+		}
+	}
+
+	return s.issue(ctx, invoice, models.InvoiceSeriesInvoice)
+}
+
+// IssueCreditNote emite la factura rectificativa de un pedido reembolsado.
+// Si el pedido no llegó a facturarse no hay nada que rectificar.
+func (s *InvoiceServiceImpl) IssueCreditNote(ctx context.Context, orderID, reason string) (*models.Invoice, error) {
+	original, err := s.invoiceRepo.GetByOrderAndKind(ctx, orderID, models.InvoiceKindInvoice)
+	if err != nil {
+		if errors.Is(err, common.ErrNotFound) {
+			return nil, common.NewBusinessError("invoice_not_issued", "El pedido no tiene factura que rectificar")
+		}
+		return nil, err
+	}
+
+	if existing, err := s.invoiceRepo.GetByOrderAndKind(ctx, orderID, models.InvoiceKindCreditNote); err == nil {
+		return existing, nil
+	} else if !errors.Is(err, common.ErrNotFound) {
+		return nil, err
+	}
+
+	if reason == "" {
+		reason = "Reembolso del pedido"
+	}
+
+	credit := original.NewCreditNote(reason, time.Now())
+	return s.issue(ctx, credit, models.InvoiceSeriesCreditNote)
+}
+
+// ListOrderInvoices lista las facturas de un pedido del usuario. Las que no se
+// pudieron emitir al pagar o reembolsar (p. ej. porque la organización no
+// tenía datos fiscales) se emiten en este momento.
+func (s *InvoiceServiceImpl) ListOrderInvoices(ctx context.Context, orderID string, userCtx *common.UserContext) ([]*models.Invoice, error) {
+	if userCtx == nil {
+		return nil, common.ErrUnauthorized
+	}
+
+	order, err := s.orderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if order.UserID != userCtx.ID && !userCtx.IsAdmin() {
+		return nil, common.ErrNotFound
+	}
+
+	s.ensureInvoices(ctx, order)
+	return s.invoiceRepo.GetByOrder(ctx, orderID)
+}
+
+// ListOrganizationInvoices lista las facturas emitidas por una organización
+func (s *InvoiceServiceImpl) ListOrganizationInvoices(ctx context.Context, organizationID string, opts common.QueryOptions, userCtx *common.UserContext) ([]*models.Invoice, *common.PaginationMeta, error) {
+	if userCtx == nil {
+		return nil, nil, common.ErrUnauthorized
+	}
+	if !userCtx.CanManageOrganization(organizationID) {
+		return nil, nil, common.ErrForbidden
+	}
+
+	return s.invoiceRepo.GetByOrganization(ctx, organizationID, opts)
+}
+
+// GetInvoice obtiene una factura; solo la ven el comprador y la organización emisora
+func (s *InvoiceServiceImpl) GetInvoice(ctx context.Context, invoiceID string, userCtx *common.UserContext) (*models.Invoice, error) {
+	if userCtx == nil {
+		return nil, common.ErrUnauthorized
+	}
+
+	invoice, err := s.invoiceRepo.GetByID(ctx, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if invoice.UserID != userCtx.ID && !userCtx.CanManageOrganization(invoice.OrganizationID) {
+		return nil, common.ErrNotFound
+	}
+
+	if invoice.RectifiesID != nil {
+		if original, err := s.invoiceRepo.GetByID(ctx, *invoice.RectifiesID); err == nil {
+			invoice.Rectifies = original
+		}
+	}
+
+	return invoice, nil
+}
+
+// RenderInvoicePDF genera el PDF de una factura
+func (s *InvoiceServiceImpl) RenderInvoicePDF(ctx context.Context, invoiceID string, userCtx *common.UserContext) (*models.Invoice, []byte, error) {
+	invoice, err := s.GetInvoice(ctx, invoiceID, userCtx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return invoice, renderInvoicePDF(invoice), nil
+}
+
+// issue numera y guarda una factura; si otra petición la emitió a la vez devuelve esa
+func (s *InvoiceServiceImpl) issue(ctx context.Context, invoice *models.Invoice, series string) (*models.Invoice, error) {
+	err := s.invoiceRepo.Issue(ctx, invoice, series)
+	if err == nil {
+		logger.Infof("Emitida la factura %s del pedido %s", invoice.Number, invoice.OrderID)
+		return invoice, nil
+	}
+
+	if existing, getErr := s.invoiceRepo.GetByOrderAndKind(ctx, invoice.OrderID, invoice.Kind); getErr == nil {
+		return existing, nil
+	}
+	return nil, err
+}
+
+// ensureInvoices emite las facturas pendientes de un pedido
+func (s *InvoiceServiceImpl) ensureInvoices(ctx context.Context, order *models.Order) {
+	if order.PaidAt == nil {
+		return
+	}
+
+	if _, err := s.IssueInvoice(ctx, order.ID.String()); err != nil {
+		logger.Warnf("No se pudo emitir la factura del pedido %s: %v", order.ID, err)
+		return
+	}
+
+	if order.Status == models.OrderStatusRefunded {
+		if _, err := s.IssueCreditNote(ctx, order.ID.String(), ""); err != nil {
+			logger.Warnf("No se pudo emitir la rectificativa del pedido %s: %v", order.ID, err)
+		}
+	}
+}
+
+// invoiceDescription concepto facturado
+func invoiceDescription(event *models.Event, registration *models.EventRegistration) string {
+	if registration != nil && registration.TicketType != nil {
+		return fmt.Sprintf("Entrada %s - %s", registration.TicketType.Name, event.Title)
+	}
+	return "Inscripción - " + event.Title
+}
+
+// =============================================================================
+// PDF
+// =============================================================================
+
+// renderInvoicePDF compone el PDF de una factura en una página A4
+func renderInvoicePDF(invoice *models.Invoice) []byte {
+	doc := pdf.New()
+	doc.Title = "Factura " + invoice.Number
+	doc.Author = invoice.IssuerName
+	page := doc.AddPage()
+
+	const (
+		left  = 50.0
+		right = pdf.PageWidth - 50
+	)
+	y := pdf.PageHeight - 60
+
+	title := "FACTURA"
+	if invoice.IsCreditNote() {
+		title = "FACTURA RECTIFICATIVA"
+	}
+	page.Text(left, y, pdf.Bold, 18, title)
+	page.TextRight(right, y, 11, invoice.Number)
+	y -= 18
+	page.Text(left, y, pdf.Regular, 10, "Fecha de expedición: "+invoice.IssuedAt.Format("02/01/2006"))
+
+	// Emisor y cliente
+	y -= 40
+	page.Text(left, y, pdf.Bold, 10, "Emisor")
+	page.Text(left+260, y, pdf.Bold, 10, "Cliente")
+	issuer := []string{invoice.IssuerName, "NIF: " + invoice.IssuerTaxID, invoice.IssuerAddress}
+	customer := []string{invoice.Customer.Name}
+	if invoice.Customer.TaxID != "" {
+		customer = append(customer, "NIF: "+invoice.Customer.TaxID)
+	}
+	customer = append(customer, invoice.Customer.Address, invoice.CustomerEmail)
+
+	lineY := y
+	for _, line := range issuer {
+		if line != "" {
+			lineY -= 14
+			page.Text(left, lineY, pdf.Regular, 9, truncate(line, 55))
+		}
+	}
+	customerY := y
+	for _, line := range customer {
+		if line != "" {
+			customerY -= 14
+			page.Text(left+260, customerY, pdf.Regular, 9, truncate(line, 55))
+		}
+	}
+	y = min(lineY, customerY)
+
+	if invoice.IsCreditNote() {
+		y -= 28
+		rectified := "Rectifica la factura emitida para el mismo pedido"
+		if invoice.Rectifies != nil {
+			rectified = fmt.Sprintf("Rectifica la factura %s de %s", invoice.Rectifies.Number,
+				invoice.Rectifies.IssuedAt.Format("02/01/2006"))
+		}
+		page.Text(left, y, pdf.Regular, 9, rectified)
+		y -= 14
+		page.Text(left, y, pdf.Regular, 9, "Motivo: "+truncate(invoice.RectificationReason, 90))
+	}
+
+	// Detalle
+	y -= 36
+	page.Text(left, y, pdf.Bold, 9, "Concepto")
+	page.TextRight(right-200, y, 9, "Base")
+	page.TextRight(right-130, y, 9, "IVA")
+	page.TextRight(right-70, y, 9, "Cuota")
+	page.TextRight(right, y, 9, "Total")
+	y -= 6
+	page.Line(left, y, right, y, 0.5)
+	y -= 16
+	page.Text(left, y, pdf.Regular, 9, truncate(invoice.Description, 50))
+	page.TextRight(right-200, y, 9, formatAmount(invoice.BaseCents, ""))
+	page.TextRight(right-130, y, 9, formatRate(invoice.VATRate))
+	page.TextRight(right-70, y, 9, formatAmount(invoice.VATCents, ""))
+	page.TextRight(right, y, 9, formatAmount(invoice.TotalCents, ""))
+	y -= 10
+	page.Line(left, y, right, y, 0.5)
+
+	// Totales
+	totals := [][2]string{
+		{"Base imponible", formatAmount(invoice.BaseCents, invoice.Currency)},
+		{"IVA " + formatRate(invoice.VATRate), formatAmount(invoice.VATCents, invoice.Currency)},
+		{"Total", formatAmount(invoice.TotalCents, invoice.Currency)},
+	}
+	y -= 10
+	for i, total := range totals {
+		y -= 16
+		font := pdf.Regular
+		if i == len(totals)-1 {
+			font = pdf.Bold
+		}
+		page.Text(right-220, y, font, 10, total[0])
+		page.TextRight(right, y, 10, total[1])
+	}
+
+	if invoice.VATRate == 0 {
+		y -= 30
+		page.Text(left, y, pdf.Regular, 8, "Operación exenta de IVA")
+	}
+
+	page.Text(left, 40, pdf.Regular, 7, "Importes con IVA incluido, expresados en "+invoice.Currency+".")
+
+	return doc.Bytes()
+}
+
+// formatRate formatea un tipo en puntos básicos (2100 -> "21%", 1050 -> "10,5%")
+func formatRate(basisPoints int) string {
+	rate := strings.TrimRight(fmt.Sprintf("%d,%02d", basisPoints/100, basisPoints%100), "0")
+	return strings.TrimSuffix(rate, ",") + "%"
+}
+
+// truncate recorta un texto para que quepa en su columna
+func truncate(text string, maxRunes int) string {
+	runes := []rune(text)
+	if len(runes) <= maxRunes {
+		return text
+	}
+	return string(runes[:maxRunes-3]) + "..."
+}
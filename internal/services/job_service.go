@@ -0,0 +1,271 @@
+// internal/services/job_service.go
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/models"
+	"cybesphere-backend/internal/repositories"
+	"cybesphere-backend/pkg/logger"
+	"cybesphere-backend/pkg/scheduler"
+)
+
+// Tareas programadas incluidas. Las horas son las del servidor
+const (
+	JobTokenCleanup      = "token_cleanup"
+	JobEventLifecycle    = "event_lifecycle"
+	JobAuditRetention    = "audit_log_retention"
+	JobSoftDeletePurge   = "soft_delete_purge"
+	JobPrivacyErasures   = "privacy_erasures"
+	JobPrivacyExports    = "privacy_exports"
+	JobMediaProcessing   = "media_processing"
+	JobSeriesOccurrences = "series_occurrences"
+	JobOrderRefunds      = "order_refunds"
+)
+
+// softDeletePurgeLimit filas por tabla que se intentan borrar una a una
+// cuando alguna sigue referenciada
+const softDeletePurgeLimit = 1000
+
+// JobSettings plazos de las tareas de limpieza
+type JobSettings struct {
+	TokenRetention       time.Duration // Conservación de los refresh tokens revocados
+	AuditLogRetention    time.Duration // Conservación de la auditoría (0 = indefinida)
+	SoftDeleteRetention  time.Duration // Plazo hasta borrar definitivamente lo eliminado
+	EventCompletionDelay time.Duration // Margen tras el fin de un evento para completarlo
+}
+
+// JobServiceImpl planificador de las tareas de limpieza y conservación de datos
+type JobServiceImpl struct {
+	scheduler       *scheduler.Scheduler
+	jobRepo         *repositories.ScheduledJobRepository
+	tokenRepo       *repositories.RefreshTokenRepository
+	lifecycle       EventLifecycleService
+	eventSeries     EventSeriesService
+	payments        PaymentService
+	maintenanceRepo *repositories.MaintenanceRepository
+	auditService    AuditService
+	privacyService  PrivacyService
+	mediaProcessing MediaProcessingService
+	settings        JobSettings
+}
+
+// Verificación en tiempo de compilación
+var _ JobService = (*JobServiceImpl)(nil)
+
+// NewJobService crea el servicio con las tareas incluidas
+func NewJobService(
+	jobRepo *repositories.ScheduledJobRepository,
+	tokenRepo *repositories.RefreshTokenRepository,
+	lifecycle EventLifecycleService,
+	eventSeries EventSeriesService,
+	payments PaymentService,
+	maintenanceRepo *repositories.MaintenanceRepository,
+	auditService AuditService,
+	privacyService PrivacyService,
+	mediaProcessing MediaProcessingService,
+	settings JobSettings,
+) JobService {
+	s := &JobServiceImpl{
+		jobRepo:         jobRepo,
+		tokenRepo:       tokenRepo,
+		lifecycle:       lifecycle,
+		eventSeries:     eventSeries,
+		payments:        payments,
+		maintenanceRepo: maintenanceRepo,
+		auditService:    auditService,
+		privacyService:  privacyService,
+		mediaProcessing: mediaProcessing,
+		settings:        settings,
+	}
+	s.scheduler = scheduler.New(scheduler.Config{
+		Locker:   jobRepo,
+		Recorder: jobRepo,
+		Logf:     logger.Errorf,
+	})
+
+	jobs := []scheduler.Job{
+		{Name: JobTokenCleanup, Spec: "15 * * * *", Run: s.cleanupTokens},
+		{Name: JobEventLifecycle, Spec: "* * * * *", Run: s.runEventLifecycle},
+		{Name: JobSeriesOccurrences, Spec: "0 2 * * *", Timeout: time.Hour, Run: s.generateSeriesOccurrences},
+		{Name: JobOrderRefunds, Spec: "*/15 * * * *", Timeout: 30 * time.Minute, Run: s.retryPendingRefunds},
+		{Name: JobPrivacyExports, Spec: "*/10 * * * *", Run: s.purgeExpiredExports},
+		{Name: JobPrivacyErasures, Spec: "0 * * * *", Run: s.processDueErasures},
+		{Name: JobMediaProcessing, Spec: "*/5 * * * *", Timeout: 30 * time.Minute, Run: s.processPendingMedia},
+		{Name: JobAuditRetention, Spec: "30 3 * * *", Timeout: 2 * time.Hour, Run: s.applyAuditRetention},
+		{Name: JobSoftDeletePurge, Spec: "0 4 * * *", Timeout: time.Hour, Run: s.purgeSoftDeleted},
+	}
+	for _, job := range jobs {
+		if err := s.scheduler.Add(job); err != nil {
+			logger.Errorf("Error registrando la tarea %s: %v", job.Name, err)
+		}
+	}
+	return s
+}
+
+// Start registra las tareas y las ejecuta en segundo plano
+func (s *JobServiceImpl) Start(ctx context.Context) error {
+	return s.scheduler.Start(ctx)
+}
+
+// Stop detiene el planificador y espera a las tareas en curso
+func (s *JobServiceImpl) Stop() {
+	s.scheduler.Stop()
+}
+
+// ListJobs tareas con su planificación y su última ejecución (solo admin)
+func (s *JobServiceImpl) ListJobs(ctx context.Context, userCtx *common.UserContext) ([]*models.ScheduledJob, error) {
+	if !userCtx.IsAdmin() {
+		return nil, common.ErrForbidden
+	}
+	return s.jobRepo.GetAll(ctx)
+}
+
+// RunJob ejecuta una tarea en el momento y espera a que termine (solo admin)
+func (s *JobServiceImpl) RunJob(ctx context.Context, name string, userCtx *common.UserContext) (*models.ScheduledJob, error) {
+	if !userCtx.IsAdmin() {
+		return nil, common.ErrForbidden
+	}
+
+	_, err := s.scheduler.RunNow(ctx, name)
+	switch {
+	case errors.Is(err, scheduler.ErrUnknownJob):
+		return nil, common.ErrNotFound
+	case errors.Is(err, scheduler.ErrJobRunning):
+		return nil, common.NewBusinessError("job_running", "La tarea ya se está ejecutando")
+	case err != nil:
+		return nil, err
+	}
+
+	logger.Infof("Tarea %s ejecutada manualmente por %s", name, userCtx.ID)
+	return s.jobRepo.GetByName(ctx, name)
+}
+
+// cleanupTokens elimina los refresh tokens caducados y los revocados antiguos
+func (s *JobServiceImpl) cleanupTokens(ctx context.Context) (string, error) {
+	expired, err := s.tokenRepo.DeleteExpired(ctx)
+	if err != nil {
+		return "", err
+	}
+	revoked, err := s.tokenRepo.CleanupOldTokens(ctx, s.settings.TokenRetention)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%d caducados, %d revocados", expired, revoked), nil
+}
+
+// runEventLifecycle aplica las transiciones de los eventos que han vencido
+func (s *JobServiceImpl) runEventLifecycle(ctx context.Context) (string, error) {
+	report, err := s.lifecycle.RunDue(ctx, s.settings.EventCompletionDelay)
+	if err != nil {
+		return "", err
+	}
+
+	summary := fmt.Sprintf("%d publicados, %d inscripciones cerradas, %d completados",
+		report.Published, report.RegistrationsClosed, report.Completed)
+	if report.Failed > 0 {
+		return summary, fmt.Errorf("%d transiciones fallidas", report.Failed)
+	}
+	return summary, nil
+}
+
+// generateSeriesOccurrences materializa las ocurrencias de las series activas
+// que entran en el horizonte de generación, de modo que las series sin fin
+// siguen generando eventos
+func (s *JobServiceImpl) generateSeriesOccurrences(ctx context.Context) (string, error) {
+	created, err := s.eventSeries.GenerateUpcoming(ctx)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%d ocurrencias creadas", created), nil
+}
+
+// retryPendingRefunds reintenta los reembolsos pendientes de los eventos cancelados
+func (s *JobServiceImpl) retryPendingRefunds(ctx context.Context) (string, error) {
+	report, err := s.payments.RetryPendingRefunds(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	summary := fmt.Sprintf("%d pedidos cerrados", report.Closed)
+	if report.Failed > 0 {
+		return summary, fmt.Errorf("%d reembolsos fallidos", report.Failed)
+	}
+	return summary, nil
+}
+
+// purgeExpiredExports borra los archivos de exportación caducados
+func (s *JobServiceImpl) purgeExpiredExports(ctx context.Context) (string, error) {
+	purged, err := s.privacyService.PurgeExpiredExports(ctx)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%d archivos caducados borrados", purged), nil
+}
+
+// processDueErasures anonimiza las cuentas con el plazo de gracia vencido
+func (s *JobServiceImpl) processDueErasures(ctx context.Context) (string, error) {
+	processed, err := s.privacyService.ProcessDueErasures(ctx)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%d cuentas suprimidas", processed), nil
+}
+
+// processPendingMedia procesa las imágenes que los workers no han recogido
+func (s *JobServiceImpl) processPendingMedia(ctx context.Context) (string, error) {
+	processed, err := s.mediaProcessing.ProcessPending(ctx)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%d imágenes procesadas", processed), nil
+}
+
+// applyAuditRetention borra la auditoría más antigua que la retención
+func (s *JobServiceImpl) applyAuditRetention(ctx context.Context) (string, error) {
+	if s.settings.AuditLogRetention == 0 {
+		return "Retención desactivada", nil
+	}
+
+	report, err := s.auditService.ApplyRetention(ctx, time.Now().Add(-s.settings.AuditLogRetention))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%d registros encadenados y %d sin encadenar borrados; punto de retención %d",
+		report.Pruned, report.Unchained, report.PrunedThrough), nil
+}
+
+// purgeSoftDeleted borra definitivamente lo eliminado hace más de la retención.
+// Una tabla con error no detiene las demás
+func (s *JobServiceImpl) purgeSoftDeleted(ctx context.Context) (string, error) {
+	before := time.Now().Add(-s.settings.SoftDeleteRetention)
+
+	var summary, failed []string
+	for _, table := range repositories.PurgeableTables {
+		result, err := s.maintenanceRepo.PurgeSoftDeleted(ctx, table, before, softDeletePurgeLimit)
+		if err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %v", table, err))
+			continue
+		}
+		if result.Deleted > 0 || result.Kept > 0 {
+			line := fmt.Sprintf("%s %d", table, result.Deleted)
+			if result.Kept > 0 {
+				line += fmt.Sprintf(" (%d referenciadas)", result.Kept)
+			}
+			summary = append(summary, line)
+		}
+	}
+
+	if len(summary) == 0 {
+		summary = append(summary, "Nada que borrar")
+	}
+	if len(failed) > 0 {
+		return strings.Join(summary, ", "), errors.New(strings.Join(failed, "; "))
+	}
+	return strings.Join(summary, ", "), nil
+}
@@ -2,17 +2,42 @@
 package services
 
 import (
+	"crypto/ed25519"
+
 	"cybesphere-backend/internal/models"
 	"cybesphere-backend/internal/repositories"
+	"cybesphere-backend/pkg/geo"
+	"cybesphere-backend/pkg/geocoding"
+	"cybesphere-backend/pkg/payments"
 )
 
 // ServiceManager centraliza todos los servicios
 type ServiceManager struct {
-	Events        EventService
-	Organizations OrganizationService
-	Users         UserService
-	mapper        ResponseMapper
-	auth          AuthorizationService
+	Events          EventService
+	Organizations   OrganizationService
+	Users           UserService
+	Calendars       CalendarService
+	EventSeries     EventSeriesService
+	Agenda          AgendaService
+	Notifications   NotificationService
+	CFP             CFPService
+	Ticketing       TicketingService
+	Payments        PaymentService
+	Invoices        InvoiceService
+	Search          SearchService
+	Geocoding       GeocodingService
+	Recommendations RecommendationService
+	Audit           AuditService
+	Versions        VersionService
+	Privacy         PrivacyService
+	Jobs            JobService
+	EventLifecycle  EventLifecycleService
+	Moderation      ModerationService
+	Verification    VerificationService
+	Media           MediaService
+	MediaProcessing MediaProcessingService
+	mapper          ResponseMapper
+	auth            AuthorizationService
 }
 
 // NewServiceManager crea nueva instancia del manager con interfaces
@@ -20,7 +45,66 @@ func NewServiceManager(
 	repoManager *repositories.RepositoryManager,
 	mapper ResponseMapper,
 	auth AuthorizationService,
+	paymentProvider payments.Provider,
+	geocoder geocoding.Geocoder,
+	nearbyLimits geo.RadiusLimits,
+	auditSigningKey ed25519.PrivateKey,
+	privacyRetention PrivacyRetention,
+	jobSettings JobSettings,
+	moderationSettings ModerationSettings,
+	mediaSettings MediaSettings,
 ) *ServiceManager {
+	agenda := NewAgendaService(
+		repoManager.Sessions,
+		repoManager.Speakers,
+		repoManager.Events,
+		repoManager.Users,
+	)
+	notifications := NewNotificationService(repoManager.Notifications)
+	invoices := NewInvoiceService(
+		repoManager.Invoices,
+		repoManager.Orders,
+		repoManager.Events,
+		repoManager.Users,
+	)
+	paymentService := NewPaymentService(
+		repoManager.Orders,
+		repoManager.Registrations,
+		repoManager.Users,
+		paymentProvider,
+		notifications,
+		invoices,
+	)
+	geocodingService := NewGeocodingService(
+		geocoder,
+		repoManager.Events,
+		repoManager.Organizations,
+	)
+	lifecycle := NewEventLifecycleService(repoManager.Events, auth)
+	lifecycle.Subscribe(refundCanceledEvents(paymentService))
+	lifecycle.Subscribe(notifyCanceledEvents(repoManager.Registrations, notifications))
+	eventSeries := NewEventSeriesService(
+		repoManager.EventSeries,
+		repoManager.Events,
+		repoManager.Organizations,
+		mapper,
+		lifecycle,
+	)
+	auditService := NewAuditService(repoManager.AuditLogs, auditSigningKey)
+	privacyService := NewPrivacyService(
+		repoManager.DataExports,
+		repoManager.Erasures,
+		repoManager.Users,
+		repoManager.RefreshTokens,
+		repoManager.Events,
+		repoManager.Organizations,
+		repoManager.Registrations,
+		repoManager.AuditLogs,
+		paymentService,
+		privacyRetention,
+	)
+	mediaProcessing := NewMediaProcessingService(repoManager.Media, mediaSettings.Storage, mediaSettings.Processing)
+
 	// Los constructores ahora devuelven interfaces directamente
 	return &ServiceManager{
 		Events: NewEventService(
@@ -29,12 +113,17 @@ func NewServiceManager(
 			repoManager.Users,
 			mapper,
 			auth,
+			lifecycle,
+			geocodingService,
+			nearbyLimits,
+			moderationSettings.FirstEventApproval,
 		),
 		Organizations: NewOrganizationService(
 			repoManager.Organizations,
 			repoManager.Users,
 			mapper,
 			auth,
+			geocodingService,
 		),
 		Users: NewUserService(
 			repoManager.Users,
@@ -42,6 +131,84 @@ func NewServiceManager(
 			mapper,
 			auth,
 		),
+		Calendars: NewCalendarService(
+			repoManager.Events,
+			repoManager.Organizations,
+			repoManager.CalendarFeeds,
+		),
+		EventSeries:   eventSeries,
+		Agenda:        agenda,
+		Notifications: notifications,
+		CFP: NewCFPService(
+			repoManager.Submissions,
+			repoManager.Reviews,
+			repoManager.Events,
+			repoManager.Users,
+			repoManager.Speakers,
+			agenda,
+			notifications,
+		),
+		Ticketing: NewTicketingService(
+			repoManager.TicketTypes,
+			repoManager.PromoCodes,
+			repoManager.Registrations,
+			repoManager.Events,
+			paymentService,
+		),
+		Payments:  paymentService,
+		Invoices:  invoices,
+		Search:    NewSearchService(repoManager.Search),
+		Geocoding: geocodingService,
+		Recommendations: NewRecommendationService(
+			repoManager.Events,
+			repoManager.Organizations,
+			repoManager.Users,
+		),
+		Audit: auditService,
+		Versions: NewVersionService(
+			repoManager.Versions,
+			repoManager.Events,
+			repoManager.Organizations,
+			auth,
+			geocodingService,
+		),
+		Privacy:        privacyService,
+		EventLifecycle: lifecycle,
+		Moderation: NewModerationService(
+			repoManager.Reports,
+			repoManager.Events,
+			repoManager.Organizations,
+			repoManager.Users,
+			lifecycle,
+			notifications,
+		),
+		Verification: NewVerificationService(
+			repoManager.Verifications,
+			repoManager.Organizations,
+			repoManager.Users,
+			repoManager.Media,
+			notifications,
+		),
+		Media: NewMediaService(
+			repoManager.Media,
+			repoManager.Events,
+			repoManager.Organizations,
+			mediaProcessing,
+			mediaSettings,
+		),
+		MediaProcessing: mediaProcessing,
+		Jobs: NewJobService(
+			repoManager.Jobs,
+			repoManager.RefreshTokens,
+			lifecycle,
+			eventSeries,
+			paymentService,
+			repoManager.Maintenance,
+			auditService,
+			privacyService,
+			mediaProcessing,
+			jobSettings,
+		),
 		mapper: mapper,
 		auth:   auth,
 	}
@@ -62,6 +229,106 @@ func (sm *ServiceManager) GetUserService() UserService {
 	return sm.Users
 }
 
+// GetCalendarService retorna el servicio de calendarios
+func (sm *ServiceManager) GetCalendarService() CalendarService {
+	return sm.Calendars
+}
+
+// GetEventSeriesService retorna el servicio de series de eventos
+func (sm *ServiceManager) GetEventSeriesService() EventSeriesService {
+	return sm.EventSeries
+}
+
+// GetAgendaService retorna el servicio de agenda
+func (sm *ServiceManager) GetAgendaService() AgendaService {
+	return sm.Agenda
+}
+
+// GetNotificationService retorna el servicio de notificaciones
+func (sm *ServiceManager) GetNotificationService() NotificationService {
+	return sm.Notifications
+}
+
+// GetCFPService retorna el servicio de call for papers
+func (sm *ServiceManager) GetCFPService() CFPService {
+	return sm.CFP
+}
+
+// GetTicketingService retorna el servicio de entradas e inscripciones
+func (sm *ServiceManager) GetTicketingService() TicketingService {
+	return sm.Ticketing
+}
+
+// GetPaymentService retorna el servicio de pagos
+func (sm *ServiceManager) GetPaymentService() PaymentService {
+	return sm.Payments
+}
+
+// GetInvoiceService retorna el servicio de facturación
+func (sm *ServiceManager) GetInvoiceService() InvoiceService {
+	return sm.Invoices
+}
+
+// GetSearchService retorna el servicio de búsqueda
+func (sm *ServiceManager) GetSearchService() SearchService {
+	return sm.Search
+}
+
+// GetGeocodingService retorna el servicio de geocodificación
+func (sm *ServiceManager) GetGeocodingService() GeocodingService {
+	return sm.Geocoding
+}
+
+// GetRecommendationService retorna el servicio de recomendaciones
+func (sm *ServiceManager) GetRecommendationService() RecommendationService {
+	return sm.Recommendations
+}
+
+// GetAuditService retorna el servicio de la cadena de auditoría
+func (sm *ServiceManager) GetAuditService() AuditService {
+	return sm.Audit
+}
+
+// GetVersionService retorna el servicio de historial de versiones
+func (sm *ServiceManager) GetVersionService() VersionService {
+	return sm.Versions
+}
+
+// GetPrivacyService retorna el servicio de privacidad
+func (sm *ServiceManager) GetPrivacyService() PrivacyService {
+	return sm.Privacy
+}
+
+// GetEventLifecycleService retorna el servicio del ciclo de vida de los eventos
+func (sm *ServiceManager) GetEventLifecycleService() EventLifecycleService {
+	return sm.EventLifecycle
+}
+
+// GetModerationService retorna el servicio de moderación de contenidos
+func (sm *ServiceManager) GetModerationService() ModerationService {
+	return sm.Moderation
+}
+
+// GetVerificationService retorna el servicio de verificación de organizaciones
+func (sm *ServiceManager) GetVerificationService() VerificationService {
+	return sm.Verification
+}
+
+// GetMediaService retorna el servicio de ficheros subidos
+func (sm *ServiceManager) GetMediaService() MediaService {
+	return sm.Media
+}
+
+// GetMediaProcessingService retorna el servicio de procesado de imágenes
+func (sm *ServiceManager) GetMediaProcessingService() MediaProcessingService {
+	return sm.MediaProcessing
+}
+
+// GetJobService retorna el servicio de tareas programadas
+func (sm *ServiceManager) GetJobService() JobService {
+	return sm.Jobs
+}
+
 // GetAuthorizationService retorna el servicio de autorización
 func (sm *ServiceManager) GetAuthorizationService() AuthorizationService {
 	return sm.auth
@@ -0,0 +1,333 @@
+// internal/services/media_processing_service.go
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"cybesphere-backend/internal/models"
+	"cybesphere-backend/internal/repositories"
+	"cybesphere-backend/pkg/imaging"
+	"cybesphere-backend/pkg/logger"
+	"cybesphere-backend/pkg/storage"
+)
+
+// Límites del procesado de imágenes
+const (
+	mediaProcessingQueueSize   = 256              // Avisos en cola; si se llena, los recoge la tarea periódica
+	mediaProcessingBatch       = 100              // Ficheros por pasada de la tarea periódica
+	mediaProcessingMaxAttempts = 3                // Intentos antes de marcarlo como failed
+	mediaProcessingTimeout     = 2 * time.Minute  // Tiempo máximo por imagen
+	mediaProcessingStale       = 15 * time.Minute // Un procesado más antiguo se da por abandonado
+)
+
+// mediaVariantSpecs versiones que se generan para cada uso. Los logos se
+// encajan sin recortar; el resto se recorta a la proporción de la versión
+var mediaVariantSpecs = map[models.MediaPurpose][]imaging.Spec{
+	models.MediaPurposeEventImage: {
+		{Name: models.MediaVariantThumbnail, Width: 320, Height: 180, Mode: imaging.Fill},
+		{Name: models.MediaVariantCard, Width: 640, Height: 360, Mode: imaging.Fill},
+		{Name: models.MediaVariantBanner, Width: 1600, Height: 400, Mode: imaging.Fill},
+	},
+	models.MediaPurposeEventBanner: {
+		{Name: models.MediaVariantThumbnail, Width: 320, Height: 180, Mode: imaging.Fill},
+		{Name: models.MediaVariantCard, Width: 640, Height: 360, Mode: imaging.Fill},
+		{Name: models.MediaVariantBanner, Width: 1600, Height: 400, Mode: imaging.Fill},
+	},
+	models.MediaPurposeOrganizationLogo: {
+		{Name: models.MediaVariantThumbnail, Width: 128, Height: 128, Mode: imaging.Fit},
+		{Name: models.MediaVariantCard, Width: 320, Height: 320, Mode: imaging.Fit},
+	},
+	models.MediaPurposeOrganizationBanner: {
+		{Name: models.MediaVariantThumbnail, Width: 320, Height: 180, Mode: imaging.Fill},
+		{Name: models.MediaVariantCard, Width: 640, Height: 360, Mode: imaging.Fill},
+		{Name: models.MediaVariantBanner, Width: 1600, Height: 400, Mode: imaging.Fill},
+	},
+}
+
+// MediaProcessingSettings workers y límites del procesado de imágenes
+type MediaProcessingSettings struct {
+	Workers   int // Workers en segundo plano (0 = solo la tarea periódica)
+	Quality   int // Calidad WebP de las versiones
+	MaxPixels int // Límite de píxeles para decodificar
+}
+
+// MediaProcessingServiceImpl genera en segundo plano las versiones de las
+// imágenes subidas y su color dominante. Las subidas avisan a los workers; la
+// tarea periódica recoge lo que quedó pendiente (cola llena, reinicios u
+// otras instancias). Cada fichero se reclama en base de datos antes de
+// procesarlo, así que varias instancias no lo procesan a la vez
+type MediaProcessingServiceImpl struct {
+	mediaRepo *repositories.MediaRepository
+	store     storage.Storage
+	settings  MediaProcessingSettings
+
+	queue   chan string
+	mu      sync.Mutex
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+	started bool
+}
+
+// Verificación en tiempo de compilación
+var _ MediaProcessingService = (*MediaProcessingServiceImpl)(nil)
+
+// NewMediaProcessingService crea el servicio; los workers arrancan con Start
+func NewMediaProcessingService(
+	mediaRepo *repositories.MediaRepository,
+	store storage.Storage,
+	settings MediaProcessingSettings,
+) MediaProcessingService {
+	return &MediaProcessingServiceImpl{
+		mediaRepo: mediaRepo,
+		store:     store,
+		settings:  settings,
+		queue:     make(chan string, mediaProcessingQueueSize),
+	}
+}
+
+// Start arranca los workers y pone en cola lo que quedó pendiente
+func (s *MediaProcessingServiceImpl) Start(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.started {
+		return errors.New("media processing already started")
+	}
+	if s.settings.Workers <= 0 {
+		return nil
+	}
+
+	ctx, s.cancel = context.WithCancel(ctx)
+	s.started = true
+	for i := 0; i < s.settings.Workers; i++ {
+		s.wg.Add(1)
+		go s.work(ctx)
+	}
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		ids, err := s.mediaRepo.ListProcessable(ctx, time.Now().Add(-mediaProcessingStale), mediaProcessingMaxAttempts, mediaProcessingQueueSize)
+		if err != nil {
+			logger.Errorf("Error buscando imágenes pendientes de procesar: %v", err)
+			return
+		}
+		for _, id := range ids {
+			s.Enqueue(id)
+		}
+	}()
+	return nil
+}
+
+// Stop detiene los workers y espera a que terminen la imagen en curso
+func (s *MediaProcessingServiceImpl) Stop() {
+	s.mu.Lock()
+	if !s.started {
+		s.mu.Unlock()
+		return
+	}
+	s.started = false
+	s.cancel()
+	s.mu.Unlock()
+
+	s.wg.Wait()
+}
+
+// Enqueue avisa a los workers de un fichero pendiente. No bloquea: sin
+// workers o con la cola llena, lo recogerá la tarea periódica
+func (s *MediaProcessingServiceImpl) Enqueue(mediaID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.started {
+		return
+	}
+	select {
+	case s.queue <- mediaID:
+	default:
+		logger.Warnf("Cola de procesado de imágenes llena; %s se procesará en la próxima pasada", mediaID)
+	}
+}
+
+// ProcessPending procesa los ficheros pendientes y los abandonados. Devuelve
+// cuántos ha procesado
+func (s *MediaProcessingServiceImpl) ProcessPending(ctx context.Context) (int, error) {
+	ids, err := s.mediaRepo.ListProcessable(ctx, time.Now().Add(-mediaProcessingStale), mediaProcessingMaxAttempts, mediaProcessingBatch)
+	if err != nil {
+		return 0, err
+	}
+
+	processed := 0
+	for _, id := range ids {
+		if ctx.Err() != nil {
+			return processed, ctx.Err()
+		}
+		done, err := s.process(ctx, id)
+		if err != nil {
+			logger.Errorf("Error procesando la imagen %s: %v", id, err)
+			continue
+		}
+		if done {
+			processed++
+		}
+	}
+	return processed, nil
+}
+
+// work bucle de un worker
+func (s *MediaProcessingServiceImpl) work(ctx context.Context) {
+	defer s.wg.Done()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case id := <-s.queue:
+			// Se termina la imagen en curso aunque se esté parando
+			if _, err := s.process(context.WithoutCancel(ctx), id); err != nil {
+				logger.Errorf("Error procesando la imagen %s: %v", id, err)
+			}
+		}
+	}
+}
+
+// process reclama el fichero y genera sus versiones. done es false si otra
+// instancia lo había reclamado o ya no está pendiente
+func (s *MediaProcessingServiceImpl) process(ctx context.Context, id string) (done bool, err error) {
+	ctx, cancel := context.WithTimeout(ctx, mediaProcessingTimeout)
+	defer cancel()
+
+	claimed, err := s.mediaRepo.ClaimProcessing(ctx, id, time.Now().Add(-mediaProcessingStale))
+	if err != nil || !claimed {
+		return false, err
+	}
+
+	media, err := s.mediaRepo.GetByID(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	specs, ok := mediaVariantSpecs[media.Purpose]
+	if !ok || !imaging.CanDecode(media.ContentType) {
+		return true, s.mediaRepo.FinishProcessing(ctx, id, models.MediaProcessingSkipped, "")
+	}
+
+	data, err := s.read(ctx, media)
+	if err != nil {
+		return false, s.retryOrFail(ctx, media, err)
+	}
+
+	result, err := imaging.Process(data, specs, imaging.Options{
+		MaxPixels: s.settings.MaxPixels,
+		Quality:   s.settings.Quality,
+	})
+	switch {
+	case errors.Is(err, imaging.ErrUnsupportedFormat):
+		return true, s.mediaRepo.FinishProcessing(ctx, id, models.MediaProcessingSkipped, "Formato sin decodificador")
+	case errors.Is(err, imaging.ErrTooManyPixels):
+		return true, s.mediaRepo.FinishProcessing(ctx, id, models.MediaProcessingFailed, "La imagen supera el máximo de píxeles")
+	case errors.Is(err, imaging.ErrMalformed):
+		return true, s.mediaRepo.FinishProcessing(ctx, id, models.MediaProcessingFailed, "La imagen está dañada")
+	case err != nil:
+		return false, s.retryOrFail(ctx, media, err)
+	}
+
+	previous := media.GetVariants()
+	variants, err := s.storeVariants(ctx, media, result.Variants)
+	if err != nil {
+		return false, s.retryOrFail(ctx, media, err)
+	}
+
+	media.Width, media.Height = result.Width, result.Height
+	media.DominantColor = result.DominantColor
+	if err := media.SetVariants(variants); err != nil {
+		return false, err
+	}
+	if err := s.mediaRepo.CompleteProcessing(ctx, media); err != nil {
+		return false, s.retryOrFail(ctx, media, err)
+	}
+
+	// Al reprocesar, las versiones que han cambiado de clave quedan huérfanas
+	for _, old := range previous {
+		if !hasVariantKey(variants, old.StorageKey) {
+			if err := s.store.Delete(ctx, old.StorageKey); err != nil {
+				logger.Errorf("Error borrando la versión %s: %v", old.StorageKey, err)
+			}
+		}
+	}
+
+	logger.Infof("Imagen %s procesada: %dx%d, %d versiones, color %s",
+		media.ID, result.Width, result.Height, len(variants), result.DominantColor)
+	return true, nil
+}
+
+// read lee el original del almacén
+func (s *MediaProcessingServiceImpl) read(ctx context.Context, media *models.Media) ([]byte, error) {
+	body, err := s.store.Get(ctx, media.StorageKey)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(body, media.Size+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) != media.Size {
+		return nil, fmt.Errorf("stored object has %d bytes, expected %d", len(data), media.Size)
+	}
+	return data, nil
+}
+
+// storeVariants guarda las versiones junto al original
+func (s *MediaProcessingServiceImpl) storeVariants(ctx context.Context, media *models.Media, generated []imaging.Variant) ([]models.MediaVariant, error) {
+	variants := make([]models.MediaVariant, 0, len(generated))
+	for _, v := range generated {
+		key := models.MediaVariantKey(media.StorageKey, v.Name, v.Ext)
+		if err := s.store.Put(ctx, key, bytes.NewReader(v.Data), int64(len(v.Data)), v.ContentType); err != nil {
+			return nil, err
+		}
+
+		sum := sha256.Sum256(v.Data)
+		variants = append(variants, models.MediaVariant{
+			Name:        v.Name,
+			StorageKey:  key,
+			URL:         mediaVariantURL(s.store, media, v.Name, key),
+			ContentType: v.ContentType,
+			Width:       v.Width,
+			Height:      v.Height,
+			Size:        int64(len(v.Data)),
+			Checksum:    hex.EncodeToString(sum[:]),
+		})
+	}
+	return variants, nil
+}
+
+// retryOrFail devuelve el fichero a la cola o, agotados los intentos, lo marca como failed
+func (s *MediaProcessingServiceImpl) retryOrFail(ctx context.Context, media *models.Media, cause error) error {
+	status := models.MediaProcessingPending
+	if media.ProcessingAttempts >= mediaProcessingMaxAttempts {
+		status = models.MediaProcessingFailed
+	}
+	if err := s.mediaRepo.FinishProcessing(context.WithoutCancel(ctx), media.ID.String(), status, cause.Error()); err != nil {
+		return errors.Join(cause, err)
+	}
+	return cause
+}
+
+// hasVariantKey indica si alguna versión usa la clave
+func hasVariantKey(variants []models.MediaVariant, key string) bool {
+	for _, variant := range variants {
+		if variant.StorageKey == key {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,460 @@
+// internal/services/media_service.go
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/dto"
+	"cybesphere-backend/internal/models"
+	"cybesphere-backend/internal/repositories"
+	"cybesphere-backend/pkg/imaging"
+	"cybesphere-backend/pkg/logger"
+	"cybesphere-backend/pkg/storage"
+
+	"github.com/google/uuid"
+)
+
+// Rutas de la API que sirven los ficheros cuando el almacén no los publica
+const (
+	publicMediaPath  = "/api/v1/public/media/"
+	privateMediaPath = "/api/v1/media/"
+)
+
+// mediaColumns columna del recurso que guarda la URL de cada campo
+var mediaColumns = map[string]string{
+	models.MediaResourceEvent + "." + models.MediaFieldImage:         "image_url",
+	models.MediaResourceEvent + "." + models.MediaFieldBanner:        "banner_url",
+	models.MediaResourceOrganization + "." + models.MediaFieldLogo:   "logo_url",
+	models.MediaResourceOrganization + "." + models.MediaFieldBanner: "banner_url",
+}
+
+// errStorageUnavailable el almacén de ficheros no responde
+var errStorageUnavailable = common.NewBusinessError("storage_error", "No se pudo acceder al almacén de ficheros")
+
+// MediaSettings almacén, reglas de aceptación y procesado de los ficheros subidos
+type MediaSettings struct {
+	Storage    storage.Storage
+	Policy     storage.Policy
+	Processing MediaProcessingSettings
+}
+
+// UploadedFile fichero recibido en una subida
+type UploadedFile struct {
+	Name string
+	Size int64
+	Body io.Reader
+}
+
+// MediaServiceImpl subida de ficheros, su almacenamiento y su asignación a
+// eventos y organizaciones
+type MediaServiceImpl struct {
+	mediaRepo  *repositories.MediaRepository
+	eventRepo  *repositories.EventRepository
+	orgRepo    *repositories.OrganizationRepository
+	processing MediaProcessingService
+	store      storage.Storage
+	policy     storage.Policy
+}
+
+// Verificación en tiempo de compilación
+var _ MediaService = (*MediaServiceImpl)(nil)
+
+// NewMediaService crea una nueva instancia del servicio de ficheros
+func NewMediaService(
+	mediaRepo *repositories.MediaRepository,
+	eventRepo *repositories.EventRepository,
+	orgRepo *repositories.OrganizationRepository,
+	processing MediaProcessingService,
+	settings MediaSettings,
+) MediaService {
+	return &MediaServiceImpl{
+		mediaRepo:  mediaRepo,
+		eventRepo:  eventRepo,
+		orgRepo:    orgRepo,
+		processing: processing,
+		store:      settings.Storage,
+		policy:     settings.Policy,
+	}
+}
+
+// =============================================================================
+// SUBIDA Y CONSULTA
+// =============================================================================
+
+// Upload valida y guarda un fichero en nombre de una organización. El tipo se
+// detecta a partir del contenido y debe coincidir con la extensión y con el
+// uso. De las imágenes se eliminan los metadatos (EXIF con GPS, XMP...) antes
+// de guardarlas, y las de eventos y organizaciones quedan en cola para
+// generar sus versiones
+func (s *MediaServiceImpl) Upload(ctx context.Context, req dto.UploadMediaRequest, file UploadedFile, userCtx *common.UserContext) (*models.Media, error) {
+	if !userCtx.IsAdmin() && !userCtx.CanManageOrganization(req.OrganizationID) {
+		return nil, common.ErrForbidden
+	}
+	if _, err := s.orgRepo.GetByID(ctx, req.OrganizationID); err != nil {
+		return nil, err
+	}
+
+	head := make([]byte, storage.SniffLen)
+	n, err := io.ReadFull(file.Body, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	head = head[:n]
+
+	ext, contentType, err := s.policy.Check(file.Name, file.Size, head)
+	if err != nil {
+		return nil, s.policyError(err)
+	}
+	purpose := models.MediaPurpose(req.Purpose)
+	if !purpose.AcceptsContentType(contentType) {
+		return nil, common.NewValidationError("file", "Este tipo de fichero no se admite para "+req.Purpose)
+	}
+
+	id := uuid.New()
+	media := &models.Media{
+		BaseModel:      models.BaseModel{ID: id},
+		OwnerID:        userCtx.ID,
+		OrganizationID: req.OrganizationID,
+		Purpose:        purpose,
+		StorageKey:     models.MediaStorageKey(purpose, id, ext, time.Now().UTC()),
+		FileName:       file.Name,
+		ContentType:    contentType,
+		Size:           file.Size,
+	}
+	media.URL = mediaURL(s.store, media)
+
+	content := io.LimitReader(io.MultiReader(bytes.NewReader(head), file.Body), file.Size)
+	if storage.IsImage(contentType) {
+		stripped, err := stripImage(content, file.Size)
+		if err != nil {
+			return nil, err
+		}
+		content, media.Size = bytes.NewReader(stripped), int64(len(stripped))
+
+		media.ProcessingStatus = models.MediaProcessingSkipped
+		if _, ok := mediaVariantSpecs[purpose]; ok && imaging.CanDecode(contentType) {
+			media.ProcessingStatus = models.MediaProcessingPending
+		}
+	}
+
+	// Se calcula el hash mientras se guarda, sin leer el fichero dos veces
+	hash := sha256.New()
+	var written byteCounter
+	body := io.TeeReader(content, io.MultiWriter(hash, &written))
+	if err := s.store.Put(ctx, media.StorageKey, body, media.Size, contentType); err != nil {
+		logger.Errorf("Error guardando el fichero %s: %v", media.StorageKey, err)
+		return nil, errStorageUnavailable
+	}
+	if int64(written) != media.Size {
+		s.deleteObject(ctx, media.StorageKey)
+		return nil, common.NewValidationError("file", "El fichero llegó incompleto")
+	}
+	media.Checksum = hex.EncodeToString(hash.Sum(nil))
+
+	if err := s.mediaRepo.Create(ctx, media); err != nil {
+		s.deleteObject(ctx, media.StorageKey)
+		return nil, err
+	}
+	if media.ProcessingStatus == models.MediaProcessingPending {
+		s.processing.Enqueue(media.ID.String())
+	}
+
+	logger.Infof("Fichero %s (%s, %d bytes) subido por %s", media.ID, media.Purpose, media.Size, userCtx.ID)
+	return media, nil
+}
+
+// ListMedia lista los ficheros de una organización que el usuario gestiona o,
+// sin organización, los que ha subido el propio usuario
+func (s *MediaServiceImpl) ListMedia(ctx context.Context, query dto.MediaQuery, opts common.QueryOptions, userCtx *common.UserContext) ([]*models.Media, *common.PaginationMeta, error) {
+	if query.OrganizationID != "" {
+		if !userCtx.IsAdmin() && !userCtx.CanManageOrganization(query.OrganizationID) {
+			return nil, nil, common.ErrForbidden
+		}
+		opts.AddFilter("organization_id", query.OrganizationID)
+	} else {
+		opts.AddFilter("owner_id", userCtx.ID)
+	}
+	if query.Purpose != "" {
+		opts.AddFilter("purpose", query.Purpose)
+	}
+	opts.Preloads = append(opts.Preloads, "References")
+
+	return s.mediaRepo.GetAll(ctx, opts)
+}
+
+// GetMedia obtiene un fichero con los recursos que lo usan
+func (s *MediaServiceImpl) GetMedia(ctx context.Context, id string, userCtx *common.UserContext) (*models.Media, error) {
+	media, err := s.mediaRepo.GetWithReferences(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !canManageMedia(media, userCtx) {
+		return nil, common.ErrForbidden
+	}
+	return media, nil
+}
+
+// OpenContent abre el contenido de un fichero. Los ficheros privados solo los
+// descargan quien los subió, los gestores de la organización y los administradores
+func (s *MediaServiceImpl) OpenContent(ctx context.Context, id string, userCtx *common.UserContext) (*models.Media, io.ReadCloser, error) {
+	media, err := s.mediaRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !media.IsPublic() && !canManageMedia(media, userCtx) {
+		return nil, nil, common.ErrForbidden
+	}
+	return s.open(ctx, media)
+}
+
+// OpenPublicContent abre el contenido de un fichero público; los privados no existen
+func (s *MediaServiceImpl) OpenPublicContent(ctx context.Context, id string) (*models.Media, io.ReadCloser, error) {
+	media, err := s.mediaRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !media.IsPublic() {
+		return nil, nil, common.ErrNotFound
+	}
+	return s.open(ctx, media)
+}
+
+// OpenPublicVariant abre una versión de un fichero público
+func (s *MediaServiceImpl) OpenPublicVariant(ctx context.Context, id, name string) (*models.Media, *models.MediaVariant, io.ReadCloser, error) {
+	media, err := s.mediaRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	variant, ok := media.FindVariant(name)
+	if !media.IsPublic() || !ok {
+		return nil, nil, nil, common.ErrNotFound
+	}
+
+	body, err := s.store.Get(ctx, variant.StorageKey)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, nil, nil, common.ErrNotFound
+	}
+	if err != nil {
+		logger.Errorf("Error leyendo la versión %s: %v", variant.StorageKey, err)
+		return nil, nil, nil, errStorageUnavailable
+	}
+	return media, variant, body, nil
+}
+
+// ReprocessMedia vuelve a generar las versiones de una imagen, p. ej. tras
+// un fallo
+func (s *MediaServiceImpl) ReprocessMedia(ctx context.Context, id string, userCtx *common.UserContext) (*models.Media, error) {
+	media, err := s.mediaRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !canManageMedia(media, userCtx) {
+		return nil, common.ErrForbidden
+	}
+	if _, ok := mediaVariantSpecs[media.Purpose]; !ok || !imaging.CanDecode(media.ContentType) {
+		return nil, common.NewValidationError("media_id", "Este fichero no admite versiones")
+	}
+	if media.ProcessingStatus == models.MediaProcessingProcessing {
+		return nil, common.NewBusinessError("media_processing", "La imagen se está procesando")
+	}
+
+	if err := s.mediaRepo.ResetProcessing(ctx, id); err != nil {
+		return nil, err
+	}
+	s.processing.Enqueue(id)
+
+	logger.Infof("Imagen %s en cola para reprocesar por %s", id, userCtx.ID)
+	return s.mediaRepo.GetWithReferences(ctx, id)
+}
+
+// DeleteMedia borra un fichero que ningún recurso usa
+func (s *MediaServiceImpl) DeleteMedia(ctx context.Context, id string, userCtx *common.UserContext) error {
+	media, err := s.mediaRepo.GetWithReferences(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !canManageMedia(media, userCtx) {
+		return common.ErrForbidden
+	}
+	if media.IsInUse() {
+		return common.NewBusinessError("media_in_use", "El fichero está en uso; sustitúyelo en los recursos que lo usan antes de borrarlo")
+	}
+
+	if err := s.mediaRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+	s.deleteObject(ctx, media.StorageKey)
+	for _, variant := range media.GetVariants() {
+		s.deleteObject(ctx, variant.StorageKey)
+	}
+
+	logger.Infof("Fichero %s borrado por %s", media.ID, userCtx.ID)
+	return nil
+}
+
+// =============================================================================
+// ASIGNACIÓN A RECURSOS
+// =============================================================================
+
+// AttachEventMedia usa un fichero como imagen (image) o banner (banner) del evento
+func (s *MediaServiceImpl) AttachEventMedia(ctx context.Context, eventID, field string, req dto.AttachMediaRequest, userCtx *common.UserContext) (*models.Media, error) {
+	purpose, ok := models.MediaPurposeForField(models.MediaResourceEvent, field)
+	if !ok {
+		return nil, common.NewValidationError("field", "Campo no válido; usa image o banner")
+	}
+
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if !userCtx.IsAdmin() && !userCtx.CanManageOrganization(event.OrganizationID) {
+		return nil, common.ErrForbidden
+	}
+
+	return s.attach(ctx, req.MediaID, purpose, event.OrganizationID, models.MediaResourceEvent, eventID, field, &models.Event{})
+}
+
+// AttachOrganizationMedia usa un fichero como logo (logo) o banner (banner) de la organización
+func (s *MediaServiceImpl) AttachOrganizationMedia(ctx context.Context, orgID, field string, req dto.AttachMediaRequest, userCtx *common.UserContext) (*models.Media, error) {
+	purpose, ok := models.MediaPurposeForField(models.MediaResourceOrganization, field)
+	if !ok {
+		return nil, common.NewValidationError("field", "Campo no válido; usa logo o banner")
+	}
+
+	if !userCtx.IsAdmin() && !userCtx.CanManageOrganization(orgID) {
+		return nil, common.ErrForbidden
+	}
+	if _, err := s.orgRepo.GetByID(ctx, orgID); err != nil {
+		return nil, err
+	}
+
+	return s.attach(ctx, req.MediaID, purpose, orgID, models.MediaResourceOrganization, orgID, field, &models.Organization{})
+}
+
+// attach comprueba que el fichero sirve para el campo y lo asigna al recurso
+func (s *MediaServiceImpl) attach(ctx context.Context, mediaID string, purpose models.MediaPurpose, orgID, resourceType, resourceID, field string, target interface{}) (*models.Media, error) {
+	media, err := s.mediaRepo.GetByID(ctx, mediaID)
+	if err != nil {
+		return nil, err
+	}
+	if media.Purpose != purpose {
+		return nil, common.NewValidationError("media_id", "El fichero se subió para otro uso ("+string(media.Purpose)+")")
+	}
+	if media.OrganizationID != orgID {
+		return nil, common.NewValidationError("media_id", "El fichero pertenece a otra organización")
+	}
+
+	ref := &models.MediaReference{
+		MediaID:      media.ID.String(),
+		ResourceType: resourceType,
+		ResourceID:   resourceID,
+		Field:        field,
+	}
+	if err := s.mediaRepo.Attach(ctx, ref, target, mediaColumns[resourceType+"."+field]); err != nil {
+		return nil, err
+	}
+
+	logger.Infof("Fichero %s asignado a %s %s (%s)", media.ID, resourceType, resourceID, field)
+	return s.mediaRepo.GetWithReferences(ctx, mediaID)
+}
+
+// =============================================================================
+// AUXILIARES
+// =============================================================================
+
+// mediaURL dirección del fichero: la del almacén si publica los objetos o la
+// de la API. Los privados siempre se sirven desde la API, que comprueba el acceso
+func mediaURL(store storage.Storage, media *models.Media) string {
+	if !media.IsPublic() {
+		return privateMediaPath + media.ID.String() + "/file"
+	}
+	if url := store.URL(media.StorageKey); url != "" {
+		return url
+	}
+	return publicMediaPath + media.ID.String()
+}
+
+// mediaVariantURL dirección de una versión de un fichero público
+func mediaVariantURL(store storage.Storage, media *models.Media, name, key string) string {
+	if url := store.URL(key); url != "" {
+		return url
+	}
+	return publicMediaPath + media.ID.String() + "/variants/" + name
+}
+
+// stripImage lee la imagen completa (ya validada contra el tamaño máximo) y
+// elimina sus metadatos
+func stripImage(content io.Reader, size int64) ([]byte, error) {
+	data, err := io.ReadAll(content)
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) != size {
+		return nil, common.NewValidationError("file", "El fichero llegó incompleto")
+	}
+
+	stripped, err := imaging.StripMetadata(data)
+	if err != nil {
+		return nil, common.NewValidationError("file", "La imagen está dañada")
+	}
+	return stripped, nil
+}
+
+// open abre el contenido del fichero en el almacén
+func (s *MediaServiceImpl) open(ctx context.Context, media *models.Media) (*models.Media, io.ReadCloser, error) {
+	body, err := s.store.Get(ctx, media.StorageKey)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, nil, common.ErrNotFound
+	}
+	if err != nil {
+		logger.Errorf("Error leyendo el fichero %s: %v", media.StorageKey, err)
+		return nil, nil, errStorageUnavailable
+	}
+	return media, body, nil
+}
+
+// deleteObject borra el objeto del almacén; los errores solo se registran
+func (s *MediaServiceImpl) deleteObject(ctx context.Context, key string) {
+	if err := s.store.Delete(ctx, key); err != nil {
+		logger.Errorf("Error borrando el fichero %s del almacén: %v", key, err)
+	}
+}
+
+// policyError traduce los errores de validación de la subida
+func (s *MediaServiceImpl) policyError(err error) error {
+	switch {
+	case errors.Is(err, storage.ErrEmptyFile):
+		return common.NewValidationError("file", "El fichero está vacío")
+	case errors.Is(err, storage.ErrFileTooLarge):
+		return common.NewValidationError("file", fmt.Sprintf("El fichero supera el tamaño máximo de %d MB", s.policy.MaxSize>>20))
+	case errors.Is(err, storage.ErrExtensionNotAllowed):
+		return common.NewValidationError("file", "Extensión de fichero no permitida")
+	case errors.Is(err, storage.ErrContentMismatch):
+		return common.NewValidationError("file", "El contenido del fichero no coincide con su extensión")
+	default:
+		return common.NewValidationError("file", err.Error())
+	}
+}
+
+// canManageMedia indica si el usuario subió el fichero, gestiona su
+// organización o es administrador
+func canManageMedia(media *models.Media, userCtx *common.UserContext) bool {
+	return userCtx.IsAdmin() || media.OwnerID == userCtx.ID || userCtx.CanManageOrganization(media.OrganizationID)
+}
+
+// byteCounter cuenta los bytes escritos
+type byteCounter int64
+
+// Write implementa io.Writer
+func (c *byteCounter) Write(p []byte) (int, error) {
+	*c += byteCounter(len(p))
+	return len(p), nil
+}
@@ -0,0 +1,413 @@
+// internal/services/moderation_service.go
+package services
+
+import (
+	"context"
+	"time"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/dto"
+	"cybesphere-backend/internal/models"
+	"cybesphere-backend/internal/repositories"
+	"cybesphere-backend/pkg/logger"
+)
+
+// defaultReportQueue estados que muestra la cola de moderación si no se filtra
+const defaultReportQueue = string(models.ReportStatusOpen) + "," + string(models.ReportStatusInReview)
+
+// errReportResolved la denuncia ya no admite cambios
+var errReportResolved = common.NewBusinessError("report_resolved", "La denuncia ya está resuelta")
+
+// ModerationSettings opciones de la moderación de contenidos
+type ModerationSettings struct {
+	FirstEventApproval bool // Aprobar los eventos de organizaciones que aún no han publicado ninguno
+}
+
+// ModerationServiceImpl denuncias de contenido, cola de moderación y
+// aprobación de eventos pendientes
+type ModerationServiceImpl struct {
+	reportRepo    *repositories.ContentReportRepository
+	eventRepo     *repositories.EventRepository
+	orgRepo       *repositories.OrganizationRepository
+	userRepo      *repositories.UserRepository
+	lifecycle     EventLifecycleService
+	notifications NotificationService
+}
+
+// Verificación en tiempo de compilación
+var _ ModerationService = (*ModerationServiceImpl)(nil)
+
+// NewModerationService crea una nueva instancia del servicio de moderación
+func NewModerationService(
+	reportRepo *repositories.ContentReportRepository,
+	eventRepo *repositories.EventRepository,
+	orgRepo *repositories.OrganizationRepository,
+	userRepo *repositories.UserRepository,
+	lifecycle EventLifecycleService,
+	notifications NotificationService,
+) ModerationService {
+	return &ModerationServiceImpl{
+		reportRepo:    reportRepo,
+		eventRepo:     eventRepo,
+		orgRepo:       orgRepo,
+		userRepo:      userRepo,
+		lifecycle:     lifecycle,
+		notifications: notifications,
+	}
+}
+
+// =============================================================================
+// DENUNCIAS DE LOS USUARIOS
+// =============================================================================
+
+// ReportContent registra la denuncia de un usuario sobre un evento o una
+// organización. No se puede denunciar el contenido de la propia organización
+// ni repetir una denuncia pendiente
+func (s *ModerationServiceImpl) ReportContent(ctx context.Context, resourceType models.ReportResourceType, resourceID string, req dto.ReportContentRequest, userCtx *common.UserContext) (*models.ContentReport, error) {
+	target, err := s.loadTarget(ctx, resourceType, resourceID)
+	if err != nil {
+		return nil, err
+	}
+	if target.event != nil && !isEventVisible(target.event, userCtx) {
+		return nil, common.ErrNotFound
+	}
+	if userCtx.OrganizationID != nil && *userCtx.OrganizationID == target.organization.ID.String() {
+		return nil, common.NewBusinessError("own_content", "No puedes denunciar contenido de tu organización")
+	}
+
+	exists, err := s.reportRepo.HasUnresolved(ctx, userCtx.ID, resourceType, resourceID)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, common.NewBusinessError("already_reported", "Ya has denunciado este contenido y la denuncia está pendiente de revisión")
+	}
+
+	report := &models.ContentReport{
+		ResourceType: resourceType,
+		ResourceID:   resourceID,
+		ReporterID:   userCtx.ID,
+		Reason:       models.ReportReason(req.Reason),
+		Details:      req.Details,
+		Status:       models.ReportStatusOpen,
+	}
+	if err := report.ValidateContentReport(); err != nil {
+		return nil, common.NewValidationError("report", err.Error())
+	}
+	if err := s.reportRepo.Create(ctx, report); err != nil {
+		return nil, err
+	}
+
+	logger.Infof("Denuncia %s sobre %s %s por %s", report.ID, resourceType, resourceID, userCtx.ID)
+	return report, nil
+}
+
+// ListOwnReports lista las denuncias presentadas por el usuario
+func (s *ModerationServiceImpl) ListOwnReports(ctx context.Context, opts common.QueryOptions, userCtx *common.UserContext) ([]*models.ContentReport, *common.PaginationMeta, error) {
+	opts.AddFilter("reporter_id", userCtx.ID)
+	return s.reportRepo.GetAll(ctx, opts)
+}
+
+// =============================================================================
+// COLA DE MODERACIÓN (solo admin)
+// =============================================================================
+
+// ListReports lista la cola de moderación; sin filtro de estado muestra las
+// denuncias abiertas y en revisión
+func (s *ModerationServiceImpl) ListReports(ctx context.Context, query dto.ContentReportsQuery, opts common.QueryOptions, userCtx *common.UserContext) ([]*models.ContentReport, *common.PaginationMeta, error) {
+	if !userCtx.IsAdmin() {
+		return nil, nil, common.ErrForbidden
+	}
+
+	status := query.Status
+	if status == "" {
+		status = defaultReportQueue
+	}
+	opts.AddFilter("status", status)
+	if query.ResourceType != "" {
+		opts.AddFilter("resource_type", query.ResourceType)
+	}
+	if query.Reason != "" {
+		opts.AddFilter("reason", query.Reason)
+	}
+	opts.Preloads = append(opts.Preloads, "Reporter")
+
+	return s.reportRepo.GetAll(ctx, opts)
+}
+
+// GetReport obtiene una denuncia con sus notas
+func (s *ModerationServiceImpl) GetReport(ctx context.Context, id string, userCtx *common.UserContext) (*models.ContentReport, error) {
+	if !userCtx.IsAdmin() {
+		return nil, common.ErrForbidden
+	}
+	return s.reportRepo.GetWithNotes(ctx, id)
+}
+
+// StartReview asigna la denuncia al moderador y la pasa a revisión
+func (s *ModerationServiceImpl) StartReview(ctx context.Context, id string, userCtx *common.UserContext) (*models.ContentReport, error) {
+	if !userCtx.IsAdmin() {
+		return nil, common.ErrForbidden
+	}
+
+	report, err := s.reportRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := report.StartReview(userCtx.ID); err != nil {
+		return nil, errReportResolved
+	}
+	if err := s.reportRepo.Update(ctx, report); err != nil {
+		return nil, err
+	}
+	return s.reportRepo.GetWithNotes(ctx, id)
+}
+
+// AddNote añade una nota interna a la denuncia
+func (s *ModerationServiceImpl) AddNote(ctx context.Context, id string, req dto.ModerationNoteRequest, userCtx *common.UserContext) (*models.ContentReport, error) {
+	if !userCtx.IsAdmin() {
+		return nil, common.ErrForbidden
+	}
+
+	if _, err := s.reportRepo.GetByID(ctx, id); err != nil {
+		return nil, err
+	}
+	note := &models.ModerationNote{ReportID: id, AuthorID: userCtx.ID, Note: req.Note}
+	if err := s.reportRepo.AddNote(ctx, note); err != nil {
+		return nil, err
+	}
+	return s.reportRepo.GetWithNotes(ctx, id)
+}
+
+// ResolveReport resuelve la denuncia: con hide oculta el contenido y con warn
+// solo avisa. En ambos casos se avisa al organizador y a quien denunció
+func (s *ModerationServiceImpl) ResolveReport(ctx context.Context, id string, req dto.ResolveReportRequest, userCtx *common.UserContext) (*models.ContentReport, error) {
+	if !userCtx.IsAdmin() {
+		return nil, common.ErrForbidden
+	}
+
+	report, err := s.reportRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if report.IsResolved() {
+		return nil, errReportResolved
+	}
+	target, err := s.loadTarget(ctx, report.ResourceType, report.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	action := models.ModerationAction(req.Action)
+	if action == models.ModerationActionHide {
+		if err := s.hide(ctx, target, req.Message, userCtx.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := report.Resolve(action, req.Message, userCtx.ID, time.Now()); err != nil {
+		return nil, common.NewValidationError("action", err.Error())
+	}
+	if err := s.reportRepo.Update(ctx, report); err != nil {
+		return nil, err
+	}
+
+	title := "Aviso de moderación sobre \"" + target.name() + "\""
+	if action == models.ModerationActionHide {
+		title = "\"" + target.name() + "\" se ha ocultado por moderación"
+	}
+	s.notifyOrganization(ctx, target.organization.ID.String(), models.NotificationTypeContentModerated, title, req.Message, report.ResourceType, report.ResourceID)
+	s.notifyReporter(ctx, report, "Hemos revisado tu denuncia sobre \""+target.name()+"\" y hemos tomado medidas")
+
+	return s.reportRepo.GetWithNotes(ctx, id)
+}
+
+// DismissReport descarta la denuncia sin actuar sobre el contenido
+func (s *ModerationServiceImpl) DismissReport(ctx context.Context, id string, req dto.DismissReportRequest, userCtx *common.UserContext) (*models.ContentReport, error) {
+	if !userCtx.IsAdmin() {
+		return nil, common.ErrForbidden
+	}
+
+	report, err := s.reportRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := report.Dismiss(req.Reason, userCtx.ID, time.Now()); err != nil {
+		return nil, errReportResolved
+	}
+	if err := s.reportRepo.Update(ctx, report); err != nil {
+		return nil, err
+	}
+
+	s.notifyReporter(ctx, report, "Hemos revisado tu denuncia y no hemos encontrado una infracción de las normas")
+	return s.reportRepo.GetWithNotes(ctx, id)
+}
+
+// RestoreContent vuelve a mostrar un evento oculto o reactiva una
+// organización suspendida por moderación
+func (s *ModerationServiceImpl) RestoreContent(ctx context.Context, resourceType models.ReportResourceType, resourceID string, userCtx *common.UserContext) error {
+	if !userCtx.IsAdmin() {
+		return common.ErrForbidden
+	}
+
+	target, err := s.loadTarget(ctx, resourceType, resourceID)
+	if err != nil {
+		return err
+	}
+
+	if target.event != nil {
+		if err := s.lifecycle.Apply(ctx, target.event, models.EventTransitionUnhide, userCtx.ID); err != nil {
+			return err
+		}
+	} else {
+		if target.organization.Status != models.OrgStatusSuspended {
+			return common.NewBusinessError("organization_not_suspended", "La organización no está suspendida")
+		}
+		if err := s.orgRepo.UpdateStatus(ctx, resourceID, models.OrgStatusActive); err != nil {
+			return err
+		}
+	}
+
+	s.notifyOrganization(ctx, target.organization.ID.String(), models.NotificationTypeContentModerated,
+		"\""+target.name()+"\" se ha restaurado",
+		"La moderación ha retirado la ocultación; el contenido vuelve a estar disponible", resourceType, resourceID)
+	return nil
+}
+
+// =============================================================================
+// APROBACIÓN DE EVENTOS (solo admin)
+// =============================================================================
+
+// ListPendingEvents lista los eventos pendientes de aprobación
+func (s *ModerationServiceImpl) ListPendingEvents(ctx context.Context, opts common.QueryOptions, userCtx *common.UserContext) ([]*models.Event, *common.PaginationMeta, error) {
+	if !userCtx.IsAdmin() {
+		return nil, nil, common.ErrForbidden
+	}
+
+	opts.AddFilter("status", models.EventStatusPendingReview)
+	opts.Preloads = append(opts.Preloads, "Organization")
+	return s.eventRepo.GetAll(ctx, opts)
+}
+
+// ApproveEvent aprueba y publica un evento pendiente
+func (s *ModerationServiceImpl) ApproveEvent(ctx context.Context, eventID string, userCtx *common.UserContext) (*models.Event, error) {
+	if !userCtx.IsAdmin() {
+		return nil, common.ErrForbidden
+	}
+
+	event, err := s.lifecycle.Transition(ctx, eventID, models.EventTransitionApprove, userCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.notifyOrganization(ctx, event.OrganizationID, models.NotificationTypeEventReviewed,
+		"Evento aprobado", "Se ha aprobado y publicado el evento \""+event.Title+"\"",
+		models.ReportResourceEvent, eventID)
+	return event, nil
+}
+
+// RejectEvent devuelve a borrador un evento pendiente con el motivo del rechazo
+func (s *ModerationServiceImpl) RejectEvent(ctx context.Context, eventID string, req dto.RejectEventRequest, userCtx *common.UserContext) (*models.Event, error) {
+	if !userCtx.IsAdmin() {
+		return nil, common.ErrForbidden
+	}
+
+	event, err := s.lifecycle.Transition(ctx, eventID, models.EventTransitionReject, userCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.notifyOrganization(ctx, event.OrganizationID, models.NotificationTypeEventReviewed,
+		"Evento rechazado: \""+event.Title+"\"", req.Reason, models.ReportResourceEvent, eventID)
+	return event, nil
+}
+
+// =============================================================================
+// MÉTODOS AUXILIARES
+// =============================================================================
+
+// moderationTarget contenido denunciado y la organización responsable
+type moderationTarget struct {
+	event        *models.Event // nil si se denuncia la organización
+	organization *models.Organization
+}
+
+// name nombre del contenido para los avisos
+func (t moderationTarget) name() string {
+	if t.event != nil {
+		return t.event.Title
+	}
+	return t.organization.Name
+}
+
+// loadTarget carga el contenido denunciado y su organización
+func (s *ModerationServiceImpl) loadTarget(ctx context.Context, resourceType models.ReportResourceType, resourceID string) (moderationTarget, error) {
+	var target moderationTarget
+	organizationID := resourceID
+
+	switch resourceType {
+	case models.ReportResourceEvent:
+		event, err := s.eventRepo.GetByID(ctx, resourceID)
+		if err != nil {
+			return target, err
+		}
+		target.event = event
+		organizationID = event.OrganizationID
+	case models.ReportResourceOrganization:
+	default:
+		return target, common.NewValidationError("resource_type", "Tipo de contenido no válido")
+	}
+
+	organization, err := s.orgRepo.GetByID(ctx, organizationID)
+	if err != nil {
+		return target, err
+	}
+	target.organization = organization
+	return target, nil
+}
+
+// hide oculta el contenido: el evento vuelve a borrador y no se puede
+// publicar, y la organización se suspende. Si ya está oculto no hace nada
+func (s *ModerationServiceImpl) hide(ctx context.Context, target moderationTarget, reason, actorID string) error {
+	if target.event != nil {
+		if target.event.IsHidden() {
+			return nil
+		}
+		return s.lifecycle.Hide(ctx, target.event, reason, actorID)
+	}
+
+	switch target.organization.Status {
+	case models.OrgStatusSuspended:
+		return nil
+	case models.OrgStatusActive:
+		return s.orgRepo.UpdateStatus(ctx, target.organization.ID.String(), models.OrgStatusSuspended)
+	default:
+		return common.NewBusinessError("organization_not_active", "Solo se pueden suspender organizaciones activas")
+	}
+}
+
+// notifyOrganization avisa a los miembros activos de la organización. Los
+// errores se registran sin interrumpir la moderación
+func (s *ModerationServiceImpl) notifyOrganization(ctx context.Context, organizationID string, notificationType models.NotificationType, title, message string, resourceType models.ReportResourceType, resourceID string) {
+	userIDs, err := s.userRepo.GetActiveIDsByOrganization(ctx, organizationID)
+	if err != nil {
+		logger.Errorf("Error obteniendo los miembros de la organización %s: %v", organizationID, err)
+		return
+	}
+
+	data := map[string]interface{}{"resource_type": resourceType, "resource_id": resourceID}
+	for _, userID := range userIDs {
+		if err := s.notifications.Notify(ctx, userID, notificationType, title, message, data); err != nil {
+			logger.Errorf("Error avisando a %s de la moderación de %s %s: %v", userID, resourceType, resourceID, err)
+		}
+	}
+}
+
+// notifyReporter avisa a quien denunció de que su denuncia se ha revisado
+func (s *ModerationServiceImpl) notifyReporter(ctx context.Context, report *models.ContentReport, message string) {
+	data := map[string]interface{}{"report_id": report.ID.String()}
+	err := s.notifications.Notify(ctx, report.ReporterID, models.NotificationTypeReportResolved,
+		"Denuncia revisada", message, data)
+	if err != nil {
+		logger.Errorf("Error avisando a %s de la resolución de la denuncia %s: %v", report.ReporterID, report.ID, err)
+	}
+}
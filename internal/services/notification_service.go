@@ -0,0 +1,77 @@
+package services
+
+import (
+	"context"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/models"
+	"cybesphere-backend/internal/repositories"
+)
+
+// NotificationServiceImpl implementación del servicio de notificaciones in-app
+type NotificationServiceImpl struct {
+	notificationRepo *repositories.NotificationRepository
+}
+
+// Verificación en tiempo de compilación de que NotificationServiceImpl implementa NotificationService
+var _ NotificationService = (*NotificationServiceImpl)(nil)
+
+// NewNotificationService crea una nueva instancia del servicio de notificaciones
+func NewNotificationService(notificationRepo *repositories.NotificationRepository) NotificationService {
+	return &NotificationServiceImpl{notificationRepo: notificationRepo}
+}
+
+// Notify crea una notificación para un usuario
+func (s *NotificationServiceImpl) Notify(ctx context.Context, userID string, notificationType models.NotificationType, title, message string, data map[string]interface{}) error {
+	notification := &models.Notification{
+		UserID:  userID,
+		Type:    notificationType,
+		Title:   title,
+		Message: message,
+	}
+
+	if data != nil {
+		if err := notification.SetData(data); err != nil {
+			return common.ErrInternalError
+		}
+	}
+
+	return s.notificationRepo.Create(ctx, notification)
+}
+
+// ListNotifications lista las notificaciones del usuario y cuántas quedan sin leer
+func (s *NotificationServiceImpl) ListNotifications(ctx context.Context, opts common.QueryOptions, userCtx *common.UserContext) ([]*models.Notification, *common.PaginationMeta, int64, error) {
+	if userCtx == nil {
+		return nil, nil, 0, common.ErrUnauthorized
+	}
+
+	notifications, pagination, err := s.notificationRepo.GetByUser(ctx, userCtx.ID, opts)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	unread, err := s.notificationRepo.CountUnread(ctx, userCtx.ID)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	return notifications, pagination, unread, nil
+}
+
+// MarkAsRead marca una notificación del usuario como leída
+func (s *NotificationServiceImpl) MarkAsRead(ctx context.Context, notificationID string, userCtx *common.UserContext) error {
+	if userCtx == nil {
+		return common.ErrUnauthorized
+	}
+
+	return s.notificationRepo.MarkAsRead(ctx, notificationID, userCtx.ID)
+}
+
+// MarkAllAsRead marca todas las notificaciones del usuario como leídas
+func (s *NotificationServiceImpl) MarkAllAsRead(ctx context.Context, userCtx *common.UserContext) error {
+	if userCtx == nil {
+		return common.ErrUnauthorized
+	}
+
+	return s.notificationRepo.MarkAllAsRead(ctx, userCtx.ID)
+}
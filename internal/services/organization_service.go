@@ -14,9 +14,10 @@ import (
 // OrganizationServiceImpl implementación concreta del servicio de organizaciones
 type OrganizationServiceImpl struct {
 	*BaseService[models.Organization, dto.CreateOrganizationRequest, dto.UpdateOrganizationRequest]
-	orgRepo  *repositories.OrganizationRepository
-	userRepo *repositories.UserRepository
-	auth     AuthorizationService
+	orgRepo   *repositories.OrganizationRepository
+	userRepo  *repositories.UserRepository
+	auth      AuthorizationService
+	geocoding GeocodingService
 }
 
 // Verificación en tiempo de compilación
@@ -28,6 +29,7 @@ func NewOrganizationService(
 	userRepo *repositories.UserRepository,
 	mapper ResponseMapper,
 	auth AuthorizationService,
+	geocoding GeocodingService,
 ) OrganizationService {
 	base := NewBaseService[models.Organization, dto.CreateOrganizationRequest, dto.UpdateOrganizationRequest](
 		orgRepo, mapper, auth,
@@ -38,6 +40,7 @@ func NewOrganizationService(
 		orgRepo:     orgRepo,
 		userRepo:    userRepo,
 		auth:        auth,
+		geocoding:   geocoding,
 	}
 }
 
@@ -78,6 +81,30 @@ func (s *OrganizationServiceImpl) CreateOrganization(ctx context.Context, req dt
 	return org, nil
 }
 
+// Create crea la organización y, si tiene dirección pero no coordenadas, las
+// calcula en segundo plano
+func (s *OrganizationServiceImpl) Create(ctx context.Context, req dto.CreateOrganizationRequest, userCtx *common.UserContext) (*models.Organization, error) {
+	org, err := s.BaseService.Create(ctx, req, userCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.geocoding.ScheduleOrganization(org)
+	return org, nil
+}
+
+// Update actualiza la organización y vuelve a calcular sus coordenadas si ha
+// cambiado la dirección y no son manuales
+func (s *OrganizationServiceImpl) Update(ctx context.Context, id string, req dto.UpdateOrganizationRequest, userCtx *common.UserContext) (*models.Organization, error) {
+	org, err := s.BaseService.Update(ctx, id, req, userCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.geocoding.ScheduleOrganization(org)
+	return org, nil
+}
+
 // GetActiveOrganizations obtiene organizaciones activas
 func (s *OrganizationServiceImpl) GetActiveOrganizations(ctx context.Context, opts common.QueryOptions) ([]*models.Organization, *common.PaginationMeta, error) {
 	return s.orgRepo.GetActive(ctx, opts)
@@ -107,6 +134,33 @@ func (s *OrganizationServiceImpl) GetMembers(ctx context.Context, organizationID
 	return s.userRepo.GetByOrganization(ctx, organizationID, opts)
 }
 
+// FollowOrganization hace que el usuario siga a una organización activa
+func (s *OrganizationServiceImpl) FollowOrganization(ctx context.Context, orgID string, userCtx *common.UserContext) error {
+	if userCtx == nil {
+		return common.ErrUnauthorized
+	}
+
+	org, err := s.orgRepo.GetByID(ctx, orgID)
+	if err != nil {
+		return err
+	}
+
+	if !org.IsActive() {
+		return common.NewBusinessError("organization_not_available", "La organización no está disponible")
+	}
+
+	return s.orgRepo.Follow(ctx, userCtx.ID, orgID)
+}
+
+// UnfollowOrganization deja de seguir una organización
+func (s *OrganizationServiceImpl) UnfollowOrganization(ctx context.Context, orgID string, userCtx *common.UserContext) error {
+	if userCtx == nil {
+		return common.ErrUnauthorized
+	}
+
+	return s.orgRepo.Unfollow(ctx, userCtx.ID, orgID)
+}
+
 // validateOrganizationCreation valida creación de organización
 func (s *OrganizationServiceImpl) validateOrganizationCreation(userCtx *common.UserContext) error {
 	// Solo usuarios verificados pueden crear organizaciones (excepto admin)
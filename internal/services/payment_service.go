@@ -0,0 +1,496 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/models"
+	"cybesphere-backend/internal/repositories"
+	"cybesphere-backend/pkg/logger"
+	"cybesphere-backend/pkg/payments"
+)
+
+// canceledEventRefundReason motivo de los reembolsos por cancelación del evento
+const canceledEventRefundReason = "Evento cancelado"
+
+// latePaymentRefundReason motivo del reembolso de un pago que llega después de cerrar el pedido
+const latePaymentRefundReason = "Pago recibido después de cerrar el pedido"
+
+// refundRetryBatchSize pedidos que se reintentan en cada pasada de la tarea
+const refundRetryBatchSize = 200
+
+// errPaymentsDisabled no hay proveedor de pagos configurado
+var errPaymentsDisabled = common.NewBusinessError("payments_disabled", "Los pagos no están disponibles en esta instancia")
+
+// RefundRetryReport resultado de una pasada de reintento de reembolsos
+type RefundRetryReport struct {
+	Closed int // Pedidos cerrados (reembolsados o fallidos)
+	Failed int // Pedidos que siguen pendientes de reembolso
+}
+
+// PaymentServiceImpl implementación del servicio de pedidos y pagos
+type PaymentServiceImpl struct {
+	orderRepo        *repositories.OrderRepository
+	registrationRepo *repositories.EventRegistrationRepository
+	userRepo         *repositories.UserRepository
+	provider         payments.Provider
+	notifications    NotificationService
+	invoices         InvoiceService
+}
+
+// Verificación en tiempo de compilación de que PaymentServiceImpl implementa PaymentService
+var _ PaymentService = (*PaymentServiceImpl)(nil)
+
+// NewPaymentService crea una nueva instancia del servicio de pagos
+func NewPaymentService(
+	orderRepo *repositories.OrderRepository,
+	registrationRepo *repositories.EventRegistrationRepository,
+	userRepo *repositories.UserRepository,
+	provider payments.Provider,
+	notifications NotificationService,
+	invoices InvoiceService,
+) PaymentService {
+	return &PaymentServiceImpl{
+		orderRepo:        orderRepo,
+		registrationRepo: registrationRepo,
+		userRepo:         userRepo,
+		provider:         provider,
+		notifications:    notifications,
+		invoices:         invoices,
+	}
+}
+
+// CreateOrder crea el pedido de una inscripción pendiente e inicia el pago en el
+// proveedor. Si el proveedor falla, el pedido queda fallido y la plaza se libera.
+// Con los pagos desactivados se libera la plaza sin crear el pedido.
+func (s *PaymentServiceImpl) CreateOrder(ctx context.Context, registration *models.EventRegistration, event *models.Event, billing models.BillingDetails) (*models.Order, error) {
+	if s.provider == nil {
+		s.releaseWithoutOrder(ctx, registration)
+		return nil, errPaymentsDisabled
+	}
+
+	order := &models.Order{
+		RegistrationID: registration.ID.String(),
+		EventID:        registration.EventID,
+		UserID:         registration.UserID,
+		AmountCents:    registration.TotalCents,
+		Currency:       registration.Currency,
+		Provider:       s.provider.Name(),
+		Billing:        billing,
+		Status:         models.OrderStatusPending,
+	}
+
+	if err := s.orderRepo.Create(ctx, order); err != nil {
+		s.releaseWithoutOrder(ctx, registration)
+		return nil, err
+	}
+
+	request := payments.PaymentRequest{
+		OrderID:     order.ID.String(),
+		AmountCents: order.AmountCents,
+		Currency:    order.Currency,
+		Description: event.Title,
+	}
+	if user, err := s.userRepo.GetByID(ctx, registration.UserID); err == nil {
+		request.Email = user.Email
+	}
+
+	payment, err := s.provider.CreatePayment(ctx, request)
+	if err != nil {
+		logger.Errorf("Error creando el pago del pedido %s: %v", order.ID, err)
+		if markErr := order.MarkFailed("No se pudo iniciar el pago", time.Now()); markErr == nil {
+			if err := s.orderRepo.ApplyTransition(ctx, order, models.OrderStatusPending, registration); err != nil {
+				logger.Errorf("Error liberando la inscripción del pedido %s: %v", order.ID, err)
+			}
+		}
+		return nil, common.NewBusinessError("payment_unavailable", "No se pudo iniciar el pago; inténtalo de nuevo")
+	}
+
+	order.ProviderPaymentID = payment.ID
+	order.CheckoutURL = payment.CheckoutURL
+	if err := s.orderRepo.SetProviderPayment(ctx, order); err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+// GetOrder obtiene un pedido del usuario
+func (s *PaymentServiceImpl) GetOrder(ctx context.Context, orderID string, userCtx *common.UserContext) (*models.Order, error) {
+	if userCtx == nil {
+		return nil, common.ErrUnauthorized
+	}
+
+	order, err := s.orderRepo.GetWithRegistration(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if order.UserID != userCtx.ID && !userCtx.IsAdmin() {
+		return nil, common.ErrNotFound
+	}
+
+	return order, nil
+}
+
+// ListMyOrders lista los pedidos del usuario
+func (s *PaymentServiceImpl) ListMyOrders(ctx context.Context, opts common.QueryOptions, userCtx *common.UserContext) ([]*models.Order, *common.PaginationMeta, error) {
+	if userCtx == nil {
+		return nil, nil, common.ErrUnauthorized
+	}
+
+	return s.orderRepo.GetByUser(ctx, userCtx.ID, opts)
+}
+
+// HandleWebhook procesa una notificación firmada del proveedor. Es idempotente:
+// las notificaciones repetidas o que llegan tras otro cambio de estado se ignoran.
+func (s *PaymentServiceImpl) HandleWebhook(ctx context.Context, provider string, payload []byte, signature string) error {
+	if s.provider == nil || provider != s.provider.Name() {
+		return common.ErrNotFound
+	}
+
+	event, err := s.provider.ParseWebhook(payload, signature)
+	if err != nil {
+		if errors.Is(err, payments.ErrInvalidSignature) {
+			return common.ErrUnauthorized
+		}
+		return common.NewValidationError("payload", "Notificación de pago inválida")
+	}
+
+	order, err := s.orderRepo.GetByProviderPayment(ctx, provider, event.PaymentID)
+	if err != nil {
+		if errors.Is(err, common.ErrNotFound) {
+			logger.Warnf("Webhook %s (%s) para un pago desconocido: %s", event.ID, event.Type, event.PaymentID)
+			return nil
+		}
+		return err
+	}
+
+	switch event.Type {
+	case payments.EventPaymentSucceeded:
+		return s.confirmPayment(ctx, order)
+	case payments.EventPaymentFailed:
+		return s.failPayment(ctx, order, event.FailureReason)
+	case payments.EventRefundSucceeded:
+		return s.completeRefund(ctx, order, event.RefundID)
+	default:
+		logger.Infof("Webhook %s de tipo %s ignorado", event.ID, event.Type)
+		return nil
+	}
+}
+
+// SimulateCheckout completa un pago del proveedor fake enviando al webhook la
+// notificación firmada que enviaría un proveedor real
+func (s *PaymentServiceImpl) SimulateCheckout(ctx context.Context, paymentID string, succeed bool) error {
+	fake, ok := s.provider.(*payments.FakeProvider)
+	if !ok {
+		return common.ErrNotFound
+	}
+
+	eventType, reason := payments.EventPaymentSucceeded, ""
+	if !succeed {
+		eventType, reason = payments.EventPaymentFailed, "Pago rechazado en el checkout de pruebas"
+	}
+
+	payload, signature, err := fake.BuildWebhook(eventType, paymentID, reason)
+	if err != nil {
+		return common.ErrInternalError
+	}
+
+	return s.HandleWebhook(ctx, fake.Name(), payload, signature)
+}
+
+// CloseOrder cierra el pedido de una inscripción que se cancela: un pedido
+// pendiente pasa a fallido y se anula su checkout, y uno pagado se reembolsa.
+// En ambos casos la inscripción queda cancelada y la plaza liberada. Un pedido
+// fallido que se cobró después de cerrarlo también se reembolsa.
+func (s *PaymentServiceImpl) CloseOrder(ctx context.Context, order *models.Order, registration *models.EventRegistration, reason string) error {
+	switch {
+	case order.Status == models.OrderStatusPending:
+		if err := order.MarkFailed(reason, time.Now()); err != nil {
+			return common.NewBusinessError("invalid_order_status", err.Error())
+		}
+		if err := s.applyCloseTransition(ctx, order, models.OrderStatusPending, registration); err != nil {
+			return err
+		}
+		s.cancelCheckout(ctx, order)
+		return nil
+	case order.Status == models.OrderStatusPaid:
+		return s.refundOrder(ctx, order, registration, reason)
+	case order.HasLatePayment():
+		// La inscripción ya se liberó al fallar el pedido
+		return s.refundOrder(ctx, order, nil, order.RefundReason)
+	case order.Status == models.OrderStatusRefunding:
+		return common.NewBusinessError("order_in_progress", "El pedido se está reembolsando")
+	default:
+		return common.NewBusinessError("invalid_order_status", "El pedido ya está cerrado")
+	}
+}
+
+// cancelCheckout anula en el proveedor el checkout de un pedido cerrado antes
+// de pagarse. Si falla, o el pago llega de todos modos, confirmPayment lo
+// reembolsa
+func (s *PaymentServiceImpl) cancelCheckout(ctx context.Context, order *models.Order) {
+	if s.provider == nil || order.ProviderPaymentID == "" {
+		return
+	}
+	if err := s.provider.CancelPayment(ctx, order.ProviderPaymentID); err != nil {
+		logger.Warnf("No se pudo anular el checkout del pedido %s: %v", order.ID, err)
+	}
+}
+
+// refundOrder reembolsa un pedido pagado, o uno fallido que se cobró después
+// de cerrarlo. El pedido se reserva (refunding) antes de llamar al proveedor
+// para que dos cierres simultáneos, o un webhook que llega a la vez, no
+// reembolsen dos veces; si el proveedor falla vuelve a su estado anterior
+// para poder reintentarlo
+func (s *PaymentServiceImpl) refundOrder(ctx context.Context, order *models.Order, registration *models.EventRegistration, reason string) error {
+	if s.provider == nil {
+		return errPaymentsDisabled
+	}
+	from := order.Status
+	if err := order.MarkRefunding(); err != nil {
+		return common.NewBusinessError("invalid_order_status", err.Error())
+	}
+	if err := s.applyCloseTransition(ctx, order, from, nil); err != nil {
+		return err
+	}
+
+	refund, err := s.provider.Refund(ctx, order.ProviderPaymentID, order.AmountCents)
+	if err != nil {
+		logger.Errorf("Error reembolsando el pedido %s: %v", order.ID, err)
+		if revertErr := order.RevertRefunding(); revertErr == nil {
+			if err := s.orderRepo.ApplyTransition(ctx, order, models.OrderStatusRefunding, nil); err != nil {
+				logger.Errorf("Pedido %s bloqueado en %s tras fallar el reembolso: requiere revisión manual: %v",
+					order.ID, models.OrderStatusRefunding, err)
+			}
+		}
+		return common.NewBusinessError("refund_failed", "No se pudo reembolsar el pago; inténtalo de nuevo")
+	}
+
+	if err := order.MarkRefunded(refund.ID, time.Now()); err != nil {
+		return common.NewBusinessError("invalid_order_status", err.Error())
+	}
+	if err := s.orderRepo.ApplyTransition(ctx, order, models.OrderStatusRefunding, registration); err != nil {
+		// El proveedor ya ha devuelto el dinero: el pedido no debe volver a reembolsarse
+		logger.Errorf("Reembolso %s del pedido %s completado pero no registrado: requiere revisión manual: %v",
+			refund.ID, order.ID, err)
+		return err
+	}
+
+	s.afterRefund(ctx, order, reason)
+	return nil
+}
+
+// applyCloseTransition persiste el cierre de un pedido; si otro proceso lo ha
+// cambiado entretanto se pide reintentar
+func (s *PaymentServiceImpl) applyCloseTransition(ctx context.Context, order *models.Order, from models.OrderStatus, registration *models.EventRegistration) error {
+	err := s.orderRepo.ApplyTransition(ctx, order, from, registration)
+	if errors.Is(err, repositories.ErrOrderStatusChanged) {
+		return common.NewBusinessError("order_in_progress", "El pedido se está procesando; inténtalo de nuevo")
+	}
+	return err
+}
+
+// RefundEvent cierra los pedidos abiertos de un evento cancelado. Antes los
+// marca para reembolso, de modo que los que fallen los reintenta la tarea de
+// reembolsos (RetryPendingRefunds). Los errores de cada pedido se registran y
+// no interrumpen el resto; es seguro repetirlo.
+func (s *PaymentServiceImpl) RefundEvent(ctx context.Context, eventID string) (int, error) {
+	if _, err := s.orderRepo.RequestEventRefunds(ctx, eventID, canceledEventRefundReason); err != nil {
+		return 0, err
+	}
+
+	orders, err := s.orderRepo.GetOpenByEvent(ctx, eventID)
+	if err != nil {
+		return 0, err
+	}
+
+	closed := 0
+	for _, order := range orders {
+		if err := s.CloseOrder(ctx, order, order.Registration, canceledEventRefundReason); err != nil {
+			logger.Errorf("Error cerrando el pedido %s del evento cancelado %s (se reintentará): %v", order.ID, eventID, err)
+			continue
+		}
+		closed++
+	}
+
+	return closed, nil
+}
+
+// RetryPendingRefunds cierra los pedidos marcados para reembolso que siguen
+// abiertos. Marca antes los de eventos cancelados que no lo estén (p. ej. si
+// falló la cancelación), así que ningún evento cancelado queda sin reembolsar
+func (s *PaymentServiceImpl) RetryPendingRefunds(ctx context.Context) (RefundRetryReport, error) {
+	var report RefundRetryReport
+	if _, err := s.orderRepo.RequestCanceledEventRefunds(ctx, canceledEventRefundReason); err != nil {
+		return report, err
+	}
+
+	orders, err := s.orderRepo.GetRefundPending(ctx, refundRetryBatchSize)
+	if err != nil {
+		return report, err
+	}
+
+	for _, order := range orders {
+		if err := s.CloseOrder(ctx, order, order.Registration, order.RefundReason); err != nil {
+			logger.Errorf("Error reintentando el reembolso del pedido %s: %v", order.ID, err)
+			report.Failed++
+			continue
+		}
+		report.Closed++
+	}
+	return report, nil
+}
+
+// confirmPayment marca el pedido como pagado y confirma la inscripción. Si el
+// pedido ya había fallado (p. ej. cancelado mientras se pagaba), el cobro se
+// registra y se reembolsa; si el reembolso falla lo reintenta la tarea
+// order_refunds
+func (s *PaymentServiceImpl) confirmPayment(ctx context.Context, order *models.Order) error {
+	switch order.Status {
+	case models.OrderStatusPaid, models.OrderStatusRefunding, models.OrderStatusRefunded:
+		return nil
+	case models.OrderStatusFailed:
+		return s.refundLatePayment(ctx, order)
+	}
+
+	if err := order.MarkPaid(time.Now()); err != nil {
+		return nil
+	}
+
+	if err := s.applyWebhookTransition(ctx, order, models.OrderStatusPending); err != nil {
+		return err
+	}
+
+	s.notify(ctx, order.UserID, models.NotificationTypePaymentConfirmed,
+		"Pago confirmado",
+		fmt.Sprintf("Tu pago de %s se ha completado y tu inscripción está confirmada", formatAmount(order.AmountCents, order.Currency)),
+		map[string]interface{}{"order_id": order.ID.String(), "event_id": order.EventID})
+
+	if _, err := s.invoices.IssueInvoice(ctx, order.ID.String()); err != nil {
+		logger.Warnf("No se pudo emitir la factura del pedido %s: %v", order.ID, err)
+	}
+	return nil
+}
+
+// refundLatePayment registra el cobro de un pedido fallido y lo reembolsa
+func (s *PaymentServiceImpl) refundLatePayment(ctx context.Context, order *models.Order) error {
+	if err := order.RecordLatePayment(latePaymentRefundReason, time.Now()); err != nil {
+		// Cobro ya registrado por otra notificación
+		return nil
+	}
+	if err := s.orderRepo.RecordLatePayment(ctx, order); err != nil {
+		if errors.Is(err, repositories.ErrOrderStatusChanged) {
+			return nil
+		}
+		return err
+	}
+
+	logger.Warnf("Pago recibido para el pedido fallido %s: se reembolsa", order.ID)
+	if err := s.refundOrder(ctx, order, nil, order.RefundReason); err != nil {
+		logger.Errorf("Error reembolsando el pago tardío del pedido %s (se reintentará): %v", order.ID, err)
+	}
+	return nil
+}
+
+// failPayment marca el pedido como fallido y libera la plaza
+func (s *PaymentServiceImpl) failPayment(ctx context.Context, order *models.Order, reason string) error {
+	if order.Status != models.OrderStatusPending {
+		return nil
+	}
+
+	if reason == "" {
+		reason = "Pago rechazado"
+	}
+	if err := order.MarkFailed(reason, time.Now()); err != nil {
+		return nil
+	}
+
+	return s.applyWebhookTransition(ctx, order, models.OrderStatusPending)
+}
+
+// completeRefund registra un reembolso iniciado desde el proveedor
+func (s *PaymentServiceImpl) completeRefund(ctx context.Context, order *models.Order, refundID string) error {
+	if order.Status != models.OrderStatusPaid {
+		return nil
+	}
+
+	if err := order.MarkRefunded(refundID, time.Now()); err != nil {
+		return nil
+	}
+
+	if err := s.applyWebhookTransition(ctx, order, models.OrderStatusPaid); err != nil {
+		return err
+	}
+
+	s.afterRefund(ctx, order, "")
+	return nil
+}
+
+// applyWebhookTransition persiste una transición originada por un webhook;
+// si otra entrega ya la procesó se considera completada
+func (s *PaymentServiceImpl) applyWebhookTransition(ctx context.Context, order *models.Order, from models.OrderStatus) error {
+	err := s.orderRepo.ApplyTransition(ctx, order, from, order.Registration)
+	if errors.Is(err, repositories.ErrOrderStatusChanged) {
+		logger.Infof("Pedido %s ya procesado por otra notificación", order.ID)
+		return nil
+	}
+	return err
+}
+
+// releaseWithoutOrder libera la plaza de una inscripción cuyo pedido no se pudo crear
+func (s *PaymentServiceImpl) releaseWithoutOrder(ctx context.Context, registration *models.EventRegistration) {
+	if err := s.registrationRepo.Release(ctx, registration); err != nil {
+		logger.Errorf("Error liberando la inscripción %s: %v", registration.ID, err)
+	}
+}
+
+// afterRefund emite la factura rectificativa y avisa al usuario de un
+// reembolso. Un pedido cobrado después de fallar no tiene factura que rectificar
+func (s *PaymentServiceImpl) afterRefund(ctx context.Context, order *models.Order, reason string) {
+	if order.FailedAt != nil {
+		s.notifyRefund(ctx, order, reason)
+		return
+	}
+	if _, err := s.invoices.IssueCreditNote(ctx, order.ID.String(), reason); err != nil {
+		logger.Warnf("No se pudo emitir la factura rectificativa del pedido %s: %v", order.ID, err)
+	}
+
+	s.notifyRefund(ctx, order, reason)
+}
+
+// notifyRefund avisa al usuario de un reembolso
+func (s *PaymentServiceImpl) notifyRefund(ctx context.Context, order *models.Order, reason string) {
+	message := fmt.Sprintf("Se ha reembolsado tu pago de %s", formatAmount(order.AmountCents, order.Currency))
+	if reason != "" {
+		message += ": " + reason
+	}
+
+	s.notify(ctx, order.UserID, models.NotificationTypeOrderRefunded, "Pago reembolsado", message,
+		map[string]interface{}{"order_id": order.ID.String(), "event_id": order.EventID})
+}
+
+// notify envía una notificación registrando los errores sin interrumpir el flujo
+func (s *PaymentServiceImpl) notify(ctx context.Context, userID string, notificationType models.NotificationType, title, message string, data map[string]interface{}) {
+	if err := s.notifications.Notify(ctx, userID, notificationType, title, message, data); err != nil {
+		logger.Warnf("Error enviando notificación %s al usuario %s: %v", notificationType, userID, err)
+	}
+}
+
+// formatAmount formatea un importe en céntimos (4900 EUR -> "49,00 EUR")
+func formatAmount(cents int, currency string) string {
+	sign := ""
+	if cents < 0 {
+		sign, cents = "-", -cents
+	}
+
+	amount := fmt.Sprintf("%s%d,%02d", sign, cents/100, cents%100)
+	if currency == "" {
+		return amount
+	}
+	return amount + " " + currency
+}
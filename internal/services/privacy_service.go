@@ -0,0 +1,467 @@
+// internal/services/privacy_service.go
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/dto"
+	"cybesphere-backend/internal/mappers"
+	"cybesphere-backend/internal/models"
+	"cybesphere-backend/internal/repositories"
+	"cybesphere-backend/pkg/dataexport"
+	"cybesphere-backend/pkg/logger"
+)
+
+const (
+	// dataExportTimeout tiempo máximo para generar un archivo; las exportaciones
+	// en curso más antiguas se dan por interrumpidas
+	dataExportTimeout = 30 * time.Minute
+
+	// dataExportAuditLimit registros de auditoría incluidos en un archivo
+	dataExportAuditLimit = 50000
+
+	// erasureBatchSize solicitudes vencidas que se ejecutan por pasada
+	erasureBatchSize = 100
+
+	// erasureCancelReason motivo de cierre de los pedidos de una cuenta suprimida
+	erasureCancelReason = "Inscripción cancelada por la supresión de la cuenta"
+)
+
+// PrivacyRetention plazos de conservación de los datos personales
+type PrivacyRetention struct {
+	ExportRetention    time.Duration // Tiempo que se puede descargar un archivo
+	ErasureGracePeriod time.Duration // Plazo hasta anonimizar una cuenta (0 = inmediato)
+}
+
+// PrivacyServiceImpl exportación de datos personales y supresión de cuentas (RGPD)
+type PrivacyServiceImpl struct {
+	exportRepo       *repositories.DataExportRepository
+	erasureRepo      *repositories.ErasureRequestRepository
+	userRepo         *repositories.UserRepository
+	refreshTokenRepo *repositories.RefreshTokenRepository
+	eventRepo        *repositories.EventRepository
+	orgRepo          *repositories.OrganizationRepository
+	registrationRepo *repositories.EventRegistrationRepository
+	auditRepo        *repositories.AuditLogRepository
+	payments         PaymentService
+	retention        PrivacyRetention
+}
+
+// Verificación en tiempo de compilación
+var _ PrivacyService = (*PrivacyServiceImpl)(nil)
+
+// NewPrivacyService crea el servicio de privacidad
+func NewPrivacyService(
+	exportRepo *repositories.DataExportRepository,
+	erasureRepo *repositories.ErasureRequestRepository,
+	userRepo *repositories.UserRepository,
+	refreshTokenRepo *repositories.RefreshTokenRepository,
+	eventRepo *repositories.EventRepository,
+	orgRepo *repositories.OrganizationRepository,
+	registrationRepo *repositories.EventRegistrationRepository,
+	auditRepo *repositories.AuditLogRepository,
+	payments PaymentService,
+	retention PrivacyRetention,
+) PrivacyService {
+	return &PrivacyServiceImpl{
+		exportRepo:       exportRepo,
+		erasureRepo:      erasureRepo,
+		userRepo:         userRepo,
+		refreshTokenRepo: refreshTokenRepo,
+		eventRepo:        eventRepo,
+		orgRepo:          orgRepo,
+		registrationRepo: registrationRepo,
+		auditRepo:        auditRepo,
+		payments:         payments,
+		retention:        retention,
+	}
+}
+
+// RequestDataExport pone en cola la generación del archivo con los datos del usuario
+func (s *PrivacyServiceImpl) RequestDataExport(ctx context.Context, userCtx *common.UserContext) (*models.DataExport, error) {
+	if userCtx == nil {
+		return nil, common.ErrUnauthorized
+	}
+
+	inProgress, err := s.exportRepo.HasInProgress(ctx, userCtx.ID, time.Now().Add(-dataExportTimeout))
+	if err != nil {
+		return nil, err
+	}
+	if inProgress {
+		return nil, common.NewBusinessError("export_in_progress", "Ya hay una exportación de tus datos en curso")
+	}
+
+	export := &models.DataExport{
+		UserID: userCtx.ID,
+		Status: models.DataExportStatusPending,
+	}
+	if err := s.exportRepo.Create(ctx, export); err != nil {
+		return nil, err
+	}
+
+	// Copia para no compartir la exportación con quien responde a la petición
+	job := *export
+	go s.generateDataExport(&job)
+
+	return export, nil
+}
+
+// ListDataExports exportaciones del usuario, de la más reciente a la más antigua
+func (s *PrivacyServiceImpl) ListDataExports(ctx context.Context, userCtx *common.UserContext) ([]*models.DataExport, error) {
+	if userCtx == nil {
+		return nil, common.ErrUnauthorized
+	}
+	return s.exportRepo.GetByUser(ctx, userCtx.ID)
+}
+
+// GetDataExport estado de una exportación del usuario
+func (s *PrivacyServiceImpl) GetDataExport(ctx context.Context, id string, userCtx *common.UserContext) (*models.DataExport, error) {
+	if userCtx == nil {
+		return nil, common.ErrUnauthorized
+	}
+	return s.exportRepo.GetForUser(ctx, id, userCtx.ID)
+}
+
+// DownloadDataExport exportación con su archivo, si aún puede descargarse
+func (s *PrivacyServiceImpl) DownloadDataExport(ctx context.Context, id string, userCtx *common.UserContext) (*models.DataExport, error) {
+	if userCtx == nil {
+		return nil, common.ErrUnauthorized
+	}
+
+	export, err := s.exportRepo.GetWithArchive(ctx, id, userCtx.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case export.IsDownloadable(time.Now()):
+		return export, nil
+	case export.IsInProgress():
+		return nil, common.NewBusinessError("export_not_ready", "El archivo aún se está generando")
+	case export.Status == models.DataExportStatusFailed:
+		return nil, common.NewBusinessError("export_failed", "No se pudo generar el archivo; solicita una nueva exportación")
+	default:
+		return nil, common.NewBusinessError("export_expired", "El archivo ha caducado; solicita una nueva exportación")
+	}
+}
+
+// PurgeExpiredExports borra los archivos caducados y da por fallidas las
+// exportaciones interrumpidas
+func (s *PrivacyServiceImpl) PurgeExpiredExports(ctx context.Context) (int64, error) {
+	if _, err := s.exportRepo.FailStale(ctx, time.Now().Add(-dataExportTimeout)); err != nil {
+		return 0, err
+	}
+	return s.exportRepo.ExpireReady(ctx, time.Now())
+}
+
+// RequestErasure registra la solicitud de supresión de la propia cuenta. Se
+// ejecuta al vencer el plazo de gracia, durante el que puede retirarse
+func (s *PrivacyServiceImpl) RequestErasure(ctx context.Context, req dto.AccountErasureRequest, userCtx *common.UserContext) (*models.ErasureRequest, error) {
+	if userCtx == nil {
+		return nil, common.ErrUnauthorized
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userCtx.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !user.CheckPassword(req.Password) {
+		return nil, common.NewValidationError("password", "Contraseña incorrecta")
+	}
+	if user.IsAdmin() {
+		return nil, common.NewBusinessError("admin_erasure_denied",
+			"Un administrador no puede suprimir su cuenta; otro administrador debe retirarle el rol antes")
+	}
+
+	if _, err := s.pendingErasure(ctx, userCtx.ID); err == nil {
+		return nil, common.NewBusinessError("erasure_already_requested", "Ya has solicitado la supresión de tu cuenta")
+	} else if !errors.Is(err, common.ErrNotFound) {
+		return nil, err
+	}
+
+	now := time.Now()
+	request := &models.ErasureRequest{
+		UserID:       userCtx.ID,
+		Reason:       req.Reason,
+		Status:       models.ErasureStatusPending,
+		ScheduledFor: now.Add(s.retention.ErasureGracePeriod),
+	}
+	if err := s.erasureRepo.Create(ctx, request); err != nil {
+		return nil, err
+	}
+
+	// Sin plazo de gracia la cuenta se anonimiza en el momento
+	if s.retention.ErasureGracePeriod == 0 {
+		if err := s.execute(ctx, request, user, userCtx.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	return request, nil
+}
+
+// GetErasureRequest última solicitud de supresión del usuario
+func (s *PrivacyServiceImpl) GetErasureRequest(ctx context.Context, userCtx *common.UserContext) (*models.ErasureRequest, error) {
+	if userCtx == nil {
+		return nil, common.ErrUnauthorized
+	}
+	return s.erasureRepo.GetLatestByUser(ctx, userCtx.ID)
+}
+
+// CancelOwnErasure retira la solicitud pendiente del usuario
+func (s *PrivacyServiceImpl) CancelOwnErasure(ctx context.Context, userCtx *common.UserContext) (*models.ErasureRequest, error) {
+	if userCtx == nil {
+		return nil, common.ErrUnauthorized
+	}
+
+	request, err := s.pendingErasure(ctx, userCtx.ID)
+	if err != nil {
+		return nil, err
+	}
+	return s.cancel(ctx, request, userCtx.ID)
+}
+
+// ListErasureRequests solicitudes de supresión por estado (solo admin)
+func (s *PrivacyServiceImpl) ListErasureRequests(ctx context.Context, status models.ErasureStatus, opts common.QueryOptions, userCtx *common.UserContext) ([]*models.ErasureRequest, *common.PaginationMeta, error) {
+	if !userCtx.IsAdmin() {
+		return nil, nil, common.ErrForbidden
+	}
+	if status == "" {
+		status = models.ErasureStatusPending
+	}
+	return s.erasureRepo.GetByStatus(ctx, status, opts)
+}
+
+// ExecuteErasure anonimiza la cuenta sin esperar al plazo de gracia (solo admin)
+func (s *PrivacyServiceImpl) ExecuteErasure(ctx context.Context, id string, userCtx *common.UserContext) (*models.ErasureRequest, error) {
+	if !userCtx.IsAdmin() {
+		return nil, common.ErrForbidden
+	}
+
+	request, err := s.erasureRepo.GetWithUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !request.IsPending() {
+		return nil, common.NewBusinessError("erasure_not_pending", "La solicitud ya se ha ejecutado o retirado")
+	}
+	if request.User == nil {
+		return nil, common.ErrNotFound
+	}
+	if request.User.IsAdmin() {
+		return nil, common.NewBusinessError("admin_erasure_denied",
+			"Retira el rol de administrador antes de suprimir la cuenta")
+	}
+
+	if err := s.execute(ctx, request, request.User, userCtx.ID); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+// CancelErasure retira una solicitud pendiente (solo admin)
+func (s *PrivacyServiceImpl) CancelErasure(ctx context.Context, id string, userCtx *common.UserContext) (*models.ErasureRequest, error) {
+	if !userCtx.IsAdmin() {
+		return nil, common.ErrForbidden
+	}
+
+	request, err := s.erasureRepo.GetWithUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.cancel(ctx, request, userCtx.ID)
+}
+
+// ProcessDueErasures anonimiza las cuentas cuyo plazo de gracia ha vencido.
+// Una solicitud fallida no detiene las demás; se reintenta en la siguiente pasada
+func (s *PrivacyServiceImpl) ProcessDueErasures(ctx context.Context) (int, error) {
+	requests, err := s.erasureRepo.GetDue(ctx, time.Now(), erasureBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	processed := 0
+	for _, request := range requests {
+		user, err := s.userRepo.GetByID(ctx, request.UserID)
+		if err != nil {
+			logger.Errorf("Error cargando el usuario de la solicitud de supresión %s: %v", request.ID, err)
+			continue
+		}
+		if user.IsAdmin() {
+			logger.Warnf("Solicitud de supresión %s aplazada: el usuario es administrador", request.ID)
+			continue
+		}
+		if err := s.execute(ctx, request, user, models.SystemActor); err != nil {
+			logger.Errorf("Error ejecutando la solicitud de supresión %s: %v", request.ID, err)
+			continue
+		}
+		processed++
+	}
+	return processed, nil
+}
+
+// pendingErasure solicitud pendiente del usuario (ErrNotFound si no hay)
+func (s *PrivacyServiceImpl) pendingErasure(ctx context.Context, userID string) (*models.ErasureRequest, error) {
+	request, err := s.erasureRepo.GetLatestByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !request.IsPending() {
+		return nil, common.ErrNotFound
+	}
+	return request, nil
+}
+
+// execute cancela las inscripciones futuras, anonimiza al usuario y marca la
+// solicitud como completada
+func (s *PrivacyServiceImpl) execute(ctx context.Context, request *models.ErasureRequest, user *models.User, by string) error {
+	if err := s.cancelUpcomingRegistrations(ctx, user.ID.String()); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if err := user.Anonymize(now); err != nil {
+		logger.Errorf("Error anonimizando el usuario %s: %v", user.ID, err)
+		return common.ErrInternalError
+	}
+	if err := request.MarkCompleted(by, now); err != nil {
+		return common.NewBusinessError("erasure_not_pending", "La solicitud ya se ha ejecutado o retirado")
+	}
+
+	if err := s.erasureRepo.Execute(ctx, request, user); err != nil {
+		return err
+	}
+	logger.Infof("Cuenta %s anonimizada (solicitud %s)", user.ID, request.ID)
+	return nil
+}
+
+// cancelUpcomingRegistrations cancela las inscripciones del usuario en eventos
+// que aún no han empezado para liberar sus plazas. Los pedidos abiertos se
+// cierran como en una cancelación del usuario (los pagados se reembolsan); si
+// alguno falla la supresión se aplaza y se reintenta en la siguiente pasada
+func (s *PrivacyServiceImpl) cancelUpcomingRegistrations(ctx context.Context, userID string) error {
+	registrations, err := s.registrationRepo.GetUpcomingActiveByUser(ctx, userID, time.Now())
+	if err != nil {
+		return err
+	}
+
+	for _, registration := range registrations {
+		if registration.Order != nil && !registration.Order.IsFinal() {
+			if err := s.payments.CloseOrder(ctx, registration.Order, registration, erasureCancelReason); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := s.registrationRepo.Release(ctx, registration); err != nil && !errors.Is(err, common.ErrNotFound) {
+			return err
+		}
+	}
+	return nil
+}
+
+// cancel retira una solicitud pendiente
+func (s *PrivacyServiceImpl) cancel(ctx context.Context, request *models.ErasureRequest, by string) (*models.ErasureRequest, error) {
+	if err := request.MarkCanceled(by, time.Now()); err != nil {
+		return nil, common.NewBusinessError("erasure_not_pending", "La solicitud ya se ha ejecutado o retirado")
+	}
+	if err := s.erasureRepo.Update(ctx, request); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+// generateDataExport genera el archivo en segundo plano
+func (s *PrivacyServiceImpl) generateDataExport(export *models.DataExport) {
+	ctx, cancel := context.WithTimeout(context.Background(), dataExportTimeout)
+	defer cancel()
+
+	export.Status = models.DataExportStatusProcessing
+	if err := s.exportRepo.Update(ctx, export); err != nil {
+		logger.Errorf("Error iniciando la exportación de datos %s: %v", export.ID, err)
+		return
+	}
+
+	archive, err := s.buildArchive(ctx, export.UserID)
+	now := time.Now()
+	if err == nil {
+		err = export.MarkReady(archive, dataexport.Checksum(archive), now, s.retention.ExportRetention)
+	}
+	if err != nil {
+		logger.Errorf("Error generando la exportación de datos %s: %v", export.ID, err)
+		if markErr := export.MarkFailed("No se pudo generar el archivo", now); markErr != nil {
+			return
+		}
+	}
+
+	if err := s.exportRepo.Update(ctx, export); err != nil {
+		logger.Errorf("Error guardando la exportación de datos %s: %v", export.ID, err)
+	}
+}
+
+// buildArchive reúne los datos personales del usuario en un archivo ZIP
+func (s *PrivacyServiceImpl) buildArchive(ctx context.Context, userID string) ([]byte, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	tokens, err := s.refreshTokenRepo.GetAllByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	favorites, err := s.eventRepo.GetFavoriteEvents(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	followed, err := s.orgRepo.GetFollowed(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	registrations, err := s.registrationRepo.GetAllByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	auditLogs, err := s.auditRepo.GetBySubject(ctx, userID, dataExportAuditLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	eventMapper := mappers.NewEventMapper()
+	favoriteResponses := make([]dto.EventSummaryResponse, 0, len(favorites))
+	for _, event := range favorites {
+		favoriteResponses = append(favoriteResponses, eventMapper.EventToSummaryResponse(event))
+	}
+
+	orgMapper := mappers.NewOrganizationMapper()
+	followedResponses := make([]dto.OrganizationSummaryResponse, 0, len(followed))
+	for _, org := range followed {
+		followedResponses = append(followedResponses, orgMapper.OrganizationToSummaryResponse(org))
+	}
+
+	ticketingMapper := mappers.NewTicketingMapper()
+	registrationResponses := make([]dto.RegistrationResponse, 0, len(registrations))
+	for _, registration := range registrations {
+		registrationResponses = append(registrationResponses, ticketingMapper.RegistrationToResponse(registration))
+	}
+
+	archive := dataexport.New(userID, time.Now())
+	files := []struct {
+		name string
+		data any
+	}{
+		{"profile.json", user},
+		{"sessions.json", mappers.NewAuthMapper().RefreshTokensToSessionList(tokens, "").Sessions},
+		{"favorites.json", favoriteResponses},
+		{"followed_organizations.json", followedResponses},
+		{"registrations.json", registrationResponses},
+		{"audit_logs.json", auditLogs},
+	}
+	for _, file := range files {
+		if err := archive.AddJSON(file.name, file.data); err != nil {
+			return nil, err
+		}
+	}
+	return archive.Bytes()
+}
@@ -0,0 +1,207 @@
+// internal/services/recommendation_service.go
+package services
+
+import (
+	"context"
+	"time"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/models"
+	"cybesphere-backend/internal/repositories"
+	"cybesphere-backend/pkg/geo"
+	"cybesphere-backend/pkg/recommend"
+)
+
+// recommendationCandidatesLimit eventos próximos que se puntúan en cada petición
+const recommendationCandidatesLimit = 500
+
+// recommendationEvaluationLimit eventos que se cargan para la evaluación offline
+const recommendationEvaluationLimit = 10000
+
+// RecommendedEvent evento recomendado con su puntuación y motivos
+type RecommendedEvent struct {
+	Event *models.Event
+	recommend.Recommendation
+}
+
+// RecommendationsResult página de eventos recomendados, del más al menos afín
+type RecommendationsResult struct {
+	Events     []RecommendedEvent
+	Pagination *common.PaginationMeta
+}
+
+// RecommendationEvaluation métricas del recomendador frente a una ordenación
+// solo por popularidad
+type RecommendationEvaluation struct {
+	Model    recommend.Metrics
+	Baseline recommend.Metrics
+}
+
+// RecommendationServiceImpl recomienda eventos a partir de los favoritos, el
+// puesto, la ubicación y las organizaciones seguidas del usuario
+type RecommendationServiceImpl struct {
+	eventRepo *repositories.EventRepository
+	orgRepo   *repositories.OrganizationRepository
+	userRepo  *repositories.UserRepository
+	weights   recommend.Weights
+}
+
+// Verificación en tiempo de compilación
+var _ RecommendationService = (*RecommendationServiceImpl)(nil)
+
+// NewRecommendationService crea el servicio de recomendaciones
+func NewRecommendationService(
+	eventRepo *repositories.EventRepository,
+	orgRepo *repositories.OrganizationRepository,
+	userRepo *repositories.UserRepository,
+) RecommendationService {
+	return &RecommendationServiceImpl{
+		eventRepo: eventRepo,
+		orgRepo:   orgRepo,
+		userRepo:  userRepo,
+		weights:   recommend.DefaultWeights,
+	}
+}
+
+// GetRecommendations ordena los próximos eventos públicos para el usuario. Sin
+// señales (usuario nuevo) el orden es por popularidad
+func (s *RecommendationServiceImpl) GetRecommendations(ctx context.Context, opts common.QueryOptions, userCtx *common.UserContext) (*RecommendationsResult, error) {
+	if userCtx == nil {
+		return nil, common.ErrUnauthorized
+	}
+
+	user, err := s.loadUser(ctx, userCtx.ID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := s.eventRepo.GetRecommendationCandidates(ctx, time.Now(), recommendationCandidatesLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[string]*models.Event, len(events))
+	candidates := make([]recommend.Item, 0, len(events))
+	for _, event := range events {
+		byID[event.ID.String()] = event
+		candidates = append(candidates, eventToItem(event))
+	}
+
+	ranked := recommend.Rank(recommend.BuildProfile(user), candidates, s.weights)
+
+	start := min(opts.Offset, len(ranked))
+	end := min(start+opts.Limit, len(ranked))
+	result := &RecommendationsResult{
+		Events:     make([]RecommendedEvent, 0, end-start),
+		Pagination: common.NewPaginationMeta(opts.Page, opts.Limit, int64(len(ranked))),
+	}
+	for _, rec := range ranked[start:end] {
+		result.Events = append(result.Events, RecommendedEvent{Event: byID[rec.Item.ID], Recommendation: rec})
+	}
+
+	return result, nil
+}
+
+// Evaluate mide offline la calidad del recomendador ocultando por turnos cada
+// favorito de los usuarios con al menos dos y comprobando si vuelve a aparecer
+// entre los k primeros. Se comparan los pesos por defecto con una ordenación
+// solo por popularidad
+func (s *RecommendationServiceImpl) Evaluate(ctx context.Context, k int) (*RecommendationEvaluation, error) {
+	events, err := s.eventRepo.GetRecommendationCandidates(ctx, time.Time{}, recommendationEvaluationLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make(map[string]recommend.Item, len(events))
+	candidates := make([]recommend.Item, 0, len(events))
+	for _, event := range events {
+		item := eventToItem(event)
+		items[item.ID] = item
+		candidates = append(candidates, item)
+	}
+
+	favorites, err := s.eventRepo.GetFavoriteEventIDsByUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]recommend.User, 0, len(favorites))
+	for userID, eventIDs := range favorites {
+		// Solo cuentan los favoritos que están entre los candidatos
+		known := make([]recommend.Item, 0, len(eventIDs))
+		for _, id := range eventIDs {
+			if item, ok := items[id]; ok {
+				known = append(known, item)
+			}
+		}
+
+		user, err := s.loadUser(ctx, userID, known)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, user)
+	}
+
+	return &RecommendationEvaluation{
+		Model:    recommend.LeaveOneOut(users, candidates, recommend.NewRanker(s.weights), k),
+		Baseline: recommend.LeaveOneOut(users, candidates, recommend.NewRanker(recommend.Weights{}), k),
+	}, nil
+}
+
+// loadUser reúne las señales del usuario; si favorites es nil se cargan sus favoritos
+func (s *RecommendationServiceImpl) loadUser(ctx context.Context, userID string, favorites []recommend.Item) (recommend.User, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return recommend.User{}, err
+	}
+
+	signals := recommend.User{
+		Position:  user.Position,
+		Favorites: favorites,
+	}
+	if user.HasLocation() {
+		signals.Location = &geo.Point{Latitude: *user.Latitude, Longitude: *user.Longitude}
+	}
+
+	if favorites == nil {
+		events, err := s.eventRepo.GetFavoriteEvents(ctx, userID)
+		if err != nil {
+			return recommend.User{}, err
+		}
+		for _, event := range events {
+			signals.Favorites = append(signals.Favorites, eventToItem(event))
+		}
+	}
+
+	followed, err := s.orgRepo.GetFollowed(ctx, userID)
+	if err != nil {
+		return recommend.User{}, err
+	}
+	signals.FollowedOrganizations = make(map[string]string, len(followed))
+	for _, org := range followed {
+		signals.FollowedOrganizations[org.ID.String()] = org.Name
+	}
+
+	return signals, nil
+}
+
+// eventToItem convierte un evento en candidato del recomendador
+func eventToItem(event *models.Event) recommend.Item {
+	item := recommend.Item{
+		ID:             event.ID.String(),
+		Title:          event.Title,
+		Tags:           event.GetTags(),
+		Category:       event.Category,
+		Level:          event.Level,
+		OrganizationID: event.OrganizationID,
+		StartDate:      event.StartDate,
+		Popularity:     float64(event.ViewsCount),
+	}
+	if event.Organization != nil {
+		item.OrganizationName = event.Organization.Name
+	}
+	if !event.IsOnline && event.HasLocation() {
+		item.Location = &geo.Point{Latitude: *event.Latitude, Longitude: *event.Longitude}
+	}
+	return item
+}
@@ -0,0 +1,144 @@
+package services
+
+import (
+	"context"
+	"strings"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/dto"
+	"cybesphere-backend/internal/query"
+	"cybesphere-backend/internal/repositories"
+)
+
+const (
+	// searchDefaultLimit resultados por página si no se indica otro valor
+	searchDefaultLimit = 20
+
+	// searchMaxTags tags coincidentes devueltos por búsqueda
+	searchMaxTags = 10
+
+	// searchSuggestionThreshold por debajo de este número de resultados se
+	// proponen términos alternativos
+	searchSuggestionThreshold = 3
+
+	// searchMaxSuggestions sugerencias devueltas como máximo
+	searchMaxSuggestions = 5
+)
+
+// Ámbitos de búsqueda
+const (
+	SearchScopeAll           = "all"
+	SearchScopeEvents        = "events"
+	SearchScopeOrganizations = "organizations"
+	SearchScopeTags          = "tags"
+)
+
+// SearchResult resultados de una búsqueda full-text
+type SearchResult struct {
+	Query              string
+	Events             []*repositories.EventSearchHit
+	EventsTotal        int64
+	Organizations      []*repositories.OrganizationSearchHit
+	OrganizationsTotal int64
+	Tags               []query.FacetCount
+	Facets             *repositories.EventFacets // nil si no se buscan eventos
+	Suggestions        []string
+	Pagination         *common.PaginationMeta
+}
+
+// Total número total de resultados de todos los tipos
+func (r *SearchResult) Total() int64 {
+	return r.EventsTotal + r.OrganizationsTotal + int64(len(r.Tags))
+}
+
+// SearchServiceImpl implementación del servicio de búsqueda
+type SearchServiceImpl struct {
+	searchRepo *repositories.SearchRepository
+}
+
+// Verificación en tiempo de compilación de que SearchServiceImpl implementa SearchService
+var _ SearchService = (*SearchServiceImpl)(nil)
+
+// NewSearchService crea una nueva instancia del servicio de búsqueda
+func NewSearchService(searchRepo *repositories.SearchRepository) SearchService {
+	return &SearchServiceImpl{searchRepo: searchRepo}
+}
+
+// Search busca eventos, organizaciones y tags ordenados por relevancia
+func (s *SearchServiceImpl) Search(ctx context.Context, req dto.SearchRequest) (*SearchResult, error) {
+	text := strings.Join(strings.Fields(req.Query), " ")
+	if len([]rune(text)) < 2 {
+		return nil, common.NewValidationError("q", "la búsqueda debe tener al menos 2 caracteres")
+	}
+
+	scope := req.Scope
+	if scope == "" {
+		scope = SearchScopeAll
+	}
+	page := req.Page
+	if page < 1 {
+		page = 1
+	}
+	limit := req.Limit
+	if limit < 1 {
+		limit = searchDefaultLimit
+	}
+
+	params := repositories.SearchParams{
+		Query:  text,
+		Type:   req.Type,
+		Level:  req.Level,
+		City:   strings.TrimSpace(req.City),
+		Tag:    strings.TrimSpace(req.Tag),
+		Limit:  limit,
+		Offset: (page - 1) * limit,
+	}
+	result := &SearchResult{
+		Query:         text,
+		Events:        []*repositories.EventSearchHit{},
+		Organizations: []*repositories.OrganizationSearchHit{},
+		Tags:          []query.FacetCount{},
+	}
+
+	var err error
+	if scope == SearchScopeAll || scope == SearchScopeEvents {
+		if result.Events, result.EventsTotal, err = s.searchRepo.SearchEvents(ctx, params); err != nil {
+			return nil, err
+		}
+		if result.Facets, err = s.searchRepo.EventFacets(ctx, params); err != nil {
+			return nil, err
+		}
+	}
+	if scope == SearchScopeAll || scope == SearchScopeOrganizations {
+		if result.Organizations, result.OrganizationsTotal, err = s.searchRepo.SearchOrganizations(ctx, params); err != nil {
+			return nil, err
+		}
+	}
+	// Los tags no se paginan: solo se devuelven en la primera página
+	if (scope == SearchScopeAll || scope == SearchScopeTags) && page == 1 {
+		if result.Tags, err = s.searchRepo.SearchTags(ctx, text, searchMaxTags); err != nil {
+			return nil, err
+		}
+	}
+
+	total := result.EventsTotal
+	if result.OrganizationsTotal > total {
+		total = result.OrganizationsTotal
+	}
+	result.Pagination = common.NewPaginationMeta(page, limit, total)
+
+	if result.Total() < searchSuggestionThreshold {
+		suggestions, err := s.searchRepo.Suggest(ctx, text, searchMaxSuggestions+1)
+		if err != nil {
+			return nil, err
+		}
+		result.Suggestions = make([]string, 0, len(suggestions))
+		for _, suggestion := range suggestions {
+			if !strings.EqualFold(suggestion, text) && len(result.Suggestions) < searchMaxSuggestions {
+				result.Suggestions = append(result.Suggestions, suggestion)
+			}
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,677 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/dto"
+	"cybesphere-backend/internal/models"
+	"cybesphere-backend/internal/repositories"
+)
+
+// TicketSelection entrada elegida por el usuario con el precio calculado
+type TicketSelection struct {
+	Event      *models.Event
+	TicketType *models.TicketType // nil en eventos sin tipos de entrada
+	PromoCode  *models.PromoCode
+	Quote      models.PriceQuote
+}
+
+// TicketingServiceImpl implementación del servicio de entradas e inscripciones
+type TicketingServiceImpl struct {
+	ticketRepo       *repositories.TicketTypeRepository
+	promoRepo        *repositories.PromoCodeRepository
+	registrationRepo *repositories.EventRegistrationRepository
+	eventRepo        *repositories.EventRepository
+	payments         PaymentService
+}
+
+// Verificación en tiempo de compilación de que TicketingServiceImpl implementa TicketingService
+var _ TicketingService = (*TicketingServiceImpl)(nil)
+
+// NewTicketingService crea una nueva instancia del servicio de entradas
+func NewTicketingService(
+	ticketRepo *repositories.TicketTypeRepository,
+	promoRepo *repositories.PromoCodeRepository,
+	registrationRepo *repositories.EventRegistrationRepository,
+	eventRepo *repositories.EventRepository,
+	payments PaymentService,
+) TicketingService {
+	return &TicketingServiceImpl{
+		ticketRepo:       ticketRepo,
+		promoRepo:        promoRepo,
+		registrationRepo: registrationRepo,
+		eventRepo:        eventRepo,
+		payments:         payments,
+	}
+}
+
+// =============================================================================
+// TIPOS DE ENTRADA
+// =============================================================================
+
+// ListTicketTypes lista las entradas de un evento (los gestores ven también las inactivas)
+func (s *TicketingServiceImpl) ListTicketTypes(ctx context.Context, eventID string, userCtx *common.UserContext) (*models.Event, []*models.TicketType, error) {
+	event, err := s.getVisibleEvent(ctx, eventID, userCtx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	activeOnly := userCtx == nil || !userCtx.CanManageOrganization(event.OrganizationID)
+	ticketTypes, err := s.ticketRepo.GetByEvent(ctx, eventID, activeOnly)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return event, ticketTypes, nil
+}
+
+// CreateTicketType añade un tipo de entrada a un evento
+func (s *TicketingServiceImpl) CreateTicketType(ctx context.Context, eventID string, req dto.CreateTicketTypeRequest, userCtx *common.UserContext) (*models.TicketType, error) {
+	event, err := s.getManagedEvent(ctx, eventID, userCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	ticketType := &models.TicketType{
+		EventID:        eventID,
+		Name:           req.Name,
+		Description:    req.Description,
+		PriceCents:     req.PriceCents,
+		Currency:       event.Currency,
+		Quantity:       req.Quantity,
+		SalesStartDate: req.SalesStartDate,
+		SalesEndDate:   req.SalesEndDate,
+		IsActive:       req.IsActive == nil || *req.IsActive,
+		SortOrder:      req.SortOrder,
+	}
+
+	if err := ticketType.ValidateTicketType(); err != nil {
+		return nil, common.NewValidationError("ticket_type", err.Error())
+	}
+
+	if err := s.ticketRepo.Create(ctx, ticketType); err != nil {
+		return nil, err
+	}
+
+	if err := s.syncEventPricing(ctx, event); err != nil {
+		return nil, err
+	}
+
+	return ticketType, nil
+}
+
+// UpdateTicketType modifica un tipo de entrada
+func (s *TicketingServiceImpl) UpdateTicketType(ctx context.Context, eventID, ticketTypeID string, req dto.UpdateTicketTypeRequest, userCtx *common.UserContext) (*models.TicketType, error) {
+	event, err := s.getManagedEvent(ctx, eventID, userCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	ticketType, err := s.getEventTicketType(ctx, eventID, ticketTypeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		ticketType.Name = *req.Name
+	}
+	if req.Description != nil {
+		ticketType.Description = *req.Description
+	}
+	if req.PriceCents != nil {
+		ticketType.PriceCents = *req.PriceCents
+	}
+	if req.Unlimited {
+		ticketType.Quantity = nil
+	} else if req.Quantity != nil {
+		ticketType.Quantity = req.Quantity
+	}
+	if req.SalesStartDate != nil {
+		ticketType.SalesStartDate = req.SalesStartDate
+	}
+	if req.SalesEndDate != nil {
+		ticketType.SalesEndDate = req.SalesEndDate
+	}
+	if req.IsActive != nil {
+		ticketType.IsActive = *req.IsActive
+	}
+	if req.SortOrder != nil {
+		ticketType.SortOrder = *req.SortOrder
+	}
+
+	if err := ticketType.ValidateTicketType(); err != nil {
+		return nil, common.NewValidationError("ticket_type", err.Error())
+	}
+
+	if err := s.ticketRepo.UpdateDetails(ctx, ticketType); err != nil {
+		if errors.Is(err, repositories.ErrTicketQuantityBelowSold) {
+			return nil, common.NewValidationError("quantity", "El cupo no puede ser menor que las entradas ya vendidas")
+		}
+		return nil, err
+	}
+
+	if err := s.syncEventPricing(ctx, event); err != nil {
+		return nil, err
+	}
+
+	return ticketType, nil
+}
+
+// DeleteTicketType elimina un tipo de entrada sin ventas
+func (s *TicketingServiceImpl) DeleteTicketType(ctx context.Context, eventID, ticketTypeID string, userCtx *common.UserContext) error {
+	event, err := s.getManagedEvent(ctx, eventID, userCtx)
+	if err != nil {
+		return err
+	}
+
+	ticketType, err := s.getEventTicketType(ctx, eventID, ticketTypeID)
+	if err != nil {
+		return err
+	}
+
+	if ticketType.QuantitySold > 0 {
+		return common.NewBusinessError("ticket_type_in_use",
+			"La entrada ya tiene inscripciones; desactívala en lugar de eliminarla")
+	}
+
+	if err := s.ticketRepo.Delete(ctx, ticketTypeID); err != nil {
+		return err
+	}
+
+	return s.syncEventPricing(ctx, event)
+}
+
+// =============================================================================
+// CÓDIGOS DE DESCUENTO
+// =============================================================================
+
+// ListPromoCodes lista los códigos de descuento de un evento
+func (s *TicketingServiceImpl) ListPromoCodes(ctx context.Context, eventID string, userCtx *common.UserContext) ([]*models.PromoCode, error) {
+	if _, err := s.getManagedEvent(ctx, eventID, userCtx); err != nil {
+		return nil, err
+	}
+
+	return s.promoRepo.GetByEvent(ctx, eventID)
+}
+
+// CreatePromoCode crea un código de descuento para un evento
+func (s *TicketingServiceImpl) CreatePromoCode(ctx context.Context, eventID string, req dto.CreatePromoCodeRequest, userCtx *common.UserContext) (*models.PromoCode, error) {
+	if _, err := s.getManagedEvent(ctx, eventID, userCtx); err != nil {
+		return nil, err
+	}
+
+	promo := &models.PromoCode{
+		EventID:      eventID,
+		Code:         models.NormalizePromoCode(req.Code),
+		DiscountType: models.DiscountType(req.DiscountType),
+		MaxUses:      req.MaxUses,
+		StartsAt:     req.StartsAt,
+		ExpiresAt:    req.ExpiresAt,
+		IsActive:     req.IsActive == nil || *req.IsActive,
+	}
+
+	value, err := discountValue(promo.DiscountType, req.PercentOff, req.AmountOffCents)
+	if err != nil {
+		return nil, err
+	}
+	promo.DiscountValue = value
+
+	if err := s.setPromoTicketTypes(ctx, promo, req.TicketTypeIDs); err != nil {
+		return nil, err
+	}
+
+	if err := promo.ValidatePromoCode(); err != nil {
+		return nil, common.NewValidationError("promo_code", err.Error())
+	}
+
+	exists, err := s.promoRepo.CodeExists(ctx, eventID, promo.Code, "")
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, common.NewBusinessError("promo_code_exists", "Ya existe un código con ese nombre en el evento")
+	}
+
+	if err := s.promoRepo.Create(ctx, promo); err != nil {
+		return nil, err
+	}
+
+	return promo, nil
+}
+
+// UpdatePromoCode modifica un código de descuento
+func (s *TicketingServiceImpl) UpdatePromoCode(ctx context.Context, eventID, promoCodeID string, req dto.UpdatePromoCodeRequest, userCtx *common.UserContext) (*models.PromoCode, error) {
+	if _, err := s.getManagedEvent(ctx, eventID, userCtx); err != nil {
+		return nil, err
+	}
+
+	promo, err := s.getEventPromoCode(ctx, eventID, promoCodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.PercentOff != nil || req.AmountOffCents != nil {
+		value, err := discountValue(promo.DiscountType, req.PercentOff, req.AmountOffCents)
+		if err != nil {
+			return nil, err
+		}
+		promo.DiscountValue = value
+	}
+	if req.Unlimited {
+		promo.MaxUses = nil
+	} else if req.MaxUses != nil {
+		if *req.MaxUses < promo.UsedCount {
+			return nil, common.NewValidationError("max_uses", "El límite no puede ser menor que los usos ya realizados")
+		}
+		promo.MaxUses = req.MaxUses
+	}
+	if req.StartsAt != nil {
+		promo.StartsAt = req.StartsAt
+	}
+	if req.ExpiresAt != nil {
+		promo.ExpiresAt = req.ExpiresAt
+	}
+	if req.TicketTypeIDs != nil {
+		if err := s.setPromoTicketTypes(ctx, promo, *req.TicketTypeIDs); err != nil {
+			return nil, err
+		}
+	}
+	if req.IsActive != nil {
+		promo.IsActive = *req.IsActive
+	}
+
+	if err := promo.ValidatePromoCode(); err != nil {
+		return nil, common.NewValidationError("promo_code", err.Error())
+	}
+
+	if err := s.promoRepo.UpdateDetails(ctx, promo); err != nil {
+		if errors.Is(err, repositories.ErrPromoMaxUsesBelowUsed) {
+			return nil, common.NewValidationError("max_uses", "El límite no puede ser menor que los usos ya realizados")
+		}
+		return nil, err
+	}
+
+	return promo, nil
+}
+
+// DeletePromoCode elimina un código de descuento (las inscripciones conservan el importe aplicado)
+func (s *TicketingServiceImpl) DeletePromoCode(ctx context.Context, eventID, promoCodeID string, userCtx *common.UserContext) error {
+	if _, err := s.getManagedEvent(ctx, eventID, userCtx); err != nil {
+		return err
+	}
+
+	if _, err := s.getEventPromoCode(ctx, eventID, promoCodeID); err != nil {
+		return err
+	}
+
+	return s.promoRepo.Delete(ctx, promoCodeID)
+}
+
+// =============================================================================
+// INSCRIPCIONES
+// =============================================================================
+
+// QuotePrice calcula el precio de una entrada sin reservarla
+func (s *TicketingServiceImpl) QuotePrice(ctx context.Context, eventID string, req dto.TicketSelectionRequest, userCtx *common.UserContext) (*TicketSelection, error) {
+	event, err := s.getVisibleEvent(ctx, eventID, userCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.resolveSelection(ctx, event, req, time.Now())
+}
+
+// Register inscribe al usuario en un evento reservando la entrada elegida.
+// Las inscripciones gratuitas quedan confirmadas; las de pago, pendientes.
+func (s *TicketingServiceImpl) Register(ctx context.Context, eventID string, req dto.TicketSelectionRequest, userCtx *common.UserContext) (*models.EventRegistration, error) {
+	if userCtx == nil {
+		return nil, common.ErrUnauthorized
+	}
+
+	event, err := s.getVisibleEvent(ctx, eventID, userCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !event.IsRegistrationOpen() {
+		return nil, common.NewBusinessError("registration_closed", "Las inscripciones del evento no están abiertas")
+	}
+
+	selection, err := s.resolveSelection(ctx, event, req, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	registration := &models.EventRegistration{
+		EventID: eventID,
+		UserID:  userCtx.ID,
+	}
+	if selection.TicketType != nil {
+		ticketTypeID := selection.TicketType.ID.String()
+		registration.TicketTypeID = &ticketTypeID
+	}
+	if selection.PromoCode != nil {
+		promoCodeID := selection.PromoCode.ID.String()
+		registration.PromoCodeID = &promoCodeID
+	}
+	registration.ApplyPrice(selection.Quote)
+
+	if err := s.registrationRepo.Reserve(ctx, registration); err != nil {
+		return nil, registrationError(err)
+	}
+
+	// Las inscripciones de pago quedan pendientes hasta que se confirme el pedido
+	if registration.Status == models.RegistrationStatusPending {
+		order, err := s.payments.CreateOrder(ctx, registration, event, billingDetails(req.Billing))
+		if err != nil {
+			return nil, err
+		}
+		registration.Order = order
+	}
+
+	registration.TicketType = selection.TicketType
+	return registration, nil
+}
+
+// CancelRegistration cancela la inscripción activa del usuario y libera su plaza
+func (s *TicketingServiceImpl) CancelRegistration(ctx context.Context, eventID string, userCtx *common.UserContext) (*models.EventRegistration, error) {
+	if userCtx == nil {
+		return nil, common.ErrUnauthorized
+	}
+
+	registration, err := s.registrationRepo.GetActiveByEventAndUser(ctx, eventID, userCtx.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Si hay un pedido abierto se cierra (y reembolsa si estaba pagado) junto con la inscripción
+	if registration.Order != nil && !registration.Order.IsFinal() {
+		if err := s.payments.CloseOrder(ctx, registration.Order, registration, "Inscripción cancelada por el usuario"); err != nil {
+			return nil, err
+		}
+		return registration, nil
+	}
+
+	if err := s.registrationRepo.Release(ctx, registration); err != nil {
+		return nil, err
+	}
+
+	return registration, nil
+}
+
+// ListMyRegistrations lista las inscripciones del usuario
+func (s *TicketingServiceImpl) ListMyRegistrations(ctx context.Context, opts common.QueryOptions, userCtx *common.UserContext) ([]*models.EventRegistration, *common.PaginationMeta, error) {
+	if userCtx == nil {
+		return nil, nil, common.ErrUnauthorized
+	}
+
+	return s.registrationRepo.GetByUser(ctx, userCtx.ID, opts)
+}
+
+// ListRegistrations lista los asistentes de un evento con el recuento por estado
+func (s *TicketingServiceImpl) ListRegistrations(ctx context.Context, eventID string, opts common.QueryOptions, userCtx *common.UserContext) (*models.Event, []*models.EventRegistration, *common.PaginationMeta, map[models.RegistrationStatus]int, error) {
+	event, err := s.getManagedEvent(ctx, eventID, userCtx)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	registrations, pagination, err := s.registrationRepo.GetByEvent(ctx, eventID, opts)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	counts, err := s.registrationRepo.CountByStatus(ctx, eventID)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	return event, registrations, pagination, counts, nil
+}
+
+// =============================================================================
+// HELPERS
+// =============================================================================
+
+// resolveSelection valida la entrada y el código elegidos y calcula el precio.
+// Los eventos sin tipos de entrada usan el precio único del evento.
+func (s *TicketingServiceImpl) resolveSelection(ctx context.Context, event *models.Event, req dto.TicketSelectionRequest, now time.Time) (*TicketSelection, error) {
+	eventID := event.ID.String()
+	selection := &TicketSelection{Event: event}
+
+	ticketTypes, err := s.ticketRepo.GetByEvent(ctx, eventID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	priceCents, currency := 0, event.Currency
+	switch {
+	case len(ticketTypes) == 0:
+		if req.TicketTypeID != "" {
+			return nil, common.NewValidationError("ticket_type_id", "El evento no tiene tipos de entrada")
+		}
+		if !event.IsFree && event.Price != nil {
+			priceCents = *event.Price
+		}
+	case req.TicketTypeID == "" && len(ticketTypes) > 1:
+		return nil, common.NewValidationError("ticket_type_id", "Debes elegir un tipo de entrada")
+	default:
+		ticketType := ticketTypes[0]
+		if req.TicketTypeID != "" {
+			ticketType = nil
+			for _, candidate := range ticketTypes {
+				if candidate.ID.String() == req.TicketTypeID {
+					ticketType = candidate
+					break
+				}
+			}
+			if ticketType == nil {
+				return nil, common.NewValidationError("ticket_type_id", "Tipo de entrada no disponible")
+			}
+		}
+
+		if ticketType.IsSoldOut() {
+			return nil, common.NewBusinessError("ticket_sold_out", "Las entradas de este tipo están agotadas")
+		}
+		if !ticketType.IsOnSale(now) {
+			return nil, common.NewBusinessError("ticket_not_on_sale", "Este tipo de entrada no está a la venta en este momento")
+		}
+
+		selection.TicketType = ticketType
+		priceCents, currency = ticketType.PriceCents, ticketType.Currency
+	}
+
+	// Un código sobre una entrada gratuita no aporta nada y no debe consumir usos
+	if code := models.NormalizePromoCode(req.PromoCode); code != "" && priceCents > 0 {
+		promo, err := s.resolvePromoCode(ctx, eventID, code, selection.TicketType, now)
+		if err != nil {
+			return nil, err
+		}
+		selection.PromoCode = promo
+	}
+
+	selection.Quote = models.QuotePrice(priceCents, currency, selection.PromoCode)
+	return selection, nil
+}
+
+// resolvePromoCode obtiene un código de descuento verificando que es aplicable
+func (s *TicketingServiceImpl) resolvePromoCode(ctx context.Context, eventID, code string, ticketType *models.TicketType, now time.Time) (*models.PromoCode, error) {
+	promo, err := s.promoRepo.GetByEventAndCode(ctx, eventID, code)
+	if err != nil {
+		if errors.Is(err, common.ErrNotFound) {
+			return nil, common.NewValidationError("promo_code", "Código de descuento no válido")
+		}
+		return nil, err
+	}
+
+	if err := promo.CheckUsable(now); err != nil {
+		return nil, common.NewBusinessError("promo_code_unavailable", "El código de descuento no está disponible")
+	}
+
+	if ticketType != nil && !promo.AppliesTo(ticketType.ID.String()) {
+		return nil, common.NewBusinessError("promo_code_not_applicable", "El código de descuento no aplica a esta entrada")
+	}
+
+	return promo, nil
+}
+
+// setPromoTicketTypes restringe un código a entradas que pertenezcan al evento
+func (s *TicketingServiceImpl) setPromoTicketTypes(ctx context.Context, promo *models.PromoCode, ids []string) error {
+	if len(ids) > 0 {
+		ticketTypes, err := s.ticketRepo.GetByEvent(ctx, promo.EventID, false)
+		if err != nil {
+			return err
+		}
+
+		known := make(map[string]bool, len(ticketTypes))
+		for _, ticketType := range ticketTypes {
+			known[ticketType.ID.String()] = true
+		}
+
+		for _, id := range ids {
+			if !known[id] {
+				return common.NewValidationError("ticket_type_ids", "Tipo de entrada no encontrado en el evento")
+			}
+		}
+	}
+
+	if err := promo.SetTicketTypeIDs(ids); err != nil {
+		return common.NewValidationError("ticket_type_ids", "Tipos de entrada inválidos")
+	}
+	return nil
+}
+
+// syncEventPricing mantiene IsFree y Price del evento (precio mínimo activo)
+// para que listados y filtros reflejen los tipos de entrada
+func (s *TicketingServiceImpl) syncEventPricing(ctx context.Context, event *models.Event) error {
+	ticketTypes, err := s.ticketRepo.GetByEvent(ctx, event.ID.String(), true)
+	if err != nil {
+		return err
+	}
+
+	if len(ticketTypes) == 0 {
+		return nil
+	}
+
+	minPrice := ticketTypes[0].PriceCents
+	for _, ticketType := range ticketTypes[1:] {
+		if ticketType.PriceCents < minPrice {
+			minPrice = ticketType.PriceCents
+		}
+	}
+
+	return s.eventRepo.UpdatePricing(ctx, event.ID.String(), minPrice == 0, minPrice)
+}
+
+// getVisibleEvent obtiene un evento visible para el usuario
+func (s *TicketingServiceImpl) getVisibleEvent(ctx context.Context, eventID string, userCtx *common.UserContext) (*models.Event, error) {
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isEventVisible(event, userCtx) {
+		return nil, common.ErrNotFound
+	}
+
+	return event, nil
+}
+
+// getManagedEvent obtiene un evento verificando que el usuario puede gestionarlo
+func (s *TicketingServiceImpl) getManagedEvent(ctx context.Context, eventID string, userCtx *common.UserContext) (*models.Event, error) {
+	if userCtx == nil {
+		return nil, common.ErrUnauthorized
+	}
+
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !userCtx.CanManageOrganization(event.OrganizationID) {
+		return nil, common.ErrForbidden
+	}
+
+	return event, nil
+}
+
+// getEventTicketType obtiene un tipo de entrada verificando que pertenece al evento
+func (s *TicketingServiceImpl) getEventTicketType(ctx context.Context, eventID, ticketTypeID string) (*models.TicketType, error) {
+	ticketType, err := s.ticketRepo.GetByID(ctx, ticketTypeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if ticketType.EventID != eventID {
+		return nil, common.ErrNotFound
+	}
+
+	return ticketType, nil
+}
+
+// getEventPromoCode obtiene un código de descuento verificando que pertenece al evento
+func (s *TicketingServiceImpl) getEventPromoCode(ctx context.Context, eventID, promoCodeID string) (*models.PromoCode, error) {
+	promo, err := s.promoRepo.GetByID(ctx, promoCodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if promo.EventID != eventID {
+		return nil, common.ErrNotFound
+	}
+
+	return promo, nil
+}
+
+// discountValue convierte el descuento de la petición al valor del modelo:
+// puntos básicos para porcentajes (máximo dos decimales) o céntimos
+func discountValue(discountType models.DiscountType, percentOff *float64, amountOffCents *int) (int, error) {
+	switch discountType {
+	case models.DiscountTypePercentage:
+		if percentOff == nil || amountOffCents != nil {
+			return 0, common.NewValidationError("percent_off", "Indica solo percent_off para descuentos porcentuales")
+		}
+		basisPoints := math.Round(*percentOff * 100)
+		if math.Abs(*percentOff*100-basisPoints) > 1e-6 {
+			return 0, common.NewValidationError("percent_off", "El porcentaje admite como máximo dos decimales")
+		}
+		return int(basisPoints), nil
+	case models.DiscountTypeFixed:
+		if amountOffCents == nil || percentOff != nil {
+			return 0, common.NewValidationError("amount_off_cents", "Indica solo amount_off_cents para descuentos de importe fijo")
+		}
+		return *amountOffCents, nil
+	default:
+		return 0, common.NewValidationError("discount_type", "Tipo de descuento inválido")
+	}
+}
+
+// registrationError traduce los errores de reserva a errores de negocio
+func registrationError(err error) error {
+	switch {
+	case errors.Is(err, repositories.ErrAlreadyRegistered):
+		return common.NewBusinessError("already_registered", "Ya estás inscrito en este evento")
+	case errors.Is(err, repositories.ErrTicketSoldOut):
+		return common.NewBusinessError("ticket_sold_out", "Las entradas de este tipo están agotadas")
+	case errors.Is(err, repositories.ErrPromoCodeExhausted):
+		return common.NewBusinessError("promo_code_unavailable", "El código de descuento ha alcanzado su límite de usos")
+	case errors.Is(err, repositories.ErrEventFull):
+		return common.NewBusinessError("event_full", "El evento no tiene plazas disponibles")
+	default:
+		return err
+	}
+}
+
+// billingDetails convierte los datos de facturación de la petición
+func billingDetails(req *dto.BillingDetailsRequest) models.BillingDetails {
+	if req == nil {
+		return models.BillingDetails{}
+	}
+
+	billing := models.BillingDetails{Name: req.Name, TaxID: req.TaxID, Address: req.Address}
+	billing.Normalize()
+	return billing
+}
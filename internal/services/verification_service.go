@@ -0,0 +1,350 @@
+// internal/services/verification_service.go
+package services
+
+import (
+	"context"
+	"time"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/dto"
+	"cybesphere-backend/internal/models"
+	"cybesphere-backend/internal/repositories"
+	"cybesphere-backend/pkg/logger"
+	"cybesphere-backend/pkg/taxid"
+
+	"github.com/google/uuid"
+)
+
+// defaultVerificationQueue estados que muestra la cola de verificación si no se filtra
+const defaultVerificationQueue = string(models.VerificationStatusSubmitted) + "," + string(models.VerificationStatusInReview)
+
+// errVerificationDecided la solicitud ya no admite cambios
+var errVerificationDecided = common.NewBusinessError("verification_decided", "La solicitud de verificación ya está resuelta")
+
+// VerificationServiceImpl solicitudes de verificación de organizaciones y su
+// revisión por los administradores
+type VerificationServiceImpl struct {
+	verificationRepo *repositories.OrganizationVerificationRepository
+	orgRepo          *repositories.OrganizationRepository
+	userRepo         *repositories.UserRepository
+	mediaRepo        *repositories.MediaRepository
+	notifications    NotificationService
+}
+
+// Verificación en tiempo de compilación
+var _ VerificationService = (*VerificationServiceImpl)(nil)
+
+// NewVerificationService crea una nueva instancia del servicio de verificación
+func NewVerificationService(
+	verificationRepo *repositories.OrganizationVerificationRepository,
+	orgRepo *repositories.OrganizationRepository,
+	userRepo *repositories.UserRepository,
+	mediaRepo *repositories.MediaRepository,
+	notifications NotificationService,
+) VerificationService {
+	return &VerificationServiceImpl{
+		verificationRepo: verificationRepo,
+		orgRepo:          orgRepo,
+		userRepo:         userRepo,
+		mediaRepo:        mediaRepo,
+		notifications:    notifications,
+	}
+}
+
+// =============================================================================
+// SOLICITUDES DE LA ORGANIZACIÓN
+// =============================================================================
+
+// SubmitVerification envía la documentación de la organización para su
+// revisión. Solo puede haber una solicitud pendiente; una organización
+// rechazada vuelve a quedar pendiente al enviar una nueva
+func (s *VerificationServiceImpl) SubmitVerification(ctx context.Context, organizationID string, req dto.SubmitVerificationRequest, userCtx *common.UserContext) (*models.OrganizationVerification, error) {
+	if !userCtx.IsAdmin() && !userCtx.CanManageOrganization(organizationID) {
+		return nil, common.ErrForbidden
+	}
+
+	org, err := s.orgRepo.GetByID(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	if org.IsVerified {
+		return nil, common.NewBusinessError("already_verified", "La organización ya está verificada")
+	}
+	if !org.CanRequestVerification() {
+		return nil, common.NewBusinessError("verification_not_allowed", "La organización no puede solicitar la verificación en su estado actual")
+	}
+
+	open, err := s.verificationRepo.HasOpen(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	if open {
+		return nil, common.NewBusinessError("verification_pending", "La organización ya tiene una solicitud de verificación pendiente")
+	}
+
+	if _, err := taxid.Validate(req.TaxID); err != nil {
+		return nil, common.NewValidationError("tax_id", "El NIF/CIF no es válido")
+	}
+
+	verification := &models.OrganizationVerification{
+		BaseModel:      models.BaseModel{ID: uuid.New()},
+		OrganizationID: organizationID,
+		SubmittedBy:    userCtx.ID,
+		TaxID:          taxid.Normalize(req.TaxID),
+		LegalName:      req.LegalName,
+		Comments:       req.Comments,
+		Status:         models.VerificationStatusSubmitted,
+	}
+	docs, refs, err := s.resolveDocuments(ctx, verification, req.Documents)
+	if err != nil {
+		return nil, err
+	}
+	if err := verification.SetDocuments(docs); err != nil {
+		return nil, common.NewValidationError("documents", err.Error())
+	}
+	if err := verification.ValidateVerification(); err != nil {
+		return nil, common.NewValidationError("verification", err.Error())
+	}
+
+	var orgChange *models.Organization
+	if org.Status == models.OrgStatusRejected {
+		org.Status = models.OrgStatusPending
+		orgChange = org
+	}
+	if err := s.verificationRepo.Submit(ctx, verification, refs, orgChange); err != nil {
+		return nil, err
+	}
+
+	logger.Infof("Solicitud de verificación %s de la organización %s por %s", verification.ID, organizationID, userCtx.ID)
+	return s.verificationRepo.GetWithRelations(ctx, verification.ID.String())
+}
+
+// resolveDocuments convierte los documentos de la solicitud. Los ficheros
+// subidos deben ser documentación de la misma organización; se usa su ruta en
+// la API y se registra la referencia para que no se puedan borrar
+func (s *VerificationServiceImpl) resolveDocuments(ctx context.Context, verification *models.OrganizationVerification, requested []dto.VerificationDocumentRequest) ([]models.VerificationDocument, []*models.MediaReference, error) {
+	docs := make([]models.VerificationDocument, 0, len(requested))
+	var refs []*models.MediaReference
+	referenced := make(map[string]bool)
+
+	for _, doc := range requested {
+		document := models.VerificationDocument{
+			Kind: models.VerificationDocumentKind(doc.Kind),
+			Name: doc.Name,
+			URL:  doc.URL,
+		}
+
+		if doc.MediaID != "" {
+			media, err := s.mediaRepo.GetByID(ctx, doc.MediaID)
+			if err != nil {
+				return nil, nil, common.NewValidationError("documents", "No existe el fichero "+doc.MediaID)
+			}
+			if media.Purpose != models.MediaPurposeVerificationDocument || media.OrganizationID != verification.OrganizationID {
+				return nil, nil, common.NewValidationError("documents", "El fichero "+doc.MediaID+" no es documentación de esta organización")
+			}
+
+			document.MediaID = doc.MediaID
+			document.URL = media.URL
+			if document.Name == "" {
+				document.Name = media.FileName
+			}
+			if !referenced[doc.MediaID] {
+				referenced[doc.MediaID] = true
+				refs = append(refs, &models.MediaReference{
+					MediaID:      doc.MediaID,
+					ResourceType: models.MediaResourceVerification,
+					ResourceID:   verification.ID.String(),
+					Field:        models.MediaFieldDocument,
+				})
+			}
+		}
+
+		docs = append(docs, document)
+	}
+	return docs, refs, nil
+}
+
+// GetVerificationStatus obtiene la organización y su historial de solicitudes
+func (s *VerificationServiceImpl) GetVerificationStatus(ctx context.Context, organizationID string, userCtx *common.UserContext) (*models.Organization, []*models.OrganizationVerification, error) {
+	if !userCtx.IsAdmin() && !userCtx.CanManageOrganization(organizationID) {
+		return nil, nil, common.ErrForbidden
+	}
+
+	org, err := s.orgRepo.GetByID(ctx, organizationID)
+	if err != nil {
+		return nil, nil, err
+	}
+	history, err := s.verificationRepo.GetHistory(ctx, organizationID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return org, history, nil
+}
+
+// =============================================================================
+// REVISIÓN (solo admin)
+// =============================================================================
+
+// ListVerifications lista la cola de verificación; sin filtro de estado
+// muestra las solicitudes enviadas y en revisión
+func (s *VerificationServiceImpl) ListVerifications(ctx context.Context, query dto.VerificationsQuery, opts common.QueryOptions, userCtx *common.UserContext) ([]*models.OrganizationVerification, *common.PaginationMeta, error) {
+	if !userCtx.IsAdmin() {
+		return nil, nil, common.ErrForbidden
+	}
+
+	status := query.Status
+	if status == "" {
+		status = defaultVerificationQueue
+	}
+	opts.AddFilter("status", status)
+	opts.Preloads = append(opts.Preloads, "Organization", "Submitter")
+
+	return s.verificationRepo.GetAll(ctx, opts)
+}
+
+// GetVerification obtiene una solicitud y el historial de solicitudes de su
+// organización
+func (s *VerificationServiceImpl) GetVerification(ctx context.Context, id string, userCtx *common.UserContext) (*models.OrganizationVerification, []*models.OrganizationVerification, error) {
+	if !userCtx.IsAdmin() {
+		return nil, nil, common.ErrForbidden
+	}
+
+	verification, err := s.verificationRepo.GetWithRelations(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	history, err := s.verificationRepo.GetHistory(ctx, verification.OrganizationID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return verification, history, nil
+}
+
+// StartReview asigna la solicitud al administrador y la pasa a revisión
+func (s *VerificationServiceImpl) StartReview(ctx context.Context, id string, userCtx *common.UserContext) (*models.OrganizationVerification, error) {
+	if !userCtx.IsAdmin() {
+		return nil, common.ErrForbidden
+	}
+
+	verification, err := s.verificationRepo.GetWithRelations(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := verification.StartReview(userCtx.ID); err != nil {
+		return nil, errVerificationDecided
+	}
+	if err := s.verificationRepo.Decide(ctx, verification, nil); err != nil {
+		return nil, err
+	}
+	return verification, nil
+}
+
+// ApproveVerification aprueba la solicitud: la organización queda verificada
+// con los datos fiscales revisados y, si estaba pendiente, activa
+func (s *VerificationServiceImpl) ApproveVerification(ctx context.Context, id string, req dto.ApproveVerificationRequest, userCtx *common.UserContext) (*models.OrganizationVerification, error) {
+	if !userCtx.IsAdmin() {
+		return nil, common.ErrForbidden
+	}
+
+	verification, err := s.verificationRepo.GetWithRelations(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if err := verification.Approve(userCtx.ID, req.Message, now); err != nil {
+		return nil, errVerificationDecided
+	}
+
+	org := verification.Organization
+	org.TaxID = verification.TaxID
+	org.LegalName = verification.LegalName
+	org.Verify(userCtx.ID, now)
+	if err := s.verificationRepo.Decide(ctx, verification, org); err != nil {
+		return nil, err
+	}
+
+	message := "Hemos revisado la documentación y \"" + org.Name + "\" ya está verificada"
+	if verification.ReviewMessage != "" {
+		message += ": " + verification.ReviewMessage
+	}
+	s.notifyOrganization(ctx, verification, "Organización verificada", message)
+	return verification, nil
+}
+
+// RejectVerification rechaza la solicitud con un motivo. Una organización
+// pendiente pasa a rechazada y puede enviar una nueva solicitud
+func (s *VerificationServiceImpl) RejectVerification(ctx context.Context, id string, req dto.RejectVerificationRequest, userCtx *common.UserContext) (*models.OrganizationVerification, error) {
+	if !userCtx.IsAdmin() {
+		return nil, common.ErrForbidden
+	}
+
+	verification, err := s.verificationRepo.GetWithRelations(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !verification.IsOpen() {
+		return nil, errVerificationDecided
+	}
+	if err := verification.Reject(userCtx.ID, req.Reason, time.Now()); err != nil {
+		return nil, common.NewValidationError("reason", err.Error())
+	}
+
+	org := verification.Organization
+	var orgChange *models.Organization
+	if org.Status == models.OrgStatusPending {
+		org.RejectVerification()
+		orgChange = org
+	}
+	if err := s.verificationRepo.Decide(ctx, verification, orgChange); err != nil {
+		return nil, err
+	}
+
+	s.notifyOrganization(ctx, verification, "Verificación rechazada", verification.ReviewMessage)
+	return verification, nil
+}
+
+// RequestInfo cierra la solicitud pidiendo más documentación. La
+// organización responde enviando una nueva solicitud
+func (s *VerificationServiceImpl) RequestInfo(ctx context.Context, id string, req dto.RequestVerificationInfoRequest, userCtx *common.UserContext) (*models.OrganizationVerification, error) {
+	if !userCtx.IsAdmin() {
+		return nil, common.ErrForbidden
+	}
+
+	verification, err := s.verificationRepo.GetWithRelations(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !verification.IsOpen() {
+		return nil, errVerificationDecided
+	}
+	if err := verification.RequestInfo(userCtx.ID, req.Message, time.Now()); err != nil {
+		return nil, common.NewValidationError("message", err.Error())
+	}
+	if err := s.verificationRepo.Decide(ctx, verification, nil); err != nil {
+		return nil, err
+	}
+
+	s.notifyOrganization(ctx, verification, "Necesitamos más documentación para verificar la organización", verification.ReviewMessage)
+	return verification, nil
+}
+
+// notifyOrganization avisa a los miembros activos de la organización de la
+// decisión. Los errores se registran sin interrumpir la revisión
+func (s *VerificationServiceImpl) notifyOrganization(ctx context.Context, verification *models.OrganizationVerification, title, message string) {
+	userIDs, err := s.userRepo.GetActiveIDsByOrganization(ctx, verification.OrganizationID)
+	if err != nil {
+		logger.Errorf("Error obteniendo los miembros de la organización %s: %v", verification.OrganizationID, err)
+		return
+	}
+
+	data := map[string]interface{}{
+		"organization_id": verification.OrganizationID,
+		"verification_id": verification.ID.String(),
+		"status":          verification.Status,
+	}
+	for _, userID := range userIDs {
+		if err := s.notifications.Notify(ctx, userID, models.NotificationTypeVerificationReviewed, title, message, data); err != nil {
+			logger.Errorf("Error avisando a %s de la verificación %s: %v", userID, verification.ID, err)
+		}
+	}
+}
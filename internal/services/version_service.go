@@ -0,0 +1,171 @@
+// internal/services/version_service.go
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"cybesphere-backend/internal/common"
+	"cybesphere-backend/internal/models"
+	"cybesphere-backend/internal/repositories"
+	"cybesphere-backend/pkg/audit"
+	"cybesphere-backend/pkg/logger"
+)
+
+// VersionDiff diferencias entre dos versiones de un registro
+type VersionDiff struct {
+	From    *models.EntityVersion
+	To      *models.EntityVersion
+	Changes map[string]audit.Change
+}
+
+// VersionServiceImpl historial de versiones de eventos y organizaciones
+type VersionServiceImpl struct {
+	versionRepo *repositories.EntityVersionRepository
+	eventRepo   *repositories.EventRepository
+	orgRepo     *repositories.OrganizationRepository
+	auth        AuthorizationService
+	geocoding   GeocodingService
+}
+
+// Verificación en tiempo de compilación
+var _ VersionService = (*VersionServiceImpl)(nil)
+
+// NewVersionService crea el servicio de versiones
+func NewVersionService(
+	versionRepo *repositories.EntityVersionRepository,
+	eventRepo *repositories.EventRepository,
+	orgRepo *repositories.OrganizationRepository,
+	auth AuthorizationService,
+	geocoding GeocodingService,
+) VersionService {
+	return &VersionServiceImpl{
+		versionRepo: versionRepo,
+		eventRepo:   eventRepo,
+		orgRepo:     orgRepo,
+		auth:        auth,
+		geocoding:   geocoding,
+	}
+}
+
+// ListVersions historial de un registro, de la versión más reciente a la más antigua
+func (s *VersionServiceImpl) ListVersions(ctx context.Context, resource, resourceID string, opts common.QueryOptions, userCtx *common.UserContext) ([]*models.EntityVersion, *common.PaginationMeta, error) {
+	if err := s.checkAccess(resource, resourceID, userCtx); err != nil {
+		return nil, nil, err
+	}
+	return s.versionRepo.GetByResource(ctx, resource, resourceID, opts)
+}
+
+// GetVersion versión concreta con el estado completo del registro
+func (s *VersionServiceImpl) GetVersion(ctx context.Context, resource, resourceID string, version int, userCtx *common.UserContext) (*models.EntityVersion, error) {
+	if err := s.checkAccess(resource, resourceID, userCtx); err != nil {
+		return nil, err
+	}
+	return s.versionRepo.GetVersion(ctx, resource, resourceID, version)
+}
+
+// DiffVersions campos que cambian entre dos versiones cualesquiera
+func (s *VersionServiceImpl) DiffVersions(ctx context.Context, resource, resourceID string, from, to int, userCtx *common.UserContext) (*VersionDiff, error) {
+	if err := s.checkAccess(resource, resourceID, userCtx); err != nil {
+		return nil, err
+	}
+
+	fromVersion, err := s.versionRepo.GetVersion(ctx, resource, resourceID, from)
+	if err != nil {
+		return nil, err
+	}
+	toVersion, err := s.versionRepo.GetVersion(ctx, resource, resourceID, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VersionDiff{
+		From:    fromVersion,
+		To:      toVersion,
+		Changes: audit.Diff(fromVersion.Snapshot, toVersion.Snapshot, nil),
+	}, nil
+}
+
+// RestoreVersion vuelve a aplicar los campos editables de una versión anterior.
+// Solo se escriben esas columnas, validadas por los hooks del modelo, y el
+// cambio genera una versión nueva y un registro de auditoría marcados como
+// restauración
+func (s *VersionServiceImpl) RestoreVersion(ctx context.Context, resource, resourceID string, version int, userCtx *common.UserContext) (*models.EntityVersion, error) {
+	if err := s.checkAccess(resource, resourceID, userCtx); err != nil {
+		return nil, err
+	}
+
+	target, err := s.versionRepo.GetVersion(ctx, resource, resourceID, version)
+	if err != nil {
+		return nil, err
+	}
+	latest, err := s.versionRepo.GetLatest(ctx, resource, resourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	columns := models.RestorableColumns[resource]
+	if len(audit.Diff(restorableState(latest.Snapshot, columns), restorableState(target.Snapshot, columns), nil)) == 0 {
+		return nil, common.NewBusinessError("nothing_to_restore",
+			fmt.Sprintf("El contenido actual ya coincide con la versión %d", version))
+	}
+
+	restoreCtx := models.ContextWithRestoredVersion(ctx, version)
+	switch resource {
+	case models.AuditResourceEvent:
+		event, err := s.eventRepo.GetByID(ctx, resourceID)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.restore(event, target.Snapshot, columns); err != nil {
+			return nil, err
+		}
+		if err := s.eventRepo.UpdateColumns(restoreCtx, event, columns); err != nil {
+			return nil, err
+		}
+		s.geocoding.ScheduleEvent(event)
+
+	case models.AuditResourceOrganization:
+		org, err := s.orgRepo.GetByID(ctx, resourceID)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.restore(org, target.Snapshot, columns); err != nil {
+			return nil, err
+		}
+		if err := s.orgRepo.UpdateColumns(restoreCtx, org, columns); err != nil {
+			return nil, err
+		}
+		s.geocoding.ScheduleOrganization(org)
+	}
+
+	return s.versionRepo.GetLatest(ctx, resource, resourceID)
+}
+
+// checkAccess el historial solo lo consultan quienes pueden editar el registro
+func (s *VersionServiceImpl) checkAccess(resource, resourceID string, userCtx *common.UserContext) error {
+	if _, ok := models.RestorableColumns[resource]; !ok {
+		return common.NewBusinessError("unknown_resource", "Tipo de recurso desconocido")
+	}
+	return s.auth.CheckUpdatePermission(userCtx, resource, resourceID)
+}
+
+// restore copia el estado guardado al modelo
+func (s *VersionServiceImpl) restore(model any, snapshot map[string]any, columns []string) error {
+	if err := audit.Restore(model, snapshot, columns); err != nil {
+		logger.Errorf("Error restaurando versión: %v", err)
+		return common.ErrInternalError
+	}
+	return nil
+}
+
+// restorableState columnas restaurables de un estado guardado
+func restorableState(snapshot map[string]any, columns []string) map[string]any {
+	state := make(map[string]any, len(columns))
+	for _, column := range columns {
+		if value, ok := snapshot[column]; ok {
+			state[column] = value
+		}
+	}
+	return state
+}
@@ -0,0 +1,220 @@
+// Package audit registra los cambios de las tablas auditadas como diferencias
+// campo a campo (valor anterior y nuevo), ocultando los valores sensibles
+package audit
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Acciones registradas
+const (
+	ActionCreate = "create"
+	ActionUpdate = "update"
+	ActionDelete = "delete"
+)
+
+// Redacted valor que sustituye a los campos sensibles
+const Redacted = "[REDACTED]"
+
+// sensitiveParts fragmentos de nombre de columna cuyo valor nunca se registra
+var sensitiveParts = []string{"password", "secret", "token", "api_key", "private_key"}
+
+// Change valor de un campo antes y después del cambio. From se omite al crear y
+// To al borrar
+type Change struct {
+	From any `json:"from,omitempty"`
+	To   any `json:"to,omitempty"`
+}
+
+// Entry cambio de un registro
+type Entry struct {
+	Action     string
+	Resource   string
+	ResourceID string
+	Changes    map[string]Change
+}
+
+// Version estado completo de un registro tras crearse o modificarse
+type Version struct {
+	Action     string
+	Resource   string
+	ResourceID string
+	Values     map[string]any // Estado actual (ver State)
+	Previous   map[string]any // Estado anterior en las modificaciones
+	Changed    []string       // Columnas modificadas, ordenadas
+}
+
+// Request datos de la petición HTTP que origina los cambios
+type Request struct {
+	IPAddress string
+	UserAgent string
+}
+
+type requestKey struct{}
+
+// ContextWithRequest añade al contexto los datos de la petición
+func ContextWithRequest(ctx context.Context, req Request) context.Context {
+	return context.WithValue(ctx, requestKey{}, req)
+}
+
+// RequestFromContext datos de la petición guardados en el contexto (vacíos si no hay)
+func RequestFromContext(ctx context.Context) Request {
+	if ctx == nil {
+		return Request{}
+	}
+	req, _ := ctx.Value(requestKey{}).(Request)
+	return req
+}
+
+// IsSensitive indica si el valor de una columna debe ocultarse
+func IsSensitive(column string) bool {
+	column = strings.ToLower(column)
+	for _, part := range sensitiveParts {
+		if strings.Contains(column, part) {
+			return true
+		}
+	}
+	return false
+}
+
+// Diff compara dos estados de un registro y devuelve los campos que cambian,
+// sin los ignorados. Los campos sensibles que cambian se registran ocultos
+func Diff(before, after map[string]any, ignore map[string]bool) map[string]Change {
+	changes := make(map[string]Change)
+	for column, to := range after {
+		if ignore[column] {
+			continue
+		}
+		from := before[column]
+		if equal(from, to) {
+			continue
+		}
+		changes[column] = redact(column, Change{From: normalize(from), To: normalize(to)})
+	}
+	for column, from := range before {
+		if _, ok := after[column]; ok || ignore[column] || normalize(from) == nil {
+			continue
+		}
+		changes[column] = redact(column, Change{From: normalize(from)})
+	}
+	return changes
+}
+
+// Snapshot valores no vacíos de un registro como cambios de creación (To) o
+// de borrado (From), sin los ignorados y con los sensibles ocultos
+func Snapshot(values map[string]any, ignore map[string]bool, created bool) map[string]Change {
+	changes := make(map[string]Change)
+	for column, value := range values {
+		value = normalize(value)
+		if ignore[column] || isEmpty(value) {
+			continue
+		}
+		change := Change{From: value}
+		if created {
+			change = Change{To: value}
+		}
+		changes[column] = redact(column, change)
+	}
+	return changes
+}
+
+// State valores de un registro sin los ignorados, incluidos los nulos, con los
+// sensibles ocultos; sirve para guardar y restaurar versiones completas
+func State(values map[string]any, ignore map[string]bool) map[string]any {
+	state := make(map[string]any, len(values))
+	for column, value := range values {
+		if ignore[column] {
+			continue
+		}
+		value = normalize(value)
+		if value != nil && IsSensitive(column) {
+			value = Redacted
+		}
+		state[column] = value
+	}
+	return state
+}
+
+// RedactColumns oculta los valores de las columnas indicadas, que se registran
+// como modificadas sin que su contenido llegue a la cadena
+func RedactColumns(changes map[string]Change, columns map[string]bool) map[string]Change {
+	for column, change := range changes {
+		if columns[column] {
+			changes[column] = hide(change)
+		}
+	}
+	return changes
+}
+
+// redact oculta los valores de una columna sensible manteniendo qué lado existe
+func redact(column string, change Change) Change {
+	if !IsSensitive(column) {
+		return change
+	}
+	return hide(change)
+}
+
+// hide sustituye los valores presentes del cambio por Redacted
+func hide(change Change) Change {
+	if change.From != nil {
+		change.From = Redacted
+	}
+	if change.To != nil {
+		change.To = Redacted
+	}
+	return change
+}
+
+// normalize convierte los valores leídos de la base de datos a tipos que se
+// comparan y serializan de forma estable
+func normalize(value any) any {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []byte:
+		return string(v)
+	case time.Time:
+		return v.UTC()
+	case *time.Time:
+		if v == nil {
+			return nil
+		}
+		return v.UTC()
+	}
+
+	rv := reflect.ValueOf(value)
+	if rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil
+		}
+		return normalize(rv.Elem().Interface())
+	}
+	return value
+}
+
+func equal(a, b any) bool {
+	a, b = normalize(a), normalize(b)
+	if ta, ok := a.(time.Time); ok {
+		tb, ok := b.(time.Time)
+		return ok && ta.Equal(tb)
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func isEmpty(value any) bool {
+	if value == nil {
+		return true
+	}
+	if t, ok := value.(time.Time); ok {
+		return t.IsZero()
+	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.String, reflect.Slice, reflect.Map:
+		return rv.Len() == 0
+	}
+	return false
+}
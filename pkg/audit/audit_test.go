@@ -0,0 +1,151 @@
+package audit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestIsSensitive tests para la detección de columnas sensibles
+func TestIsSensitive(t *testing.T) {
+	tests := []struct {
+		column string
+		want   bool
+	}{
+		{column: "password", want: true},
+		{column: "reset_token_hash", want: true},
+		{column: "webhook_secret", want: true},
+		{column: "API_KEY", want: true},
+		{column: "email", want: false},
+		{column: "title", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.column, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsSensitive(tt.column))
+		})
+	}
+}
+
+// TestDiff tests para la comparación de estados de un registro
+func TestDiff(t *testing.T) {
+	madrid, _ := time.LoadLocation("Europe/Madrid")
+	start := time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC)
+	ignore := map[string]bool{"updated_at": true}
+
+	tests := []struct {
+		name   string
+		before map[string]any
+		after  map[string]any
+		want   map[string]Change
+	}{
+		{
+			name:   "campos modificados",
+			before: map[string]any{"title": "Antes", "status": "draft", "city": "Madrid"},
+			after:  map[string]any{"title": "Después", "status": "published", "city": "Madrid"},
+			want: map[string]Change{
+				"title":  {From: "Antes", To: "Después"},
+				"status": {From: "draft", To: "published"},
+			},
+		},
+		{
+			name:   "ignora columnas y fechas iguales en otra zona",
+			before: map[string]any{"start_date": start, "updated_at": start},
+			after:  map[string]any{"start_date": start.In(madrid), "updated_at": start.Add(time.Hour)},
+			want:   map[string]Change{},
+		},
+		{
+			name:   "de nulo a valor",
+			before: map[string]any{"latitude": nil, "tags": []byte(nil)},
+			after:  map[string]any{"latitude": 40.4, "tags": []byte(`["osint"]`)},
+			want: map[string]Change{
+				"latitude": {To: 40.4},
+				"tags":     {From: "", To: `["osint"]`},
+			},
+		},
+		{
+			name:   "oculta los valores sensibles",
+			before: map[string]any{"password": "$2a$old"},
+			after:  map[string]any{"password": "$2a$new"},
+			want:   map[string]Change{"password": {From: Redacted, To: Redacted}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Diff(tt.before, tt.after, ignore))
+		})
+	}
+}
+
+// TestSnapshot tests para los valores de altas y bajas
+func TestSnapshot(t *testing.T) {
+	values := map[string]any{
+		"id":         "uuid-1",
+		"email":      "ana@example.com",
+		"password":   "$2a$hash",
+		"bio":        "",
+		"latitude":   nil,
+		"is_active":  false,
+		"updated_at": time.Now(),
+	}
+	ignore := map[string]bool{"updated_at": true}
+
+	assert.Equal(t, map[string]Change{
+		"id":        {To: "uuid-1"},
+		"email":     {To: "ana@example.com"},
+		"password":  {To: Redacted},
+		"is_active": {To: false},
+	}, Snapshot(values, ignore, true))
+
+	deleted := Snapshot(values, ignore, false)
+	assert.Equal(t, Change{From: "ana@example.com"}, deleted["email"])
+	assert.Equal(t, Change{From: Redacted}, deleted["password"])
+}
+
+// TestRequestFromContext tests para los datos de la petición en el contexto
+func TestRequestFromContext(t *testing.T) {
+	req := Request{IPAddress: "10.0.0.1", UserAgent: "curl/8.0"}
+	ctx := ContextWithRequest(context.Background(), req)
+
+	assert.Equal(t, req, RequestFromContext(ctx))
+	assert.Equal(t, Request{}, RequestFromContext(context.Background()))
+}
+
+// TestState tests para el estado completo de un registro
+func TestState(t *testing.T) {
+	start := time.Date(2025, 6, 1, 9, 0, 0, 0, time.FixedZone("CEST", 2*3600))
+	values := map[string]any{
+		"title":          "Evento",
+		"latitude":       nil,
+		"tags":           []byte(`["osint"]`),
+		"start_date":     start,
+		"webhook_secret": "s3cr3t",
+		"views_count":    int64(10),
+	}
+
+	assert.Equal(t, map[string]any{
+		"title":          "Evento",
+		"latitude":       nil,
+		"tags":           `["osint"]`,
+		"start_date":     start.UTC(),
+		"webhook_secret": Redacted,
+	}, State(values, map[string]bool{"views_count": true}))
+}
+
+// TestRedactColumns tests para las columnas registradas sin su valor
+func TestRedactColumns(t *testing.T) {
+	changes := map[string]Change{
+		"email":     {From: "ana@example.com", To: "ana@corp.example"},
+		"last_name": {To: "García"},
+		"role":      {From: "user", To: "organizer"},
+	}
+
+	assert.Equal(t, map[string]Change{
+		"email":     {From: Redacted, To: Redacted},
+		"last_name": {To: Redacted},
+		"role":      {From: "user", To: "organizer"},
+	}, RedactColumns(changes, map[string]bool{"email": true, "last_name": true, "bio": true}))
+}
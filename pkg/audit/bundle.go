@@ -0,0 +1,130 @@
+package audit
+
+import (
+	"crypto/ed25519"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// BundleVersion versión del formato de los paquetes exportados
+const BundleVersion = 1
+
+// Errores de los paquetes firmados
+var (
+	ErrInvalidSigningKey = errors.New("audit: signing key must be a base64 encoded 32 byte ed25519 seed")
+	ErrInvalidSignature  = errors.New("audit: invalid bundle signature")
+	ErrUntrustedKey      = errors.New("audit: bundle signed with an untrusted key")
+	ErrEmptyBundle       = errors.New("audit: bundle has no links")
+)
+
+// Bundle tramo consecutivo de la cadena firmado con Ed25519 para archivarlo
+// fuera de la plataforma. PrevHash enlaza con el tramo anterior y LastHash
+// permite comprobar después que la cadena no se ha truncado
+type Bundle struct {
+	Version      int       `json:"version"`
+	GeneratedAt  time.Time `json:"generated_at"`
+	FromSequence int64     `json:"from_sequence"`
+	ToSequence   int64     `json:"to_sequence"`
+	PrevHash     string    `json:"prev_hash"`
+	LastHash     string    `json:"last_hash"`
+	Links        []Link    `json:"links"`
+	PublicKey    string    `json:"public_key"`
+	Signature    string    `json:"signature,omitempty"`
+}
+
+// ParseSigningKey interpreta la clave de firma (semilla Ed25519 en base64)
+func ParseSigningKey(encoded string) (ed25519.PrivateKey, error) {
+	seed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil || len(seed) != ed25519.SeedSize {
+		return nil, ErrInvalidSigningKey
+	}
+	return ed25519.NewKeyFromSeed(seed), nil
+}
+
+// NewBundle crea un paquete sin firmar con eslabones consecutivos
+func NewBundle(links []Link, generatedAt time.Time) (*Bundle, error) {
+	if len(links) == 0 {
+		return nil, ErrEmptyBundle
+	}
+	first, last := links[0], links[len(links)-1]
+	return &Bundle{
+		Version:      BundleVersion,
+		GeneratedAt:  generatedAt.UTC(),
+		FromSequence: first.Sequence,
+		ToSequence:   last.Sequence,
+		PrevHash:     first.PrevHash,
+		LastHash:     last.Hash,
+		Links:        links,
+	}, nil
+}
+
+// Sign firma el paquete e incluye la clave pública
+func (b *Bundle) Sign(key ed25519.PrivateKey) error {
+	b.PublicKey = base64.StdEncoding.EncodeToString(key.Public().(ed25519.PublicKey))
+	message, err := b.signedMessage()
+	if err != nil {
+		return err
+	}
+	b.Signature = base64.StdEncoding.EncodeToString(ed25519.Sign(key, message))
+	return nil
+}
+
+// Verify comprueba la firma y la continuidad de los eslabones. Con trusted se
+// exige además que la firma sea de esa clave; sin ella solo se garantiza que
+// el paquete no se ha modificado desde que se firmó
+func (b *Bundle) Verify(trusted ed25519.PublicKey) (*Break, error) {
+	if b.Version != BundleVersion {
+		return nil, fmt.Errorf("audit: unsupported bundle version %d", b.Version)
+	}
+	if len(b.Links) == 0 {
+		return nil, ErrEmptyBundle
+	}
+
+	publicKey, err := base64.StdEncoding.DecodeString(b.PublicKey)
+	if err != nil || len(publicKey) != ed25519.PublicKeySize {
+		return nil, ErrInvalidSignature
+	}
+	if trusted != nil && subtle.ConstantTimeCompare(publicKey, trusted) != 1 {
+		return nil, ErrUntrustedKey
+	}
+	signature, err := base64.StdEncoding.DecodeString(b.Signature)
+	if err != nil {
+		return nil, ErrInvalidSignature
+	}
+	message, err := b.signedMessage()
+	if err != nil {
+		return nil, err
+	}
+	if !ed25519.Verify(publicKey, message, signature) {
+		return nil, ErrInvalidSignature
+	}
+
+	verifier := ResumeVerifier(b.FromSequence-1, b.PrevHash)
+	for _, link := range b.Links {
+		link.Payload = compactJSON(link.Payload)
+		if brk := verifier.Check(link); brk != nil {
+			return brk, nil
+		}
+	}
+	if sequence, hash := verifier.Head(); sequence != b.ToSequence || hash != b.LastHash {
+		return &Break{Sequence: sequence, Reason: BreakHash, Expected: b.LastHash, Actual: hash}, nil
+	}
+	return nil, nil
+}
+
+// signedMessage contenido firmado: el paquete completo sin la firma y con los
+// contenidos compactados
+func (b *Bundle) signedMessage() ([]byte, error) {
+	unsigned := *b
+	unsigned.Signature = ""
+	unsigned.Links = make([]Link, len(b.Links))
+	for i, link := range b.Links {
+		link.Payload = compactJSON(link.Payload)
+		unsigned.Links[i] = link
+	}
+	return json.Marshal(unsigned)
+}
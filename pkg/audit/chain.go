@@ -0,0 +1,128 @@
+package audit
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+)
+
+// Motivos de rotura de la cadena
+const (
+	BreakSequenceGap = "sequence_gap"       // Falta un eslabón o está desordenado
+	BreakPrevHash    = "prev_hash_mismatch" // No enlaza con el hash del eslabón anterior
+	BreakHash        = "hash_mismatch"      // El contenido no corresponde a su hash
+)
+
+// Link eslabón de la cadena de auditoría: el contenido canónico del registro
+// y su hash, que incluye el del eslabón anterior
+type Link struct {
+	Sequence int64           `json:"sequence"`
+	PrevHash string          `json:"prev_hash"`
+	Hash     string          `json:"hash"`
+	Payload  json.RawMessage `json:"payload"`
+}
+
+// Break primer eslabón que no verifica
+type Break struct {
+	Sequence int64  `json:"sequence"`
+	Reason   string `json:"reason"`
+	Expected string `json:"expected"`
+	Actual   string `json:"actual"`
+}
+
+// CanonicalJSON serializa un valor de forma estable: claves ordenadas y los
+// mismos tipos que se obtienen al volver a leerlo de una columna JSON, de modo
+// que el hash se puede recalcular a partir de lo almacenado
+func CanonicalJSON(value any) ([]byte, error) {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var decoded any
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		return nil, err
+	}
+	return json.Marshal(decoded)
+}
+
+// ChainHash hash SHA-256 (hexadecimal) de un eslabón a partir de su posición,
+// el hash anterior y su contenido canónico
+func ChainHash(sequence int64, prevHash string, payload []byte) string {
+	h := sha256.New()
+	h.Write([]byte(strconv.FormatInt(sequence, 10)))
+	h.Write([]byte{'\n'})
+	h.Write([]byte(prevHash))
+	h.Write([]byte{'\n'})
+	h.Write(payload)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// NewLink crea el eslabón siguiente a prevHash
+func NewLink(sequence int64, prevHash string, payload []byte) Link {
+	return Link{
+		Sequence: sequence,
+		PrevHash: prevHash,
+		Hash:     ChainHash(sequence, prevHash, payload),
+		Payload:  payload,
+	}
+}
+
+// Verifier recorre la cadena en orden comprobando cada eslabón
+type Verifier struct {
+	sequence int64
+	hash     string
+	checked  int64
+}
+
+// NewVerifier verificador desde el principio de la cadena
+func NewVerifier() *Verifier {
+	return &Verifier{}
+}
+
+// ResumeVerifier verificador que continúa tras un eslabón ya verificado
+func ResumeVerifier(sequence int64, hash string) *Verifier {
+	return &Verifier{sequence: sequence, hash: hash}
+}
+
+// Check comprueba el siguiente eslabón; devuelve la rotura o nil si es correcto
+func (v *Verifier) Check(link Link) *Break {
+	if expected := v.sequence + 1; link.Sequence != expected {
+		return &Break{
+			Sequence: link.Sequence,
+			Reason:   BreakSequenceGap,
+			Expected: strconv.FormatInt(expected, 10),
+			Actual:   strconv.FormatInt(link.Sequence, 10),
+		}
+	}
+	if link.PrevHash != v.hash {
+		return &Break{Sequence: link.Sequence, Reason: BreakPrevHash, Expected: v.hash, Actual: link.PrevHash}
+	}
+	if hash := ChainHash(link.Sequence, link.PrevHash, link.Payload); hash != link.Hash {
+		return &Break{Sequence: link.Sequence, Reason: BreakHash, Expected: hash, Actual: link.Hash}
+	}
+
+	v.sequence, v.hash = link.Sequence, link.Hash
+	v.checked++
+	return nil
+}
+
+// Checked eslabones verificados
+func (v *Verifier) Checked() int64 {
+	return v.checked
+}
+
+// Head posición y hash del último eslabón verificado
+func (v *Verifier) Head() (int64, string) {
+	return v.sequence, v.hash
+}
+
+// compactJSON elimina los espacios de un JSON ya canónico leído de un fichero
+func compactJSON(raw json.RawMessage) json.RawMessage {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, raw); err != nil {
+		return raw
+	}
+	return buf.Bytes()
+}
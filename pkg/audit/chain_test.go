@@ -0,0 +1,184 @@
+package audit
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// buildChain crea n eslabones consecutivos desde el principio de la cadena
+func buildChain(t *testing.T, n int) []Link {
+	t.Helper()
+	links := make([]Link, 0, n)
+	prev := ""
+	for i := 1; i <= n; i++ {
+		payload, err := CanonicalJSON(map[string]any{"action": "update", "n": i})
+		require.NoError(t, err)
+		link := NewLink(int64(i), prev, payload)
+		links = append(links, link)
+		prev = link.Hash
+	}
+	return links
+}
+
+// TestCanonicalJSON tests para la serialización estable de los contenidos
+func TestCanonicalJSON(t *testing.T) {
+	type change struct {
+		To any `json:"to"`
+	}
+
+	written, err := CanonicalJSON(map[string]any{
+		"status":  1,
+		"changes": map[string]any{"title": change{To: "Nuevo"}, "latitude": change{To: 40.4168}},
+	})
+	require.NoError(t, err)
+
+	// Lo mismo leído de una columna JSON: mapas genéricos y números float64
+	var stored any
+	require.NoError(t, json.Unmarshal(written, &stored))
+	read, err := CanonicalJSON(stored)
+	require.NoError(t, err)
+
+	assert.Equal(t, string(written), string(read))
+	assert.Equal(t, `{"changes":{"latitude":{"to":40.4168},"title":{"to":"Nuevo"}},"status":1}`, string(written))
+}
+
+// TestVerifier tests para la verificación de la cadena
+func TestVerifier(t *testing.T) {
+	tests := []struct {
+		name   string
+		tamper func(links []Link) []Link
+		want   *Break
+	}{
+		{
+			name:   "cadena íntegra",
+			tamper: func(links []Link) []Link { return links },
+		},
+		{
+			name: "contenido modificado",
+			tamper: func(links []Link) []Link {
+				links[1].Payload = json.RawMessage(`{"action":"delete","n":2}`)
+				return links
+			},
+			want: &Break{Sequence: 2, Reason: BreakHash},
+		},
+		{
+			name: "eslabón borrado",
+			tamper: func(links []Link) []Link {
+				return append(links[:1], links[2:]...)
+			},
+			want: &Break{Sequence: 3, Reason: BreakSequenceGap, Expected: "2", Actual: "3"},
+		},
+		{
+			name: "eslabón reescrito con su hash recalculado",
+			tamper: func(links []Link) []Link {
+				links[1] = NewLink(2, links[0].Hash, json.RawMessage(`{"action":"delete","n":2}`))
+				return links
+			},
+			want: &Break{Sequence: 3, Reason: BreakPrevHash},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			links := tt.tamper(buildChain(t, 4))
+			verifier := NewVerifier()
+
+			var got *Break
+			for _, link := range links {
+				if got = verifier.Check(link); got != nil {
+					break
+				}
+			}
+
+			if tt.want == nil {
+				assert.Nil(t, got)
+				sequence, hash := verifier.Head()
+				assert.Equal(t, int64(4), sequence)
+				assert.Equal(t, links[3].Hash, hash)
+				assert.Equal(t, int64(4), verifier.Checked())
+				return
+			}
+			require.NotNil(t, got)
+			assert.Equal(t, tt.want.Sequence, got.Sequence)
+			assert.Equal(t, tt.want.Reason, got.Reason)
+			if tt.want.Expected != "" {
+				assert.Equal(t, tt.want.Expected, got.Expected)
+				assert.Equal(t, tt.want.Actual, got.Actual)
+			}
+		})
+	}
+}
+
+// TestBundle tests para los paquetes firmados
+func TestBundle(t *testing.T) {
+	seed := make([]byte, ed25519.SeedSize)
+	for i := range seed {
+		seed[i] = byte(i)
+	}
+	key, err := ParseSigningKey(base64.StdEncoding.EncodeToString(seed))
+	require.NoError(t, err)
+	trusted := key.Public().(ed25519.PublicKey)
+
+	_, err = ParseSigningKey("corta")
+	assert.ErrorIs(t, err, ErrInvalidSigningKey)
+
+	// Tramo intermedio de la cadena, exportado con formato legible
+	newBundle := func(t *testing.T) *Bundle {
+		t.Helper()
+		bundle, err := NewBundle(buildChain(t, 5)[2:], time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		require.NoError(t, bundle.Sign(key))
+
+		raw, err := json.MarshalIndent(bundle, "", "  ")
+		require.NoError(t, err)
+		var archived Bundle
+		require.NoError(t, json.Unmarshal(raw, &archived))
+		return &archived
+	}
+
+	t.Run("paquete válido", func(t *testing.T) {
+		bundle := newBundle(t)
+		assert.Equal(t, int64(3), bundle.FromSequence)
+		assert.Equal(t, int64(5), bundle.ToSequence)
+
+		brk, err := bundle.Verify(trusted)
+		assert.NoError(t, err)
+		assert.Nil(t, brk)
+	})
+
+	t.Run("clave no confiable", func(t *testing.T) {
+		other := ed25519.NewKeyFromSeed(make([]byte, ed25519.SeedSize))
+		_, err := newBundle(t).Verify(other.Public().(ed25519.PublicKey))
+		assert.ErrorIs(t, err, ErrUntrustedKey)
+	})
+
+	t.Run("contenido modificado tras firmar", func(t *testing.T) {
+		bundle := newBundle(t)
+		bundle.Links[1].Payload = json.RawMessage(`{"action":"delete","n":4}`)
+		_, err := bundle.Verify(trusted)
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+	})
+
+	t.Run("paquete refirmado con eslabones inconsistentes", func(t *testing.T) {
+		bundle := newBundle(t)
+		bundle.Links[1].Payload = json.RawMessage(`{"action":"delete","n":4}`)
+		require.NoError(t, bundle.Sign(key))
+
+		brk, err := bundle.Verify(trusted)
+		require.NoError(t, err)
+		require.NotNil(t, brk)
+		assert.Equal(t, int64(4), brk.Sequence)
+		assert.Equal(t, BreakHash, brk.Reason)
+	})
+
+	t.Run("sin eslabones", func(t *testing.T) {
+		_, err := NewBundle(nil, time.Now())
+		assert.ErrorIs(t, err, ErrEmptyBundle)
+	})
+}
@@ -0,0 +1,320 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// beforeKey clave de la instancia donde se guarda el estado previo al cambio
+const beforeKey = "audit:before"
+
+// alwaysIgnored columnas que cambian en cada escritura y no aportan al registro
+var alwaysIgnored = []string{"updated_at", "updated_by"}
+
+// Table configuración de una tabla auditada
+type Table struct {
+	Resource  string   // Nombre del recurso en el registro (p. ej. "event")
+	Ignore    []string // Columnas que no se registran (contadores, último acceso...)
+	Redact    []string // Columnas que se registran sin su valor (datos personales)
+	Versioned bool     // Guardar además el estado completo en cada alta y cambio
+}
+
+// Config configuración del plugin
+type Config struct {
+	Tables map[string]Table // Por nombre de tabla
+	// Record guarda los cambios; recibe una sesión de la misma transacción
+	Record func(tx *gorm.DB, entries []Entry) error
+	// Version guarda el estado de las tablas versionadas (opcional); solo se
+	// llama cuando cambia alguna columna no ignorada
+	Version func(tx *gorm.DB, versions []Version) error
+}
+
+// Plugin plugin de GORM que registra los cambios de las tablas configuradas.
+// Antes de cada actualización o borrado lee las filas afectadas y, si la
+// operación tiene éxito, las vuelve a leer y registra las diferencias en la
+// misma transacción
+type Plugin struct {
+	tables  map[string]tableConfig
+	record  func(tx *gorm.DB, entries []Entry) error
+	version func(tx *gorm.DB, versions []Version) error
+}
+
+type tableConfig struct {
+	resource  string
+	ignore    map[string]bool
+	redact    map[string]bool
+	versioned bool
+}
+
+// Verificación en tiempo de compilación
+var _ gorm.Plugin = (*Plugin)(nil)
+
+// New crea el plugin de auditoría
+func New(cfg Config) *Plugin {
+	p := &Plugin{
+		tables:  make(map[string]tableConfig, len(cfg.Tables)),
+		record:  cfg.Record,
+		version: cfg.Version,
+	}
+	for name, table := range cfg.Tables {
+		ignore := make(map[string]bool, len(table.Ignore)+len(alwaysIgnored))
+		for _, column := range table.Ignore {
+			ignore[column] = true
+		}
+		for _, column := range alwaysIgnored {
+			ignore[column] = true
+		}
+		redact := make(map[string]bool, len(table.Redact))
+		for _, column := range table.Redact {
+			redact[column] = true
+		}
+		p.tables[name] = tableConfig{resource: table.Resource, ignore: ignore, redact: redact, versioned: table.Versioned}
+	}
+	return p
+}
+
+// Name implementa gorm.Plugin
+func (p *Plugin) Name() string {
+	return "audit"
+}
+
+// Initialize implementa gorm.Plugin registrando los callbacks
+func (p *Plugin) Initialize(db *gorm.DB) error {
+	if p.record == nil {
+		return errors.New("audit: record function is required")
+	}
+
+	callbacks := db.Callback()
+	return errors.Join(
+		callbacks.Create().After("gorm:create").Register("audit:after_create", p.afterCreate),
+		callbacks.Update().After("gorm:before_update").Before("gorm:update").Register("audit:before_update", p.captureBefore),
+		callbacks.Update().After("gorm:update").Register("audit:after_update", p.afterChange(ActionUpdate)),
+		callbacks.Delete().After("gorm:before_delete").Before("gorm:delete").Register("audit:before_delete", p.captureBefore),
+		callbacks.Delete().After("gorm:delete").Register("audit:after_delete", p.afterChange(ActionDelete)),
+	)
+}
+
+// table configuración de la tabla de la sentencia, si se audita
+func (p *Plugin) table(tx *gorm.DB) (tableConfig, bool) {
+	if tx.Error != nil || tx.Statement.Schema == nil || tx.Statement.Schema.PrioritizedPrimaryField == nil {
+		return tableConfig{}, false
+	}
+	table, ok := p.tables[tx.Statement.Table]
+	return table, ok
+}
+
+// afterCreate registra los registros creados con sus valores iniciales
+func (p *Plugin) afterCreate(tx *gorm.DB) {
+	table, ok := p.table(tx)
+	if !ok || tx.RowsAffected == 0 {
+		return
+	}
+	// Con ON CONFLICT (upserts de asociaciones) no se sabe si se insertó
+	if _, upsert := tx.Statement.Clauses["ON CONFLICT"]; upsert {
+		return
+	}
+
+	ids := primaryKeys(tx)
+	if len(ids) == 0 {
+		return
+	}
+	rows, err := p.load(tx, clause.IN{Column: p.pkColumn(tx), Values: ids}, true)
+	if err != nil {
+		tx.AddError(fmt.Errorf("audit: %w", err))
+		return
+	}
+
+	entries := make([]Entry, 0, len(rows))
+	var versions []Version
+	for id, row := range rows {
+		entries = append(entries, Entry{
+			Action:     ActionCreate,
+			Resource:   table.resource,
+			ResourceID: id,
+			Changes:    RedactColumns(Snapshot(row, table.ignore, true), table.redact),
+		})
+		if table.versioned {
+			versions = append(versions, Version{
+				Action:     ActionCreate,
+				Resource:   table.resource,
+				ResourceID: id,
+				Values:     State(row, table.ignore),
+			})
+		}
+	}
+	p.save(tx, entries, versions)
+}
+
+// captureBefore guarda las filas que va a modificar o borrar la sentencia
+func (p *Plugin) captureBefore(tx *gorm.DB) {
+	if _, ok := p.table(tx); !ok {
+		return
+	}
+
+	var conditions []clause.Expression
+	if where, ok := tx.Statement.Clauses["WHERE"].Expression.(clause.Where); ok {
+		conditions = append(conditions, where.Exprs...)
+	}
+	if ids := primaryKeys(tx); len(ids) > 0 {
+		conditions = append(conditions, clause.IN{Column: p.pkColumn(tx), Values: ids})
+	}
+	// Sin condiciones GORM rechaza la sentencia
+	if len(conditions) == 0 {
+		return
+	}
+
+	rows, err := p.load(tx, clause.And(conditions...), tx.Statement.Unscoped)
+	if err != nil {
+		tx.AddError(fmt.Errorf("audit: %w", err))
+		return
+	}
+	tx.InstanceSet(beforeKey, rows)
+}
+
+// afterChange registra las diferencias entre las filas previas y las actuales
+func (p *Plugin) afterChange(action string) func(tx *gorm.DB) {
+	return func(tx *gorm.DB) {
+		table, ok := p.table(tx)
+		if !ok || tx.RowsAffected == 0 {
+			return
+		}
+		value, ok := tx.InstanceGet(beforeKey)
+		before, _ := value.(map[string]map[string]any)
+		if !ok || len(before) == 0 {
+			return
+		}
+
+		ids := make([]any, 0, len(before))
+		for id := range before {
+			ids = append(ids, id)
+		}
+		after, err := p.load(tx, clause.IN{Column: p.pkColumn(tx), Values: ids}, true)
+		if err != nil {
+			tx.AddError(fmt.Errorf("audit: %w", err))
+			return
+		}
+
+		entries := make([]Entry, 0, len(before))
+		var versions []Version
+		for id, previous := range before {
+			current, exists := after[id]
+			entry := Entry{Action: action, Resource: table.resource, ResourceID: id}
+
+			switch {
+			case action == ActionDelete && exists:
+				// Borrado lógico: se registra el estado previo y la marca de borrado
+				entry.Changes = Snapshot(previous, table.ignore, false)
+				for column, change := range Diff(previous, current, table.ignore) {
+					entry.Changes[column] = change
+				}
+			case action == ActionDelete:
+				entry.Changes = Snapshot(previous, table.ignore, false)
+			case exists:
+				entry.Changes = Diff(previous, current, table.ignore)
+			}
+
+			if len(entry.Changes) == 0 {
+				continue
+			}
+			entry.Changes = RedactColumns(entry.Changes, table.redact)
+			entries = append(entries, entry)
+
+			if table.versioned && action == ActionUpdate {
+				changed := make([]string, 0, len(entry.Changes))
+				for column := range entry.Changes {
+					changed = append(changed, column)
+				}
+				sort.Strings(changed)
+				versions = append(versions, Version{
+					Action:     ActionUpdate,
+					Resource:   table.resource,
+					ResourceID: id,
+					Values:     State(current, table.ignore),
+					Previous:   State(previous, table.ignore),
+					Changed:    changed,
+				})
+			}
+		}
+		p.save(tx, entries, versions)
+	}
+}
+
+// load lee las filas de la tabla de la sentencia indexadas por clave primaria
+func (p *Plugin) load(tx *gorm.DB, condition clause.Expression, unscoped bool) (map[string]map[string]any, error) {
+	query := tx.Session(&gorm.Session{NewDB: true}).
+		Table(tx.Statement.Table).
+		Clauses(clause.Where{Exprs: []clause.Expression{condition}})
+	if !unscoped {
+		if field := tx.Statement.Schema.LookUpField("deleted_at"); field != nil {
+			query = query.Where(clause.Eq{Column: clause.Column{Name: field.DBName}, Value: nil})
+		}
+	}
+
+	var rows []map[string]any
+	if err := query.Find(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	pk := tx.Statement.Schema.PrioritizedPrimaryField.DBName
+	result := make(map[string]map[string]any, len(rows))
+	for _, row := range rows {
+		result[fmt.Sprint(normalize(row[pk]))] = row
+	}
+	return result, nil
+}
+
+// save guarda las entradas y las versiones en la misma transacción que el cambio
+func (p *Plugin) save(tx *gorm.DB, entries []Entry, versions []Version) {
+	if len(entries) > 0 {
+		if err := p.record(tx.Session(&gorm.Session{NewDB: true}), entries); err != nil {
+			tx.AddError(fmt.Errorf("audit: %w", err))
+			return
+		}
+	}
+	if len(versions) > 0 && p.version != nil {
+		if err := p.version(tx.Session(&gorm.Session{NewDB: true}), versions); err != nil {
+			tx.AddError(fmt.Errorf("audit: %w", err))
+		}
+	}
+}
+
+func (p *Plugin) pkColumn(tx *gorm.DB) clause.Column {
+	return clause.Column{Name: tx.Statement.Schema.PrioritizedPrimaryField.DBName}
+}
+
+// primaryKeys claves primarias no vacías del valor de la sentencia (struct o slice)
+func primaryKeys(tx *gorm.DB) []any {
+	field := tx.Statement.Schema.PrioritizedPrimaryField
+	ctx := tx.Statement.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	var ids []any
+	add := func(rv reflect.Value) {
+		if value, zero := field.ValueOf(ctx, rv); !zero {
+			ids = append(ids, fmt.Sprint(value))
+		}
+	}
+
+	rv := reflect.Indirect(tx.Statement.ReflectValue)
+	switch rv.Kind() {
+	case reflect.Struct:
+		if rv.Type() == tx.Statement.Schema.ModelType {
+			add(rv)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < rv.Len(); i++ {
+			if elem := reflect.Indirect(rv.Index(i)); elem.Kind() == reflect.Struct && elem.Type() == tx.Statement.Schema.ModelType {
+				add(elem)
+			}
+		}
+	}
+	return ids
+}
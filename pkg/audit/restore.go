@@ -0,0 +1,70 @@
+package audit
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"gorm.io/gorm/schema"
+)
+
+// schemaCache esquemas de los modelos que se restauran
+var schemaCache sync.Map
+
+// Restore copia al modelo las columnas indicadas de un estado guardado con
+// State y leído de JSON (fechas como texto, números como float64). Las
+// columnas ocultas o ausentes del estado se dejan como están
+func Restore(model any, values map[string]any, columns []string) error {
+	s, err := schema.Parse(model, &schemaCache, schema.NamingStrategy{})
+	if err != nil {
+		return err
+	}
+	rv := reflect.ValueOf(model)
+	ctx := context.Background()
+
+	for _, column := range columns {
+		field := s.LookUpField(column)
+		if field == nil {
+			return fmt.Errorf("audit: unknown column %q", column)
+		}
+		value, ok := values[column]
+		if !ok || value == Redacted {
+			continue
+		}
+
+		decoded, err := decodeColumn(field, value)
+		if err != nil {
+			return fmt.Errorf("audit: column %q: %w", column, err)
+		}
+		if err := field.Set(ctx, rv, decoded); err != nil {
+			return fmt.Errorf("audit: column %q: %w", column, err)
+		}
+	}
+	return nil
+}
+
+// decodeColumn convierte un valor leído de JSON al tipo del campo. Los tipos
+// que leen de la base de datos (JSON, etc.) reciben el valor tal cual
+func decodeColumn(field *schema.Field, value any) (any, error) {
+	target := reflect.New(field.FieldType)
+	if scanner, ok := target.Interface().(sql.Scanner); ok {
+		if value != nil {
+			if err := scanner.Scan(value); err != nil {
+				return nil, err
+			}
+		}
+		return target.Elem().Interface(), nil
+	}
+
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(raw, target.Interface()); err != nil {
+		return nil, err
+	}
+	return target.Elem().Interface(), nil
+}
@@ -0,0 +1,80 @@
+package audit
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/datatypes"
+)
+
+type restoreLevel string
+
+// restoreModel modelo con los tipos de columna de eventos y organizaciones
+type restoreModel struct {
+	ID        string
+	Title     string
+	Level     restoreLevel
+	StartDate time.Time
+	EndDate   *time.Time
+	Latitude  *float64
+	Price     *int
+	IsOnline  bool
+	Tags      datatypes.JSON `gorm:"type:jsonb"`
+	APIKey    string
+}
+
+// TestRestore tests para la restauración de un estado guardado
+func TestRestore(t *testing.T) {
+	start := time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC)
+	latitude := 40.4168
+	price := 1500
+	saved := State(map[string]any{
+		"title":      "Versión buena",
+		"level":      "advanced",
+		"start_date": start,
+		"end_date":   nil,
+		"latitude":   &latitude,
+		"price":      &price,
+		"is_online":  true,
+		"tags":       []byte(`["osint","dfir"]`),
+		"api_key":    "clave",
+	}, nil)
+
+	// El estado se guarda como JSON
+	raw, err := json.Marshal(saved)
+	require.NoError(t, err)
+	var stored map[string]any
+	require.NoError(t, json.Unmarshal(raw, &stored))
+
+	end := start.Add(time.Hour)
+	model := &restoreModel{
+		ID:        "id-1",
+		Title:     "Edición rota",
+		StartDate: start.AddDate(0, 1, 0),
+		EndDate:   &end,
+		Tags:      datatypes.JSON(`[]`),
+		APIKey:    "actual",
+	}
+
+	columns := []string{"title", "level", "start_date", "end_date", "latitude", "price", "is_online", "tags", "api_key"}
+	require.NoError(t, Restore(model, stored, columns))
+
+	assert.Equal(t, "id-1", model.ID)
+	assert.Equal(t, "Versión buena", model.Title)
+	assert.Equal(t, restoreLevel("advanced"), model.Level)
+	assert.True(t, model.StartDate.Equal(start))
+	assert.Nil(t, model.EndDate)
+	require.NotNil(t, model.Latitude)
+	assert.Equal(t, latitude, *model.Latitude)
+	require.NotNil(t, model.Price)
+	assert.Equal(t, price, *model.Price)
+	assert.True(t, model.IsOnline)
+	assert.JSONEq(t, `["osint","dfir"]`, string(model.Tags))
+	// Las columnas ocultas no se restauran
+	assert.Equal(t, "actual", model.APIKey)
+
+	assert.Error(t, Restore(model, stored, []string{"unknown"}))
+}
@@ -0,0 +1,144 @@
+package dataexport
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"reflect"
+	"time"
+)
+
+// FormatVersion versión del formato del archivo
+const FormatVersion = 1
+
+// ManifestName fichero que describe el contenido del archivo
+const ManifestName = "manifest.json"
+
+// ErrClosed el archivo ya se ha cerrado
+var ErrClosed = errors.New("dataexport: archive already closed")
+
+// File fichero incluido en el archivo
+type File struct {
+	Name    string `json:"name"`
+	Records int    `json:"records"`
+}
+
+// Manifest descripción del archivo: titular, fecha y ficheros incluidos
+type Manifest struct {
+	Version     int       `json:"version"`
+	Subject     string    `json:"subject"`
+	GeneratedAt time.Time `json:"generated_at"`
+	Files       []File    `json:"files"`
+}
+
+// Archive archivo ZIP con un fichero JSON por tipo de dato y un manifiesto
+type Archive struct {
+	buf      bytes.Buffer
+	zw       *zip.Writer
+	manifest Manifest
+	names    map[string]bool
+	closed   bool
+}
+
+// New crea un archivo vacío para los datos de subject
+func New(subject string, generatedAt time.Time) *Archive {
+	a := &Archive{
+		manifest: Manifest{
+			Version:     FormatVersion,
+			Subject:     subject,
+			GeneratedAt: generatedAt.UTC(),
+			Files:       []File{},
+		},
+		names: map[string]bool{ManifestName: true},
+	}
+	a.zw = zip.NewWriter(&a.buf)
+	return a
+}
+
+// AddJSON añade v como fichero JSON indentado. Las listas cuentan un registro
+// por elemento; cualquier otro valor cuenta como uno
+func (a *Archive) AddJSON(name string, v any) error {
+	if a.closed {
+		return ErrClosed
+	}
+	if path.Ext(name) != ".json" || path.Base(name) != name {
+		return fmt.Errorf("dataexport: invalid file name %q", name)
+	}
+	if a.names[name] {
+		return fmt.Errorf("dataexport: duplicate file %q", name)
+	}
+
+	if err := a.writeJSON(name, v); err != nil {
+		return err
+	}
+	a.names[name] = true
+	a.manifest.Files = append(a.manifest.Files, File{Name: name, Records: countRecords(v)})
+	return nil
+}
+
+// Bytes añade el manifiesto, cierra el archivo y devuelve su contenido
+func (a *Archive) Bytes() ([]byte, error) {
+	if a.closed {
+		return nil, ErrClosed
+	}
+	if err := a.writeJSON(ManifestName, a.manifest); err != nil {
+		return nil, err
+	}
+	if err := a.zw.Close(); err != nil {
+		return nil, err
+	}
+	a.closed = true
+	return a.buf.Bytes(), nil
+}
+
+// Manifest descripción de los ficheros añadidos hasta ahora
+func (a *Archive) Manifest() Manifest {
+	return a.manifest
+}
+
+// Checksum huella SHA-256 en hexadecimal de un archivo generado
+func Checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// writeJSON escribe un fichero con la fecha de generación del archivo
+func (a *Archive) writeJSON(name string, v any) error {
+	body, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("dataexport: %s: %w", name, err)
+	}
+
+	w, err := a.zw.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: a.manifest.GeneratedAt,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(body, '\n'))
+	return err
+}
+
+// countRecords número de elementos de una lista (uno para otros valores, cero para nil)
+func countRecords(v any) int {
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() {
+		return 0
+	}
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		return rv.Len()
+	case reflect.Pointer, reflect.Map:
+		if rv.IsNil() {
+			return 0
+		}
+	}
+	return 1
+}
@@ -0,0 +1,97 @@
+package dataexport
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// readArchive lee los ficheros de un archivo generado
+func readArchive(t *testing.T, data []byte) map[string][]byte {
+	t.Helper()
+
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+
+	files := make(map[string][]byte, len(zr.File))
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		require.NoError(t, err)
+		body, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		require.NoError(t, rc.Close())
+		files[f.Name] = body
+	}
+	return files
+}
+
+// TestArchive tests para la generación del archivo
+func TestArchive(t *testing.T) {
+	generatedAt := time.Date(2026, 10, 18, 10, 0, 0, 0, time.UTC)
+
+	t.Run("ficheros y manifiesto", func(t *testing.T) {
+		archive := New("uuid-usuario", generatedAt)
+		require.NoError(t, archive.AddJSON("profile.json", map[string]string{"email": "ana@example.com"}))
+		require.NoError(t, archive.AddJSON("sessions.json", []string{"a", "b"}))
+		require.NoError(t, archive.AddJSON("favorites.json", []string{}))
+
+		data, err := archive.Bytes()
+		require.NoError(t, err)
+
+		files := readArchive(t, data)
+		assert.Len(t, files, 4)
+		assert.JSONEq(t, `{"email":"ana@example.com"}`, string(files["profile.json"]))
+		assert.JSONEq(t, `[]`, string(files["favorites.json"]))
+
+		var manifest Manifest
+		require.NoError(t, json.Unmarshal(files[ManifestName], &manifest))
+		assert.Equal(t, FormatVersion, manifest.Version)
+		assert.Equal(t, "uuid-usuario", manifest.Subject)
+		assert.True(t, generatedAt.Equal(manifest.GeneratedAt))
+		assert.Equal(t, []File{
+			{Name: "profile.json", Records: 1},
+			{Name: "sessions.json", Records: 2},
+			{Name: "favorites.json", Records: 0},
+		}, manifest.Files)
+	})
+
+	t.Run("nombres inválidos o repetidos", func(t *testing.T) {
+		archive := New("uuid-usuario", generatedAt)
+		require.NoError(t, archive.AddJSON("profile.json", nil))
+
+		assert.Error(t, archive.AddJSON("profile.json", nil))
+		assert.Error(t, archive.AddJSON(ManifestName, nil))
+		assert.Error(t, archive.AddJSON("../profile.json", nil))
+		assert.Error(t, archive.AddJSON("profile.txt", nil))
+	})
+
+	t.Run("archivo cerrado", func(t *testing.T) {
+		archive := New("uuid-usuario", generatedAt)
+		_, err := archive.Bytes()
+		require.NoError(t, err)
+
+		assert.ErrorIs(t, archive.AddJSON("profile.json", nil), ErrClosed)
+		_, err = archive.Bytes()
+		assert.ErrorIs(t, err, ErrClosed)
+	})
+
+	t.Run("mismo contenido, mismo archivo", func(t *testing.T) {
+		build := func() []byte {
+			archive := New("uuid-usuario", generatedAt)
+			require.NoError(t, archive.AddJSON("profile.json", map[string]int{"a": 1}))
+			data, err := archive.Bytes()
+			require.NoError(t, err)
+			return data
+		}
+
+		first, second := build(), build()
+		assert.Equal(t, Checksum(first), Checksum(second))
+		assert.Len(t, Checksum(first), 64)
+	})
+}
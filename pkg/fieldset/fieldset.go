@@ -0,0 +1,213 @@
+// Package fieldset selecciona campos de una respuesta JSON (sparse fieldsets)
+// a partir de una lista como
+//
+//	id,title,start_date,organization.name
+//
+// Los campos anidados se indican con punto. La selección se valida contra los
+// nombres JSON del tipo de la respuesta y se aplica sobre su forma serializada,
+// de modo que respeta las etiquetas json (omitempty incluido).
+package fieldset
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Límites para acotar selecciones arbitrarias
+const (
+	MaxFields = 50 // Campos en total
+	MaxDepth  = 3  // Niveles de anidamiento de un campo
+)
+
+// ErrEmptyField campo vacío en la lista (p. ej. "id,,title")
+var ErrEmptyField = errors.New("empty field name")
+
+// FieldError campo inexistente o demasiado anidado
+type FieldError struct {
+	Path string
+	Msg  string
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Path, e.Msg)
+}
+
+// Set campos seleccionados; un hijo nil selecciona el valor completo
+type Set map[string]Set
+
+// Parse analiza una lista de campos separados por coma
+func Parse(spec string) (Set, error) {
+	set := Set{}
+	parts := strings.Split(spec, ",")
+	if len(parts) > MaxFields {
+		return nil, fmt.Errorf("more than %d fields", MaxFields)
+	}
+
+	for _, part := range parts {
+		path := strings.ToLower(strings.TrimSpace(part))
+		if path == "" {
+			return nil, ErrEmptyField
+		}
+		if err := set.Add(path); err != nil {
+			return nil, err
+		}
+	}
+
+	return set, nil
+}
+
+// Add selecciona un campo; si ya estaba seleccionado completo no cambia nada
+func (s Set) Add(path string) error {
+	segments := strings.Split(path, ".")
+	if len(segments) > MaxDepth {
+		return &FieldError{Path: path, Msg: fmt.Sprintf("nested more than %d levels", MaxDepth)}
+	}
+
+	current := s
+	for i, segment := range segments {
+		if segment == "" {
+			return ErrEmptyField
+		}
+
+		child, exists := current[segment]
+		last := i == len(segments)-1
+		switch {
+		case last:
+			current[segment] = nil
+			return nil
+		case exists && child == nil:
+			// El padre ya se incluye completo
+			return nil
+		case !exists:
+			child = Set{}
+			current[segment] = child
+		}
+		current = child
+	}
+
+	return nil
+}
+
+// Validate comprueba que los campos existen en el tipo t según sus etiquetas json
+func (s Set) Validate(t reflect.Type) error {
+	return s.validate(t, "")
+}
+
+func (s Set) validate(t reflect.Type, prefix string) error {
+	t = elemType(t)
+
+	// Mapas e interfaces admiten cualquier clave
+	if t.Kind() == reflect.Map || t.Kind() == reflect.Interface {
+		return nil
+	}
+
+	fields := jsonFields(t)
+	for name, child := range s {
+		path := prefix + name
+		fieldType, ok := fields[name]
+		if !ok {
+			return &FieldError{Path: path, Msg: "unknown field"}
+		}
+		if child == nil {
+			continue
+		}
+		if err := child.validate(fieldType, path+"."); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Project devuelve v reducido a los campos seleccionados; los arrays se
+// reducen elemento a elemento
+func Project(v interface{}, s Set) (interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var generic interface{}
+	if err := json.Unmarshal(data, &generic); err != nil {
+		return nil, err
+	}
+
+	return s.project(generic), nil
+}
+
+func (s Set) project(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(s))
+		for name, child := range s {
+			field, ok := v[name]
+			if !ok {
+				continue
+			}
+			if child == nil {
+				result[name] = field
+			} else {
+				result[name] = child.project(field)
+			}
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			result[i] = s.project(item)
+		}
+		return result
+	default:
+		return value
+	}
+}
+
+// elemType tipo base tras punteros, slices y arrays
+func elemType(t reflect.Type) reflect.Type {
+	for {
+		switch t.Kind() {
+		case reflect.Ptr, reflect.Slice, reflect.Array:
+			t = t.Elem()
+		default:
+			return t
+		}
+	}
+}
+
+// jsonFields nombres JSON de un struct, incluidos los de structs embebidos
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	fields := make(map[string]reflect.Type)
+	if t.Kind() != reflect.Struct {
+		return fields
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name := strings.Split(tag, ",")[0]
+		if field.Anonymous && name == "" {
+			for embedded, fieldType := range jsonFields(elemType(field.Type)) {
+				if _, exists := fields[embedded]; !exists {
+					fields[embedded] = fieldType
+				}
+			}
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = field.Type
+	}
+
+	return fields
+}
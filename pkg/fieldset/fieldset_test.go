@@ -0,0 +1,162 @@
+package fieldset
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testOrganization struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type testBase struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+}
+
+type testEvent struct {
+	testBase
+	Price        *int                   `json:"price,omitempty"`
+	Tags         []string               `json:"tags"`
+	Organization *testOrganization      `json:"organization,omitempty"`
+	Sessions     []testOrganization     `json:"sessions"`
+	Metadata     map[string]interface{} `json:"metadata"`
+	internal     string
+	Hidden       string `json:"-"`
+}
+
+// TestParse tests para el análisis de listas de campos
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    Set
+		wantErr bool
+	}{
+		{
+			name:  "campos simples con espacios y mayúsculas",
+			input: "id, Title ,start_date",
+			want:  Set{"id": nil, "title": nil, "start_date": nil},
+		},
+		{
+			name:  "campos anidados",
+			input: "id,organization.name,organization.id",
+			want:  Set{"id": nil, "organization": Set{"name": nil, "id": nil}},
+		},
+		{
+			name:  "el campo completo prevalece sobre sus hijos",
+			input: "organization.name,organization,organization.id",
+			want:  Set{"organization": nil},
+		},
+		{name: "campo vacío", input: "id,,title", wantErr: true},
+		{name: "segmento vacío", input: "organization..name", wantErr: true},
+		{name: "demasiado anidado", input: "a.b.c.d", wantErr: true},
+		{name: "demasiados campos", input: strings.Repeat("a,", MaxFields) + "a", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// TestValidate tests para la validación contra las etiquetas json
+func TestValidate(t *testing.T) {
+	eventType := reflect.TypeOf(testEvent{})
+
+	tests := []struct {
+		name     string
+		input    string
+		wantPath string
+	}{
+		{name: "campos propios y embebidos", input: "id,title,price,tags"},
+		{name: "anidado en puntero", input: "organization.name"},
+		{name: "anidado en slice", input: "sessions.id"},
+		{name: "claves de un mapa", input: "metadata.cualquiera"},
+		{name: "campo inexistente", input: "id,secret", wantPath: "secret"},
+		{name: "campo excluido con json:\"-\"", input: "hidden", wantPath: "hidden"},
+		{name: "campo no exportado", input: "internal", wantPath: "internal"},
+		{name: "anidado inexistente", input: "organization.email", wantPath: "organization.email"},
+		{name: "anidado en un escalar", input: "title.length", wantPath: "title.length"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			set, err := Parse(tt.input)
+			require.NoError(t, err)
+
+			err = set.Validate(eventType)
+			if tt.wantPath == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			var fieldErr *FieldError
+			require.ErrorAs(t, err, &fieldErr)
+			assert.Equal(t, tt.wantPath, fieldErr.Path)
+		})
+	}
+}
+
+// TestProject tests para la reducción de respuestas
+func TestProject(t *testing.T) {
+	price := 1500
+	events := []testEvent{
+		{
+			testBase:     testBase{ID: "1", Title: "Congreso"},
+			Price:        &price,
+			Tags:         []string{"ctf"},
+			Organization: &testOrganization{ID: "org-1", Name: "CybESphere"},
+			Sessions:     []testOrganization{{ID: "s-1", Name: "Apertura"}},
+		},
+		{
+			testBase: testBase{ID: "2", Title: "Taller"},
+		},
+	}
+
+	t.Run("lista con campos anidados", func(t *testing.T) {
+		set, err := Parse("id,price,organization.name,sessions.id")
+		require.NoError(t, err)
+
+		got, err := Project(events, set)
+		require.NoError(t, err)
+
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{
+				"id":           "1",
+				"price":        float64(1500),
+				"organization": map[string]interface{}{"name": "CybESphere"},
+				"sessions":     []interface{}{map[string]interface{}{"id": "s-1"}},
+			},
+			map[string]interface{}{
+				"id":       "2",
+				"sessions": nil,
+			},
+		}, got)
+	})
+
+	t.Run("objeto con valor completo", func(t *testing.T) {
+		set, err := Parse("title,organization")
+		require.NoError(t, err)
+
+		got, err := Project(events[0], set)
+		require.NoError(t, err)
+
+		assert.Equal(t, map[string]interface{}{
+			"title":        "Congreso",
+			"organization": map[string]interface{}{"id": "org-1", "name": "CybESphere"},
+		}, got)
+	})
+}
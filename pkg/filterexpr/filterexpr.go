@@ -0,0 +1,445 @@
+// Package filterexpr analiza expresiones de filtrado del tipo
+//
+//	start_date >= 2026-11-01 and (type in (workshop, training) or tags has "ctf")
+//
+// y las convierte en un árbol que cada consumidor compila a su destino
+// (por ejemplo, cláusulas SQL parametrizadas). El paquete solo valida la
+// sintaxis: qué campos existen y qué valores admiten lo decide el consumidor.
+package filterexpr
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Operator operador de comparación
+type Operator string
+
+const (
+	OpEqual        Operator = "="
+	OpNotEqual     Operator = "!="
+	OpGreater      Operator = ">"
+	OpGreaterEqual Operator = ">="
+	OpLess         Operator = "<"
+	OpLessEqual    Operator = "<="
+	OpIn           Operator = "in"
+	OpNotIn        Operator = "not in"
+	OpHas          Operator = "has"  // El array contiene el valor
+	OpLike         Operator = "like" // Contiene el texto
+)
+
+// Límites para acotar el coste de expresiones arbitrarias
+const (
+	MaxLength     = 1000 // Caracteres de la expresión
+	MaxDepth      = 10   // Niveles de paréntesis y negaciones
+	MaxConditions = 30   // Comparaciones en total
+	MaxListValues = 50   // Valores de una lista in (...)
+)
+
+// Node nodo del árbol de una expresión
+type Node interface {
+	node()
+}
+
+// And conjunción de dos expresiones
+type And struct {
+	Left, Right Node
+}
+
+// Or disyunción de dos expresiones
+type Or struct {
+	Left, Right Node
+}
+
+// Not negación de una expresión
+type Not struct {
+	Expr Node
+}
+
+// Comparison comparación de un campo con uno o varios valores
+type Comparison struct {
+	Field    string
+	Operator Operator
+	Values   []string // Un valor salvo en in / not in
+	Pos      int      // Posición del campo en la expresión (desde 1)
+}
+
+func (And) node()        {}
+func (Or) node()         {}
+func (Not) node()        {}
+func (Comparison) node() {}
+
+// SyntaxError error de sintaxis con la posición en la que se detectó
+type SyntaxError struct {
+	Pos int
+	Msg string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("%s at position %d", e.Msg, e.Pos)
+}
+
+// Parse analiza una expresión completa
+func Parse(input string) (Node, error) {
+	if strings.TrimSpace(input) == "" {
+		return nil, &SyntaxError{Pos: 1, Msg: "empty expression"}
+	}
+	if len(input) > MaxLength {
+		return nil, &SyntaxError{Pos: MaxLength, Msg: fmt.Sprintf("expression longer than %d characters", MaxLength)}
+	}
+
+	tokens, err := tokenize(input)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+	node, err := p.parseOr(0)
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokenEOF {
+		return nil, p.unexpected(tok)
+	}
+
+	return node, nil
+}
+
+// Fields devuelve las comparaciones de la expresión en orden de aparición
+func Fields(node Node) []Comparison {
+	var result []Comparison
+	var walk func(Node)
+	walk = func(n Node) {
+		switch v := n.(type) {
+		case And:
+			walk(v.Left)
+			walk(v.Right)
+		case Or:
+			walk(v.Left)
+			walk(v.Right)
+		case Not:
+			walk(v.Expr)
+		case Comparison:
+			result = append(result, v)
+		}
+	}
+	walk(node)
+	return result
+}
+
+// =============================================================================
+// ANÁLISIS LÉXICO
+// =============================================================================
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenWord
+	tokenString
+	tokenOperator
+	tokenLParen
+	tokenRParen
+	tokenComma
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+	pos   int
+}
+
+// isWordRune caracteres admitidos en nombres de campo y valores sin comillas
+// (fechas, números, identificadores, instantes RFC3339)
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune("_-.:+", r)
+}
+
+func tokenize(input string) ([]token, error) {
+	runes := []rune(input)
+	var tokens []token
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		pos := i + 1
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, token{kind: tokenLParen, value: "(", pos: pos})
+			i++
+		case r == ')':
+			tokens = append(tokens, token{kind: tokenRParen, value: ")", pos: pos})
+			i++
+		case r == ',':
+			tokens = append(tokens, token{kind: tokenComma, value: ",", pos: pos})
+			i++
+		case r == '=' || r == '!' || r == '<' || r == '>':
+			op := string(r)
+			if i+1 < len(runes) && runes[i+1] == '=' {
+				op += "="
+			}
+			if op == "!" {
+				return nil, &SyntaxError{Pos: pos, Msg: "expected '!='"}
+			}
+			if op == "==" {
+				op = "="
+			}
+			tokens = append(tokens, token{kind: tokenOperator, value: op, pos: pos})
+			i += len(op)
+			if op == "=" && i < len(runes) && runes[i] == '=' {
+				i++
+			}
+		case r == '"' || r == '\'':
+			value, next, err := readString(runes, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, token{kind: tokenString, value: value, pos: pos})
+			i = next
+		case isWordRune(r):
+			start := i
+			for i < len(runes) && isWordRune(runes[i]) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenWord, value: string(runes[start:i]), pos: pos})
+		default:
+			return nil, &SyntaxError{Pos: pos, Msg: fmt.Sprintf("unexpected character %q", r)}
+		}
+	}
+
+	return append(tokens, token{kind: tokenEOF, pos: len(runes) + 1}), nil
+}
+
+// readString lee un texto entre comillas; la comilla se escapa con \
+func readString(runes []rune, start int) (string, int, error) {
+	quote := runes[start]
+	var b strings.Builder
+
+	for i := start + 1; i < len(runes); i++ {
+		switch runes[i] {
+		case '\\':
+			if i+1 < len(runes) {
+				i++
+				b.WriteRune(runes[i])
+			}
+		case quote:
+			return b.String(), i + 1, nil
+		default:
+			b.WriteRune(runes[i])
+		}
+	}
+
+	return "", 0, &SyntaxError{Pos: start + 1, Msg: "unterminated string"}
+}
+
+// =============================================================================
+// ANÁLISIS SINTÁCTICO
+// =============================================================================
+
+// parser descenso recursivo sobre la gramática:
+//
+//	or         := and ("or" and)*
+//	and        := unary ("and" unary)*
+//	unary      := "not" unary | "(" or ")" | comparison
+//	comparison := field op value | field ["not"] "in" "(" value ("," value)* ")"
+//	            | field "has" value | field "like" value
+type parser struct {
+	tokens     []token
+	current    int
+	conditions int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.current]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.current]
+	if tok.kind != tokenEOF {
+		p.current++
+	}
+	return tok
+}
+
+// isKeyword indica si el token es la palabra reservada indicada
+func isKeyword(tok token, keyword string) bool {
+	return tok.kind == tokenWord && strings.EqualFold(tok.value, keyword)
+}
+
+func (p *parser) unexpected(tok token) error {
+	if tok.kind == tokenEOF {
+		return &SyntaxError{Pos: tok.pos, Msg: "unexpected end of expression"}
+	}
+	return &SyntaxError{Pos: tok.pos, Msg: fmt.Sprintf("unexpected %q", tok.value)}
+}
+
+func (p *parser) parseOr(depth int) (Node, error) {
+	left, err := p.parseAnd(depth)
+	if err != nil {
+		return nil, err
+	}
+	for isKeyword(p.peek(), "or") {
+		p.next()
+		right, err := p.parseAnd(depth)
+		if err != nil {
+			return nil, err
+		}
+		left = Or{Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd(depth int) (Node, error) {
+	left, err := p.parseUnary(depth)
+	if err != nil {
+		return nil, err
+	}
+	for isKeyword(p.peek(), "and") {
+		p.next()
+		right, err := p.parseUnary(depth)
+		if err != nil {
+			return nil, err
+		}
+		left = And{Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseUnary(depth int) (Node, error) {
+	tok := p.peek()
+	if depth >= MaxDepth {
+		return nil, &SyntaxError{Pos: tok.pos, Msg: fmt.Sprintf("expression nested more than %d levels", MaxDepth)}
+	}
+
+	if isKeyword(tok, "not") {
+		p.next()
+		expr, err := p.parseUnary(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		return Not{Expr: expr}, nil
+	}
+
+	if tok.kind == tokenLParen {
+		p.next()
+		expr, err := p.parseOr(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.next(); closing.kind != tokenRParen {
+			if closing.kind == tokenEOF {
+				return nil, &SyntaxError{Pos: tok.pos, Msg: "unclosed parenthesis"}
+			}
+			return nil, p.unexpected(closing)
+		}
+		return expr, nil
+	}
+
+	return p.parseComparison()
+}
+
+func (p *parser) parseComparison() (Node, error) {
+	field := p.next()
+	if field.kind != tokenWord || isReserved(field.value) {
+		return nil, p.unexpected(field)
+	}
+
+	p.conditions++
+	if p.conditions > MaxConditions {
+		return nil, &SyntaxError{Pos: field.pos, Msg: fmt.Sprintf("more than %d conditions", MaxConditions)}
+	}
+
+	comparison := Comparison{Field: strings.ToLower(field.value), Pos: field.pos}
+	tok := p.next()
+
+	switch {
+	case tok.kind == tokenOperator:
+		comparison.Operator = Operator(tok.value)
+	case isKeyword(tok, "in"):
+		comparison.Operator = OpIn
+	case isKeyword(tok, "not") && isKeyword(p.peek(), "in"):
+		p.next()
+		comparison.Operator = OpNotIn
+	case isKeyword(tok, "has"):
+		comparison.Operator = OpHas
+	case isKeyword(tok, "like"):
+		comparison.Operator = OpLike
+	default:
+		if tok.kind == tokenEOF {
+			return nil, &SyntaxError{Pos: tok.pos, Msg: fmt.Sprintf("expected operator after %q", field.value)}
+		}
+		return nil, &SyntaxError{Pos: tok.pos, Msg: fmt.Sprintf("unknown operator %q", tok.value)}
+	}
+
+	if comparison.Operator == OpIn || comparison.Operator == OpNotIn {
+		values, err := p.parseList()
+		if err != nil {
+			return nil, err
+		}
+		comparison.Values = values
+		return comparison, nil
+	}
+
+	value, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+	comparison.Values = []string{value}
+	return comparison, nil
+}
+
+func (p *parser) parseList() ([]string, error) {
+	open := p.next()
+	if open.kind != tokenLParen {
+		return nil, &SyntaxError{Pos: open.pos, Msg: "expected '(' after in"}
+	}
+
+	var values []string
+	for {
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+		if len(values) > MaxListValues {
+			return nil, &SyntaxError{Pos: open.pos, Msg: fmt.Sprintf("list longer than %d values", MaxListValues)}
+		}
+
+		switch tok := p.next(); tok.kind {
+		case tokenComma:
+			continue
+		case tokenRParen:
+			return values, nil
+		case tokenEOF:
+			return nil, &SyntaxError{Pos: open.pos, Msg: "unclosed list"}
+		default:
+			return nil, p.unexpected(tok)
+		}
+	}
+}
+
+func (p *parser) parseValue() (string, error) {
+	tok := p.next()
+	switch {
+	case tok.kind == tokenString:
+		return tok.value, nil
+	case tok.kind == tokenWord && !isReserved(tok.value):
+		return tok.value, nil
+	case tok.kind == tokenEOF:
+		return "", &SyntaxError{Pos: tok.pos, Msg: "expected value"}
+	default:
+		return "", p.unexpected(tok)
+	}
+}
+
+// isReserved palabras que no pueden usarse como campo ni valor sin comillas
+func isReserved(word string) bool {
+	switch strings.ToLower(word) {
+	case "and", "or", "not", "in", "has", "like":
+		return true
+	}
+	return false
+}
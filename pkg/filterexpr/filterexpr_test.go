@@ -0,0 +1,158 @@
+package filterexpr
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestParse tests para el análisis de expresiones válidas
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  Node
+	}{
+		{
+			name:  "comparación simple sin espacios",
+			input: "start_date>=2026-11-01",
+			want:  Comparison{Field: "start_date", Operator: OpGreaterEqual, Values: []string{"2026-11-01"}, Pos: 1},
+		},
+		{
+			name:  "lista in",
+			input: "type in (workshop, training)",
+			want:  Comparison{Field: "type", Operator: OpIn, Values: []string{"workshop", "training"}, Pos: 1},
+		},
+		{
+			name:  "not in y texto entre comillas",
+			input: `city not in ("A Coruña", 'Las Palmas')`,
+			want:  Comparison{Field: "city", Operator: OpNotIn, Values: []string{"A Coruña", "Las Palmas"}, Pos: 1},
+		},
+		{
+			name:  "and tiene prioridad sobre or",
+			input: "is_free = true or price < 1000 and is_online = false",
+			want: Or{
+				Left: Comparison{Field: "is_free", Operator: OpEqual, Values: []string{"true"}, Pos: 1},
+				Right: And{
+					Left:  Comparison{Field: "price", Operator: OpLess, Values: []string{"1000"}, Pos: 19},
+					Right: Comparison{Field: "is_online", Operator: OpEqual, Values: []string{"false"}, Pos: 36},
+				},
+			},
+		},
+		{
+			name:  "paréntesis, has y palabras clave en mayúsculas",
+			input: `start_date>=2026-11-01 AND (type in (workshop,training) OR tags has "ctf")`,
+			want: And{
+				Left: Comparison{Field: "start_date", Operator: OpGreaterEqual, Values: []string{"2026-11-01"}, Pos: 1},
+				Right: Or{
+					Left:  Comparison{Field: "type", Operator: OpIn, Values: []string{"workshop", "training"}, Pos: 29},
+					Right: Comparison{Field: "tags", Operator: OpHas, Values: []string{"ctf"}, Pos: 60},
+				},
+			},
+		},
+		{
+			name:  "negación y like",
+			input: `not title like "intro"`,
+			want:  Not{Expr: Comparison{Field: "title", Operator: OpLike, Values: []string{"intro"}, Pos: 5}},
+		},
+		{
+			name:  "comillas escapadas, == y campo en mayúsculas",
+			input: `Title == "el \"mejor\" CTF"`,
+			want:  Comparison{Field: "title", Operator: OpEqual, Values: []string{`el "mejor" CTF`}, Pos: 1},
+		},
+		{
+			name:  "instante RFC3339",
+			input: "start_date < 2026-11-01T10:00:00+01:00",
+			want:  Comparison{Field: "start_date", Operator: OpLess, Values: []string{"2026-11-01T10:00:00+01:00"}, Pos: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// TestParseErrors tests para expresiones inválidas
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantMsg string
+		wantPos int
+	}{
+		{name: "vacía", input: "   ", wantMsg: "empty expression", wantPos: 1},
+		{name: "sin operador", input: "type", wantMsg: `expected operator after "type"`, wantPos: 5},
+		{name: "operador desconocido", input: "type is workshop", wantMsg: `unknown operator "is"`, wantPos: 6},
+		{name: "sin valor", input: "type =", wantMsg: "expected value", wantPos: 7},
+		{name: "paréntesis sin cerrar", input: "(type = a", wantMsg: "unclosed parenthesis", wantPos: 1},
+		{name: "paréntesis de más", input: "type = a)", wantMsg: `unexpected ")"`, wantPos: 9},
+		{name: "lista sin paréntesis", input: "type in workshop", wantMsg: "expected '(' after in", wantPos: 9},
+		{name: "lista sin cerrar", input: "type in (a, b", wantMsg: "unclosed list", wantPos: 9},
+		{name: "texto sin cerrar", input: `title = "abc`, wantMsg: "unterminated string", wantPos: 9},
+		{name: "carácter no válido", input: "type = a; drop", wantMsg: `unexpected character ';'`, wantPos: 9},
+		{name: "palabra reservada como valor", input: "type = and", wantMsg: `unexpected "and"`, wantPos: 8},
+		{name: "and colgante", input: "type = a and", wantMsg: "unexpected end of expression", wantPos: 13},
+		{name: "exclamación sola", input: "type ! a", wantMsg: "expected '!='", wantPos: 6},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.input)
+			require.Error(t, err)
+
+			var syntaxErr *SyntaxError
+			require.ErrorAs(t, err, &syntaxErr)
+			assert.Equal(t, tt.wantMsg, syntaxErr.Msg)
+			assert.Equal(t, tt.wantPos, syntaxErr.Pos)
+		})
+	}
+}
+
+// TestParseLimits tests para los límites de tamaño de las expresiones
+func TestParseLimits(t *testing.T) {
+	t.Run("demasiado larga", func(t *testing.T) {
+		_, err := Parse("title = " + strings.Repeat("a", MaxLength))
+		assert.Error(t, err)
+	})
+
+	t.Run("demasiado anidada", func(t *testing.T) {
+		_, err := Parse(strings.Repeat("(", MaxDepth+1) + "a = 1" + strings.Repeat(")", MaxDepth+1))
+		assert.ErrorContains(t, err, "nested")
+	})
+
+	t.Run("demasiadas condiciones", func(t *testing.T) {
+		conditions := make([]string, MaxConditions+1)
+		for i := range conditions {
+			conditions[i] = "a = 1"
+		}
+		_, err := Parse(strings.Join(conditions, " or "))
+		assert.ErrorContains(t, err, "conditions")
+	})
+
+	t.Run("lista demasiado larga", func(t *testing.T) {
+		values := make([]string, MaxListValues+1)
+		for i := range values {
+			values[i] = "x"
+		}
+		_, err := Parse("type in (" + strings.Join(values, ",") + ")")
+		assert.ErrorContains(t, err, "list longer")
+	})
+}
+
+// TestFields tests para la enumeración de comparaciones
+func TestFields(t *testing.T) {
+	node, err := Parse("a = 1 and (b = 2 or not c in (3, 4))")
+	require.NoError(t, err)
+
+	var fields []string
+	for _, comparison := range Fields(node) {
+		fields = append(fields, comparison.Field)
+	}
+	assert.Equal(t, []string{"a", "b", "c"}, fields)
+}
@@ -0,0 +1,118 @@
+// Package geo cálculos geográficos sobre coordenadas WGS84: distancia por
+// Haversine y cajas delimitadoras para prefiltrar búsquedas por radio con
+// índices convencionales de latitud y longitud.
+package geo
+
+import (
+	"errors"
+	"math"
+)
+
+// EarthRadiusKm radio medio de la Tierra
+const EarthRadiusKm = 6371.0
+
+// ErrInvalidCoordinates coordenadas fuera de rango
+var ErrInvalidCoordinates = errors.New("invalid coordinates")
+
+// Point coordenadas en grados decimales
+type Point struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// Validate comprueba que las coordenadas están dentro de rango
+func (p Point) Validate() error {
+	if math.IsNaN(p.Latitude) || math.IsNaN(p.Longitude) ||
+		p.Latitude < -90 || p.Latitude > 90 || p.Longitude < -180 || p.Longitude > 180 {
+		return ErrInvalidCoordinates
+	}
+	return nil
+}
+
+// Distance distancia en kilómetros entre dos puntos (fórmula de Haversine)
+func Distance(a, b Point) float64 {
+	lat1 := radians(a.Latitude)
+	lat2 := radians(b.Latitude)
+	dLat := radians(b.Latitude - a.Latitude)
+	dLng := radians(b.Longitude - a.Longitude)
+
+	h := math.Pow(math.Sin(dLat/2), 2) + math.Cos(lat1)*math.Cos(lat2)*math.Pow(math.Sin(dLng/2), 2)
+	return 2 * EarthRadiusKm * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// BoundingBox rectángulo que contiene todos los puntos a una distancia dada.
+// Si cruza el antimeridiano, MinLongitude es mayor que MaxLongitude
+type BoundingBox struct {
+	MinLatitude  float64
+	MaxLatitude  float64
+	MinLongitude float64
+	MaxLongitude float64
+}
+
+// CrossesAntimeridian indica si la caja cruza la longitud ±180
+func (b BoundingBox) CrossesAntimeridian() bool {
+	return b.MinLongitude > b.MaxLongitude
+}
+
+// Contains indica si el punto está dentro de la caja
+func (b BoundingBox) Contains(p Point) bool {
+	if p.Latitude < b.MinLatitude || p.Latitude > b.MaxLatitude {
+		return false
+	}
+	if b.CrossesAntimeridian() {
+		return p.Longitude >= b.MinLongitude || p.Longitude <= b.MaxLongitude
+	}
+	return p.Longitude >= b.MinLongitude && p.Longitude <= b.MaxLongitude
+}
+
+// Bounds caja delimitadora de los puntos a radiusKm del centro
+func Bounds(center Point, radiusKm float64) BoundingBox {
+	angular := radiusKm / EarthRadiusKm
+	lat := radians(center.Latitude)
+
+	box := BoundingBox{
+		MinLatitude: center.Latitude - degrees(angular),
+		MaxLatitude: center.Latitude + degrees(angular),
+	}
+
+	// Si el radio alcanza un polo, la caja incluye todas las longitudes
+	if box.MinLatitude <= -90 || box.MaxLatitude >= 90 {
+		box.MinLatitude = math.Max(box.MinLatitude, -90)
+		box.MaxLatitude = math.Min(box.MaxLatitude, 90)
+		box.MinLongitude = -180
+		box.MaxLongitude = 180
+		return box
+	}
+
+	deltaLng := degrees(math.Asin(math.Min(1, math.Sin(angular)/math.Cos(lat))))
+	box.MinLongitude = center.Longitude - deltaLng
+	box.MaxLongitude = center.Longitude + deltaLng
+
+	if box.MaxLongitude-box.MinLongitude >= 360 {
+		box.MinLongitude = -180
+		box.MaxLongitude = 180
+		return box
+	}
+	if box.MinLongitude < -180 {
+		box.MinLongitude += 360
+	}
+	if box.MaxLongitude > 180 {
+		box.MaxLongitude -= 360
+	}
+
+	return box
+}
+
+// RadiusLimits radio por defecto y máximo de las búsquedas por cercanía
+type RadiusLimits struct {
+	DefaultKm int
+	MaxKm     int
+}
+
+func radians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
+
+func degrees(rad float64) float64 {
+	return rad * 180 / math.Pi
+}
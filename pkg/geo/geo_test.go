@@ -0,0 +1,88 @@
+package geo
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	madrid    = Point{Latitude: 40.4168, Longitude: -3.7038}
+	barcelona = Point{Latitude: 41.3874, Longitude: 2.1686}
+	valencia  = Point{Latitude: 39.4699, Longitude: -0.3763}
+)
+
+// TestDistance tests para la distancia por Haversine
+func TestDistance(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b Point
+		want float64
+	}{
+		{name: "mismo punto", a: madrid, b: madrid, want: 0},
+		{name: "Madrid - Barcelona", a: madrid, b: barcelona, want: 505},
+		{name: "Madrid - Valencia", a: madrid, b: valencia, want: 302},
+		{name: "antípodas", a: Point{0, 0}, b: Point{0, 180}, want: math.Pi * EarthRadiusKm},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.want, Distance(tt.a, tt.b), 2)
+			assert.InDelta(t, Distance(tt.a, tt.b), Distance(tt.b, tt.a), 1e-9)
+		})
+	}
+}
+
+// TestValidate tests para la validación de coordenadas
+func TestValidate(t *testing.T) {
+	assert.NoError(t, madrid.Validate())
+	assert.NoError(t, Point{Latitude: -90, Longitude: 180}.Validate())
+	assert.ErrorIs(t, Point{Latitude: 90.1, Longitude: 0}.Validate(), ErrInvalidCoordinates)
+	assert.ErrorIs(t, Point{Latitude: 0, Longitude: -180.5}.Validate(), ErrInvalidCoordinates)
+	assert.ErrorIs(t, Point{Latitude: math.NaN(), Longitude: 0}.Validate(), ErrInvalidCoordinates)
+}
+
+// TestBounds tests para las cajas delimitadoras
+func TestBounds(t *testing.T) {
+	t.Run("contiene todo el círculo", func(t *testing.T) {
+		box := Bounds(madrid, 350)
+		assert.True(t, box.Contains(valencia))
+		assert.False(t, box.Contains(barcelona))
+		assert.False(t, box.CrossesAntimeridian())
+
+		// Los puntos del borde del círculo quedan dentro de la caja
+		for bearing := 0.0; bearing < 360; bearing += 15 {
+			assert.True(t, box.Contains(destination(madrid, bearing, 349.9)), "rumbo %v", bearing)
+		}
+	})
+
+	t.Run("cruza el antimeridiano", func(t *testing.T) {
+		fiji := Point{Latitude: -17.7, Longitude: 179.9}
+		box := Bounds(fiji, 100)
+		assert.True(t, box.CrossesAntimeridian())
+		assert.True(t, box.Contains(Point{Latitude: -17.7, Longitude: -179.8}))
+		assert.True(t, box.Contains(Point{Latitude: -17.7, Longitude: 179.5}))
+		assert.False(t, box.Contains(Point{Latitude: -17.7, Longitude: 0}))
+	})
+
+	t.Run("alcanza el polo", func(t *testing.T) {
+		box := Bounds(Point{Latitude: 89.5, Longitude: 10}, 100)
+		assert.Equal(t, 90.0, box.MaxLatitude)
+		assert.Equal(t, -180.0, box.MinLongitude)
+		assert.Equal(t, 180.0, box.MaxLongitude)
+	})
+}
+
+// destination punto a distanceKm del origen con el rumbo indicado
+func destination(origin Point, bearing, distanceKm float64) Point {
+	angular := distanceKm / EarthRadiusKm
+	lat1 := radians(origin.Latitude)
+	lng1 := radians(origin.Longitude)
+	b := radians(bearing)
+
+	lat2 := math.Asin(math.Sin(lat1)*math.Cos(angular) + math.Cos(lat1)*math.Sin(angular)*math.Cos(b))
+	lng2 := lng1 + math.Atan2(math.Sin(b)*math.Sin(angular)*math.Cos(lat1), math.Cos(angular)-math.Sin(lat1)*math.Sin(lat2))
+
+	return Point{Latitude: degrees(lat2), Longitude: degrees(lng2)}
+}
@@ -0,0 +1,113 @@
+package geocoding
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"cybesphere-backend/pkg/geo"
+)
+
+// DefaultCacheSize direcciones que se guardan si no se indica otro tamaño
+const DefaultCacheSize = 1000
+
+// Cache geocodificador que guarda en memoria los resultados de otro. También
+// guarda las direcciones no encontradas para no repetir consultas inútiles;
+// el resto de errores no se guardan. Al llenarse descarta la dirección usada
+// hace más tiempo
+type Cache struct {
+	next    Geocoder
+	ttl     time.Duration
+	size    int
+	now     func() time.Time
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	order   *list.List // Más reciente al principio
+}
+
+// cacheEntry resultado guardado para una dirección
+type cacheEntry struct {
+	key       string
+	point     geo.Point
+	err       error
+	expiresAt time.Time
+}
+
+// NewCache envuelve un geocodificador con una caché de ttl y tamaño dados
+func NewCache(next Geocoder, ttl time.Duration, size int) *Cache {
+	if size <= 0 {
+		size = DefaultCacheSize
+	}
+
+	return &Cache{
+		next:    next,
+		ttl:     ttl,
+		size:    size,
+		now:     time.Now,
+		entries: make(map[string]*list.Element),
+		order:   list.New(),
+	}
+}
+
+// Geocode implementa Geocoder
+func (c *Cache) Geocode(ctx context.Context, address Address) (geo.Point, error) {
+	key := address.Key()
+	if entry, ok := c.get(key); ok {
+		return entry.point, entry.err
+	}
+
+	point, err := c.next.Geocode(ctx, address)
+	if err == nil || errors.Is(err, ErrNotFound) {
+		c.put(&cacheEntry{key: key, point: point, err: err, expiresAt: c.now().Add(c.ttl)})
+	}
+
+	return point, err
+}
+
+// Len direcciones guardadas, incluidas las caducadas que aún no se han descartado
+func (c *Cache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len()
+}
+
+func (c *Cache) get(key string) (*cacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	entry := element.Value.(*cacheEntry)
+	if !c.now().Before(entry.expiresAt) {
+		c.order.Remove(element)
+		delete(c.entries, key)
+		return nil, false
+	}
+
+	c.order.MoveToFront(element)
+	return entry, true
+}
+
+func (c *Cache) put(entry *cacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.entries[entry.key]; ok {
+		element.Value = entry
+		c.order.MoveToFront(element)
+		return
+	}
+
+	c.entries[entry.key] = c.order.PushFront(entry)
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).key)
+	}
+}
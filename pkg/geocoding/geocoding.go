@@ -0,0 +1,125 @@
+// Package geocoding obtiene las coordenadas de una dirección postal. Los
+// proveedores son intercambiables detrás de la interfaz Geocoder y se pueden
+// envolver con una caché en memoria para no repetir consultas.
+package geocoding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"cybesphere-backend/pkg/geo"
+	"cybesphere-backend/pkg/utils"
+)
+
+// Nombres de los proveedores disponibles
+const (
+	ProviderNone      = "none"      // Geocodificación desactivada
+	ProviderFixture   = "fixture"   // Tabla local de direcciones (desarrollo y tests)
+	ProviderNominatim = "nominatim" // API de OpenStreetMap
+)
+
+// Errores comunes de los proveedores
+var (
+	ErrNotFound        = errors.New("address not found")
+	ErrEmptyAddress    = errors.New("empty address")
+	ErrUnknownProvider = errors.New("unknown geocoding provider")
+)
+
+// Address dirección a geocodificar
+type Address struct {
+	Street     string
+	City       string
+	PostalCode string
+	Country    string
+}
+
+// IsEmpty indica si la dirección no tiene datos suficientes para buscarla
+func (a Address) IsEmpty() bool {
+	return strings.TrimSpace(a.Street) == "" && strings.TrimSpace(a.City) == ""
+}
+
+// String dirección en una línea, de lo más concreto a lo más general
+func (a Address) String() string {
+	var parts []string
+	for _, part := range []string{a.Street, strings.TrimSpace(a.PostalCode + " " + a.City), a.Country} {
+		if part = strings.TrimSpace(part); part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Key forma normalizada de la dirección, sin tildes ni diferencias de
+// mayúsculas o espacios. Dos direcciones con la misma clave son equivalentes
+func (a Address) Key() string {
+	return Normalize(a.String())
+}
+
+// Geocoder proveedor de geocodificación
+type Geocoder interface {
+	// Geocode devuelve las coordenadas de la dirección o ErrNotFound
+	Geocode(ctx context.Context, address Address) (geo.Point, error)
+}
+
+// Config configuración para construir un geocodificador
+type Config struct {
+	Provider    string
+	FixturePath string        // Fichero JSON con direcciones (proveedor fixture)
+	BaseURL     string        // URL de la API (proveedor nominatim)
+	UserAgent   string        // Identificación exigida por Nominatim
+	Timeout     time.Duration // Tiempo máximo por consulta
+	CacheTTL    time.Duration // Sin caché si es 0
+	CacheSize   int
+}
+
+// New crea el geocodificador indicado en la configuración. Devuelve nil si
+// la geocodificación está desactivada
+func New(cfg Config) (Geocoder, error) {
+	var geocoder Geocoder
+
+	switch cfg.Provider {
+	case ProviderNone, "":
+		return nil, nil
+	case ProviderFixture:
+		if cfg.FixturePath == "" {
+			geocoder = NewFixture(DefaultFixture)
+			break
+		}
+		fixture, err := LoadFixture(cfg.FixturePath)
+		if err != nil {
+			return nil, err
+		}
+		geocoder = fixture
+	case ProviderNominatim:
+		geocoder = NewNominatim(cfg.BaseURL, cfg.UserAgent, cfg.Timeout)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownProvider, cfg.Provider)
+	}
+
+	if cfg.CacheTTL > 0 {
+		geocoder = NewCache(geocoder, cfg.CacheTTL, cfg.CacheSize)
+	}
+
+	return geocoder, nil
+}
+
+// Normalize pasa a minúsculas, quita tildes y colapsa espacios y comas
+func Normalize(s string) string {
+	var b strings.Builder
+	for _, char := range strings.ToLower(s) {
+		if replacement, exists := utils.CharacterReplacements[char]; exists {
+			b.WriteString(replacement)
+		} else {
+			b.WriteRune(char)
+		}
+	}
+
+	fields := strings.FieldsFunc(b.String(), func(r rune) bool {
+		return unicode.IsSpace(r) || r == ','
+	})
+	return strings.Join(fields, " ")
+}
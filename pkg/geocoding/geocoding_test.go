@@ -0,0 +1,247 @@
+package geocoding
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"cybesphere-backend/pkg/geo"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var sol = geo.Point{Latitude: 40.4169, Longitude: -3.7035}
+
+// countingGeocoder geocodificador de pruebas que cuenta las consultas
+type countingGeocoder struct {
+	calls int
+	point geo.Point
+	err   error
+}
+
+func (g *countingGeocoder) Geocode(ctx context.Context, address Address) (geo.Point, error) {
+	g.calls++
+	return g.point, g.err
+}
+
+// TestNormalize tests para la normalización de direcciones
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "tildes y mayúsculas", input: "Málaga, ESPAÑA", want: "malaga espana"},
+		{name: "espacios y comas repetidos", input: "  Calle Mayor 1 ,, Madrid ", want: "calle mayor 1 madrid"},
+		{name: "vacía", input: " , ", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Normalize(tt.input))
+		})
+	}
+}
+
+// TestAddress tests para la representación de direcciones
+func TestAddress(t *testing.T) {
+	address := Address{Street: "Puerta del Sol 1", City: "Madrid", PostalCode: "28013", Country: "España"}
+	assert.Equal(t, "Puerta del Sol 1, 28013 Madrid, España", address.String())
+	assert.Equal(t, "puerta del sol 1 28013 madrid espana", address.Key())
+	assert.False(t, address.IsEmpty())
+	assert.True(t, Address{Country: "España"}.IsEmpty())
+}
+
+// TestFixture tests para el geocodificador sobre tabla local
+func TestFixture(t *testing.T) {
+	fixture := NewFixture(map[string]geo.Point{
+		"Puerta del Sol 1, Madrid, España": sol,
+		"Madrid":                           {Latitude: 40.4168, Longitude: -3.7038},
+		"Valencia, España":                 {Latitude: 39.4699, Longitude: -0.3763},
+	})
+
+	tests := []struct {
+		name    string
+		address Address
+		want    geo.Point
+		wantErr error
+	}{
+		{
+			name:    "dirección exacta sin tildes",
+			address: Address{Street: "puerta del sol 1", City: "MADRID", Country: "Espana"},
+			want:    sol,
+		},
+		{
+			name:    "calle desconocida en ciudad conocida",
+			address: Address{Street: "Gran Vía 28", City: "Madrid", Country: "España"},
+			want:    geo.Point{Latitude: 40.4168, Longitude: -3.7038},
+		},
+		{
+			name:    "ciudad y país",
+			address: Address{Street: "Calle Colón 1", City: "Valencia", Country: "España"},
+			want:    geo.Point{Latitude: 39.4699, Longitude: -0.3763},
+		},
+		{name: "no encontrada", address: Address{City: "Soria"}, wantErr: ErrNotFound},
+		{name: "vacía", address: Address{Country: "España"}, wantErr: ErrEmptyAddress},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := fixture.Geocode(context.Background(), tt.address)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// TestLoadFixture tests para la carga de fixtures desde fichero
+func TestLoadFixture(t *testing.T) {
+	dir := t.TempDir()
+
+	t.Run("fichero válido", func(t *testing.T) {
+		path := filepath.Join(dir, "valid.json")
+		require.NoError(t, os.WriteFile(path, []byte(`[{"address":"Puerta del Sol 1, Madrid","latitude":40.4169,"longitude":-3.7035}]`), 0o600))
+
+		fixture, err := LoadFixture(path)
+		require.NoError(t, err)
+
+		got, err := fixture.Geocode(context.Background(), Address{Street: "Puerta del Sol 1", City: "Madrid"})
+		require.NoError(t, err)
+		assert.Equal(t, sol, got)
+	})
+
+	t.Run("coordenadas fuera de rango", func(t *testing.T) {
+		path := filepath.Join(dir, "invalid.json")
+		require.NoError(t, os.WriteFile(path, []byte(`[{"address":"Madrid","latitude":140,"longitude":0}]`), 0o600))
+
+		_, err := LoadFixture(path)
+		assert.ErrorIs(t, err, geo.ErrInvalidCoordinates)
+	})
+
+	t.Run("fichero inexistente", func(t *testing.T) {
+		_, err := LoadFixture(filepath.Join(dir, "missing.json"))
+		assert.Error(t, err)
+	})
+}
+
+// TestCache tests para la caché de resultados
+func TestCache(t *testing.T) {
+	ctx := context.Background()
+	madrid := Address{City: "Madrid"}
+
+	t.Run("guarda aciertos hasta que caducan", func(t *testing.T) {
+		next := &countingGeocoder{point: sol}
+		cache := NewCache(next, time.Hour, 10)
+		now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+		cache.now = func() time.Time { return now }
+
+		for i := 0; i < 3; i++ {
+			got, err := cache.Geocode(ctx, madrid)
+			require.NoError(t, err)
+			assert.Equal(t, sol, got)
+		}
+		// La misma dirección escrita de otra forma comparte entrada
+		_, _ = cache.Geocode(ctx, Address{City: " MADRID "})
+		assert.Equal(t, 1, next.calls)
+
+		now = now.Add(time.Hour)
+		_, _ = cache.Geocode(ctx, madrid)
+		assert.Equal(t, 2, next.calls)
+	})
+
+	t.Run("guarda direcciones no encontradas", func(t *testing.T) {
+		next := &countingGeocoder{err: ErrNotFound}
+		cache := NewCache(next, time.Hour, 10)
+
+		for i := 0; i < 2; i++ {
+			_, err := cache.Geocode(ctx, madrid)
+			assert.ErrorIs(t, err, ErrNotFound)
+		}
+		assert.Equal(t, 1, next.calls)
+	})
+
+	t.Run("no guarda errores del proveedor", func(t *testing.T) {
+		next := &countingGeocoder{err: errors.New("timeout")}
+		cache := NewCache(next, time.Hour, 10)
+
+		for i := 0; i < 2; i++ {
+			_, err := cache.Geocode(ctx, madrid)
+			assert.Error(t, err)
+		}
+		assert.Equal(t, 2, next.calls)
+		assert.Equal(t, 0, cache.Len())
+	})
+
+	t.Run("descarta la dirección usada hace más tiempo", func(t *testing.T) {
+		next := &countingGeocoder{point: sol}
+		cache := NewCache(next, time.Hour, 2)
+
+		_, _ = cache.Geocode(ctx, Address{City: "Madrid"})
+		_, _ = cache.Geocode(ctx, Address{City: "Bilbao"})
+		_, _ = cache.Geocode(ctx, Address{City: "Madrid"})
+		_, _ = cache.Geocode(ctx, Address{City: "Sevilla"})
+		assert.Equal(t, 2, cache.Len())
+		assert.Equal(t, 3, next.calls)
+
+		_, _ = cache.Geocode(ctx, Address{City: "Madrid"})
+		assert.Equal(t, 3, next.calls)
+		_, _ = cache.Geocode(ctx, Address{City: "Bilbao"})
+		assert.Equal(t, 4, next.calls)
+	})
+}
+
+// TestNominatim tests para el proveedor de OpenStreetMap
+func TestNominatim(t *testing.T) {
+	var gotQuery, gotUserAgent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.Query().Get("q")
+		gotUserAgent = r.Header.Get("User-Agent")
+		w.Header().Set("Content-Type", "application/json")
+		if gotQuery == "Nowhere" {
+			_, _ = w.Write([]byte(`[]`))
+			return
+		}
+		_, _ = w.Write([]byte(`[{"lat":"40.4169","lon":"-3.7035","display_name":"Puerta del Sol"}]`))
+	}))
+	defer server.Close()
+
+	nominatim := NewNominatim(server.URL, "cybesphere-test", time.Second)
+	nominatim.interval = 0
+
+	got, err := nominatim.Geocode(context.Background(), Address{Street: "Puerta del Sol 1", City: "Madrid", Country: "España"})
+	require.NoError(t, err)
+	assert.Equal(t, sol, got)
+	assert.Equal(t, "Puerta del Sol 1, Madrid, España", gotQuery)
+	assert.Equal(t, "cybesphere-test", gotUserAgent)
+
+	_, err = nominatim.Geocode(context.Background(), Address{City: "Nowhere"})
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+// TestNew tests para la construcción de geocodificadores
+func TestNew(t *testing.T) {
+	geocoder, err := New(Config{Provider: ProviderNone})
+	require.NoError(t, err)
+	assert.Nil(t, geocoder)
+
+	geocoder, err = New(Config{Provider: ProviderFixture})
+	require.NoError(t, err)
+	assert.IsType(t, &Fixture{}, geocoder)
+
+	geocoder, err = New(Config{Provider: ProviderFixture, CacheTTL: time.Minute})
+	require.NoError(t, err)
+	assert.IsType(t, &Cache{}, geocoder)
+
+	_, err = New(Config{Provider: "google"})
+	assert.ErrorIs(t, err, ErrUnknownProvider)
+}
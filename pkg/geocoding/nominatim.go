@@ -0,0 +1,133 @@
+package geocoding
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"cybesphere-backend/pkg/geo"
+)
+
+// Valores por defecto del proveedor Nominatim
+const (
+	DefaultNominatimURL = "https://nominatim.openstreetmap.org"
+	DefaultTimeout      = 10 * time.Second
+
+	// nominatimInterval separación mínima entre consultas que exige la
+	// política de uso del servicio público
+	nominatimInterval = time.Second
+)
+
+// Nominatim geocodificador sobre la API de búsqueda de OpenStreetMap
+type Nominatim struct {
+	baseURL   string
+	userAgent string
+	client    *http.Client
+	interval  time.Duration
+
+	mu   sync.Mutex
+	last time.Time
+}
+
+// NewNominatim crea un geocodificador contra una instancia de Nominatim
+func NewNominatim(baseURL, userAgent string, timeout time.Duration) *Nominatim {
+	if baseURL == "" {
+		baseURL = DefaultNominatimURL
+	}
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+
+	return &Nominatim{
+		baseURL:   strings.TrimSuffix(baseURL, "/"),
+		userAgent: userAgent,
+		client:    &http.Client{Timeout: timeout},
+		interval:  nominatimInterval,
+	}
+}
+
+// nominatimResult elemento de la respuesta de /search
+type nominatimResult struct {
+	Lat string `json:"lat"`
+	Lon string `json:"lon"`
+}
+
+// Geocode implementa Geocoder
+func (n *Nominatim) Geocode(ctx context.Context, address Address) (geo.Point, error) {
+	if address.IsEmpty() {
+		return geo.Point{}, ErrEmptyAddress
+	}
+	if err := n.wait(ctx); err != nil {
+		return geo.Point{}, err
+	}
+
+	params := url.Values{}
+	params.Set("q", address.String())
+	params.Set("format", "jsonv2")
+	params.Set("limit", "1")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, n.baseURL+"/search?"+params.Encode(), nil)
+	if err != nil {
+		return geo.Point{}, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if n.userAgent != "" {
+		req.Header.Set("User-Agent", n.userAgent)
+	}
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return geo.Point{}, fmt.Errorf("nominatim request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return geo.Point{}, fmt.Errorf("nominatim returned status %d", resp.StatusCode)
+	}
+
+	var results []nominatimResult
+	if err := json.NewDecoder(resp.Body).Decode(&results); err != nil {
+		return geo.Point{}, fmt.Errorf("decode nominatim response: %w", err)
+	}
+	if len(results) == 0 {
+		return geo.Point{}, ErrNotFound
+	}
+
+	lat, errLat := strconv.ParseFloat(results[0].Lat, 64)
+	lng, errLng := strconv.ParseFloat(results[0].Lon, 64)
+	if errLat != nil || errLng != nil {
+		return geo.Point{}, fmt.Errorf("nominatim returned invalid coordinates %q, %q", results[0].Lat, results[0].Lon)
+	}
+
+	point := geo.Point{Latitude: lat, Longitude: lng}
+	if err := point.Validate(); err != nil {
+		return geo.Point{}, err
+	}
+
+	return point, nil
+}
+
+// wait espera hasta que se pueda hacer la siguiente consulta
+func (n *Nominatim) wait(ctx context.Context) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if delay := n.interval - time.Since(n.last); delay > 0 {
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	n.last = time.Now()
+	return nil
+}
@@ -0,0 +1,367 @@
+package ical
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Status define los estados de un VEVENT según RFC 5545
+type Status string
+
+const (
+	StatusConfirmed Status = "CONFIRMED" // Evento confirmado
+	StatusTentative Status = "TENTATIVE" // Evento provisional
+	StatusCancelled Status = "CANCELLED" // Evento cancelado
+)
+
+const (
+	// maxLineOctets longitud máxima de línea antes de plegar (RFC 5545 §3.1)
+	maxLineOctets = 75
+
+	dateTimeLocal = "20060102T150405"
+	dateTimeUTC   = "20060102T150405Z"
+)
+
+// Calendar documento VCALENDAR con sus eventos
+type Calendar struct {
+	ProdID          string
+	Name            string        // X-WR-CALNAME, nombre visible en el cliente
+	Description     string        // X-WR-CALDESC
+	RefreshInterval time.Duration // Intervalo de refresco sugerido para suscripciones
+	Stamp           time.Time     // DTSTAMP de los eventos (time.Now() si está vacío)
+	Events          []Event
+}
+
+// Event componente VEVENT
+type Event struct {
+	UID          string
+	Summary      string
+	Description  string
+	Location     string
+	URL          string
+	Start        time.Time
+	End          time.Time
+	TimeZone     *time.Location // nil = UTC
+	Status       Status
+	Sequence     int
+	Created      time.Time
+	LastModified time.Time
+	Categories   []string
+	Latitude     *float64
+	Longitude    *float64
+}
+
+// Bytes genera el documento completo
+func (c *Calendar) Bytes() []byte {
+	var buf bytes.Buffer
+	_ = c.Encode(&buf)
+	return buf.Bytes()
+}
+
+// Encode escribe el documento en formato iCalendar
+func (c *Calendar) Encode(w io.Writer) error {
+	enc := &encoder{w: w}
+
+	stamp := c.Stamp
+	if stamp.IsZero() {
+		stamp = time.Now()
+	}
+
+	prodID := c.ProdID
+	if prodID == "" {
+		prodID = "-//CybESphere//Events//ES"
+	}
+
+	enc.line("BEGIN:VCALENDAR")
+	enc.line("VERSION:2.0")
+	enc.line("PRODID:" + prodID)
+	enc.line("CALSCALE:GREGORIAN")
+	enc.line("METHOD:PUBLISH")
+	if c.Name != "" {
+		enc.line("X-WR-CALNAME:" + EscapeText(c.Name))
+	}
+	if c.Description != "" {
+		enc.line("X-WR-CALDESC:" + EscapeText(c.Description))
+	}
+	if c.RefreshInterval > 0 {
+		duration := formatDuration(c.RefreshInterval)
+		enc.line("REFRESH-INTERVAL;VALUE=DURATION:" + duration)
+		enc.line("X-PUBLISHED-TTL:" + duration)
+	}
+
+	for _, tz := range c.timezones() {
+		enc.timezone(tz.loc, tz.from, tz.to)
+	}
+
+	for i := range c.Events {
+		enc.event(&c.Events[i], stamp)
+	}
+
+	enc.line("END:VCALENDAR")
+	return enc.err
+}
+
+// tzRange rango de fechas cubierto por una zona horaria
+type tzRange struct {
+	loc      *time.Location
+	from, to time.Time
+}
+
+// timezones calcula las zonas horarias distintas usadas por los eventos
+func (c *Calendar) timezones() []tzRange {
+	ranges := make(map[string]*tzRange)
+	for _, e := range c.Events {
+		if isUTC(e.TimeZone) {
+			continue
+		}
+		name := e.TimeZone.String()
+		r, ok := ranges[name]
+		if !ok {
+			r = &tzRange{loc: e.TimeZone, from: e.Start, to: e.End}
+			ranges[name] = r
+		}
+		if e.Start.Before(r.from) {
+			r.from = e.Start
+		}
+		if e.End.After(r.to) {
+			r.to = e.End
+		}
+	}
+
+	names := make([]string, 0, len(ranges))
+	for name := range ranges {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	result := make([]tzRange, 0, len(names))
+	for _, name := range names {
+		result = append(result, *ranges[name])
+	}
+	return result
+}
+
+// encoder escritor de líneas con plegado y gestión de errores
+type encoder struct {
+	w   io.Writer
+	err error
+}
+
+// line escribe una línea de contenido plegándola si es necesario
+func (e *encoder) line(content string) {
+	if e.err != nil {
+		return
+	}
+	_, e.err = io.WriteString(e.w, FoldLine(content)+"\r\n")
+}
+
+// event escribe un componente VEVENT
+func (e *encoder) event(ev *Event, stamp time.Time) {
+	e.line("BEGIN:VEVENT")
+	e.line("UID:" + ev.UID)
+	e.line("DTSTAMP:" + stamp.UTC().Format(dateTimeUTC))
+	e.line(formatDateTime("DTSTART", ev.Start, ev.TimeZone))
+	e.line(formatDateTime("DTEND", ev.End, ev.TimeZone))
+	e.line("SUMMARY:" + EscapeText(ev.Summary))
+	if ev.Description != "" {
+		e.line("DESCRIPTION:" + EscapeText(ev.Description))
+	}
+	if ev.Location != "" {
+		e.line("LOCATION:" + EscapeText(ev.Location))
+	}
+	if ev.Latitude != nil && ev.Longitude != nil {
+		e.line(fmt.Sprintf("GEO:%.6f;%.6f", *ev.Latitude, *ev.Longitude))
+	}
+	if ev.URL != "" {
+		e.line("URL:" + ev.URL)
+	}
+	if len(ev.Categories) > 0 {
+		escaped := make([]string, 0, len(ev.Categories))
+		for _, category := range ev.Categories {
+			escaped = append(escaped, EscapeText(category))
+		}
+		e.line("CATEGORIES:" + strings.Join(escaped, ","))
+	}
+	status := ev.Status
+	if status == "" {
+		status = StatusConfirmed
+	}
+	e.line("STATUS:" + string(status))
+	e.line(fmt.Sprintf("SEQUENCE:%d", ev.Sequence))
+	if !ev.Created.IsZero() {
+		e.line("CREATED:" + ev.Created.UTC().Format(dateTimeUTC))
+	}
+	if !ev.LastModified.IsZero() {
+		e.line("LAST-MODIFIED:" + ev.LastModified.UTC().Format(dateTimeUTC))
+	}
+	e.line("END:VEVENT")
+}
+
+// timezone escribe un componente VTIMEZONE con las transiciones del rango
+func (e *encoder) timezone(loc *time.Location, from, to time.Time) {
+	start := time.Date(from.In(loc).Year(), time.January, 1, 0, 0, 0, 0, loc)
+	end := time.Date(to.In(loc).Year()+1, time.January, 1, 0, 0, 0, 0, loc)
+
+	e.line("BEGIN:VTIMEZONE")
+	e.line("TZID:" + loc.String())
+
+	// Componente inicial con el offset vigente al comienzo del rango
+	name, offset := start.Zone()
+	e.observance(start.IsDST(), start.UTC().Add(time.Duration(offset)*time.Second), offset, offset, name)
+
+	for _, tr := range Transitions(loc, start, end) {
+		e.observance(tr.IsDST, tr.At.UTC().Add(time.Duration(tr.OffsetFrom)*time.Second), tr.OffsetFrom, tr.OffsetTo, tr.Name)
+	}
+
+	e.line("END:VTIMEZONE")
+}
+
+// observance escribe un subcomponente STANDARD o DAYLIGHT
+func (e *encoder) observance(isDST bool, localStart time.Time, offsetFrom, offsetTo int, name string) {
+	kind := "STANDARD"
+	if isDST {
+		kind = "DAYLIGHT"
+	}
+	e.line("BEGIN:" + kind)
+	e.line("DTSTART:" + localStart.Format(dateTimeLocal))
+	e.line("TZOFFSETFROM:" + formatOffset(offsetFrom))
+	e.line("TZOFFSETTO:" + formatOffset(offsetTo))
+	if name != "" {
+		e.line("TZNAME:" + EscapeText(name))
+	}
+	e.line("END:" + kind)
+}
+
+// Transition cambio de offset en una zona horaria
+type Transition struct {
+	At         time.Time
+	OffsetFrom int // segundos respecto a UTC antes del cambio
+	OffsetTo   int // segundos respecto a UTC después del cambio
+	Name       string
+	IsDST      bool
+}
+
+// Transitions calcula los cambios de offset de una zona en [from, to)
+func Transitions(loc *time.Location, from, to time.Time) []Transition {
+	var transitions []Transition
+	const step = 24 * time.Hour
+
+	prev := from
+	_, prevOffset := from.In(loc).Zone()
+	for prev.Before(to) {
+		next := prev.Add(step)
+		if next.After(to) {
+			next = to
+		}
+		if _, offset := next.In(loc).Zone(); offset != prevOffset {
+			at := findTransition(loc, prev, next)
+			name, _ := at.In(loc).Zone()
+			transitions = append(transitions, Transition{
+				At:         at,
+				OffsetFrom: prevOffset,
+				OffsetTo:   offset,
+				Name:       name,
+				IsDST:      at.In(loc).IsDST(),
+			})
+			prevOffset = offset
+		}
+		prev = next
+	}
+
+	return transitions
+}
+
+// findTransition busca por bisección el primer instante con el nuevo offset
+func findTransition(loc *time.Location, lo, hi time.Time) time.Time {
+	_, loOffset := lo.In(loc).Zone()
+	for hi.Sub(lo) > time.Second {
+		mid := lo.Add(hi.Sub(lo) / 2).Truncate(time.Second)
+		if _, offset := mid.In(loc).Zone(); offset == loOffset {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return hi
+}
+
+// EscapeText escapa un valor TEXT según RFC 5545 §3.3.11
+func EscapeText(s string) string {
+	replacer := strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", `\n`,
+	)
+	return replacer.Replace(s)
+}
+
+// FoldLine pliega una línea a 75 octetos sin partir caracteres UTF-8
+func FoldLine(line string) string {
+	if len(line) <= maxLineOctets {
+		return line
+	}
+
+	var b strings.Builder
+	limit := maxLineOctets
+	count := 0
+	for _, r := range line {
+		size := len(string(r))
+		if count+size > limit {
+			b.WriteString("\r\n ")
+			// Las líneas de continuación incluyen el espacio inicial
+			limit = maxLineOctets - 1
+			count = 0
+		}
+		b.WriteRune(r)
+		count += size
+	}
+	return b.String()
+}
+
+// formatDateTime formatea una propiedad de fecha con su TZID
+func formatDateTime(property string, t time.Time, loc *time.Location) string {
+	if isUTC(loc) {
+		return property + ":" + t.UTC().Format(dateTimeUTC)
+	}
+	return property + ";TZID=" + loc.String() + ":" + t.In(loc).Format(dateTimeLocal)
+}
+
+// formatOffset formatea un offset en segundos como ±hhmm
+func formatOffset(seconds int) string {
+	sign := "+"
+	if seconds < 0 {
+		sign = "-"
+		seconds = -seconds
+	}
+	hours := seconds / 3600
+	minutes := (seconds % 3600) / 60
+	if rest := seconds % 60; rest != 0 {
+		return fmt.Sprintf("%s%02d%02d%02d", sign, hours, minutes, rest)
+	}
+	return fmt.Sprintf("%s%02d%02d", sign, hours, minutes)
+}
+
+// formatDuration formatea una duración como valor DURATION (PT#H#M)
+func formatDuration(d time.Duration) string {
+	hours := int(d.Hours())
+	minutes := int(d.Minutes()) % 60
+	if minutes == 0 {
+		return fmt.Sprintf("PT%dH", hours)
+	}
+	if hours == 0 {
+		return fmt.Sprintf("PT%dM", minutes)
+	}
+	return fmt.Sprintf("PT%dH%dM", hours, minutes)
+}
+
+// isUTC indica si la zona es UTC o no está definida
+func isUTC(loc *time.Location) bool {
+	return loc == nil || loc == time.UTC || loc.String() == "UTC"
+}
@@ -0,0 +1,152 @@
+package ical
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestEscapeText tests para escapado de valores TEXT
+func TestEscapeText(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{name: "texto simple", input: "Workshop de Ciberseguridad", expected: "Workshop de Ciberseguridad"},
+		{name: "con comas y punto y coma", input: "Madrid, España; Sala 1", expected: `Madrid\, España\; Sala 1`},
+		{name: "con barra invertida", input: `C:\tools`, expected: `C:\\tools`},
+		{name: "con saltos de línea", input: "línea 1\nlínea 2\r\nlínea 3", expected: `línea 1\nlínea 2\nlínea 3`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, EscapeText(tt.input))
+		})
+	}
+}
+
+// TestFoldLine tests para plegado de líneas
+func TestFoldLine(t *testing.T) {
+	t.Run("línea corta sin plegar", func(t *testing.T) {
+		assert.Equal(t, "SUMMARY:Meetup", FoldLine("SUMMARY:Meetup"))
+	})
+
+	t.Run("línea larga plegada a 75 octetos", func(t *testing.T) {
+		line := "DESCRIPTION:" + strings.Repeat("a", 200)
+		folded := FoldLine(line)
+
+		parts := strings.Split(folded, "\r\n")
+		require.Greater(t, len(parts), 1)
+		for i, part := range parts {
+			assert.LessOrEqual(t, len(part), 75)
+			if i > 0 {
+				assert.True(t, strings.HasPrefix(part, " "))
+			}
+		}
+		assert.Equal(t, line, strings.ReplaceAll(folded, "\r\n ", ""))
+	})
+
+	t.Run("no parte caracteres multibyte", func(t *testing.T) {
+		line := "SUMMARY:" + strings.Repeat("ñ", 100)
+		folded := FoldLine(line)
+
+		for _, part := range strings.Split(folded, "\r\n") {
+			assert.LessOrEqual(t, len(part), 75)
+			assert.True(t, strings.HasPrefix(strings.TrimPrefix(part, " "), "ñ") || strings.HasPrefix(part, "SUMMARY"))
+		}
+		assert.Equal(t, line, strings.ReplaceAll(folded, "\r\n ", ""))
+	})
+}
+
+// TestFormatOffset tests para formato de offsets
+func TestFormatOffset(t *testing.T) {
+	tests := []struct {
+		name     string
+		seconds  int
+		expected string
+	}{
+		{name: "UTC", seconds: 0, expected: "+0000"},
+		{name: "Madrid invierno", seconds: 3600, expected: "+0100"},
+		{name: "Madrid verano", seconds: 7200, expected: "+0200"},
+		{name: "offset negativo con minutos", seconds: -(3*3600 + 30*60), expected: "-0330"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, formatOffset(tt.seconds))
+		})
+	}
+}
+
+// TestTransitions tests para cálculo de cambios horarios
+func TestTransitions(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Madrid")
+	require.NoError(t, err)
+
+	from := time.Date(2026, time.January, 1, 0, 0, 0, 0, loc)
+	to := time.Date(2027, time.January, 1, 0, 0, 0, 0, loc)
+
+	transitions := Transitions(loc, from, to)
+	require.Len(t, transitions, 2)
+
+	assert.Equal(t, time.Date(2026, time.March, 29, 1, 0, 0, 0, time.UTC), transitions[0].At.UTC())
+	assert.Equal(t, 3600, transitions[0].OffsetFrom)
+	assert.Equal(t, 7200, transitions[0].OffsetTo)
+	assert.True(t, transitions[0].IsDST)
+
+	assert.Equal(t, time.Date(2026, time.October, 25, 1, 0, 0, 0, time.UTC), transitions[1].At.UTC())
+	assert.Equal(t, 7200, transitions[1].OffsetFrom)
+	assert.Equal(t, 3600, transitions[1].OffsetTo)
+	assert.False(t, transitions[1].IsDST)
+
+	t.Run("zona sin cambios horarios", func(t *testing.T) {
+		assert.Empty(t, Transitions(time.UTC, from, to))
+	})
+}
+
+// TestCalendarEncode tests para generación del documento completo
+func TestCalendarEncode(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Madrid")
+	require.NoError(t, err)
+
+	cal := &Calendar{
+		Name:  "CybESphere",
+		Stamp: time.Date(2026, time.October, 1, 8, 0, 0, 0, time.UTC),
+		Events: []Event{
+			{
+				UID:      "evento-1@cybesphere",
+				Summary:  "Meetup de Red Team",
+				Start:    time.Date(2026, time.November, 12, 19, 0, 0, 0, loc),
+				End:      time.Date(2026, time.November, 12, 21, 0, 0, 0, loc),
+				TimeZone: loc,
+				Location: "Campus, Madrid",
+			},
+			{
+				UID:     "evento-2@cybesphere",
+				Summary: "CTF online",
+				Start:   time.Date(2026, time.December, 1, 10, 0, 0, 0, time.UTC),
+				End:     time.Date(2026, time.December, 1, 18, 0, 0, 0, time.UTC),
+				Status:  StatusCancelled,
+			},
+		},
+	}
+
+	output := string(cal.Bytes())
+
+	assert.True(t, strings.HasPrefix(output, "BEGIN:VCALENDAR\r\n"))
+	assert.True(t, strings.HasSuffix(output, "END:VCALENDAR\r\n"))
+	assert.Contains(t, output, "TZID:Europe/Madrid\r\n")
+	assert.Contains(t, output, "BEGIN:DAYLIGHT\r\nDTSTART:20260329T020000\r\nTZOFFSETFROM:+0100\r\nTZOFFSETTO:+0200\r\n")
+	assert.Contains(t, output, "BEGIN:STANDARD\r\nDTSTART:20261025T030000\r\nTZOFFSETFROM:+0200\r\nTZOFFSETTO:+0100\r\n")
+	assert.Contains(t, output, "DTSTART;TZID=Europe/Madrid:20261112T190000\r\n")
+	assert.Contains(t, output, "DTSTAMP:20261001T080000Z\r\n")
+	assert.Contains(t, output, "LOCATION:Campus\\, Madrid\r\n")
+	assert.Contains(t, output, "DTSTART:20261201T100000Z\r\n")
+	assert.Contains(t, output, "STATUS:CONFIRMED\r\n")
+	assert.Contains(t, output, "STATUS:CANCELLED\r\n")
+	assert.Equal(t, 1, strings.Count(output, "BEGIN:VTIMEZONE"))
+}
@@ -0,0 +1,85 @@
+package imaging
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+)
+
+// Ajustes del cálculo del color dominante
+const (
+	colorSampleSize = 64 // La imagen se reduce a este tamaño antes de contar
+	minChroma       = 32 // Diferencia mínima entre canales para considerar un color "con color"
+)
+
+// DominantColor color más frecuente de la imagen. Los colores se agrupan en
+// 4096 tonos (4 bits por canal) y se devuelve la media del grupo más
+// numeroso. Se ignoran los píxeles transparentes y, si hay bastantes píxeles
+// con color, los grises, blancos y negros: en un logo sobre fondo blanco el
+// dominante es el de la marca, no el fondo. ok es false si la imagen es
+// transparente
+func DominantColor(img image.Image) (c color.RGBA, ok bool) {
+	sample := Resize(img, colorSampleSize, colorSampleSize, Fit)
+
+	type bucket struct {
+		count   int
+		r, g, b int
+	}
+	var all, colorful [4096]bucket
+	var totalAll, totalColorful int
+
+	for i := 0; i+3 < len(sample.Pix); i += 4 {
+		a := int(sample.Pix[i+3])
+		if a < 128 {
+			continue
+		}
+		// Se deshace el alfa premultiplicado
+		r := int(sample.Pix[i]) * 255 / a
+		g := int(sample.Pix[i+1]) * 255 / a
+		b := int(sample.Pix[i+2]) * 255 / a
+
+		key := (r>>4)<<8 | (g>>4)<<4 | b>>4
+		add := func(buckets *[4096]bucket) {
+			bk := &buckets[key]
+			bk.count++
+			bk.r += r
+			bk.g += g
+			bk.b += b
+		}
+		add(&all)
+		totalAll++
+		if max(r, g, b)-min(r, g, b) >= minChroma {
+			add(&colorful)
+			totalColorful++
+		}
+	}
+
+	if totalAll == 0 {
+		return color.RGBA{}, false
+	}
+
+	// Con menos de un 5 % de píxeles con color, la imagen es en la práctica gris
+	buckets := &all
+	if totalColorful*20 >= totalAll {
+		buckets = &colorful
+	}
+
+	best := 0
+	for i := range buckets {
+		if buckets[i].count > buckets[best].count {
+			best = i
+		}
+	}
+	bk := buckets[best]
+	return color.RGBA{
+		R: uint8(bk.r / bk.count),
+		G: uint8(bk.g / bk.count),
+		B: uint8(bk.b / bk.count),
+		A: 255,
+	}, true
+}
+
+// Hex color en formato #rrggbb
+func Hex(c color.RGBA) string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
@@ -0,0 +1,90 @@
+// Package imaging procesa las imágenes subidas: elimina sus metadatos, las
+// orienta, genera versiones redimensionadas y calcula su color dominante.
+// Decodifica JPEG, PNG y GIF con la biblioteca estándar y WebP con
+// golang.org/x/image/webp. Las versiones se codifican en WebP con libwebp
+// (github.com/chai2010/webp, requiere cgo).
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"io"
+
+	"github.com/chai2010/webp"
+
+	// Decodificadores registrados en image.Decode
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+
+	_ "golang.org/x/image/webp"
+)
+
+// DefaultMaxPixels límite de píxeles de una imagen antes de decodificarla;
+// evita agotar la memoria con imágenes pequeñas en bytes y enormes en píxeles
+const DefaultMaxPixels = 40_000_000
+
+// DefaultQuality calidad WebP de las versiones generadas
+const DefaultQuality = 82
+
+var (
+	ErrMalformed         = errors.New("malformed image")
+	ErrUnsupportedFormat = errors.New("unsupported image format")
+	ErrTooManyPixels     = errors.New("image has too many pixels")
+)
+
+// decodableTypes tipos de contenido que se pueden decodificar
+var decodableTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/gif":  true,
+	"image/webp": true,
+}
+
+// CanDecode indica si se pueden generar versiones de las imágenes de este tipo
+func CanDecode(contentType string) bool {
+	return decodableTypes[contentType]
+}
+
+// Decode decodifica la imagen si no supera maxPixels (0 = DefaultMaxPixels).
+// Devuelve también el formato ("jpeg", "png", "gif" o "webp")
+func Decode(data []byte, maxPixels int) (image.Image, string, error) {
+	if maxPixels <= 0 {
+		maxPixels = DefaultMaxPixels
+	}
+
+	config, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if errors.Is(err, image.ErrFormat) {
+		return nil, "", ErrUnsupportedFormat
+	}
+	if err != nil {
+		return nil, "", ErrMalformed
+	}
+	if config.Width <= 0 || config.Height <= 0 {
+		return nil, "", ErrMalformed
+	}
+	if config.Width > maxPixels/config.Height {
+		return nil, "", ErrTooManyPixels
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", ErrMalformed
+	}
+	return img, format, nil
+}
+
+// Encode codifica la imagen en WebP con pérdida con la calidad indicada (0 =
+// DefaultQuality). Las transparencias se conservan sin pérdida. Devuelve el
+// tipo de contenido y la extensión
+func Encode(w io.Writer, img image.Image, quality int) (contentType, ext string, err error) {
+	if quality <= 0 || quality > 100 {
+		quality = DefaultQuality
+	}
+
+	if err := webp.Encode(w, img, &webp.Options{Quality: float32(quality)}); err != nil {
+		return "", "", err
+	}
+	return "image/webp", ".webp", nil
+}
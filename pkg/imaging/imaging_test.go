@@ -0,0 +1,222 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+
+	libwebp "github.com/chai2010/webp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/image/webp"
+)
+
+// solidImage imagen de un solo color
+func solidImage(w, h int, c color.RGBA) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for i := 0; i < len(img.Pix); i += 4 {
+		img.Pix[i], img.Pix[i+1], img.Pix[i+2], img.Pix[i+3] = c.R, c.G, c.B, c.A
+	}
+	return img
+}
+
+// TestOrient tests para la aplicación de la orientación EXIF
+func TestOrient(t *testing.T) {
+	// 3x2 con la esquina superior izquierda marcada
+	img := solidImage(3, 2, color.RGBA{A: 255})
+	img.Set(0, 0, color.RGBA{R: 255, A: 255})
+
+	tests := []struct {
+		orientation int
+		size        image.Point
+		marked      image.Point // Dónde acaba la esquina marcada
+	}{
+		{1, image.Pt(3, 2), image.Pt(0, 0)},
+		{2, image.Pt(3, 2), image.Pt(2, 0)},
+		{3, image.Pt(3, 2), image.Pt(2, 1)},
+		{4, image.Pt(3, 2), image.Pt(0, 1)},
+		{5, image.Pt(2, 3), image.Pt(0, 0)},
+		{6, image.Pt(2, 3), image.Pt(1, 0)},
+		{7, image.Pt(2, 3), image.Pt(1, 2)},
+		{8, image.Pt(2, 3), image.Pt(0, 2)},
+	}
+
+	for _, tt := range tests {
+		out := Orient(img, tt.orientation)
+		assert.Equal(t, tt.size, out.Bounds().Size(), "orientación %d", tt.orientation)
+		assert.Equal(t, uint8(255), out.RGBAAt(tt.marked.X, tt.marked.Y).R, "orientación %d", tt.orientation)
+	}
+}
+
+// TestResize tests para el redimensionado
+func TestResize(t *testing.T) {
+	tests := []struct {
+		name   string
+		src    image.Point
+		width  int
+		height int
+		mode   Mode
+		want   image.Point
+	}{
+		{name: "rellenar recorta a la proporción", src: image.Pt(1000, 1000), width: 640, height: 360, mode: Fill, want: image.Pt(640, 360)},
+		{name: "rellenar imagen vertical", src: image.Pt(600, 1200), width: 320, height: 180, mode: Fill, want: image.Pt(320, 180)},
+		{name: "rellenar sin ampliar", src: image.Pt(400, 400), width: 1600, height: 400, mode: Fill, want: image.Pt(400, 100)},
+		{name: "encajar mantiene la proporción", src: image.Pt(1000, 500), width: 256, height: 256, mode: Fit, want: image.Pt(256, 128)},
+		{name: "encajar sin ampliar", src: image.Pt(100, 50), width: 256, height: 256, mode: Fit, want: image.Pt(100, 50)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := Resize(image.NewRGBA(image.Rectangle{Max: tt.src}), tt.width, tt.height, tt.mode)
+			assert.Equal(t, tt.want, out.Bounds().Size())
+		})
+	}
+}
+
+// TestResize_PromedioPorAreas comprueba que al reducir se promedian los píxeles
+func TestResize_PromedioPorAreas(t *testing.T) {
+	// Franjas verticales alternas blancas y negras: al reducir a la mitad, gris
+	img := solidImage(8, 8, color.RGBA{A: 255})
+	for y := 0; y < 8; y++ {
+		for x := 0; x < 8; x += 2 {
+			img.Set(x, y, color.RGBA{R: 255, G: 255, B: 255, A: 255})
+		}
+	}
+
+	out := Resize(img, 4, 4, Fit)
+	require.Equal(t, image.Pt(4, 4), out.Bounds().Size())
+	for i := 0; i < len(out.Pix); i += 4 {
+		assert.InDelta(t, 128, int(out.Pix[i]), 1)
+		assert.Equal(t, uint8(255), out.Pix[i+3])
+	}
+}
+
+// TestDominantColor tests para el color dominante
+func TestDominantColor(t *testing.T) {
+	t.Run("logo sobre fondo blanco", func(t *testing.T) {
+		img := solidImage(100, 100, color.RGBA{R: 255, G: 255, B: 255, A: 255})
+		for y := 30; y < 60; y++ {
+			for x := 30; x < 70; x++ {
+				img.Set(x, y, color.RGBA{R: 200, G: 16, B: 46, A: 255})
+			}
+		}
+		c, ok := DominantColor(img)
+		require.True(t, ok)
+		assert.Equal(t, "#c8102e", Hex(c))
+	})
+
+	t.Run("imagen en grises", func(t *testing.T) {
+		c, ok := DominantColor(solidImage(20, 20, color.RGBA{R: 40, G: 40, B: 40, A: 255}))
+		require.True(t, ok)
+		assert.Equal(t, "#282828", Hex(c))
+	})
+
+	t.Run("imagen transparente", func(t *testing.T) {
+		_, ok := DominantColor(image.NewRGBA(image.Rect(0, 0, 10, 10)))
+		assert.False(t, ok)
+	})
+}
+
+// TestDecode tests para los límites de decodificación
+func TestDecode(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewGray(image.Rect(0, 0, 100, 100))))
+
+	_, format, err := Decode(buf.Bytes(), 0)
+	require.NoError(t, err)
+	assert.Equal(t, "png", format)
+
+	_, _, err = Decode(buf.Bytes(), 9999)
+	assert.ErrorIs(t, err, ErrTooManyPixels)
+
+	_, _, err = Decode([]byte("BM\x46\x00\x00\x00\x00\x00\x00\x00\x36\x00"), 0)
+	assert.ErrorIs(t, err, ErrUnsupportedFormat)
+
+	_, _, err = Decode([]byte("RIFF\x24\x00\x00\x00WEBPVP8 "), 0)
+	assert.ErrorIs(t, err, ErrMalformed)
+
+	_, _, err = Decode(buf.Bytes()[:40], 0)
+	assert.ErrorIs(t, err, ErrMalformed)
+
+	assert.True(t, CanDecode("image/gif"))
+	assert.True(t, CanDecode("image/webp"))
+	assert.False(t, CanDecode("image/svg+xml"))
+}
+
+// TestProcess tests para el procesado completo
+func TestProcess(t *testing.T) {
+	specs := []Spec{
+		{Name: "thumbnail", Width: 320, Height: 180, Mode: Fill},
+		{Name: "logo", Width: 128, Height: 128, Mode: Fit},
+	}
+
+	t.Run("JPEG girado", func(t *testing.T) {
+		data := testJPEG(t, 800, 600, exifSegment(binary.BigEndian, 6))
+
+		result, err := Process(data, specs, Options{Quality: 70})
+		require.NoError(t, err)
+		assert.Equal(t, "jpeg", result.Format)
+		assert.Equal(t, 600, result.Width)
+		assert.Equal(t, 800, result.Height)
+		assert.Regexp(t, `^#[0-9a-f]{6}$`, result.DominantColor)
+
+		require.Len(t, result.Variants, 2)
+		thumb := result.Variants[0]
+		assert.Equal(t, "thumbnail", thumb.Name)
+		assert.Equal(t, "image/webp", thumb.ContentType)
+		assert.Equal(t, ".webp", thumb.Ext)
+		assert.Equal(t, 320, thumb.Width)
+		assert.Equal(t, 180, thumb.Height)
+		assert.NotContains(t, string(thumb.Data), secret)
+
+		decoded, err := webp.Decode(bytes.NewReader(thumb.Data))
+		require.NoError(t, err)
+		assert.Equal(t, image.Pt(320, 180), decoded.Bounds().Size())
+
+		logo := result.Variants[1]
+		assert.Equal(t, 96, logo.Width)
+		assert.Equal(t, 128, logo.Height)
+	})
+
+	t.Run("PNG con transparencia", func(t *testing.T) {
+		img := solidImage(300, 300, color.RGBA{R: 0, G: 80, B: 160, A: 255})
+		img.Set(0, 0, color.RGBA{})
+		var buf bytes.Buffer
+		require.NoError(t, png.Encode(&buf, img))
+
+		result, err := Process(buf.Bytes(), specs[1:], Options{})
+		require.NoError(t, err)
+		assert.Equal(t, "#0050a0", result.DominantColor)
+		require.Len(t, result.Variants, 1)
+		assert.Equal(t, "image/webp", result.Variants[0].ContentType)
+		assert.Equal(t, ".webp", result.Variants[0].Ext)
+
+		// La transparencia se conserva
+		decoded, err := webp.Decode(bytes.NewReader(result.Variants[0].Data))
+		require.NoError(t, err)
+		_, _, _, a := decoded.At(0, 0).RGBA()
+		assert.Less(t, a, uint32(0xffff))
+	})
+
+	t.Run("WebP", func(t *testing.T) {
+		var buf bytes.Buffer
+		// Original sin pérdida para que el color dominante sea exacto
+		err := libwebp.Encode(&buf, solidImage(400, 300, color.RGBA{R: 200, G: 40, B: 40, A: 255}), &libwebp.Options{Lossless: true})
+		require.NoError(t, err)
+
+		result, err := Process(buf.Bytes(), specs[:1], Options{})
+		require.NoError(t, err)
+		assert.Equal(t, "webp", result.Format)
+		assert.Equal(t, "#c82828", result.DominantColor)
+		require.Len(t, result.Variants, 1)
+		assert.Equal(t, "image/webp", result.Variants[0].ContentType)
+		assert.Equal(t, ".webp", result.Variants[0].Ext)
+
+		decoded, err := webp.Decode(bytes.NewReader(result.Variants[0].Data))
+		require.NoError(t, err)
+		assert.Equal(t, image.Pt(320, 180), decoded.Bounds().Size())
+	})
+}
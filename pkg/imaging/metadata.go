@@ -0,0 +1,303 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+// Firmas de los formatos cuyos metadatos se eliminan
+var (
+	jpegMagic = []byte{0xFF, 0xD8}
+	pngMagic  = []byte{0x89, 'P', 'N', 'G', '\r', '\n', 0x1A, '\n'}
+	exifMagic = []byte("Exif\x00\x00")
+	iccMagic  = []byte("ICC_PROFILE\x00")
+)
+
+// Marcadores JPEG relevantes
+const (
+	markerSOI   = 0xD8
+	markerEOI   = 0xD9
+	markerSOS   = 0xDA
+	markerAPP0  = 0xE0
+	markerAPP1  = 0xE1
+	markerAPP2  = 0xE2
+	markerAPP14 = 0xEE
+	markerCOM   = 0xFE
+)
+
+// pngKeptChunks fragmentos auxiliares de PNG que afectan a cómo se ve la
+// imagen; el resto (texto, fecha, EXIF...) se descarta
+var pngKeptChunks = map[string]bool{
+	"tRNS": true, "gAMA": true, "cHRM": true, "sRGB": true, "iCCP": true,
+	"sBIT": true, "pHYs": true, "bKGD": true,
+	"acTL": true, "fcTL": true, "fdAT": true, // APNG
+}
+
+// Indicadores de la cabecera VP8X de WebP
+const (
+	webpFlagXMP  = 0x04
+	webpFlagEXIF = 0x08
+)
+
+// StripMetadata elimina de una imagen JPEG, PNG o WebP los metadatos que
+// pueden revelar datos personales (EXIF con GPS y cámara, XMP, comentarios,
+// textos), sin recodificarla. Se conservan el perfil de color y, en JPEG, la
+// orientación, para que la imagen se siga viendo igual. Otros formatos se
+// devuelven sin cambios
+func StripMetadata(data []byte) ([]byte, error) {
+	switch {
+	case bytes.HasPrefix(data, jpegMagic):
+		return stripJPEG(data)
+	case bytes.HasPrefix(data, pngMagic):
+		return stripPNG(data)
+	case isWebP(data):
+		return stripWebP(data)
+	default:
+		return data, nil
+	}
+}
+
+// Orientation orientación EXIF (1-8) de una imagen JPEG; 1 si no la indica
+func Orientation(data []byte) int {
+	orientation := 1
+	_ = walkJPEG(data, func(marker byte, segment []byte) bool {
+		if marker == markerAPP1 && bytes.HasPrefix(segment, exifMagic) {
+			if o, ok := exifOrientation(segment[len(exifMagic):]); ok {
+				orientation = o
+			}
+			return false
+		}
+		return true
+	})
+	return orientation
+}
+
+// stripJPEG copia los segmentos necesarios para decodificar la imagen y
+// sustituye el EXIF por uno que solo lleva la orientación
+func stripJPEG(data []byte) ([]byte, error) {
+	orientation := Orientation(data)
+
+	out := make([]byte, 0, len(data))
+	out = append(out, 0xFF, markerSOI)
+
+	// El EXIF nuevo va tras JFIF (APP0), que debe ser el primer segmento
+	pendingOrientation := orientation != 1
+	insertOrientation := func() {
+		if pendingOrientation {
+			out = append(out, orientationSegment(orientation)...)
+			pendingOrientation = false
+		}
+	}
+
+	var rest []byte
+	err := walkJPEG(data, func(marker byte, segment []byte) bool {
+		if marker != markerAPP0 {
+			insertOrientation()
+		}
+		if marker == markerSOS {
+			rest = segment
+			return false
+		}
+		if keepJPEGSegment(marker, segment) {
+			out = append(out, 0xFF, marker)
+			out = binary.BigEndian.AppendUint16(out, uint16(len(segment)+2))
+			out = append(out, segment...)
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	if rest == nil {
+		return nil, ErrMalformed
+	}
+
+	// Desde el inicio del escaneo se copia todo: son los datos comprimidos
+	return append(out, rest...), nil
+}
+
+// keepJPEGSegment indica si el segmento se conserva: tablas y cabeceras,
+// JFIF, perfil ICC y Adobe (transformación de color). Se eliminan EXIF, XMP,
+// comentarios y el resto de segmentos de aplicación
+func keepJPEGSegment(marker byte, segment []byte) bool {
+	switch {
+	case marker == markerAPP0, marker == markerAPP14:
+		return true
+	case marker == markerAPP2:
+		return bytes.HasPrefix(segment, iccMagic)
+	case marker >= markerAPP1 && marker <= 0xEF, marker == markerCOM:
+		return false
+	default:
+		return true
+	}
+}
+
+// walkJPEG recorre los segmentos hasta el inicio del escaneo (SOS). Para SOS,
+// fn recibe el resto del fichero desde el marcador incluido. fn devuelve false
+// para detener el recorrido
+func walkJPEG(data []byte, fn func(marker byte, segment []byte) bool) error {
+	if !bytes.HasPrefix(data, jpegMagic) {
+		return ErrMalformed
+	}
+
+	pos := 2
+	for pos < len(data) {
+		if data[pos] != 0xFF {
+			return ErrMalformed
+		}
+		// Los 0xFF repetidos son relleno
+		for pos < len(data) && data[pos] == 0xFF {
+			pos++
+		}
+		if pos >= len(data) {
+			return ErrMalformed
+		}
+		marker := data[pos]
+		pos++
+
+		switch {
+		case marker == markerEOI:
+			return nil
+		case marker == markerSOS:
+			fn(marker, data[pos-2:])
+			return nil
+		case marker == 0x01, marker >= 0xD0 && marker <= 0xD7:
+			// Marcadores sin longitud
+			continue
+		}
+
+		if pos+2 > len(data) {
+			return ErrMalformed
+		}
+		length := int(binary.BigEndian.Uint16(data[pos:]))
+		if length < 2 || pos+length > len(data) {
+			return ErrMalformed
+		}
+		if !fn(marker, data[pos+2:pos+length]) {
+			return nil
+		}
+		pos += length
+	}
+	return ErrMalformed
+}
+
+// exifOrientation lee la etiqueta de orientación (0x0112) del IFD0 de un bloque TIFF
+func exifOrientation(tiff []byte) (int, bool) {
+	if len(tiff) < 8 {
+		return 0, false
+	}
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 0, false
+	}
+
+	ifd := int(order.Uint32(tiff[4:]))
+	if ifd < 8 || ifd+2 > len(tiff) {
+		return 0, false
+	}
+	entries := int(order.Uint16(tiff[ifd:]))
+	for i := 0; i < entries; i++ {
+		entry := ifd + 2 + i*12
+		if entry+12 > len(tiff) {
+			return 0, false
+		}
+		if order.Uint16(tiff[entry:]) != 0x0112 {
+			continue
+		}
+		// Tipo SHORT (3) con un valor, guardado en los dos primeros bytes del campo
+		if order.Uint16(tiff[entry+2:]) != 3 {
+			return 0, false
+		}
+		value := int(order.Uint16(tiff[entry+8:]))
+		if value < 1 || value > 8 {
+			return 0, false
+		}
+		return value, true
+	}
+	return 0, false
+}
+
+// orientationSegment segmento APP1 con un EXIF mínimo: solo la orientación
+func orientationSegment(orientation int) []byte {
+	segment := []byte{0xFF, markerAPP1, 0, 34}
+	segment = append(segment, exifMagic...)
+	segment = append(segment, 'M', 'M', 0, 0x2A, 0, 0, 0, 8) // Cabecera TIFF, IFD0 en 8
+	segment = append(segment, 0, 1)                          // Una entrada
+	segment = append(segment, 0x01, 0x12, 0, 3, 0, 0, 0, 1)  // Orientación, SHORT, 1 valor
+	segment = append(segment, 0, byte(orientation), 0, 0)
+	return append(segment, 0, 0, 0, 0) // Sin más IFD
+}
+
+// stripPNG conserva los fragmentos críticos y los que afectan al color
+func stripPNG(data []byte) ([]byte, error) {
+	out := make([]byte, 0, len(data))
+	out = append(out, pngMagic...)
+
+	pos := len(pngMagic)
+	for pos < len(data) {
+		if pos+8 > len(data) {
+			return nil, ErrMalformed
+		}
+		length := int(binary.BigEndian.Uint32(data[pos:]))
+		chunkType := string(data[pos+4 : pos+8])
+		end := pos + 12 + length // longitud, tipo, datos y CRC
+		if length < 0 || end > len(data) || end < pos {
+			return nil, ErrMalformed
+		}
+
+		// Los críticos empiezan por mayúscula
+		if chunkType[0] >= 'A' && chunkType[0] <= 'Z' || pngKeptChunks[chunkType] {
+			out = append(out, data[pos:end]...)
+		}
+		pos = end
+		if chunkType == "IEND" {
+			return out, nil
+		}
+	}
+	return nil, ErrMalformed
+}
+
+// isWebP indica si los datos son un contenedor RIFF WebP
+func isWebP(data []byte) bool {
+	return len(data) >= 12 && string(data[:4]) == "RIFF" && string(data[8:12]) == "WEBP"
+}
+
+// stripWebP elimina los fragmentos EXIF y XMP del contenedor y sus indicadores en VP8X
+func stripWebP(data []byte) ([]byte, error) {
+	out := make([]byte, 0, len(data))
+	out = append(out, data[:12]...)
+
+	pos := 12
+	for pos < len(data) {
+		if pos+8 > len(data) {
+			return nil, ErrMalformed
+		}
+		fourCC := string(data[pos : pos+4])
+		size := int(binary.LittleEndian.Uint32(data[pos+4:]))
+		end := pos + 8 + size + size%2 // Los fragmentos se alinean a 2 bytes
+		if size < 0 || end > len(data) || end < pos {
+			return nil, ErrMalformed
+		}
+
+		switch fourCC {
+		case "EXIF", "XMP ":
+		case "VP8X":
+			start := len(out)
+			out = append(out, data[pos:end]...)
+			if size > 0 {
+				out[start+8] &^= webpFlagEXIF | webpFlagXMP
+			}
+		default:
+			out = append(out, data[pos:end]...)
+		}
+		pos = end
+	}
+
+	binary.LittleEndian.PutUint32(out[4:], uint32(len(out)-8))
+	return out, nil
+}
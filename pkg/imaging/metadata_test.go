@@ -0,0 +1,194 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// secret texto que simula datos personales en los metadatos
+const secret = "GPS 40.4168N 3.7038W"
+
+// exifSegment segmento APP1 con la marca de la cámara, la orientación y un
+// texto privado al final del bloque TIFF
+func exifSegment(order interface {
+	binary.ByteOrder
+	binary.AppendByteOrder
+}, orientation uint16) []byte {
+	tiff := make([]byte, 8)
+	if order.String() == "LittleEndian" {
+		copy(tiff, "II")
+	} else {
+		copy(tiff, "MM")
+	}
+	order.PutUint16(tiff[2:], 42)
+	order.PutUint32(tiff[4:], 8)
+
+	tiff = order.AppendUint16(tiff, 2)
+	// Make: ASCII, 4 bytes en el propio campo
+	tiff = order.AppendUint16(tiff, 0x010F)
+	tiff = order.AppendUint16(tiff, 2)
+	tiff = order.AppendUint32(tiff, 4)
+	tiff = append(tiff, 'A', 'C', 'M', 0)
+	// Orientación
+	tiff = order.AppendUint16(tiff, 0x0112)
+	tiff = order.AppendUint16(tiff, 3)
+	tiff = order.AppendUint32(tiff, 1)
+	tiff = order.AppendUint16(tiff, orientation)
+	tiff = append(tiff, 0, 0)
+	tiff = append(tiff, 0, 0, 0, 0)
+	tiff = append(tiff, secret...)
+
+	payload := append([]byte("Exif\x00\x00"), tiff...)
+	return segment(markerAPP1, payload)
+}
+
+// segment segmento JPEG con marcador y longitud
+func segment(marker byte, payload []byte) []byte {
+	out := []byte{0xFF, marker}
+	out = binary.BigEndian.AppendUint16(out, uint16(len(payload)+2))
+	return append(out, payload...)
+}
+
+// testJPEG JPEG de w x h con los segmentos extra insertados tras SOI
+func testJPEG(t *testing.T, w, h int, extra ...[]byte) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.Set(x, y, color.RGBA{R: uint8(x * 255 / w), G: 80, B: uint8(y * 255 / h), A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, img, nil))
+
+	data := buf.Bytes()
+	out := append([]byte{}, data[:2]...)
+	for _, seg := range extra {
+		out = append(out, seg...)
+	}
+	return append(out, data[2:]...)
+}
+
+// pngChunk fragmento PNG con su CRC
+func pngChunk(chunkType string, data []byte) []byte {
+	out := binary.BigEndian.AppendUint32(nil, uint32(len(data)))
+	out = append(out, chunkType...)
+	out = append(out, data...)
+	return binary.BigEndian.AppendUint32(out, crc32.ChecksumIEEE(append([]byte(chunkType), data...)))
+}
+
+// TestStripMetadata_JPEG tests para la limpieza de JPEG
+func TestStripMetadata_JPEG(t *testing.T) {
+	jfif := segment(markerAPP0, []byte("JFIF\x00\x01\x01\x00\x00\x01\x00\x01\x00\x00"))
+	icc := segment(markerAPP2, append([]byte("ICC_PROFILE\x00\x01\x01"), "perfil"...))
+	comment := segment(markerCOM, []byte(secret))
+	photoshop := segment(0xED, []byte("Photoshop 3.0\x00"+secret))
+
+	tests := []struct {
+		name            string
+		extra           [][]byte
+		wantOrientation int
+		wantKept        [][]byte
+	}{
+		{name: "EXIF big endian girado", extra: [][]byte{jfif, exifSegment(binary.BigEndian, 6), comment}, wantOrientation: 6, wantKept: [][]byte{jfif}},
+		{name: "EXIF little endian sin girar", extra: [][]byte{exifSegment(binary.LittleEndian, 1), photoshop}, wantOrientation: 1},
+		{name: "conserva el perfil ICC", extra: [][]byte{exifSegment(binary.LittleEndian, 8), icc}, wantOrientation: 8, wantKept: [][]byte{icc}},
+		{name: "sin metadatos", wantOrientation: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := testJPEG(t, 40, 30, tt.extra...)
+
+			stripped, err := StripMetadata(data)
+			require.NoError(t, err)
+
+			assert.NotContains(t, string(stripped), secret)
+			assert.NotContains(t, string(stripped), "ACM")
+			assert.Equal(t, tt.wantOrientation, Orientation(stripped))
+			for _, kept := range tt.wantKept {
+				assert.True(t, bytes.Contains(stripped, kept))
+			}
+			if len(tt.wantKept) > 0 && bytes.Equal(tt.wantKept[0], jfif) {
+				assert.True(t, bytes.HasPrefix(stripped[2:], jfif), "JFIF debe seguir siendo el primer segmento")
+			}
+
+			img, err := jpeg.Decode(bytes.NewReader(stripped))
+			require.NoError(t, err)
+			assert.Equal(t, image.Rect(0, 0, 40, 30), img.Bounds())
+		})
+	}
+}
+
+// TestStripMetadata_PNG tests para la limpieza de PNG
+func TestStripMetadata_PNG(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 8, 8))
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	data := buf.Bytes()
+
+	// Los fragmentos auxiliares se insertan tras IHDR (8 + 25 bytes)
+	ihdrEnd := len(pngMagic) + 25
+	withMeta := append([]byte{}, data[:ihdrEnd]...)
+	withMeta = append(withMeta, pngChunk("tEXt", []byte("Author\x00"+secret))...)
+	withMeta = append(withMeta, pngChunk("eXIf", []byte("MM\x00\x2a"+secret))...)
+	withMeta = append(withMeta, pngChunk("gAMA", []byte{0, 0, 0xB1, 0x8F})...)
+	withMeta = append(withMeta, data[ihdrEnd:]...)
+
+	stripped, err := StripMetadata(withMeta)
+	require.NoError(t, err)
+	assert.NotContains(t, string(stripped), secret)
+	assert.Contains(t, string(stripped), "gAMA")
+
+	_, err = png.Decode(bytes.NewReader(stripped))
+	assert.NoError(t, err)
+}
+
+// TestStripMetadata_WebP tests para la limpieza de WebP
+func TestStripMetadata_WebP(t *testing.T) {
+	chunk := func(fourCC string, data []byte) []byte {
+		out := append([]byte(fourCC), binary.LittleEndian.AppendUint32(nil, uint32(len(data)))...)
+		out = append(out, data...)
+		if len(data)%2 == 1 {
+			out = append(out, 0)
+		}
+		return out
+	}
+	body := []byte("WEBP")
+	body = append(body, chunk("VP8X", []byte{webpFlagEXIF | webpFlagXMP | 0x10, 0, 0, 0, 9, 0, 0, 9, 0, 0})...)
+	body = append(body, chunk("VP8L", []byte{0x2f, 1, 2, 3, 4})...)
+	body = append(body, chunk("EXIF", []byte(secret))...)
+	body = append(body, chunk("XMP ", []byte("<x>"+secret+"</x>"))...)
+	data := append([]byte("RIFF"), binary.LittleEndian.AppendUint32(nil, uint32(len(body)))...)
+	data = append(data, body...)
+
+	stripped, err := StripMetadata(data)
+	require.NoError(t, err)
+	assert.NotContains(t, string(stripped), secret)
+	assert.Equal(t, uint32(len(stripped)-8), binary.LittleEndian.Uint32(stripped[4:]))
+	assert.Equal(t, byte(0x10), stripped[20], "solo se quitan los indicadores de EXIF y XMP")
+	assert.Contains(t, string(stripped), "VP8L")
+}
+
+// TestStripMetadata_Otros tests para formatos sin limpieza y datos dañados
+func TestStripMetadata_Otros(t *testing.T) {
+	pdf := []byte("%PDF-1.7\n" + secret)
+	out, err := StripMetadata(pdf)
+	require.NoError(t, err)
+	assert.Equal(t, pdf, out)
+
+	_, err = StripMetadata([]byte{0xFF, 0xD8, 0xFF, 0xE1, 0xFF, 0xFF})
+	assert.ErrorIs(t, err, ErrMalformed)
+
+	_, err = StripMetadata(append(append([]byte{}, pngMagic...), 0, 0, 0, 50, 'I', 'H'))
+	assert.ErrorIs(t, err, ErrMalformed)
+}
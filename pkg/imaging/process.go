@@ -0,0 +1,76 @@
+package imaging
+
+import (
+	"bytes"
+)
+
+// Spec versión que se genera de una imagen
+type Spec struct {
+	Name   string
+	Width  int
+	Height int
+	Mode   Mode
+}
+
+// Options límites y calidad del procesado
+type Options struct {
+	MaxPixels int // 0 = DefaultMaxPixels
+	Quality   int // Calidad WebP; 0 = DefaultQuality
+}
+
+// Variant versión generada y codificada
+type Variant struct {
+	Name        string
+	Width       int
+	Height      int
+	ContentType string
+	Ext         string
+	Data        []byte
+}
+
+// Result resultado del procesado de una imagen
+type Result struct {
+	Format        string // Formato del original: jpeg, png, gif o webp
+	Width         int    // Dimensiones del original ya orientado
+	Height        int
+	DominantColor string // #rrggbb; vacío si la imagen es transparente
+	Variants      []Variant
+}
+
+// Process decodifica la imagen, la orienta según su EXIF y genera las
+// versiones. Las versiones se recodifican en WebP, así que no llevan metadatos
+func Process(data []byte, specs []Spec, opts Options) (*Result, error) {
+	img, format, err := Decode(data, opts.MaxPixels)
+	if err != nil {
+		return nil, err
+	}
+	oriented := Orient(img, Orientation(data))
+
+	result := &Result{
+		Format: format,
+		Width:  oriented.Bounds().Dx(),
+		Height: oriented.Bounds().Dy(),
+	}
+	if c, ok := DominantColor(oriented); ok {
+		result.DominantColor = Hex(c)
+	}
+
+	for _, spec := range specs {
+		resized := Resize(oriented, spec.Width, spec.Height, spec.Mode)
+
+		var buf bytes.Buffer
+		contentType, ext, err := Encode(&buf, resized, opts.Quality)
+		if err != nil {
+			return nil, err
+		}
+		result.Variants = append(result.Variants, Variant{
+			Name:        spec.Name,
+			Width:       resized.Bounds().Dx(),
+			Height:      resized.Bounds().Dy(),
+			ContentType: contentType,
+			Ext:         ext,
+			Data:        buf.Bytes(),
+		})
+	}
+	return result, nil
+}
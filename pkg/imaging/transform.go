@@ -0,0 +1,190 @@
+package imaging
+
+import (
+	"image"
+	"image/draw"
+	"math"
+)
+
+// Mode forma de ajustar la imagen a las dimensiones de una versión
+type Mode int
+
+const (
+	// Fill recorta el centro de la imagen para llenar exactamente la proporción
+	Fill Mode = iota
+	// Fit encaja la imagen entera sin recortarla (p. ej. logos)
+	Fit
+)
+
+// Orient aplica la orientación EXIF (1-8) para que la imagen se vea derecha
+func Orient(img image.Image, orientation int) *image.RGBA {
+	src := toRGBA(img)
+	if orientation < 2 || orientation > 8 {
+		return src
+	}
+
+	w, h := src.Bounds().Dx(), src.Bounds().Dy()
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+
+	for y := 0; y < dh; y++ {
+		for x := 0; x < dw; x++ {
+			var sx, sy int
+			switch orientation {
+			case 2: // Espejo horizontal
+				sx, sy = w-1-x, y
+			case 3: // Giro de 180°
+				sx, sy = w-1-x, h-1-y
+			case 4: // Espejo vertical
+				sx, sy = x, h-1-y
+			case 5: // Trasposición
+				sx, sy = y, x
+			case 6: // Giro de 90° en sentido horario
+				sx, sy = y, h-1-x
+			case 7: // Trasposición inversa
+				sx, sy = w-1-y, h-1-x
+			case 8: // Giro de 90° en sentido antihorario
+				sx, sy = w-1-y, x
+			}
+			si, di := src.PixOffset(sx, sy), dst.PixOffset(x, y)
+			copy(dst.Pix[di:di+4], src.Pix[si:si+4])
+		}
+	}
+	return dst
+}
+
+// Resize reduce la imagen para que quepa en width x height según el modo.
+// Nunca amplía: si la imagen es más pequeña se mantiene su tamaño (con Fill
+// se recorta igualmente a la proporción pedida)
+func Resize(img image.Image, width, height int, mode Mode) *image.RGBA {
+	src := toRGBA(img)
+	sw, sh := src.Bounds().Dx(), src.Bounds().Dy()
+	if width <= 0 || height <= 0 || sw == 0 || sh == 0 {
+		return src
+	}
+
+	crop := src.Bounds()
+	if mode == Fill {
+		// Recorte centrado con la proporción de destino
+		cw, ch := sw, sh
+		if sw*height > sh*width {
+			cw = max(1, int(math.Round(float64(sh)*float64(width)/float64(height))))
+		} else {
+			ch = max(1, int(math.Round(float64(sw)*float64(height)/float64(width))))
+		}
+		x0 := crop.Min.X + (sw-cw)/2
+		y0 := crop.Min.Y + (sh-ch)/2
+		crop = image.Rect(x0, y0, x0+cw, y0+ch)
+	}
+
+	cw, ch := crop.Dx(), crop.Dy()
+	dw, dh := cw, ch
+	switch {
+	case mode == Fill && (cw > width || ch > height):
+		// El recorte ya tiene la proporción; se evita el error de redondeo
+		dw, dh = width, height
+	case mode == Fit:
+		scale := math.Min(1, math.Min(float64(width)/float64(cw), float64(height)/float64(ch)))
+		dw = max(1, int(math.Round(float64(cw)*scale)))
+		dh = max(1, int(math.Round(float64(ch)*scale)))
+	}
+
+	return resample(src.SubImage(crop).(*image.RGBA), dw, dh)
+}
+
+// toRGBA copia la imagen a RGBA (alfa premultiplicado) con origen en (0, 0)
+func toRGBA(img image.Image) *image.RGBA {
+	if rgba, ok := img.(*image.RGBA); ok && rgba.Bounds().Min == (image.Point{}) {
+		return rgba
+	}
+	b := img.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(dst, dst.Bounds(), img, b.Min, draw.Src)
+	return dst
+}
+
+// contribution peso de un píxel de origen en uno de destino
+type contribution struct {
+	index  int
+	weight float32
+}
+
+// areaWeights pesos del promedio por áreas al reducir src píxeles a dst: cada
+// píxel de destino promedia los de origen que cubre, en proporción a la
+// superficie que cubre de cada uno
+func areaWeights(src, dst int) [][]contribution {
+	scale := float64(src) / float64(dst)
+	weights := make([][]contribution, dst)
+	for i := range weights {
+		start, end := float64(i)*scale, float64(i+1)*scale
+		for j := int(start); j < src && float64(j) < end; j++ {
+			overlap := math.Min(end, float64(j+1)) - math.Max(start, float64(j))
+			if overlap > 0 {
+				weights[i] = append(weights[i], contribution{index: j, weight: float32(overlap / scale)})
+			}
+		}
+	}
+	return weights
+}
+
+// resample reduce src a dw x dh en dos pasadas (horizontal y vertical)
+func resample(src *image.RGBA, dw, dh int) *image.RGBA {
+	b := src.Bounds()
+	sw, sh := b.Dx(), b.Dy()
+	if sw == dw && sh == dh {
+		return toRGBA(src)
+	}
+
+	// Pasada horizontal: sh filas de dw píxeles
+	xWeights := areaWeights(sw, dw)
+	tmp := make([]float32, dw*sh*4)
+	for y := 0; y < sh; y++ {
+		row := src.Pix[y*src.Stride:] // Pix empieza en b.Min
+		for x, weights := range xWeights {
+			var r, g, bl, a float32
+			for _, c := range weights {
+				p := row[c.index*4 : c.index*4+4]
+				r += float32(p[0]) * c.weight
+				g += float32(p[1]) * c.weight
+				bl += float32(p[2]) * c.weight
+				a += float32(p[3]) * c.weight
+			}
+			t := tmp[(y*dw+x)*4:]
+			t[0], t[1], t[2], t[3] = r, g, bl, a
+		}
+	}
+
+	// Pasada vertical
+	yWeights := areaWeights(sh, dh)
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y, weights := range yWeights {
+		for x := 0; x < dw; x++ {
+			var r, g, bl, a float32
+			for _, c := range weights {
+				t := tmp[(c.index*dw+x)*4:]
+				r += t[0] * c.weight
+				g += t[1] * c.weight
+				bl += t[2] * c.weight
+				a += t[3] * c.weight
+			}
+			d := dst.Pix[dst.PixOffset(x, y):]
+			d[0], d[1], d[2], d[3] = clamp8(r), clamp8(g), clamp8(bl), clamp8(a)
+		}
+	}
+	return dst
+}
+
+// clamp8 redondea al byte más cercano
+func clamp8(v float32) uint8 {
+	switch {
+	case v <= 0:
+		return 0
+	case v >= 255:
+		return 255
+	default:
+		return uint8(v + 0.5)
+	}
+}
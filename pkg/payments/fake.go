@@ -0,0 +1,116 @@
+package payments
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// FakeProviderName identificador del proveedor de pruebas
+const FakeProviderName = "fake"
+
+// SignatureHeader cabecera con la firma HMAC-SHA256 de los webhooks
+const SignatureHeader = "X-Payment-Signature"
+
+// FakeProvider proveedor de pagos para desarrollo local. No mueve dinero: los
+// pagos se completan enviando al webhook una notificación firmada con el secreto.
+type FakeProvider struct {
+	secret      []byte
+	checkoutURL string
+}
+
+// NewFakeProvider crea un proveedor de pruebas
+func NewFakeProvider(secret, checkoutURL string) *FakeProvider {
+	return &FakeProvider{
+		secret:      []byte(secret),
+		checkoutURL: strings.TrimSuffix(checkoutURL, "/"),
+	}
+}
+
+// Name implementa Provider
+func (p *FakeProvider) Name() string {
+	return FakeProviderName
+}
+
+// CreatePayment implementa Provider
+func (p *FakeProvider) CreatePayment(_ context.Context, req PaymentRequest) (*Payment, error) {
+	if req.AmountCents <= 0 {
+		return nil, errors.New("payment amount must be positive")
+	}
+
+	id := "fake_pay_" + uuid.NewString()
+	return &Payment{
+		ID:          id,
+		CheckoutURL: p.checkoutURL + "/" + id,
+	}, nil
+}
+
+// CancelPayment implementa Provider. El checkout simulado no guarda estado:
+// un pago anulado aún se puede completar con BuildWebhook, como ocurre con un
+// proveedor real cuando la anulación llega tarde
+func (p *FakeProvider) CancelPayment(_ context.Context, paymentID string) error {
+	if !strings.HasPrefix(paymentID, "fake_pay_") {
+		return errors.New("unknown fake payment")
+	}
+	return nil
+}
+
+// Refund implementa Provider
+func (p *FakeProvider) Refund(_ context.Context, paymentID string, amountCents int) (*Refund, error) {
+	if !strings.HasPrefix(paymentID, "fake_pay_") {
+		return nil, errors.New("unknown fake payment")
+	}
+	if amountCents <= 0 {
+		return nil, errors.New("refund amount must be positive")
+	}
+
+	return &Refund{ID: "fake_ref_" + uuid.NewString()}, nil
+}
+
+// ParseWebhook implementa Provider
+func (p *FakeProvider) ParseWebhook(payload []byte, signature string) (*WebhookEvent, error) {
+	expected, err := hex.DecodeString(signature)
+	if err != nil || !hmac.Equal(expected, p.sign(payload)) {
+		return nil, ErrInvalidSignature
+	}
+
+	var event WebhookEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, ErrInvalidPayload
+	}
+
+	if event.ID == "" || event.Type == "" || event.PaymentID == "" {
+		return nil, ErrInvalidPayload
+	}
+
+	return &event, nil
+}
+
+// BuildWebhook genera una notificación firmada, como la enviaría un proveedor real.
+// Permite simular el resultado del checkout en local.
+func (p *FakeProvider) BuildWebhook(eventType WebhookEventType, paymentID, failureReason string) ([]byte, string, error) {
+	payload, err := json.Marshal(WebhookEvent{
+		ID:            "fake_evt_" + uuid.NewString(),
+		Type:          eventType,
+		PaymentID:     paymentID,
+		FailureReason: failureReason,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return payload, hex.EncodeToString(p.sign(payload)), nil
+}
+
+// sign calcula el HMAC-SHA256 del payload
+func (p *FakeProvider) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, p.secret)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,103 @@
+package payments
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNew tests para la construcción de proveedores
+func TestNew(t *testing.T) {
+	provider, err := New(Config{Provider: "fake", WebhookSecret: "secreto"})
+	require.NoError(t, err)
+	assert.Equal(t, FakeProviderName, provider.Name())
+
+	provider, err = New(Config{Provider: ProviderNone})
+	require.NoError(t, err)
+	assert.Nil(t, provider)
+
+	_, err = New(Config{Provider: "desconocido"})
+	assert.ErrorIs(t, err, ErrUnknownProvider)
+}
+
+// TestFakeProvider_CreatePayment tests para la creación de pagos
+func TestFakeProvider_CreatePayment(t *testing.T) {
+	provider := NewFakeProvider("secreto", "http://localhost:8080/checkout/")
+
+	payment, err := provider.CreatePayment(context.Background(), PaymentRequest{OrderID: "o1", AmountCents: 4900, Currency: "EUR"})
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(payment.ID, "fake_pay_"))
+	assert.Equal(t, "http://localhost:8080/checkout/"+payment.ID, payment.CheckoutURL)
+
+	_, err = provider.CreatePayment(context.Background(), PaymentRequest{OrderID: "o2"})
+	assert.Error(t, err)
+}
+
+// TestFakeProvider_Refund tests para reembolsos
+func TestFakeProvider_Refund(t *testing.T) {
+	provider := NewFakeProvider("secreto", "")
+
+	refund, err := provider.Refund(context.Background(), "fake_pay_123", 4900)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(refund.ID, "fake_ref_"))
+
+	_, err = provider.Refund(context.Background(), "ch_123", 4900)
+	assert.Error(t, err)
+}
+
+// TestFakeProvider_CancelPayment tests para la anulación del checkout
+func TestFakeProvider_CancelPayment(t *testing.T) {
+	provider := NewFakeProvider("secreto", "")
+
+	assert.NoError(t, provider.CancelPayment(context.Background(), "fake_pay_123"))
+	assert.Error(t, provider.CancelPayment(context.Background(), "ch_123"))
+}
+
+// TestFakeProvider_Webhook tests para la firma y lectura de webhooks
+func TestFakeProvider_Webhook(t *testing.T) {
+	provider := NewFakeProvider("secreto", "")
+
+	payload, signature, err := provider.BuildWebhook(EventPaymentFailed, "fake_pay_123", "tarjeta rechazada")
+	require.NoError(t, err)
+
+	t.Run("firma válida", func(t *testing.T) {
+		event, err := provider.ParseWebhook(payload, signature)
+		require.NoError(t, err)
+		assert.Equal(t, EventPaymentFailed, event.Type)
+		assert.Equal(t, "fake_pay_123", event.PaymentID)
+		assert.Equal(t, "tarjeta rechazada", event.FailureReason)
+		assert.NotEmpty(t, event.ID)
+	})
+
+	t.Run("payload alterado", func(t *testing.T) {
+		tampered := []byte(strings.Replace(string(payload), "payment.failed", "payment.succeeded", 1))
+		_, err := provider.ParseWebhook(tampered, signature)
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+	})
+
+	t.Run("otro secreto", func(t *testing.T) {
+		other := NewFakeProvider("otro", "")
+		_, err := other.ParseWebhook(payload, signature)
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+	})
+
+	t.Run("firma no hexadecimal", func(t *testing.T) {
+		_, err := provider.ParseWebhook(payload, "no-hex")
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+	})
+
+	t.Run("payload incompleto", func(t *testing.T) {
+		body := []byte(`{"type":"payment.succeeded"}`)
+		_, err := provider.ParseWebhook(body, signatureFor(provider, body))
+		assert.ErrorIs(t, err, ErrInvalidPayload)
+	})
+}
+
+// signatureFor firma un payload arbitrario con el secreto del proveedor
+func signatureFor(p *FakeProvider, payload []byte) string {
+	return hex.EncodeToString(p.sign(payload))
+}
@@ -0,0 +1,90 @@
+package payments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// WebhookEventType tipos de notificación que envía un proveedor de pagos
+type WebhookEventType string
+
+const (
+	EventPaymentSucceeded WebhookEventType = "payment.succeeded" // Pago completado
+	EventPaymentFailed    WebhookEventType = "payment.failed"    // Pago rechazado o abandonado
+	EventRefundSucceeded  WebhookEventType = "refund.succeeded"  // Reembolso completado
+)
+
+// ProviderNone pagos desactivados: no hay proveedor y no se admiten inscripciones de pago
+const ProviderNone = "none"
+
+// Errores comunes de los proveedores
+var (
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+	ErrInvalidPayload   = errors.New("invalid webhook payload")
+	ErrUnknownProvider  = errors.New("unknown payment provider")
+)
+
+// PaymentRequest datos para iniciar un pago
+type PaymentRequest struct {
+	OrderID     string
+	AmountCents int
+	Currency    string
+	Description string
+	Email       string
+}
+
+// Payment pago creado en el proveedor
+type Payment struct {
+	ID          string
+	CheckoutURL string // URL a la que se redirige al usuario para pagar
+}
+
+// Refund reembolso creado en el proveedor
+type Refund struct {
+	ID string
+}
+
+// WebhookEvent notificación verificada de un proveedor
+type WebhookEvent struct {
+	ID            string           `json:"id"`
+	Type          WebhookEventType `json:"type"`
+	PaymentID     string           `json:"payment_id"`
+	RefundID      string           `json:"refund_id,omitempty"`
+	FailureReason string           `json:"failure_reason,omitempty"`
+}
+
+// Provider abstracción de un proveedor de pagos
+type Provider interface {
+	// Name identificador del proveedor (se usa en la ruta del webhook)
+	Name() string
+	// CreatePayment inicia un pago y devuelve la URL de checkout
+	CreatePayment(ctx context.Context, req PaymentRequest) (*Payment, error)
+	// CancelPayment anula el checkout de un pago no completado para que ya no
+	// se pueda pagar
+	CancelPayment(ctx context.Context, paymentID string) error
+	// Refund reembolsa un pago completado
+	Refund(ctx context.Context, paymentID string, amountCents int) (*Refund, error)
+	// ParseWebhook verifica la firma y decodifica una notificación
+	ParseWebhook(payload []byte, signature string) (*WebhookEvent, error)
+}
+
+// Config configuración para construir un proveedor
+type Config struct {
+	Provider      string
+	WebhookSecret string
+	CheckoutURL   string // Base de la URL de checkout (solo proveedor fake)
+}
+
+// New crea el proveedor indicado en la configuración; devuelve nil si los
+// pagos están desactivados
+func New(cfg Config) (Provider, error) {
+	switch cfg.Provider {
+	case ProviderNone, "":
+		return nil, nil
+	case FakeProviderName:
+		return NewFakeProvider(cfg.WebhookSecret, cfg.CheckoutURL), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownProvider, cfg.Provider)
+	}
+}
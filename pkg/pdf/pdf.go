@@ -0,0 +1,168 @@
+// Package pdf genera documentos PDF 1.4 sencillos (texto y líneas sobre
+// páginas A4) sin dependencias externas. Usa las fuentes estándar de PDF con
+// codificación WinAnsi, suficiente para textos en español.
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Dimensiones de una página A4 en puntos
+const (
+	PageWidth  = 595.28
+	PageHeight = 841.89
+)
+
+// Font fuentes estándar disponibles
+type Font int
+
+const (
+	Regular Font = iota // Helvetica
+	Bold                // Helvetica-Bold
+	Mono                // Courier (ancho fijo, útil para alinear importes)
+)
+
+// baseFonts nombres PostScript de las fuentes estándar
+var baseFonts = []string{"Helvetica", "Helvetica-Bold", "Courier"}
+
+// monoAdvance ancho de un carácter de Courier en milésimas del tamaño de fuente
+const monoAdvance = 600
+
+// Document documento con una o varias páginas
+type Document struct {
+	Title  string
+	Author string
+	pages  []*Page
+}
+
+// Page página A4 vertical
+type Page struct {
+	content bytes.Buffer
+}
+
+// New crea un documento vacío
+func New() *Document {
+	return &Document{}
+}
+
+// AddPage añade una página al final del documento
+func (d *Document) AddPage() *Page {
+	page := &Page{}
+	d.pages = append(d.pages, page)
+	return page
+}
+
+// Text escribe texto con la línea base en (x, y), medido desde la esquina
+// inferior izquierda
+func (p *Page) Text(x, y float64, font Font, size float64, text string) {
+	fmt.Fprintf(&p.content, "BT /F%d %s Tf %s %s Td (%s) Tj ET\n",
+		font+1, number(size), number(x), number(y), escape(encode(text)))
+}
+
+// TextRight escribe texto en fuente monoespaciada alineado a la derecha en x
+func (p *Page) TextRight(x, y float64, size float64, text string) {
+	p.Text(x-MonoWidth(text, size), y, Mono, size, text)
+}
+
+// Line dibuja una línea recta
+func (p *Page) Line(x1, y1, x2, y2, width float64) {
+	fmt.Fprintf(&p.content, "%s w %s %s m %s %s l S\n",
+		number(width), number(x1), number(y1), number(x2), number(y2))
+}
+
+// MonoWidth ancho en puntos de un texto en fuente monoespaciada
+func MonoWidth(text string, size float64) float64 {
+	return float64(utf8.RuneCountInString(text)) * size * monoAdvance / 1000
+}
+
+// Bytes genera el documento completo
+func (d *Document) Bytes() []byte {
+	pages := d.pages
+	if len(pages) == 0 {
+		pages = []*Page{{}}
+	}
+
+	// Objetos: 1 catálogo, 2 árbol de páginas, 3..5 fuentes, 6 info,
+	// y por cada página su objeto y su contenido
+	const firstPage = 7
+	var objects []string
+
+	kids := make([]string, len(pages))
+	for i := range pages {
+		kids[i] = fmt.Sprintf("%d 0 R", firstPage+2*i)
+	}
+
+	objects = append(objects,
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)),
+	)
+	for _, name := range baseFonts {
+		objects = append(objects, fmt.Sprintf(
+			"<< /Type /Font /Subtype /Type1 /BaseFont /%s /Encoding /WinAnsiEncoding >>", name))
+	}
+	objects = append(objects, fmt.Sprintf("<< /Title (%s) /Author (%s) /Producer (CybeSphere) >>",
+		escape(encode(d.Title)), escape(encode(d.Author))))
+
+	for i, page := range pages {
+		contentRef := firstPage + 2*i + 1
+		objects = append(objects,
+			fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %s %s] "+
+				"/Resources << /Font << /F1 3 0 R /F2 4 0 R /F3 5 0 R >> >> /Contents %d 0 R >>",
+				number(PageWidth), number(PageHeight), contentRef),
+			fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", page.content.Len(), page.content.String()),
+		)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	offsets := make([]int, len(objects))
+	for i, object := range objects {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, object)
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R /Info 6 0 R >>\nstartxref\n%d\n%%%%EOF\n",
+		len(objects)+1, xref)
+
+	return buf.Bytes()
+}
+
+// encode convierte texto UTF-8 a WinAnsi (Windows-1252); los caracteres sin
+// representación se sustituyen por '?'
+func encode(text string) string {
+	out := make([]byte, 0, len(text))
+	for _, r := range text {
+		switch {
+		case r == '€':
+			out = append(out, 0x80)
+		case r == '\t' || r == '\n' || r == '\r':
+			out = append(out, ' ')
+		case r >= 0x20 && r <= 0x7e, r >= 0xa0 && r <= 0xff:
+			out = append(out, byte(r))
+		default:
+			out = append(out, '?')
+		}
+	}
+	return string(out)
+}
+
+// escape escapa los caracteres especiales de una cadena literal de PDF
+func escape(text string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `(`, `\(`, `)`, `\)`)
+	return replacer.Replace(text)
+}
+
+// number formatea un número sin ceros decimales innecesarios
+func number(value float64) string {
+	formatted := strings.TrimRight(fmt.Sprintf("%.2f", value), "0")
+	return strings.TrimSuffix(formatted, ".")
+}
@@ -0,0 +1,85 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDocument_Bytes tests unitarios para la estructura del documento
+func TestDocument_Bytes(t *testing.T) {
+	doc := New()
+	doc.Title = "Factura F2026-000001"
+	first := doc.AddPage()
+	first.Text(50, 800, Bold, 16, "Factura")
+	first.Line(50, 790, 545, 790, 0.5)
+	doc.AddPage().Text(50, 800, Regular, 10, "Segunda página")
+
+	out := doc.Bytes()
+
+	assert.True(t, bytes.HasPrefix(out, []byte("%PDF-1.4\n")))
+	assert.True(t, bytes.HasSuffix(out, []byte("%%EOF\n")))
+	assert.Contains(t, string(out), "/Count 2")
+	assert.Contains(t, string(out), "/Kids [7 0 R 9 0 R]")
+	assert.Contains(t, string(out), "(Factura F2026-000001)")
+
+	// Cada entrada de la tabla xref apunta al inicio de su objeto
+	xref := regexp.MustCompile(`(?m)^(\d{10}) 00000 n $`).FindAllStringSubmatch(string(out), -1)
+	require.Len(t, xref, 10)
+	for i, entry := range xref {
+		offset, err := strconv.Atoi(entry[1])
+		require.NoError(t, err)
+		assert.True(t, bytes.HasPrefix(out[offset:], []byte(fmt.Sprintf("%d 0 obj", i+1))), "objeto %d", i+1)
+	}
+
+	// startxref apunta a la tabla
+	match := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(out)
+	require.NotNil(t, match)
+	start, _ := strconv.Atoi(string(match[1]))
+	assert.True(t, bytes.HasPrefix(out[start:], []byte("xref\n")))
+}
+
+// TestDocument_Empty tests unitarios para documentos sin páginas
+func TestDocument_Empty(t *testing.T) {
+	out := New().Bytes()
+	assert.Contains(t, string(out), "/Count 1")
+}
+
+// TestPage_Text tests unitarios para la codificación del texto
+func TestPage_Text(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{name: "ascii", text: "Total", want: "(Total) Tj"},
+		{name: "acentos y eñe", text: "Inscripción año", want: "(Inscripci\xf3n a\xf1o) Tj"},
+		{name: "símbolo del euro", text: "49,00 €", want: "(49,00 \x80) Tj"},
+		{name: "paréntesis y barra", text: `IVA (21%) \ base`, want: `(IVA \(21%\) \\ base) Tj`},
+		{name: "sin representación", text: "Ponente 日本", want: "(Ponente ??) Tj"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page := &Page{}
+			page.Text(10, 20, Regular, 12, tt.text)
+			assert.Contains(t, page.content.String(), tt.want)
+			assert.Contains(t, page.content.String(), "/F1 12 Tf 10 20 Td")
+		})
+	}
+}
+
+// TestPage_TextRight tests unitarios para la alineación a la derecha
+func TestPage_TextRight(t *testing.T) {
+	page := &Page{}
+	page.TextRight(500, 100, 10, "49,00")
+
+	// 5 caracteres * 10pt * 0.6 = 30pt
+	assert.Equal(t, 30.0, MonoWidth("49,00", 10))
+	assert.Contains(t, page.content.String(), "/F3 10 Tf 470 100 Td (49,00) Tj")
+}
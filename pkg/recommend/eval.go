@@ -0,0 +1,78 @@
+package recommend
+
+import "math"
+
+// Ranker ordena los candidatos para un usuario
+type Ranker func(user User, candidates []Item) []Recommendation
+
+// NewRanker ranker que puntúa con los pesos indicados
+func NewRanker(w Weights) Ranker {
+	return func(user User, candidates []Item) []Recommendation {
+		return Rank(BuildProfile(user), candidates, w)
+	}
+}
+
+// Metrics calidad de un ranker en la evaluación offline
+type Metrics struct {
+	Users    int     // Usuarios con al menos dos favoritos
+	Cases    int     // Favoritos ocultados
+	K        int     // Tamaño de la lista evaluada
+	HitRate  float64 // Proporción de casos con el favorito oculto entre los K primeros
+	MRR      float64 // Media del inverso de la posición del favorito oculto
+	NDCG     float64 // Ganancia acumulada descontada en los K primeros
+	Coverage float64 // Proporción de candidatos que aparecen en algún top K
+}
+
+// LeaveOneOut evalúa un ranker ocultando por turnos cada favorito de los
+// usuarios con al menos dos: el perfil se construye con el resto de favoritos
+// y se mide en qué posición queda el oculto entre todos los candidatos
+func LeaveOneOut(users []User, candidates []Item, ranker Ranker, k int) Metrics {
+	metrics := Metrics{K: k}
+	recommended := make(map[string]bool)
+
+	for _, user := range users {
+		if len(user.Favorites) < 2 {
+			continue
+		}
+		metrics.Users++
+
+		for i, hidden := range user.Favorites {
+			rest := make([]Item, 0, len(user.Favorites)-1)
+			rest = append(rest, user.Favorites[:i]...)
+			rest = append(rest, user.Favorites[i+1:]...)
+
+			probe := user
+			probe.Favorites = rest
+			ranked := ranker(probe, candidates)
+			metrics.Cases++
+
+			for position, rec := range ranked {
+				if position < k {
+					recommended[rec.Item.ID] = true
+				}
+				if rec.Item.ID != hidden.ID {
+					continue
+				}
+
+				rank := float64(position + 1)
+				metrics.MRR += 1 / rank
+				if position < k {
+					metrics.HitRate++
+					metrics.NDCG += 1 / math.Log2(rank+1)
+				}
+			}
+		}
+	}
+
+	if metrics.Cases > 0 {
+		cases := float64(metrics.Cases)
+		metrics.HitRate /= cases
+		metrics.MRR /= cases
+		metrics.NDCG /= cases
+	}
+	if len(candidates) > 0 {
+		metrics.Coverage = float64(len(recommended)) / float64(len(candidates))
+	}
+
+	return metrics
+}
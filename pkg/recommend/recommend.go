@@ -0,0 +1,364 @@
+// Package recommend ordena eventos para un usuario a partir de sus señales:
+// afinidad por tags y categorías de sus eventos favoritos, nivel técnico,
+// cercanía a su ubicación y organizaciones que sigue. Cada recomendación
+// incluye los motivos que más han contribuido a su puntuación.
+package recommend
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"cybesphere-backend/pkg/geo"
+)
+
+// Niveles técnicos de los eventos, de menor a mayor
+const (
+	LevelBeginner     = "beginner"
+	LevelIntermediate = "intermediate"
+	LevelAdvanced     = "advanced"
+)
+
+// levelRank posición de cada nivel para medir la distancia entre niveles
+var levelRank = map[string]int{LevelBeginner: 0, LevelIntermediate: 1, LevelAdvanced: 2}
+
+// levelNames nombre de cada nivel en los motivos
+var levelNames = map[string]string{
+	LevelBeginner:     "principiante",
+	LevelIntermediate: "intermedio",
+	LevelAdvanced:     "avanzado",
+}
+
+// Tipos de motivo de una recomendación
+const (
+	ReasonSimilarToFavorite    = "similar_to_favorite"
+	ReasonCategory             = "category"
+	ReasonLevel                = "level"
+	ReasonNearby               = "nearby"
+	ReasonFollowedOrganization = "followed_organization"
+)
+
+// minReasonContribution contribución mínima al score para mostrar un motivo
+const minReasonContribution = 0.02
+
+// Item evento candidato o favorito
+type Item struct {
+	ID               string
+	Title            string
+	Tags             []string
+	Category         string
+	Level            string
+	OrganizationID   string
+	OrganizationName string
+	Location         *geo.Point // nil para eventos online o sin coordenadas
+	StartDate        time.Time
+	Popularity       float64 // Desempate entre candidatos con el mismo score
+}
+
+// User señales de un usuario
+type User struct {
+	Position              string
+	Location              *geo.Point
+	Favorites             []Item            // Del más reciente al más antiguo
+	FollowedOrganizations map[string]string // ID → nombre
+}
+
+// Weights peso de cada señal en el score
+type Weights struct {
+	Tags             float64
+	Category         float64
+	Level            float64
+	Proximity        float64
+	Organization     float64
+	ProximityScaleKm float64 // Distancia a la que la cercanía vale 1/e
+}
+
+// DefaultWeights pesos por defecto; suman 1, así que el score está entre 0 y 1
+var DefaultWeights = Weights{
+	Tags:             0.35,
+	Category:         0.20,
+	Level:            0.10,
+	Proximity:        0.20,
+	Organization:     0.15,
+	ProximityScaleKm: 50,
+}
+
+// Reason motivo de una recomendación
+type Reason struct {
+	Type           string
+	Message        string
+	EventID        string   // Favorito parecido (similar_to_favorite)
+	OrganizationID string   // Organización seguida (followed_organization)
+	Tags           []string // Tags en común con el favorito
+	Contribution   float64  // Parte del score que aporta
+}
+
+// Recommendation evento recomendado con su puntuación y motivos
+type Recommendation struct {
+	Item       Item
+	Score      float64
+	Reasons    []Reason
+	DistanceKm *float64
+}
+
+// Profile preferencias derivadas de las señales de un usuario
+type Profile struct {
+	tags            map[string]float64 // Proporción de favoritos con cada tag
+	categories      map[string]float64 // Proporción de favoritos de cada categoría
+	categorySources map[string]string  // Nombre original de cada categoría
+	favorites       []Item
+	level           string
+	levelFromRole   bool // El nivel se deduce del puesto y no de los favoritos
+	location        *geo.Point
+	followed        map[string]string
+	exclude         map[string]bool
+}
+
+// BuildProfile calcula las preferencias de un usuario
+func BuildProfile(user User) *Profile {
+	p := &Profile{
+		tags:            make(map[string]float64),
+		categories:      make(map[string]float64),
+		categorySources: make(map[string]string),
+		favorites:       user.Favorites,
+		location:        user.Location,
+		followed:        user.FollowedOrganizations,
+		exclude:         make(map[string]bool, len(user.Favorites)),
+	}
+
+	levels := make(map[string]int)
+	total := float64(len(user.Favorites))
+	for _, favorite := range user.Favorites {
+		p.exclude[favorite.ID] = true
+
+		for tag := range normalizedTags(favorite.Tags) {
+			p.tags[tag] += 1 / total
+		}
+		if category := normalize(favorite.Category); category != "" {
+			p.categories[category] += 1 / total
+			if _, ok := p.categorySources[category]; !ok {
+				p.categorySources[category] = favorite.Category
+			}
+		}
+		if _, ok := levelRank[favorite.Level]; ok {
+			levels[favorite.Level]++
+		}
+	}
+
+	p.level = mostFrequentLevel(levels)
+	if p.level == "" {
+		p.level = LevelFromPosition(user.Position)
+		p.levelFromRole = p.level != ""
+	}
+
+	return p
+}
+
+// Level nivel técnico preferido ("" si no se puede deducir)
+func (p *Profile) Level() string {
+	return p.level
+}
+
+// LevelFromPosition deduce el nivel técnico a partir del puesto de trabajo
+func LevelFromPosition(position string) string {
+	position = strings.ToLower(position)
+	if position == "" {
+		return ""
+	}
+
+	// Las palabras terminadas en * son raíces; el resto deben coincidir enteras
+	keywords := []struct {
+		level string
+		words []string
+	}{
+		{LevelBeginner, []string{"junior", "jr", "student", "estudiante", "intern", "internship", "becari*", "trainee", "prácticas", "practicas"}},
+		{LevelAdvanced, []string{"senior", "sr", "lead", "principal", "head", "director*", "ciso", "cto", "jefe", "architect", "arquitect*"}},
+		{LevelIntermediate, []string{"analyst", "analista", "engineer", "ingenier*", "consultant", "consultor*", "pentester", "developer", "desarrollador*", "administrador*"}},
+	}
+
+	words := strings.FieldsFunc(position, func(r rune) bool {
+		return !('a' <= r && r <= 'z') && !strings.ContainsRune("áéíóúñü", r)
+	})
+	for _, group := range keywords {
+		for _, word := range words {
+			for _, keyword := range group.words {
+				if stem, ok := strings.CutSuffix(keyword, "*"); ok && strings.HasPrefix(word, stem) || word == keyword {
+					return group.level
+				}
+			}
+		}
+	}
+
+	return ""
+}
+
+// Score puntúa un candidato y explica la puntuación
+func (p *Profile) Score(item Item, w Weights) Recommendation {
+	rec := Recommendation{Item: item}
+
+	// Tags: probabilidad de que al menos un tag del candidato interese
+	// (combinación "noisy-OR" de la proporción de favoritos con cada tag)
+	miss := 1.0
+	for tag := range normalizedTags(item.Tags) {
+		miss *= 1 - p.tags[tag]
+	}
+	if contribution := w.Tags * (1 - miss); contribution > 0 {
+		rec.Score += contribution
+		if favorite, shared := p.closestFavorite(item); favorite != nil {
+			rec.Reasons = append(rec.Reasons, Reason{
+				Type:         ReasonSimilarToFavorite,
+				Message:      fmt.Sprintf("Porque te gustó «%s»", favorite.Title),
+				EventID:      favorite.ID,
+				Tags:         shared,
+				Contribution: contribution,
+			})
+		}
+	}
+
+	// Categoría
+	if category := normalize(item.Category); category != "" {
+		if contribution := w.Category * p.categories[category]; contribution > 0 {
+			rec.Score += contribution
+			rec.Reasons = append(rec.Reasons, Reason{
+				Type:         ReasonCategory,
+				Message:      fmt.Sprintf("Te interesan los eventos de %s", p.categorySources[category]),
+				Contribution: contribution,
+			})
+		}
+	}
+
+	// Nivel: completo si coincide, la mitad si es contiguo
+	if itemRank, ok := levelRank[item.Level]; ok && p.level != "" {
+		match := 1 - math.Abs(float64(itemRank-levelRank[p.level]))/2
+		if contribution := w.Level * match; contribution > 0 {
+			rec.Score += contribution
+			if match == 1 {
+				message := fmt.Sprintf("Nivel %s, como tus eventos favoritos", levelNames[item.Level])
+				if p.levelFromRole {
+					message = fmt.Sprintf("Nivel %s, acorde a tu puesto", levelNames[item.Level])
+				}
+				rec.Reasons = append(rec.Reasons, Reason{Type: ReasonLevel, Message: message, Contribution: contribution})
+			}
+		}
+	}
+
+	// Cercanía: decae exponencialmente con la distancia
+	if p.location != nil && item.Location != nil {
+		distance := geo.Distance(*p.location, *item.Location)
+		rec.DistanceKm = &distance
+		if w.ProximityScaleKm > 0 {
+			if contribution := w.Proximity * math.Exp(-distance/w.ProximityScaleKm); contribution > 0 {
+				rec.Score += contribution
+				rec.Reasons = append(rec.Reasons, Reason{
+					Type:         ReasonNearby,
+					Message:      fmt.Sprintf("A %.0f km de tu ubicación", math.Max(1, math.Round(distance))),
+					Contribution: contribution,
+				})
+			}
+		}
+	}
+
+	// Organizaciones seguidas
+	if name, ok := p.followed[item.OrganizationID]; ok && item.OrganizationID != "" && w.Organization > 0 {
+		rec.Score += w.Organization
+		rec.Reasons = append(rec.Reasons, Reason{
+			Type:           ReasonFollowedOrganization,
+			Message:        fmt.Sprintf("Organizado por %s, a quien sigues", name),
+			OrganizationID: item.OrganizationID,
+			Contribution:   w.Organization,
+		})
+	}
+
+	// Solo los motivos relevantes, del que más aporta al que menos
+	reasons := rec.Reasons[:0]
+	for _, reason := range rec.Reasons {
+		if reason.Contribution >= minReasonContribution {
+			reasons = append(reasons, reason)
+		}
+	}
+	sort.SliceStable(reasons, func(i, j int) bool {
+		return reasons[i].Contribution > reasons[j].Contribution
+	})
+	rec.Reasons = reasons
+
+	return rec
+}
+
+// Rank puntúa y ordena los candidatos, sin incluir los favoritos del usuario.
+// A igual score ordena por popularidad y después por fecha de inicio
+func Rank(profile *Profile, candidates []Item, w Weights) []Recommendation {
+	recs := make([]Recommendation, 0, len(candidates))
+	for _, item := range candidates {
+		if profile.exclude[item.ID] {
+			continue
+		}
+		recs = append(recs, profile.Score(item, w))
+	}
+
+	sort.SliceStable(recs, func(i, j int) bool {
+		a, b := recs[i], recs[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		if a.Item.Popularity != b.Item.Popularity {
+			return a.Item.Popularity > b.Item.Popularity
+		}
+		if !a.Item.StartDate.Equal(b.Item.StartDate) {
+			return a.Item.StartDate.Before(b.Item.StartDate)
+		}
+		return a.Item.ID < b.Item.ID
+	})
+
+	return recs
+}
+
+// closestFavorite favorito con más tags en común con el candidato; a igualdad,
+// el más reciente
+func (p *Profile) closestFavorite(item Item) (*Item, []string) {
+	tags := normalizedTags(item.Tags)
+
+	var best *Item
+	var bestShared []string
+	for i := range p.favorites {
+		var shared []string
+		for tag := range normalizedTags(p.favorites[i].Tags) {
+			if tags[tag] {
+				shared = append(shared, tag)
+			}
+		}
+		if len(shared) > len(bestShared) {
+			sort.Strings(shared)
+			best = &p.favorites[i]
+			bestShared = shared
+		}
+	}
+
+	return best, bestShared
+}
+
+// mostFrequentLevel nivel más repetido; a igualdad, el más bajo
+func mostFrequentLevel(levels map[string]int) string {
+	best := ""
+	for _, level := range []string{LevelBeginner, LevelIntermediate, LevelAdvanced} {
+		if levels[level] > levels[best] {
+			best = level
+		}
+	}
+	return best
+}
+
+func normalizedTags(tags []string) map[string]bool {
+	set := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		if tag = normalize(tag); tag != "" {
+			set[tag] = true
+		}
+	}
+	return set
+}
+
+func normalize(s string) string {
+	return strings.ToLower(strings.TrimSpace(s))
+}
@@ -0,0 +1,166 @@
+package recommend
+
+import (
+	"testing"
+	"time"
+
+	"cybesphere-backend/pkg/geo"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	madrid    = &geo.Point{Latitude: 40.4168, Longitude: -3.7038}
+	getafe    = &geo.Point{Latitude: 40.3083, Longitude: -3.7327}
+	barcelona = &geo.Point{Latitude: 41.3874, Longitude: 2.1686}
+	start     = time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC)
+)
+
+// favoritesFixture favoritos de un usuario interesado en red team
+func favoritesFixture() []Item {
+	return []Item{
+		{ID: "fav-1", Title: "RootedCON", Tags: []string{"red-team", "pentesting"}, Category: "Red Team", Level: LevelAdvanced},
+		{ID: "fav-2", Title: "Taller de OSINT", Tags: []string{"osint", "Red-Team"}, Category: "Red Team", Level: LevelAdvanced},
+		{ID: "fav-3", Title: "Forense en Linux", Tags: []string{"forensics"}, Category: "Forensics", Level: LevelIntermediate},
+	}
+}
+
+// TestLevelFromPosition tests para deducir el nivel a partir del puesto
+func TestLevelFromPosition(t *testing.T) {
+	tests := []struct {
+		position string
+		want     string
+	}{
+		{position: "Junior SOC Analyst", want: LevelBeginner},
+		{position: "Estudiante de ingeniería", want: LevelBeginner},
+		{position: "Senior Pentester", want: LevelAdvanced},
+		{position: "CISO", want: LevelAdvanced},
+		{position: "Directora de Seguridad", want: LevelAdvanced},
+		{position: "Analista de ciberseguridad", want: LevelIntermediate},
+		{position: "International sales", want: ""},
+		{position: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.position, func(t *testing.T) {
+			assert.Equal(t, tt.want, LevelFromPosition(tt.position))
+		})
+	}
+}
+
+// TestBuildProfile tests para las preferencias derivadas de los favoritos
+func TestBuildProfile(t *testing.T) {
+	t.Run("nivel más frecuente en favoritos", func(t *testing.T) {
+		profile := BuildProfile(User{Position: "Junior", Favorites: favoritesFixture()})
+		assert.Equal(t, LevelAdvanced, profile.Level())
+		assert.InDelta(t, 2.0/3, profile.tags["red-team"], 1e-9)
+		assert.InDelta(t, 2.0/3, profile.categories["red team"], 1e-9)
+	})
+
+	t.Run("sin favoritos se usa el puesto", func(t *testing.T) {
+		profile := BuildProfile(User{Position: "Junior SOC Analyst"})
+		assert.Equal(t, LevelBeginner, profile.Level())
+		assert.True(t, profile.levelFromRole)
+	})
+}
+
+// TestScore tests para la puntuación y los motivos
+func TestScore(t *testing.T) {
+	profile := BuildProfile(User{
+		Location:              madrid,
+		Favorites:             favoritesFixture(),
+		FollowedOrganizations: map[string]string{"org-1": "CyberMadrid"},
+	})
+
+	t.Run("combina todas las señales y explica cada una", func(t *testing.T) {
+		rec := profile.Score(Item{
+			ID:             "ev-1",
+			Tags:           []string{"red-team", "osint"},
+			Category:       "red team",
+			Level:          LevelAdvanced,
+			OrganizationID: "org-1",
+			Location:       getafe,
+		}, DefaultWeights)
+
+		require.NotNil(t, rec.DistanceKm)
+		assert.InDelta(t, 12, *rec.DistanceKm, 1)
+		assert.Greater(t, rec.Score, 0.8)
+		assert.LessOrEqual(t, rec.Score, 1.0)
+
+		types := make([]string, 0, len(rec.Reasons))
+		for _, reason := range rec.Reasons {
+			types = append(types, reason.Type)
+		}
+		assert.ElementsMatch(t, []string{
+			ReasonSimilarToFavorite, ReasonCategory, ReasonLevel, ReasonNearby, ReasonFollowedOrganization,
+		}, types)
+
+		// El motivo principal es el favorito con más tags en común
+		top := rec.Reasons[0]
+		assert.Equal(t, ReasonSimilarToFavorite, top.Type)
+		assert.Equal(t, "fav-2", top.EventID)
+		assert.Equal(t, []string{"osint", "red-team"}, top.Tags)
+		assert.Equal(t, "Porque te gustó «Taller de OSINT»", top.Message)
+	})
+
+	t.Run("sin afinidad no hay motivos", func(t *testing.T) {
+		rec := profile.Score(Item{ID: "ev-2", Tags: []string{"cloud"}, Location: barcelona}, DefaultWeights)
+		assert.Less(t, rec.Score, 0.01)
+		assert.Empty(t, rec.Reasons)
+	})
+
+	t.Run("nivel contiguo puntúa la mitad sin motivo", func(t *testing.T) {
+		rec := profile.Score(Item{ID: "ev-3", Level: LevelIntermediate}, DefaultWeights)
+		assert.InDelta(t, DefaultWeights.Level/2, rec.Score, 1e-9)
+		assert.Empty(t, rec.Reasons)
+	})
+}
+
+// TestRank tests para la ordenación de candidatos
+func TestRank(t *testing.T) {
+	user := User{Favorites: favoritesFixture()}
+	candidates := []Item{
+		{ID: "fav-1", Tags: []string{"red-team"}},
+		{ID: "cloud-late", Tags: []string{"cloud"}, StartDate: start.AddDate(0, 1, 0)},
+		{ID: "cloud-popular", Tags: []string{"cloud"}, StartDate: start.AddDate(0, 2, 0), Popularity: 10},
+		{ID: "cloud-early", Tags: []string{"cloud"}, StartDate: start},
+		{ID: "red-team", Tags: []string{"red-team"}},
+	}
+
+	ranked := Rank(BuildProfile(user), candidates, DefaultWeights)
+
+	ids := make([]string, 0, len(ranked))
+	for _, rec := range ranked {
+		ids = append(ids, rec.Item.ID)
+	}
+	// Los favoritos no se recomiendan; a igual score manda la popularidad y después la fecha
+	assert.Equal(t, []string{"red-team", "cloud-popular", "cloud-early", "cloud-late"}, ids)
+}
+
+// TestLeaveOneOut tests para la evaluación offline
+func TestLeaveOneOut(t *testing.T) {
+	candidates := []Item{
+		{ID: "r1", Tags: []string{"red-team"}},
+		{ID: "r2", Tags: []string{"red-team"}},
+		{ID: "c1", Tags: []string{"cloud"}},
+		{ID: "c2", Tags: []string{"cloud"}},
+	}
+	users := []User{
+		{Favorites: []Item{candidates[0], candidates[1]}},
+		{Favorites: []Item{candidates[2]}}, // Un solo favorito: no se evalúa
+	}
+
+	metrics := LeaveOneOut(users, candidates, NewRanker(DefaultWeights), 1)
+	assert.Equal(t, 1, metrics.Users)
+	assert.Equal(t, 2, metrics.Cases)
+	assert.Equal(t, 1.0, metrics.HitRate)
+	assert.Equal(t, 1.0, metrics.MRR)
+	assert.Equal(t, 1.0, metrics.NDCG)
+	assert.Equal(t, 0.5, metrics.Coverage)
+
+	// Sin pesos el orden es por ID: el favorito oculto queda tercero
+	baseline := LeaveOneOut(users, candidates, NewRanker(Weights{}), 1)
+	assert.Equal(t, 0.0, baseline.HitRate)
+	assert.InDelta(t, 1.0/3, baseline.MRR, 1e-9)
+}
@@ -0,0 +1,462 @@
+package rrule
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Frequency define la frecuencia de repetición (FREQ)
+type Frequency string
+
+const (
+	Daily   Frequency = "DAILY"   // Diaria
+	Weekly  Frequency = "WEEKLY"  // Semanal
+	Monthly Frequency = "MONTHLY" // Mensual
+	Yearly  Frequency = "YEARLY"  // Anual
+)
+
+// maxIterations límite de periodos evaluados para evitar bucles infinitos
+const maxIterations = 100000
+
+var (
+	ErrMissingFrequency = errors.New("RRULE requires FREQ")
+	ErrCountAndUntil    = errors.New("RRULE cannot contain both COUNT and UNTIL")
+)
+
+var weekdayCodes = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// WeekdayNum día de la semana con ordinal opcional (ej: 2TH, -1FR)
+type WeekdayNum struct {
+	Weekday time.Weekday
+	N       int // 0 = todas las ocurrencias del periodo
+}
+
+// String formatea el día en notación RFC 5545
+func (w WeekdayNum) String() string {
+	code := ""
+	for k, v := range weekdayCodes {
+		if v == w.Weekday {
+			code = k
+		}
+	}
+	if w.N == 0 {
+		return code
+	}
+	return strconv.Itoa(w.N) + code
+}
+
+// Rule regla de recurrencia RFC 5545 (subconjunto soportado)
+type Rule struct {
+	Freq       Frequency
+	Interval   int
+	Count      int
+	Until      *time.Time
+	ByDay      []WeekdayNum
+	ByMonthDay []int
+	ByMonth    []int
+	BySetPos   []int
+	WeekStart  time.Weekday
+}
+
+// Parse interpreta una RRULE como "FREQ=MONTHLY;BYDAY=2TH;COUNT=10"
+func Parse(value string) (*Rule, error) {
+	value = strings.TrimSpace(value)
+	value = strings.TrimPrefix(value, "RRULE:")
+	if value == "" {
+		return nil, ErrMissingFrequency
+	}
+
+	rule := &Rule{Interval: 1, WeekStart: time.Monday}
+	for _, part := range strings.Split(value, ";") {
+		if part == "" {
+			continue
+		}
+		key, val, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid RRULE part %q", part)
+		}
+		key = strings.ToUpper(strings.TrimSpace(key))
+		val = strings.ToUpper(strings.TrimSpace(val))
+
+		var err error
+		switch key {
+		case "FREQ":
+			rule.Freq, err = parseFrequency(val)
+		case "INTERVAL":
+			rule.Interval, err = parsePositive(key, val)
+		case "COUNT":
+			rule.Count, err = parsePositive(key, val)
+		case "UNTIL":
+			var until time.Time
+			until, err = parseUntil(val)
+			rule.Until = &until
+		case "BYDAY":
+			rule.ByDay, err = parseByDay(val)
+		case "BYMONTHDAY":
+			rule.ByMonthDay, err = parseIntList(key, val, -31, 31)
+		case "BYMONTH":
+			rule.ByMonth, err = parseIntList(key, val, 1, 12)
+		case "BYSETPOS":
+			rule.BySetPos, err = parseIntList(key, val, -366, 366)
+		case "WKST":
+			wd, ok := weekdayCodes[val]
+			if !ok {
+				err = fmt.Errorf("invalid WKST %q", val)
+			}
+			rule.WeekStart = wd
+		default:
+			return nil, fmt.Errorf("unsupported RRULE part %q", key)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if rule.Freq == "" {
+		return nil, ErrMissingFrequency
+	}
+	if rule.Count > 0 && rule.Until != nil {
+		return nil, ErrCountAndUntil
+	}
+
+	return rule, nil
+}
+
+// String serializa la regla en formato RFC 5545
+func (r *Rule) String() string {
+	parts := []string{"FREQ=" + string(r.Freq)}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if r.Until != nil {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format("20060102T150405Z"))
+	}
+	if len(r.ByDay) > 0 {
+		days := make([]string, 0, len(r.ByDay))
+		for _, d := range r.ByDay {
+			days = append(days, d.String())
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	if len(r.ByMonthDay) > 0 {
+		parts = append(parts, "BYMONTHDAY="+joinInts(r.ByMonthDay))
+	}
+	if len(r.ByMonth) > 0 {
+		parts = append(parts, "BYMONTH="+joinInts(r.ByMonth))
+	}
+	if len(r.BySetPos) > 0 {
+		parts = append(parts, "BYSETPOS="+joinInts(r.BySetPos))
+	}
+	if r.WeekStart != time.Monday {
+		parts = append(parts, "WKST="+WeekdayNum{Weekday: r.WeekStart}.String())
+	}
+	return strings.Join(parts, ";")
+}
+
+// All devuelve hasta limit ocurrencias a partir de dtstart
+func (r *Rule) All(dtstart time.Time, limit int) []time.Time {
+	var result []time.Time
+	r.iterate(dtstart, func(t time.Time) bool {
+		result = append(result, t)
+		return len(result) < limit
+	})
+	return result
+}
+
+// Between devuelve las ocurrencias en [from, to], como máximo limit
+func (r *Rule) Between(dtstart, from, to time.Time, limit int) []time.Time {
+	var result []time.Time
+	r.iterate(dtstart, func(t time.Time) bool {
+		if t.After(to) {
+			return false
+		}
+		if !t.Before(from) {
+			result = append(result, t)
+		}
+		return len(result) < limit
+	})
+	return result
+}
+
+// iterate recorre las ocurrencias en orden hasta que fn devuelve false
+func (r *Rule) iterate(dtstart time.Time, fn func(time.Time) bool) {
+	interval := r.Interval
+	if interval < 1 {
+		interval = 1
+	}
+
+	emitted := 0
+	for period := 0; period < maxIterations; period++ {
+		candidates := r.expandPeriod(dtstart, period*interval)
+		for _, t := range candidates {
+			if t.Before(dtstart) {
+				continue
+			}
+			if r.Until != nil && t.After(*r.Until) {
+				return
+			}
+			if !fn(t) {
+				return
+			}
+			emitted++
+			if r.Count > 0 && emitted >= r.Count {
+				return
+			}
+		}
+	}
+}
+
+// expandPeriod calcula las ocurrencias del periodo n-ésimo desde dtstart
+func (r *Rule) expandPeriod(dtstart time.Time, offset int) []time.Time {
+	loc := dtstart.Location()
+	year, month, day := dtstart.Date()
+
+	var days []time.Time
+	switch r.Freq {
+	case Daily:
+		d := time.Date(year, month, day+offset, 0, 0, 0, 0, loc)
+		if r.matchesMonth(d) && r.matchesMonthDay(d) && r.matchesWeekday(d) {
+			days = append(days, d)
+		}
+	case Weekly:
+		start := time.Date(year, month, day, 0, 0, 0, 0, loc)
+		diff := (int(start.Weekday()) - int(r.WeekStart) + 7) % 7
+		weekStart := time.Date(year, month, day-diff+7*offset, 0, 0, 0, 0, loc)
+		for i := 0; i < 7; i++ {
+			d := time.Date(weekStart.Year(), weekStart.Month(), weekStart.Day()+i, 0, 0, 0, 0, loc)
+			if !r.matchesMonth(d) {
+				continue
+			}
+			if len(r.ByDay) == 0 && d.Weekday() != dtstart.Weekday() {
+				continue
+			}
+			if len(r.ByDay) > 0 && !r.matchesWeekday(d) {
+				continue
+			}
+			days = append(days, d)
+		}
+	case Monthly:
+		first := time.Date(year, month+time.Month(offset), 1, 0, 0, 0, 0, loc)
+		if r.matchesMonth(first) {
+			days = r.expandMonth(first, day)
+		}
+	case Yearly:
+		target := year + offset
+		months := r.ByMonth
+		if len(months) == 0 {
+			months = []int{int(month)}
+		}
+		for _, m := range months {
+			first := time.Date(target, time.Month(m), 1, 0, 0, 0, 0, loc)
+			days = append(days, r.expandMonth(first, day)...)
+		}
+	}
+
+	sort.Slice(days, func(i, j int) bool { return days[i].Before(days[j]) })
+	days = r.applySetPos(days)
+
+	hour, minute, second := dtstart.Clock()
+	result := make([]time.Time, 0, len(days))
+	for _, d := range days {
+		result = append(result, time.Date(d.Year(), d.Month(), d.Day(), hour, minute, second, dtstart.Nanosecond(), loc))
+	}
+	return result
+}
+
+// expandMonth calcula los días candidatos de un mes
+func (r *Rule) expandMonth(first time.Time, defaultDay int) []time.Time {
+	loc := first.Location()
+	daysInMonth := time.Date(first.Year(), first.Month()+1, 0, 0, 0, 0, 0, loc).Day()
+
+	var days []time.Time
+	for d := 1; d <= daysInMonth; d++ {
+		date := time.Date(first.Year(), first.Month(), d, 0, 0, 0, 0, loc)
+		switch {
+		case len(r.ByMonthDay) == 0 && len(r.ByDay) == 0:
+			if d == defaultDay {
+				days = append(days, date)
+			}
+		case len(r.ByMonthDay) > 0 && len(r.ByDay) > 0:
+			if r.matchesMonthDay(date) && r.matchesWeekdayInMonth(date, daysInMonth) {
+				days = append(days, date)
+			}
+		case len(r.ByMonthDay) > 0:
+			if r.matchesMonthDay(date) {
+				days = append(days, date)
+			}
+		default:
+			if r.matchesWeekdayInMonth(date, daysInMonth) {
+				days = append(days, date)
+			}
+		}
+	}
+	return days
+}
+
+// applySetPos filtra los candidatos de un periodo según BYSETPOS
+func (r *Rule) applySetPos(days []time.Time) []time.Time {
+	if len(r.BySetPos) == 0 || len(days) == 0 {
+		return days
+	}
+
+	var result []time.Time
+	for _, pos := range r.BySetPos {
+		idx := pos - 1
+		if pos < 0 {
+			idx = len(days) + pos
+		}
+		if idx >= 0 && idx < len(days) {
+			result = append(result, days[idx])
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Before(result[j]) })
+	return result
+}
+
+// matchesMonth verifica BYMONTH
+func (r *Rule) matchesMonth(d time.Time) bool {
+	if len(r.ByMonth) == 0 {
+		return true
+	}
+	for _, m := range r.ByMonth {
+		if time.Month(m) == d.Month() {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesMonthDay verifica BYMONTHDAY (admite valores negativos desde fin de mes)
+func (r *Rule) matchesMonthDay(d time.Time) bool {
+	if len(r.ByMonthDay) == 0 {
+		return true
+	}
+	daysInMonth := time.Date(d.Year(), d.Month()+1, 0, 0, 0, 0, 0, d.Location()).Day()
+	for _, md := range r.ByMonthDay {
+		if md == d.Day() || (md < 0 && daysInMonth+md+1 == d.Day()) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesWeekday verifica BYDAY sin ordinales
+func (r *Rule) matchesWeekday(d time.Time) bool {
+	if len(r.ByDay) == 0 {
+		return true
+	}
+	for _, wd := range r.ByDay {
+		if wd.Weekday == d.Weekday() {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesWeekdayInMonth verifica BYDAY con ordinales dentro del mes
+func (r *Rule) matchesWeekdayInMonth(d time.Time, daysInMonth int) bool {
+	for _, wd := range r.ByDay {
+		if wd.Weekday != d.Weekday() {
+			continue
+		}
+		if wd.N == 0 {
+			return true
+		}
+		nth := (d.Day()-1)/7 + 1
+		nthFromEnd := -((daysInMonth-d.Day())/7 + 1)
+		if wd.N == nth || wd.N == nthFromEnd {
+			return true
+		}
+	}
+	return false
+}
+
+func parseFrequency(val string) (Frequency, error) {
+	switch Frequency(val) {
+	case Daily, Weekly, Monthly, Yearly:
+		return Frequency(val), nil
+	}
+	return "", fmt.Errorf("unsupported FREQ %q", val)
+}
+
+func parsePositive(key, val string) (int, error) {
+	n, err := strconv.Atoi(val)
+	if err != nil || n < 1 {
+		return 0, fmt.Errorf("invalid %s %q", key, val)
+	}
+	return n, nil
+}
+
+func parseUntil(val string) (time.Time, error) {
+	layouts := []string{"20060102T150405Z", "20060102T150405", "20060102"}
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, val); err == nil {
+			if layout == "20060102" {
+				// Una fecha sin hora incluye el día completo
+				t = t.Add(24*time.Hour - time.Second)
+			}
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid UNTIL %q", val)
+}
+
+func parseByDay(val string) ([]WeekdayNum, error) {
+	var days []WeekdayNum
+	for _, item := range strings.Split(val, ",") {
+		item = strings.TrimSpace(item)
+		if len(item) < 2 {
+			return nil, fmt.Errorf("invalid BYDAY %q", item)
+		}
+		code := item[len(item)-2:]
+		wd, ok := weekdayCodes[code]
+		if !ok {
+			return nil, fmt.Errorf("invalid BYDAY %q", item)
+		}
+		n := 0
+		if prefix := item[:len(item)-2]; prefix != "" {
+			var err error
+			n, err = strconv.Atoi(prefix)
+			if err != nil || n == 0 || n > 53 || n < -53 {
+				return nil, fmt.Errorf("invalid BYDAY %q", item)
+			}
+		}
+		days = append(days, WeekdayNum{Weekday: wd, N: n})
+	}
+	return days, nil
+}
+
+func parseIntList(key, val string, min, max int) ([]int, error) {
+	var values []int
+	for _, item := range strings.Split(val, ",") {
+		n, err := strconv.Atoi(strings.TrimSpace(item))
+		if err != nil || n == 0 || n < min || n > max {
+			return nil, fmt.Errorf("invalid %s %q", key, item)
+		}
+		values = append(values, n)
+	}
+	return values, nil
+}
+
+func joinInts(values []int) string {
+	parts := make([]string, 0, len(values))
+	for _, v := range values {
+		parts = append(parts, strconv.Itoa(v))
+	}
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,238 @@
+package rrule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	require.NoError(t, err)
+	return loc
+}
+
+func formatDates(times []time.Time) []string {
+	result := make([]string, 0, len(times))
+	for _, tm := range times {
+		result = append(result, tm.Format("2006-01-02 15:04 MST"))
+	}
+	return result
+}
+
+// TestParse tests para el parseo de reglas
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr bool
+		check   func(t *testing.T, r *Rule)
+	}{
+		{
+			name:  "mensual segundo jueves",
+			input: "FREQ=MONTHLY;BYDAY=2TH;COUNT=10",
+			check: func(t *testing.T, r *Rule) {
+				assert.Equal(t, Monthly, r.Freq)
+				assert.Equal(t, 10, r.Count)
+				assert.Equal(t, []WeekdayNum{{Weekday: time.Thursday, N: 2}}, r.ByDay)
+			},
+		},
+		{
+			name:  "con prefijo RRULE y minúsculas",
+			input: "RRULE:freq=weekly;interval=2;byday=MO,WE",
+			check: func(t *testing.T, r *Rule) {
+				assert.Equal(t, Weekly, r.Freq)
+				assert.Equal(t, 2, r.Interval)
+				assert.Len(t, r.ByDay, 2)
+			},
+		},
+		{
+			name:  "until solo fecha incluye el día",
+			input: "FREQ=DAILY;UNTIL=20261231",
+			check: func(t *testing.T, r *Rule) {
+				require.NotNil(t, r.Until)
+				assert.Equal(t, time.Date(2026, 12, 31, 23, 59, 59, 0, time.UTC), *r.Until)
+			},
+		},
+		{name: "sin FREQ", input: "COUNT=3", wantErr: true},
+		{name: "vacía", input: "", wantErr: true},
+		{name: "FREQ no soportada", input: "FREQ=HOURLY", wantErr: true},
+		{name: "COUNT y UNTIL juntos", input: "FREQ=DAILY;COUNT=3;UNTIL=20261231", wantErr: true},
+		{name: "BYDAY inválido", input: "FREQ=WEEKLY;BYDAY=XX", wantErr: true},
+		{name: "BYMONTHDAY fuera de rango", input: "FREQ=MONTHLY;BYMONTHDAY=32", wantErr: true},
+		{name: "parte desconocida", input: "FREQ=DAILY;BYHOUR=9", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := Parse(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			if tt.check != nil {
+				tt.check(t, r)
+			}
+		})
+	}
+}
+
+// TestRuleString tests para la serialización de reglas
+func TestRuleString(t *testing.T) {
+	inputs := []string{
+		"FREQ=MONTHLY;COUNT=10;BYDAY=2TH",
+		"FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE",
+		"FREQ=MONTHLY;UNTIL=20270101T000000Z;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1",
+		"FREQ=YEARLY;BYMONTHDAY=15;BYMONTH=3,9",
+	}
+
+	for _, input := range inputs {
+		t.Run(input, func(t *testing.T) {
+			r, err := Parse(input)
+			require.NoError(t, err)
+			assert.Equal(t, input, r.String())
+		})
+	}
+}
+
+// TestAll tests para la expansión de ocurrencias
+func TestAll(t *testing.T) {
+	madrid := mustLocation(t, "Europe/Madrid")
+
+	tests := []struct {
+		name     string
+		rule     string
+		dtstart  time.Time
+		limit    int
+		expected []string
+	}{
+		{
+			name:    "diaria con count",
+			rule:    "FREQ=DAILY;COUNT=3",
+			dtstart: time.Date(2026, 1, 30, 10, 0, 0, 0, madrid),
+			limit:   10,
+			expected: []string{
+				"2026-01-30 10:00 CET",
+				"2026-01-31 10:00 CET",
+				"2026-02-01 10:00 CET",
+			},
+		},
+		{
+			name:    "semanal lunes y miércoles cada dos semanas",
+			rule:    "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE;COUNT=4",
+			dtstart: time.Date(2026, 1, 5, 18, 0, 0, 0, madrid),
+			limit:   10,
+			expected: []string{
+				"2026-01-05 18:00 CET",
+				"2026-01-07 18:00 CET",
+				"2026-01-19 18:00 CET",
+				"2026-01-21 18:00 CET",
+			},
+		},
+		{
+			name:    "mensual segundo jueves mantiene hora local con cambio de horario",
+			rule:    "FREQ=MONTHLY;BYDAY=2TH;COUNT=3",
+			dtstart: time.Date(2026, 2, 12, 19, 0, 0, 0, madrid),
+			limit:   10,
+			expected: []string{
+				"2026-02-12 19:00 CET",
+				"2026-03-12 19:00 CET",
+				"2026-04-09 19:00 CEST",
+			},
+		},
+		{
+			name:    "mensual último viernes",
+			rule:    "FREQ=MONTHLY;BYDAY=-1FR;COUNT=3",
+			dtstart: time.Date(2026, 1, 1, 18, 30, 0, 0, time.UTC),
+			limit:   10,
+			expected: []string{
+				"2026-01-30 18:30 UTC",
+				"2026-02-27 18:30 UTC",
+				"2026-03-27 18:30 UTC",
+			},
+		},
+		{
+			name:    "mensual día 31 omite meses cortos",
+			rule:    "FREQ=MONTHLY;COUNT=3",
+			dtstart: time.Date(2026, 1, 31, 9, 0, 0, 0, time.UTC),
+			limit:   10,
+			expected: []string{
+				"2026-01-31 09:00 UTC",
+				"2026-03-31 09:00 UTC",
+				"2026-05-31 09:00 UTC",
+			},
+		},
+		{
+			name:    "último día laborable del mes",
+			rule:    "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1;COUNT=2",
+			dtstart: time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC),
+			limit:   10,
+			expected: []string{
+				"2026-05-29 09:00 UTC",
+				"2026-06-30 09:00 UTC",
+			},
+		},
+		{
+			name:    "anual con meses",
+			rule:    "FREQ=YEARLY;BYMONTH=3,9;BYMONTHDAY=15;COUNT=3",
+			dtstart: time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC),
+			limit:   10,
+			expected: []string{
+				"2026-03-15 09:00 UTC",
+				"2026-09-15 09:00 UTC",
+				"2027-03-15 09:00 UTC",
+			},
+		},
+		{
+			name:    "until corta la serie",
+			rule:    "FREQ=WEEKLY;UNTIL=20260120T000000Z",
+			dtstart: time.Date(2026, 1, 6, 9, 0, 0, 0, time.UTC),
+			limit:   10,
+			expected: []string{
+				"2026-01-06 09:00 UTC",
+				"2026-01-13 09:00 UTC",
+			},
+		},
+		{
+			name:    "límite sobre regla infinita",
+			rule:    "FREQ=DAILY",
+			dtstart: time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC),
+			limit:   2,
+			expected: []string{
+				"2026-01-01 09:00 UTC",
+				"2026-01-02 09:00 UTC",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := Parse(tt.rule)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, formatDates(r.All(tt.dtstart, tt.limit)))
+		})
+	}
+}
+
+// TestBetween tests para la expansión por ventana temporal
+func TestBetween(t *testing.T) {
+	r, err := Parse("FREQ=WEEKLY;BYDAY=TU")
+	require.NoError(t, err)
+
+	dtstart := time.Date(2026, 1, 6, 9, 0, 0, 0, time.UTC)
+	from := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 2, 28, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, []string{
+		"2026-02-03 09:00 UTC",
+		"2026-02-10 09:00 UTC",
+		"2026-02-17 09:00 UTC",
+		"2026-02-24 09:00 UTC",
+	}, formatDates(r.Between(dtstart, from, to, 100)))
+
+	assert.Len(t, r.Between(dtstart, from, to, 2), 2)
+}
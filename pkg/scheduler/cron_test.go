@@ -0,0 +1,88 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestParse tests para la interpretación de expresiones
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    string
+		wantErr bool
+	}{
+		{name: "cada minuto", spec: "* * * * *"},
+		{name: "pasos, rangos y listas", spec: "*/15 9-17 1,15 * 1-5"},
+		{name: "domingo como 7", spec: "0 0 * * 7"},
+		{name: "atajo", spec: "@daily"},
+		{name: "intervalo", spec: "@every 10m"},
+		{name: "vacía", spec: "", wantErr: true},
+		{name: "campos de menos", spec: "* * * *", wantErr: true},
+		{name: "valor fuera de rango", spec: "60 * * * *", wantErr: true},
+		{name: "rango invertido", spec: "* 17-9 * * *", wantErr: true},
+		{name: "paso inválido", spec: "*/0 * * * *", wantErr: true},
+		{name: "atajo desconocido", spec: "@sometimes", wantErr: true},
+		{name: "intervalo demasiado corto", spec: "@every 10ms", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.spec)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// TestSchedule_Next tests para el cálculo de la siguiente ejecución
+func TestSchedule_Next(t *testing.T) {
+	// Sábado 17 de octubre de 2026, 10:07:30
+	from := time.Date(2026, 10, 17, 10, 7, 30, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		spec string
+		want time.Time
+	}{
+		{name: "cada minuto", spec: "* * * * *", want: time.Date(2026, 10, 17, 10, 8, 0, 0, time.UTC)},
+		{name: "cada 15 minutos", spec: "*/15 * * * *", want: time.Date(2026, 10, 17, 10, 15, 0, 0, time.UTC)},
+		{name: "a diario a las 3", spec: "0 3 * * *", want: time.Date(2026, 10, 18, 3, 0, 0, 0, time.UTC)},
+		{name: "cada hora", spec: "@hourly", want: time.Date(2026, 10, 17, 11, 0, 0, 0, time.UTC)},
+		{name: "días laborables", spec: "30 8 * * 1-5", want: time.Date(2026, 10, 19, 8, 30, 0, 0, time.UTC)},
+		{name: "domingo como 7", spec: "0 0 * * 7", want: time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)},
+		{name: "primero de mes", spec: "@monthly", want: time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "cambio de año", spec: "0 0 1 1 *", want: time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "día del mes o de la semana", spec: "0 0 20 * 1", want: time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)},
+		{name: "29 de febrero", spec: "0 0 29 2 *", want: time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{name: "nunca", spec: "0 0 30 2 *", want: time.Time{}},
+		{name: "intervalo", spec: "@every 90s", want: from.Add(90 * time.Second)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := Parse(tt.spec)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, schedule.Next(from))
+		})
+	}
+
+	t.Run("zona horaria de la fecha", func(t *testing.T) {
+		madrid, err := time.LoadLocation("Europe/Madrid")
+		require.NoError(t, err)
+
+		schedule, err := Parse("0 3 * * *")
+		require.NoError(t, err)
+
+		// El 25 de octubre de 2026 acaba el horario de verano
+		next := schedule.Next(time.Date(2026, 10, 24, 12, 0, 0, 0, madrid))
+		assert.Equal(t, time.Date(2026, 10, 25, 3, 0, 0, 0, madrid), next)
+		assert.Equal(t, time.Date(2026, 10, 25, 2, 0, 0, 0, time.UTC), next.UTC())
+	})
+}
@@ -0,0 +1,346 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// DefaultTimeout tiempo máximo de una ejecución si la tarea no indica otro
+const DefaultTimeout = 30 * time.Minute
+
+var (
+	ErrUnknownJob   = errors.New("unknown job")
+	ErrDuplicateJob = errors.New("duplicate job")
+	ErrJobRunning   = errors.New("job is already running")
+	ErrStarted      = errors.New("scheduler already started")
+)
+
+// Func cuerpo de una tarea; devuelve un resumen de lo que ha hecho
+type Func func(ctx context.Context) (string, error)
+
+// Job tarea programada
+type Job struct {
+	Name    string
+	Spec    string        // Expresión cron, atajo o intervalo (ver Parse)
+	Timeout time.Duration // 0 = DefaultTimeout
+	Run     Func
+}
+
+// Outcome resultado de una ejecución
+type Outcome string
+
+const (
+	OutcomeSuccess Outcome = "success"
+	OutcomeFailed  Outcome = "failed"
+)
+
+// Result ejecución terminada de una tarea
+type Result struct {
+	Job        string
+	StartedAt  time.Time
+	FinishedAt time.Time
+	Outcome    Outcome
+	Summary    string
+	Err        error
+}
+
+// Duration duración de la ejecución
+func (r Result) Duration() time.Duration {
+	return r.FinishedAt.Sub(r.StartedAt)
+}
+
+// Locker bloqueo compartido entre instancias: solo quien lo obtiene ejecuta la tarea
+type Locker interface {
+	// TryLock intenta bloquear la tarea sin esperar; unlock lo libera
+	TryLock(ctx context.Context, job string) (unlock func(), acquired bool, err error)
+}
+
+// Recorder guarda la planificación y el resultado de las ejecuciones
+type Recorder interface {
+	// Register da de alta o actualiza la tarea al arrancar
+	Register(ctx context.Context, job, spec string, next time.Time) error
+	// LastStarted inicio de la última ejecución en cualquier instancia (cero si no hay)
+	LastStarted(ctx context.Context, job string) (time.Time, error)
+	// Started marca el inicio de una ejecución y la siguiente prevista
+	Started(ctx context.Context, job string, at, next time.Time) error
+	// Finished guarda el resultado
+	Finished(ctx context.Context, result Result) error
+}
+
+// Config dependencias del planificador
+type Config struct {
+	Locker   Locker
+	Recorder Recorder
+	Logf     func(format string, args ...any) // Errores del bloqueo o del registro (opcional)
+	Now      func() time.Time                 // Reloj (opcional, para tests)
+}
+
+// entry tarea con su planificación y siguiente ejecución
+type entry struct {
+	job      Job
+	schedule Schedule
+	next     time.Time
+}
+
+// Scheduler ejecuta tareas programadas dentro del proceso. En cada turno la
+// tarea se bloquea con Locker y se consulta Recorder para que, con varias
+// instancias, cada turno se ejecute una sola vez
+type Scheduler struct {
+	cfg     Config
+	mu      sync.Mutex
+	entries []*entry
+	byName  map[string]*entry
+	running map[string]bool
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+// New crea un planificador sin tareas
+func New(cfg Config) *Scheduler {
+	if cfg.Logf == nil {
+		cfg.Logf = func(string, ...any) {}
+	}
+	if cfg.Now == nil {
+		cfg.Now = time.Now
+	}
+	return &Scheduler{
+		cfg:     cfg,
+		byName:  make(map[string]*entry),
+		running: make(map[string]bool),
+	}
+}
+
+// Add añade una tarea; debe llamarse antes de Start
+func (s *Scheduler) Add(job Job) error {
+	if job.Name == "" || job.Run == nil {
+		return errors.New("job requires a name and a function")
+	}
+	schedule, err := Parse(job.Spec)
+	if err != nil {
+		return fmt.Errorf("job %s: %w", job.Name, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.cancel != nil {
+		return ErrStarted
+	}
+	if _, ok := s.byName[job.Name]; ok {
+		return fmt.Errorf("%w: %s", ErrDuplicateJob, job.Name)
+	}
+
+	e := &entry{job: job, schedule: schedule}
+	s.entries = append(s.entries, e)
+	s.byName[job.Name] = e
+	return nil
+}
+
+// Start registra las tareas y empieza a ejecutarlas en segundo plano hasta Stop
+// o hasta que se cancele ctx
+func (s *Scheduler) Start(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.cancel != nil {
+		return ErrStarted
+	}
+
+	now := s.cfg.Now()
+	for _, e := range s.entries {
+		e.next = e.schedule.Next(now)
+		if err := s.cfg.Recorder.Register(ctx, e.job.Name, e.job.Spec, e.next); err != nil {
+			return fmt.Errorf("job %s: %w", e.job.Name, err)
+		}
+	}
+
+	ctx, s.cancel = context.WithCancel(ctx)
+	s.wg.Add(1)
+	go s.loop(ctx)
+	return nil
+}
+
+// Stop detiene el planificador y espera a las ejecuciones en curso
+func (s *Scheduler) Stop() {
+	s.mu.Lock()
+	cancel := s.cancel
+	s.mu.Unlock()
+
+	if cancel != nil {
+		cancel()
+	}
+	s.wg.Wait()
+}
+
+// RunNow ejecuta una tarea fuera de su planificación y espera al resultado
+func (s *Scheduler) RunNow(ctx context.Context, name string) (Result, error) {
+	s.mu.Lock()
+	e, ok := s.byName[name]
+	var next time.Time
+	if ok {
+		next = e.next
+	}
+	s.mu.Unlock()
+
+	if !ok {
+		return Result{}, fmt.Errorf("%w: %s", ErrUnknownJob, name)
+	}
+	result, _, err := s.run(ctx, e.job, time.Time{}, next)
+	return result, err
+}
+
+// loop espera a la siguiente ejecución y lanza las tareas pendientes
+func (s *Scheduler) loop(ctx context.Context) {
+	defer s.wg.Done()
+
+	for {
+		wait, ok := s.untilNext()
+		if !ok {
+			<-ctx.Done()
+			return
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		now := s.cfg.Now()
+		s.mu.Lock()
+		for _, e := range s.entries {
+			if e.next.IsZero() || e.next.After(now) {
+				continue
+			}
+			slot := e.next
+			e.next = e.schedule.Next(now)
+
+			s.wg.Add(1)
+			go func(job Job, slot, next time.Time) {
+				defer s.wg.Done()
+				s.fire(ctx, job, slot, next)
+			}(e.job, slot, e.next)
+		}
+		s.mu.Unlock()
+	}
+}
+
+// untilNext tiempo hasta la próxima ejecución (false si no queda ninguna)
+func (s *Scheduler) untilNext() (time.Duration, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var earliest time.Time
+	for _, e := range s.entries {
+		if !e.next.IsZero() && (earliest.IsZero() || e.next.Before(earliest)) {
+			earliest = e.next
+		}
+	}
+	if earliest.IsZero() {
+		return 0, false
+	}
+	return max(earliest.Sub(s.cfg.Now()), 0), true
+}
+
+// fire ejecuta el turno programado de una tarea
+func (s *Scheduler) fire(ctx context.Context, job Job, slot, next time.Time) {
+	result, ran, err := s.run(ctx, job, slot, next)
+	switch {
+	case errors.Is(err, ErrJobRunning):
+		// La ejecución anterior sigue en curso aquí o en otra instancia
+	case err != nil:
+		s.cfg.Logf("scheduler: job %s: %v", job.Name, err)
+	case ran && result.Err != nil:
+		s.cfg.Logf("scheduler: job %s failed after %s: %v", job.Name, result.Duration(), result.Err)
+	}
+}
+
+// run bloquea la tarea y la ejecuta. Con slot, la ejecución se omite (ran =
+// false) si otra instancia ya ha empezado ese turno
+func (s *Scheduler) run(ctx context.Context, job Job, slot, next time.Time) (Result, bool, error) {
+	if !s.claim(job.Name) {
+		return Result{}, false, ErrJobRunning
+	}
+	defer s.release(job.Name)
+
+	unlock, acquired, err := s.cfg.Locker.TryLock(ctx, job.Name)
+	if err != nil {
+		return Result{}, false, fmt.Errorf("lock: %w", err)
+	}
+	if !acquired {
+		return Result{}, false, ErrJobRunning
+	}
+	defer unlock()
+
+	if !slot.IsZero() {
+		last, err := s.cfg.Recorder.LastStarted(ctx, job.Name)
+		if err != nil {
+			return Result{}, false, fmt.Errorf("last run: %w", err)
+		}
+		if !last.Before(slot) {
+			return Result{}, false, nil
+		}
+	}
+
+	started := s.cfg.Now()
+	if err := s.cfg.Recorder.Started(ctx, job.Name, started, next); err != nil {
+		return Result{}, false, fmt.Errorf("record start: %w", err)
+	}
+
+	timeout := job.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	runCtx, cancel := context.WithTimeout(ctx, timeout)
+	summary, runErr := safeRun(runCtx, job.Run)
+	cancel()
+
+	result := Result{
+		Job:        job.Name,
+		StartedAt:  started,
+		FinishedAt: s.cfg.Now(),
+		Outcome:    OutcomeSuccess,
+		Summary:    summary,
+		Err:        runErr,
+	}
+	if runErr != nil {
+		result.Outcome = OutcomeFailed
+	}
+
+	// El resultado se guarda aunque se esté deteniendo el planificador
+	if err := s.cfg.Recorder.Finished(context.WithoutCancel(ctx), result); err != nil {
+		s.cfg.Logf("scheduler: job %s: record result: %v", job.Name, err)
+	}
+	return result, true, nil
+}
+
+// claim marca la tarea como en curso en esta instancia
+func (s *Scheduler) claim(name string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.running[name] {
+		return false
+	}
+	s.running[name] = true
+	return true
+}
+
+// release libera la marca de claim
+func (s *Scheduler) release(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.running, name)
+}
+
+// safeRun ejecuta la tarea convirtiendo un panic en error
+func safeRun(ctx context.Context, fn Func) (summary string, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return fn(ctx)
+}
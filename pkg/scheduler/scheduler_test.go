@@ -0,0 +1,204 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryStore bloqueo y registro en memoria compartidos por varias instancias
+type memoryStore struct {
+	mu      sync.Mutex
+	locked  map[string]bool
+	started map[string]time.Time
+	results []Result
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{locked: map[string]bool{}, started: map[string]time.Time{}}
+}
+
+func (m *memoryStore) TryLock(_ context.Context, job string) (func(), bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.locked[job] {
+		return nil, false, nil
+	}
+	m.locked[job] = true
+	return func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		delete(m.locked, job)
+	}, true, nil
+}
+
+func (m *memoryStore) Register(context.Context, string, string, time.Time) error { return nil }
+
+func (m *memoryStore) LastStarted(_ context.Context, job string) (time.Time, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.started[job], nil
+}
+
+func (m *memoryStore) Started(_ context.Context, job string, at, _ time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.started[job] = at
+	return nil
+}
+
+func (m *memoryStore) Finished(_ context.Context, result Result) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.results = append(m.results, result)
+	return nil
+}
+
+func (m *memoryStore) Results() []Result {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Result(nil), m.results...)
+}
+
+// TestScheduler_RunNow tests para la ejecución manual
+func TestScheduler_RunNow(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("resultado correcto", func(t *testing.T) {
+		store := newMemoryStore()
+		s := New(Config{Locker: store, Recorder: store})
+		require.NoError(t, s.Add(Job{Name: "limpieza", Spec: "@daily", Run: func(context.Context) (string, error) {
+			return "3 filas", nil
+		}}))
+
+		result, err := s.RunNow(ctx, "limpieza")
+		require.NoError(t, err)
+		assert.Equal(t, OutcomeSuccess, result.Outcome)
+		assert.Equal(t, "3 filas", result.Summary)
+		assert.Len(t, store.Results(), 1)
+	})
+
+	t.Run("error y panic", func(t *testing.T) {
+		store := newMemoryStore()
+		s := New(Config{Locker: store, Recorder: store})
+		require.NoError(t, s.Add(Job{Name: "falla", Spec: "@daily", Run: func(context.Context) (string, error) {
+			return "", errors.New("sin conexión")
+		}}))
+		require.NoError(t, s.Add(Job{Name: "panic", Spec: "@daily", Run: func(context.Context) (string, error) {
+			panic("boom")
+		}}))
+
+		result, err := s.RunNow(ctx, "falla")
+		require.NoError(t, err)
+		assert.Equal(t, OutcomeFailed, result.Outcome)
+		assert.EqualError(t, result.Err, "sin conexión")
+
+		result, err = s.RunNow(ctx, "panic")
+		require.NoError(t, err)
+		assert.Equal(t, OutcomeFailed, result.Outcome)
+		assert.EqualError(t, result.Err, "panic: boom")
+	})
+
+	t.Run("bloqueada por otra instancia", func(t *testing.T) {
+		store := newMemoryStore()
+		s := New(Config{Locker: store, Recorder: store})
+		require.NoError(t, s.Add(Job{Name: "limpieza", Spec: "@daily", Run: func(context.Context) (string, error) {
+			return "", nil
+		}}))
+
+		unlock, acquired, err := store.TryLock(ctx, "limpieza")
+		require.NoError(t, err)
+		require.True(t, acquired)
+
+		_, err = s.RunNow(ctx, "limpieza")
+		assert.ErrorIs(t, err, ErrJobRunning)
+
+		unlock()
+		_, err = s.RunNow(ctx, "limpieza")
+		assert.NoError(t, err)
+	})
+
+	t.Run("tarea desconocida", func(t *testing.T) {
+		s := New(Config{Locker: newMemoryStore(), Recorder: newMemoryStore()})
+		_, err := s.RunNow(ctx, "nada")
+		assert.ErrorIs(t, err, ErrUnknownJob)
+	})
+}
+
+// TestScheduler_Add tests para el alta de tareas
+func TestScheduler_Add(t *testing.T) {
+	store := newMemoryStore()
+	s := New(Config{Locker: store, Recorder: store})
+	noop := func(context.Context) (string, error) { return "", nil }
+
+	require.NoError(t, s.Add(Job{Name: "limpieza", Spec: "@daily", Run: noop}))
+	assert.ErrorIs(t, s.Add(Job{Name: "limpieza", Spec: "@hourly", Run: noop}), ErrDuplicateJob)
+	assert.Error(t, s.Add(Job{Name: "mala", Spec: "cada día", Run: noop}))
+	assert.Error(t, s.Add(Job{Name: "sin función", Spec: "@daily"}))
+
+	require.NoError(t, s.Start(context.Background()))
+	defer s.Stop()
+	assert.ErrorIs(t, s.Add(Job{Name: "tarde", Spec: "@daily", Run: noop}), ErrStarted)
+}
+
+// TestScheduler_Instances tests para el reparto de turnos entre instancias
+func TestScheduler_Instances(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryStore()
+
+	runs := 0
+	job := Job{Name: "limpieza", Spec: "0 3 * * *", Run: func(context.Context) (string, error) {
+		runs++
+		return "", nil
+	}}
+
+	slot := time.Date(2026, 10, 18, 3, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return slot.Add(2 * time.Second) }
+	first := New(Config{Locker: store, Recorder: store, Now: clock})
+	second := New(Config{Locker: store, Recorder: store, Now: clock})
+
+	// La primera instancia ejecuta el turno; la segunda ve que ya empezó
+	_, ran, err := first.run(ctx, job, slot, slot.Add(24*time.Hour))
+	require.NoError(t, err)
+	assert.True(t, ran)
+
+	_, ran, err = second.run(ctx, job, slot, slot.Add(24*time.Hour))
+	require.NoError(t, err)
+	assert.False(t, ran)
+
+	// El turno siguiente vuelve a ejecutarse
+	next := slot.Add(24 * time.Hour)
+	second.cfg.Now = func() time.Time { return next }
+	_, ran, err = second.run(ctx, job, next, next.Add(24*time.Hour))
+	require.NoError(t, err)
+	assert.True(t, ran)
+	assert.Equal(t, 2, runs)
+}
+
+// TestScheduler_Start tests para la ejecución en segundo plano
+func TestScheduler_Start(t *testing.T) {
+	store := newMemoryStore()
+	done := make(chan struct{}, 1)
+
+	s := New(Config{Locker: store, Recorder: store})
+	require.NoError(t, s.Add(Job{Name: "latido", Spec: "@every 1s", Run: func(context.Context) (string, error) {
+		select {
+		case done <- struct{}{}:
+		default:
+		}
+		return "ok", nil
+	}}))
+	require.NoError(t, s.Start(context.Background()))
+	defer s.Stop()
+
+	select {
+	case <-done:
+	case <-time.After(3 * time.Second):
+		t.Fatal("la tarea no se ha ejecutado")
+	}
+}
@@ -0,0 +1,153 @@
+package storage
+
+import (
+	"crypto/hmac"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FakeS3 servidor S3 en memoria con el comportamiento básico de MinIO:
+// direccionamiento por ruta, firma V4 verificada y PUT/GET/DELETE de objetos.
+// Pensado para pruebas y desarrollo local con httptest
+type FakeS3 struct {
+	accessKey string
+	secretKey string
+	region    string
+
+	mu      sync.RWMutex
+	buckets map[string]map[string]fakeObject
+}
+
+// fakeObject objeto guardado en el servidor en memoria
+type fakeObject struct {
+	data        []byte
+	contentType string
+}
+
+// fakeS3Error cuerpo XML de error de S3
+type fakeS3Error struct {
+	XMLName xml.Name `xml:"Error"`
+	Code    string   `xml:"Code"`
+	Message string   `xml:"Message"`
+}
+
+// NewFakeS3 crea el servidor con las credenciales aceptadas
+func NewFakeS3(accessKey, secretKey, region string) *FakeS3 {
+	if region == "" {
+		region = defaultS3Region
+	}
+	return &FakeS3{
+		accessKey: accessKey,
+		secretKey: secretKey,
+		region:    region,
+		buckets:   make(map[string]map[string]fakeObject),
+	}
+}
+
+// CreateBucket crea un bucket vacío
+func (f *FakeS3) CreateBucket(name string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.buckets[name]; !ok {
+		f.buckets[name] = make(map[string]fakeObject)
+	}
+}
+
+// Object contenido guardado de una clave
+func (f *FakeS3) Object(bucket, key string) ([]byte, bool) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	obj, ok := f.buckets[bucket][key]
+	return obj.data, ok
+}
+
+// ServeHTTP implementa http.Handler
+func (f *FakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if code, msg := f.authenticate(r); code != "" {
+		writeFakeS3Error(w, http.StatusForbidden, code, msg)
+		return
+	}
+
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	if bucket == "" || key == "" {
+		writeFakeS3Error(w, http.StatusBadRequest, "InvalidRequest", "bucket and key are required")
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	objects, ok := f.buckets[bucket]
+	if !ok {
+		writeFakeS3Error(w, http.StatusNotFound, "NoSuchBucket", "The specified bucket does not exist")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPut:
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			writeFakeS3Error(w, http.StatusBadRequest, "IncompleteBody", err.Error())
+			return
+		}
+		objects[key] = fakeObject{data: data, contentType: r.Header.Get("Content-Type")}
+		w.WriteHeader(http.StatusOK)
+	case http.MethodGet:
+		obj, ok := objects[key]
+		if !ok {
+			writeFakeS3Error(w, http.StatusNotFound, "NoSuchKey", "The specified key does not exist")
+			return
+		}
+		if obj.contentType != "" {
+			w.Header().Set("Content-Type", obj.contentType)
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(obj.data)
+	case http.MethodDelete:
+		delete(objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeFakeS3Error(w, http.StatusMethodNotAllowed, "MethodNotAllowed", "method not allowed")
+	}
+}
+
+// authenticate verifica la firma V4; devuelve el código de error S3 si falla
+func (f *FakeS3) authenticate(r *http.Request) (string, string) {
+	auth, err := parseSigV4Authorization(r.Header.Get("Authorization"))
+	if err != nil {
+		return "AccessDenied", err.Error()
+	}
+	if auth.AccessKey != f.accessKey {
+		return "InvalidAccessKeyId", "unknown access key"
+	}
+	if auth.Region != f.region {
+		return "AuthorizationHeaderMalformed", "unexpected region"
+	}
+
+	at, err := time.Parse(amzDateFormat, r.Header.Get("X-Amz-Date"))
+	if err != nil || at.Format(amzShortDateFormat) != auth.Date {
+		return "AccessDenied", "invalid request date"
+	}
+
+	payloadHash := r.Header.Get("X-Amz-Content-Sha256")
+	if payloadHash == "" {
+		return "AccessDenied", "missing content hash"
+	}
+
+	expected := computeSignature(r, r.Host, auth.SignedHeaders, payloadHash, f.secretKey, auth.Region, at)
+	if !hmac.Equal([]byte(expected), []byte(auth.Signature)) {
+		return "SignatureDoesNotMatch", "signature does not match"
+	}
+	return "", ""
+}
+
+// writeFakeS3Error responde con un error XML al estilo de S3
+func writeFakeS3Error(w http.ResponseWriter, status int, code, message string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	_ = xml.NewEncoder(w).Encode(fakeS3Error{Code: code, Message: message})
+}
@@ -0,0 +1,94 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// Local almacén en un directorio del servidor
+type Local struct {
+	root      string
+	publicURL string
+}
+
+// NewLocal crea un almacén local en root, creando el directorio si no existe
+func NewLocal(root, publicURL string) (*Local, error) {
+	if root == "" {
+		return nil, errors.New("local storage path is required")
+	}
+	if err := os.MkdirAll(root, 0o750); err != nil {
+		return nil, fmt.Errorf("creating storage directory: %w", err)
+	}
+	return &Local{root: root, publicURL: publicURL}, nil
+}
+
+// Put implementa Storage. Escribe en un fichero temporal y lo renombra para
+// que nunca se lea un objeto a medias
+func (s *Local) Put(_ context.Context, key string, body io.Reader, _ int64, _ string) error {
+	target, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(target), 0o750); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(target), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, body); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), target)
+}
+
+// Get implementa Storage
+func (s *Local) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	target, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(target)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	return file, err
+}
+
+// Delete implementa Storage
+func (s *Local) Delete(_ context.Context, key string) error {
+	target, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(target); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// URL implementa Storage
+func (s *Local) URL(key string) string {
+	return publicURL(s.publicURL, key)
+}
+
+// path ruta del fichero de la clave dentro del directorio raíz
+func (s *Local) path(key string) (string, error) {
+	if err := ValidateKey(key); err != nil {
+		return "", err
+	}
+	return filepath.Join(s.root, filepath.FromSlash(key)), nil
+}
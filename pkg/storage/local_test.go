@@ -0,0 +1,98 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestLocal tests para el almacén en sistema de ficheros
+func TestLocal(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewLocal(t.TempDir(), "")
+	require.NoError(t, err)
+
+	key := "event_image/2026/10/foto.png"
+	require.NoError(t, store.Put(ctx, key, strings.NewReader("contenido"), 9, "image/png"))
+
+	body, err := store.Get(ctx, key)
+	require.NoError(t, err)
+	data, err := io.ReadAll(body)
+	require.NoError(t, body.Close())
+	require.NoError(t, err)
+	assert.Equal(t, "contenido", string(data))
+
+	require.NoError(t, store.Put(ctx, key, strings.NewReader("nuevo"), 5, "image/png"))
+	body, err = store.Get(ctx, key)
+	require.NoError(t, err)
+	data, _ = io.ReadAll(body)
+	body.Close()
+	assert.Equal(t, "nuevo", string(data))
+
+	require.NoError(t, store.Delete(ctx, key))
+	_, err = store.Get(ctx, key)
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.NoError(t, store.Delete(ctx, key), "borrar una clave inexistente no es un error")
+
+	assert.ErrorIs(t, store.Put(ctx, "../fuera.txt", strings.NewReader("x"), 1, ""), ErrInvalidKey)
+	assert.Empty(t, store.URL(key))
+}
+
+// TestLocal_URL tests para las URL públicas del almacén local
+func TestLocal_URL(t *testing.T) {
+	store, err := NewLocal(t.TempDir(), "https://cdn.example.com/files/")
+	require.NoError(t, err)
+	assert.Equal(t, "https://cdn.example.com/files/a/b.png", store.URL("a/b.png"))
+}
+
+// TestValidateKey tests para la validación de claves
+func TestValidateKey(t *testing.T) {
+	tests := []struct {
+		name  string
+		key   string
+		valid bool
+	}{
+		{name: "ruta relativa", key: "event_image/2026/10/x.png", valid: true},
+		{name: "fichero en la raíz", key: "x.png", valid: true},
+		{name: "vacía", key: ""},
+		{name: "ruta absoluta", key: "/etc/passwd"},
+		{name: "directorio padre", key: "a/../../b"},
+		{name: "directorio padre al inicio", key: "../b"},
+		{name: "segmento vacío", key: "a//b"},
+		{name: "barra final", key: "a/b/"},
+		{name: "punto como segmento", key: "./a"},
+		{name: "barra invertida", key: `a\b`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateKey(tt.key)
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, ErrInvalidKey)
+			}
+		})
+	}
+}
+
+// TestNew tests para la selección de backend
+func TestNew(t *testing.T) {
+	store, err := New(Config{Backend: BackendLocal, Path: t.TempDir()})
+	require.NoError(t, err)
+	assert.IsType(t, &Local{}, store)
+
+	store, err = New(Config{Backend: BackendS3, Endpoint: "http://localhost:9000", Bucket: "b", AccessKey: "a", SecretKey: "s"})
+	require.NoError(t, err)
+	assert.IsType(t, &S3{}, store)
+
+	_, err = New(Config{Backend: BackendS3, Endpoint: "http://localhost:9000"})
+	assert.Error(t, err)
+
+	_, err = New(Config{Backend: "ftp"})
+	assert.ErrorIs(t, err, ErrUnknownBackend)
+}
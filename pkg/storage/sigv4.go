@@ -0,0 +1,202 @@
+package storage
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Constantes de AWS Signature Version 4 para S3
+const (
+	sigV4Algorithm     = "AWS4-HMAC-SHA256"
+	sigV4Service       = "s3"
+	sigV4Terminator    = "aws4_request"
+	amzDateFormat      = "20060102T150405Z"
+	amzShortDateFormat = "20060102"
+
+	// unsignedPayload el cuerpo no forma parte de la firma (se envía por TLS o
+	// a un servicio local); evita leer el fichero dos veces
+	unsignedPayload = "UNSIGNED-PAYLOAD"
+	// emptyPayloadHash SHA-256 de un cuerpo vacío
+	emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+// errInvalidAuthorization cabecera Authorization que no es una firma V4
+var errInvalidAuthorization = errors.New("invalid authorization header")
+
+// signV4 firma la petición con AWS Signature Version 4. Se firman host, las
+// cabeceras x-amz-*, content-type, content-md5 y range
+func signV4(req *http.Request, payloadHash, accessKey, secretKey, region string, now time.Time) {
+	now = now.UTC()
+	req.Header.Set("X-Amz-Date", now.Format(amzDateFormat))
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	signed := signedHeaderNames(req.Header)
+	scope := strings.Join([]string{now.Format(amzShortDateFormat), region, sigV4Service, sigV4Terminator}, "/")
+	signature := computeSignature(req, requestHost(req), signed, payloadHash, secretKey, region, now)
+
+	req.Header.Set("Authorization", sigV4Algorithm+
+		" Credential="+accessKey+"/"+scope+
+		",SignedHeaders="+strings.Join(signed, ";")+
+		",Signature="+signature)
+}
+
+// sigV4Authorization datos de una cabecera Authorization firmada
+type sigV4Authorization struct {
+	AccessKey     string
+	Date          string
+	Region        string
+	SignedHeaders []string
+	Signature     string
+}
+
+// parseSigV4Authorization interpreta la cabecera Authorization de una
+// petición firmada
+func parseSigV4Authorization(header string) (sigV4Authorization, error) {
+	var auth sigV4Authorization
+	rest, ok := strings.CutPrefix(header, sigV4Algorithm+" ")
+	if !ok {
+		return auth, errInvalidAuthorization
+	}
+
+	for _, part := range strings.Split(rest, ",") {
+		name, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			return auth, errInvalidAuthorization
+		}
+		switch name {
+		case "Credential":
+			fields := strings.Split(value, "/")
+			if len(fields) != 5 || fields[3] != sigV4Service || fields[4] != sigV4Terminator {
+				return auth, errInvalidAuthorization
+			}
+			auth.AccessKey, auth.Date, auth.Region = fields[0], fields[1], fields[2]
+		case "SignedHeaders":
+			auth.SignedHeaders = strings.Split(value, ";")
+		case "Signature":
+			auth.Signature = value
+		}
+	}
+
+	if auth.AccessKey == "" || len(auth.SignedHeaders) == 0 || auth.Signature == "" {
+		return auth, errInvalidAuthorization
+	}
+	return auth, nil
+}
+
+// computeSignature firma hexadecimal de la petición con las cabeceras indicadas
+func computeSignature(req *http.Request, host string, signedHeaders []string, payloadHash, secretKey, region string, at time.Time) string {
+	date := at.Format(amzShortDateFormat)
+	scope := strings.Join([]string{date, region, sigV4Service, sigV4Terminator}, "/")
+
+	canonical := canonicalRequest(req, host, signedHeaders, payloadHash)
+	hashed := sha256.Sum256([]byte(canonical))
+	stringToSign := strings.Join([]string{
+		sigV4Algorithm,
+		at.Format(amzDateFormat),
+		scope,
+		hex.EncodeToString(hashed[:]),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+secretKey), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, sigV4Service)
+	key = hmacSHA256(key, sigV4Terminator)
+	return hex.EncodeToString(hmacSHA256(key, stringToSign))
+}
+
+// canonicalRequest petición canónica de la especificación Signature V4
+func canonicalRequest(req *http.Request, host string, signedHeaders []string, payloadHash string) string {
+	var headers strings.Builder
+	for _, name := range signedHeaders {
+		value := req.Header.Get(name)
+		if name == "host" {
+			value = host
+		}
+		headers.WriteString(name + ":" + strings.Join(strings.Fields(value), " ") + "\n")
+	}
+
+	return strings.Join([]string{
+		req.Method,
+		uriEncode(req.URL.Path, false),
+		canonicalQuery(req.URL.Query()),
+		headers.String(),
+		strings.Join(signedHeaders, ";"),
+		payloadHash,
+	}, "\n")
+}
+
+// signedHeaderNames cabeceras que se firman, en minúsculas y ordenadas
+func signedHeaderNames(header http.Header) []string {
+	names := []string{"host"}
+	for name := range header {
+		lower := strings.ToLower(name)
+		if strings.HasPrefix(lower, "x-amz-") || lower == "content-type" || lower == "content-md5" || lower == "range" {
+			names = append(names, lower)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// canonicalQuery parámetros ordenados y codificados
+func canonicalQuery(values url.Values) string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var parts []string
+	for _, key := range keys {
+		vals := append([]string(nil), values[key]...)
+		sort.Strings(vals)
+		for _, val := range vals {
+			parts = append(parts, uriEncode(key, true)+"="+uriEncode(val, true))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// uriEncode codifica según RFC 3986 dejando sin codificar los caracteres no
+// reservados. La barra se conserva en las rutas
+func uriEncode(value string, encodeSlash bool) string {
+	const hexDigits = "0123456789ABCDEF"
+	var b strings.Builder
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		switch {
+		case c >= 'A' && c <= 'Z', c >= 'a' && c <= 'z', c >= '0' && c <= '9',
+			c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			b.WriteByte('%')
+			b.WriteByte(hexDigits[c>>4])
+			b.WriteByte(hexDigits[c&15])
+		}
+	}
+	return b.String()
+}
+
+// requestHost host con el que se envía la petición
+func requestHost(req *http.Request) string {
+	if req.Host != "" {
+		return req.Host
+	}
+	return req.URL.Host
+}
+
+// hmacSHA256 HMAC-SHA256 del mensaje con la clave
+func hmacSHA256(key []byte, message string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(message))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,105 @@
+// Package storage guarda los ficheros subidos por los usuarios. Los backends
+// son intercambiables detrás de la interfaz Storage: sistema de ficheros local
+// o un servicio compatible con S3 (AWS, MinIO, etc.).
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+)
+
+// Nombres de los backends disponibles
+const (
+	BackendLocal = "local" // Directorio del servidor
+	BackendS3    = "s3"    // Servicio compatible con S3
+)
+
+// Errores comunes de los backends
+var (
+	ErrNotFound       = errors.New("object not found")
+	ErrInvalidKey     = errors.New("invalid object key")
+	ErrUnknownBackend = errors.New("unknown storage backend")
+)
+
+// Storage almacén de objetos identificados por una clave con forma de ruta
+// relativa (p. ej. "event_image/2026/10/<uuid>.png")
+type Storage interface {
+	// Put guarda el contenido con la clave indicada, sustituyendo el anterior
+	Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error
+	// Get abre el contenido de la clave o devuelve ErrNotFound
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete borra la clave; no es un error si no existe
+	Delete(ctx context.Context, key string) error
+	// URL dirección pública del objeto, o cadena vacía si el backend no
+	// publica los objetos directamente y hay que servirlos desde la API
+	URL(key string) string
+}
+
+// Config configuración para construir un almacén
+type Config struct {
+	Backend   string
+	PublicURL string // Base de las URL públicas; vacía = se sirven desde la API
+
+	// Backend local
+	Path string
+
+	// Backend S3
+	Endpoint  string // p. ej. https://s3.eu-west-1.amazonaws.com o http://localhost:9000
+	Bucket    string
+	Region    string
+	AccessKey string
+	SecretKey string
+	PathStyle bool          // bucket en la ruta (MinIO) en lugar de en el host
+	Timeout   time.Duration // Tiempo máximo por operación
+}
+
+// New crea el almacén indicado en la configuración
+func New(cfg Config) (Storage, error) {
+	switch cfg.Backend {
+	case BackendLocal, "":
+		return NewLocal(cfg.Path, cfg.PublicURL)
+	case BackendS3:
+		return NewS3(S3Config{
+			Endpoint:  cfg.Endpoint,
+			Bucket:    cfg.Bucket,
+			Region:    cfg.Region,
+			AccessKey: cfg.AccessKey,
+			SecretKey: cfg.SecretKey,
+			PathStyle: cfg.PathStyle,
+			PublicURL: cfg.PublicURL,
+			Timeout:   cfg.Timeout,
+		})
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownBackend, cfg.Backend)
+	}
+}
+
+// ValidateKey comprueba que la clave es una ruta relativa limpia, sin
+// segmentos vacíos ni referencias al directorio padre
+func ValidateKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return ErrInvalidKey
+	}
+	if path.Clean(key) != key {
+		return ErrInvalidKey
+	}
+	for _, segment := range strings.Split(key, "/") {
+		if segment == ".." || segment == "." {
+			return ErrInvalidKey
+		}
+	}
+	return nil
+}
+
+// publicURL une la base pública y la clave
+func publicURL(base, key string) string {
+	if base == "" {
+		return ""
+	}
+	return strings.TrimSuffix(base, "/") + "/" + key
+}
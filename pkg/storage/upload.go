@@ -0,0 +1,115 @@
+package storage
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"path/filepath"
+	"strings"
+)
+
+// SniffLen bytes de cabecera necesarios para detectar el tipo de contenido
+const SniffLen = 512
+
+// Errores de validación de subidas
+var (
+	ErrEmptyFile           = errors.New("file is empty")
+	ErrFileTooLarge        = errors.New("file exceeds the maximum size")
+	ErrExtensionNotAllowed = errors.New("file extension not allowed")
+	ErrContentMismatch     = errors.New("file content does not match its extension")
+)
+
+// oleMagic firma de los documentos OLE (.doc y otros formatos de Office antiguos)
+var oleMagic = []byte{0xD0, 0xCF, 0x11, 0xE0, 0xA1, 0xB1, 0x1A, 0xE1}
+
+// extensionTypes tipos de contenido aceptados para cada extensión, tal y como
+// los detecta http.DetectContentType
+var extensionTypes = map[string][]string{
+	".jpg":  {"image/jpeg"},
+	".jpeg": {"image/jpeg"},
+	".png":  {"image/png"},
+	".gif":  {"image/gif"},
+	".webp": {"image/webp"},
+	".pdf":  {"application/pdf"},
+	".doc":  {"application/msword"},
+	".docx": {"application/zip"},
+}
+
+// contentTypeOverrides tipo con el que se guarda cuando el detectado es genérico
+var contentTypeOverrides = map[string]string{
+	".docx": "application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+}
+
+// Policy reglas de aceptación de ficheros subidos
+type Policy struct {
+	MaxSize           int64    // Tamaño máximo en bytes; 0 = sin límite
+	AllowedExtensions []string // Extensiones con o sin punto; vacía = todas las conocidas
+}
+
+// Check valida nombre, tamaño y cabecera del fichero. Devuelve la extensión
+// normalizada y el tipo de contenido detectado a partir de los bytes, nunca
+// del nombre ni de la cabecera Content-Type del cliente
+func (p Policy) Check(filename string, size int64, head []byte) (string, string, error) {
+	if size == 0 || len(head) == 0 {
+		return "", "", ErrEmptyFile
+	}
+	if p.MaxSize > 0 && size > p.MaxSize {
+		return "", "", ErrFileTooLarge
+	}
+
+	ext := strings.ToLower(filepath.Ext(filename))
+	expected, known := extensionTypes[ext]
+	if !known || !p.allows(ext) {
+		return "", "", ErrExtensionNotAllowed
+	}
+
+	detected := DetectContentType(head)
+	for _, contentType := range expected {
+		if detected == contentType {
+			if override, ok := contentTypeOverrides[ext]; ok {
+				return ext, override, nil
+			}
+			return ext, detected, nil
+		}
+	}
+	return "", "", ErrContentMismatch
+}
+
+// allows indica si la extensión está en la lista blanca
+func (p Policy) allows(ext string) bool {
+	if len(p.AllowedExtensions) == 0 {
+		return true
+	}
+	for _, allowed := range p.AllowedExtensions {
+		allowed = strings.ToLower(strings.TrimSpace(allowed))
+		if !strings.HasPrefix(allowed, ".") {
+			allowed = "." + allowed
+		}
+		if allowed == ext {
+			return true
+		}
+	}
+	return false
+}
+
+// DetectContentType tipo de contenido a partir de los primeros bytes, sin
+// parámetros. Amplía http.DetectContentType con los documentos OLE
+func DetectContentType(head []byte) string {
+	if bytes.HasPrefix(head, oleMagic) {
+		return "application/msword"
+	}
+	contentType := http.DetectContentType(head)
+	if i := strings.IndexByte(contentType, ';'); i >= 0 {
+		contentType = contentType[:i]
+	}
+	return strings.TrimSpace(contentType)
+}
+
+// IsImage indica si el tipo de contenido es una imagen aceptada
+func IsImage(contentType string) bool {
+	switch contentType {
+	case "image/jpeg", "image/png", "image/gif", "image/webp":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,80 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Cabeceras mínimas de cada formato para la detección de contenido
+var (
+	pngHead  = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+	jpegHead = []byte("\xff\xd8\xff\xe0\x00\x10JFIF\x00")
+	gifHead  = []byte("GIF89a\x01\x00\x01\x00")
+	webpHead = []byte("RIFF\x24\x00\x00\x00WEBPVP8 ")
+	pdfHead  = []byte("%PDF-1.7\n")
+	docHead  = []byte("\xD0\xCF\x11\xE0\xA1\xB1\x1A\xE1\x00\x00")
+	docxHead = []byte("PK\x03\x04\x14\x00\x06\x00")
+	htmlHead = []byte("<html><script>alert(1)</script></html>")
+)
+
+// TestPolicy_Check tests para la validación de subidas
+func TestPolicy_Check(t *testing.T) {
+	policy := Policy{MaxSize: 1024, AllowedExtensions: []string{"jpg", "jpeg", "png", ".gif", "webp", "pdf", "doc", "DOCX"}}
+
+	tests := []struct {
+		name     string
+		policy   *Policy
+		filename string
+		size     int64
+		head     []byte
+		wantExt  string
+		wantType string
+		wantErr  error
+	}{
+		{name: "PNG", filename: "logo.png", size: 100, head: pngHead, wantExt: ".png", wantType: "image/png"},
+		{name: "JPEG con extensión en mayúsculas", filename: "FOTO.JPG", size: 100, head: jpegHead, wantExt: ".jpg", wantType: "image/jpeg"},
+		{name: "JPEG como .jpeg", filename: "foto.jpeg", size: 100, head: jpegHead, wantExt: ".jpeg", wantType: "image/jpeg"},
+		{name: "GIF", filename: "a.gif", size: 100, head: gifHead, wantExt: ".gif", wantType: "image/gif"},
+		{name: "WebP", filename: "a.webp", size: 100, head: webpHead, wantExt: ".webp", wantType: "image/webp"},
+		{name: "PDF", filename: "nif.pdf", size: 100, head: pdfHead, wantExt: ".pdf", wantType: "application/pdf"},
+		{name: "documento Word antiguo", filename: "estatutos.doc", size: 100, head: docHead, wantExt: ".doc", wantType: "application/msword"},
+		{name: "documento Word", filename: "estatutos.docx", size: 100, head: docxHead, wantExt: ".docx",
+			wantType: "application/vnd.openxmlformats-officedocument.wordprocessingml.document"},
+		{name: "fichero vacío", filename: "a.png", size: 0, head: nil, wantErr: ErrEmptyFile},
+		{name: "demasiado grande", filename: "a.png", size: 2048, head: pngHead, wantErr: ErrFileTooLarge},
+		{name: "sin límite de tamaño", policy: &Policy{}, filename: "a.png", size: 1 << 30, head: pngHead, wantExt: ".png", wantType: "image/png"},
+		{name: "extensión fuera de la lista", policy: &Policy{AllowedExtensions: []string{"pdf"}}, filename: "a.png", size: 10, head: pngHead, wantErr: ErrExtensionNotAllowed},
+		{name: "extensión desconocida", filename: "a.exe", size: 10, head: []byte("MZ\x90\x00"), wantErr: ErrExtensionNotAllowed},
+		{name: "sin extensión", filename: "logo", size: 10, head: pngHead, wantErr: ErrExtensionNotAllowed},
+		{name: "HTML disfrazado de imagen", filename: "a.png", size: 10, head: htmlHead, wantErr: ErrContentMismatch},
+		{name: "PNG con extensión de PDF", filename: "a.pdf", size: 10, head: pngHead, wantErr: ErrContentMismatch},
+		{name: "ZIP no sirve como imagen", filename: "a.jpg", size: 10, head: docxHead, wantErr: ErrContentMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := policy
+			if tt.policy != nil {
+				p = *tt.policy
+			}
+
+			ext, contentType, err := p.Check(tt.filename, tt.size, tt.head)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantExt, ext)
+			assert.Equal(t, tt.wantType, contentType)
+		})
+	}
+}
+
+// TestIsImage tests para la clasificación de imágenes
+func TestIsImage(t *testing.T) {
+	assert.True(t, IsImage("image/png"))
+	assert.True(t, IsImage("image/webp"))
+	assert.False(t, IsImage("image/svg+xml"))
+	assert.False(t, IsImage("application/pdf"))
+}
@@ -0,0 +1,145 @@
+// Package taxid valida identificadores fiscales españoles: NIF de personas
+// físicas (DNI y NIF especiales K, L, M), NIE de extranjeros y NIF de personas
+// jurídicas (antiguo CIF)
+package taxid
+
+import (
+	"errors"
+	"strings"
+)
+
+// Kind tipo de identificador fiscal
+type Kind string
+
+const (
+	KindDNI     Kind = "dni"     // NIF de persona física con DNI
+	KindNIE     Kind = "nie"     // Número de identidad de extranjero
+	KindSpecial Kind = "special" // NIF de persona física sin DNI ni NIE (K, L, M)
+	KindCIF     Kind = "cif"     // NIF de persona jurídica o entidad
+)
+
+var (
+	// ErrInvalidFormat el identificador no tiene el formato de ningún NIF
+	ErrInvalidFormat = errors.New("invalid tax ID format")
+	// ErrInvalidControl el carácter de control no corresponde al identificador
+	ErrInvalidControl = errors.New("invalid tax ID control character")
+)
+
+const (
+	// dniLetters letras de control de DNI y NIE (número módulo 23)
+	dniLetters = "TRWAGMYFPDXBNJZSQVHLCKE"
+	// cifControlLetters letras de control de las entidades (dígito de control como índice)
+	cifControlLetters = "JABCDEFGHI"
+	// specialLetters letras iniciales de los NIF especiales de personas físicas
+	specialLetters = "KLM"
+	// cifLetters letras iniciales de entidades
+	cifLetters = "ABCDEFGHJNPQRSUVW"
+	// cifLetterControl entidades cuyo control es siempre una letra
+	cifLetterControl = "NPQRSW"
+	// cifDigitControl entidades cuyo control es siempre un dígito
+	cifDigitControl = "ABEH"
+)
+
+// Normalize pasa el identificador a mayúsculas y quita espacios, guiones,
+// puntos y el prefijo de país ES del NIF-IVA
+func Normalize(id string) string {
+	id = strings.ToUpper(strings.TrimSpace(id))
+	id = strings.NewReplacer(" ", "", "-", "", ".", "").Replace(id)
+	if len(id) == 11 && strings.HasPrefix(id, "ES") {
+		id = id[2:]
+	}
+	return id
+}
+
+// Validate normaliza el identificador y comprueba su formato y su carácter de
+// control. Devuelve el tipo de identificador
+func Validate(id string) (Kind, error) {
+	id = Normalize(id)
+	if len(id) != 9 {
+		return "", ErrInvalidFormat
+	}
+
+	first := id[0]
+	switch {
+	case isDigit(first):
+		return KindDNI, validateDNI(id)
+	case first == 'X' || first == 'Y' || first == 'Z':
+		// El NIE se calcula como un DNI sustituyendo X, Y, Z por 0, 1, 2
+		return KindNIE, validateDNI(string(rune('0'+strings.IndexByte("XYZ", first))) + id[1:])
+	case strings.IndexByte(specialLetters, first) >= 0:
+		// Letra de control del DNI sobre los siete dígitos
+		return KindSpecial, validateDNI("0" + id[1:])
+	case strings.IndexByte(cifLetters, first) >= 0:
+		return KindCIF, validateCIF(id)
+	default:
+		return "", ErrInvalidFormat
+	}
+}
+
+// IsValid indica si el identificador es un NIF, NIE o CIF válido
+func IsValid(id string) bool {
+	_, err := Validate(id)
+	return err == nil
+}
+
+// validateDNI valida ocho dígitos seguidos de la letra de control
+func validateDNI(id string) error {
+	number := 0
+	for i := 0; i < 8; i++ {
+		if !isDigit(id[i]) {
+			return ErrInvalidFormat
+		}
+		number = number*10 + int(id[i]-'0')
+	}
+
+	if id[8] != dniLetters[number%len(dniLetters)] {
+		return ErrInvalidControl
+	}
+	return nil
+}
+
+// validateCIF valida letra de entidad, siete dígitos y el control, que según la
+// entidad es un dígito, una letra o cualquiera de los dos
+func validateCIF(id string) error {
+	sum := 0
+	for i := 1; i <= 7; i++ {
+		if !isDigit(id[i]) {
+			return ErrInvalidFormat
+		}
+		digit := int(id[i] - '0')
+		// Las posiciones impares se multiplican por dos y se suman sus cifras
+		if i%2 == 1 {
+			digit *= 2
+			digit = digit/10 + digit%10
+		}
+		sum += digit
+	}
+	control := (10 - sum%10) % 10
+
+	entity, got := id[0], id[8]
+	digitOK := got == byte('0'+control)
+	letterOK := got == cifControlLetters[control]
+
+	switch {
+	case strings.IndexByte(cifLetterControl, entity) >= 0:
+		if letterOK {
+			return nil
+		}
+	case strings.IndexByte(cifDigitControl, entity) >= 0:
+		if digitOK {
+			return nil
+		}
+	case digitOK || letterOK:
+		return nil
+	}
+
+	if !isDigit(got) && (got < 'A' || got > 'Z') {
+		return ErrInvalidFormat
+	}
+	return ErrInvalidControl
+}
+
+// isDigit indica si el byte es un dígito ASCII
+func isDigit(b byte) bool {
+	return b >= '0' && b <= '9'
+}
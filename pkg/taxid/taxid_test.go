@@ -0,0 +1,61 @@
+package taxid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestValidate tests para la validación de NIF, NIE y CIF
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		id       string
+		wantKind Kind
+		wantErr  error
+	}{
+		{name: "DNI válido", id: "12345678Z", wantKind: KindDNI},
+		{name: "DNI con minúscula y guion", id: "12345678-z", wantKind: KindDNI},
+		{name: "DNI con letra incorrecta", id: "12345678A", wantKind: KindDNI, wantErr: ErrInvalidControl},
+		{name: "DNI con letras en el número", id: "1234A678Z", wantKind: KindDNI, wantErr: ErrInvalidFormat},
+		{name: "NIE con X", id: "X1234567L", wantKind: KindNIE},
+		{name: "NIE con Y", id: "Y1234567X", wantKind: KindNIE},
+		{name: "NIE con Z", id: "Z1234567R", wantKind: KindNIE},
+		{name: "NIE con letra incorrecta", id: "X1234567A", wantKind: KindNIE, wantErr: ErrInvalidControl},
+		{name: "NIF especial K", id: "K1234567L", wantKind: KindSpecial},
+		{name: "NIF especial L", id: "L7654321J", wantKind: KindSpecial},
+		{name: "NIF especial M", id: "M0000001R", wantKind: KindSpecial},
+		{name: "NIF especial con control de CIF", id: "M1234567D", wantKind: KindSpecial, wantErr: ErrInvalidControl},
+		{name: "NIF especial con control dígito", id: "K12345674", wantKind: KindSpecial, wantErr: ErrInvalidControl},
+		{name: "NIF especial con letras en el número", id: "L12A4567L", wantKind: KindSpecial, wantErr: ErrInvalidFormat},
+		{name: "CIF de sociedad limitada", id: "B12345674", wantKind: KindCIF},
+		{name: "CIF de sociedad anónima", id: "A11223344", wantKind: KindCIF},
+		{name: "CIF con prefijo de NIF-IVA", id: "ES B12345674", wantKind: KindCIF},
+		{name: "CIF de asociación con control letra", id: "G1234567D", wantKind: KindCIF},
+		{name: "CIF de asociación con control dígito", id: "G12345674", wantKind: KindCIF},
+		{name: "CIF de organismo público", id: "Q2818018J", wantKind: KindCIF},
+		{name: "CIF de organismo público con dígito", id: "Q28180180", wantKind: KindCIF, wantErr: ErrInvalidControl},
+		{name: "CIF de sociedad con control letra", id: "B1234567D", wantKind: KindCIF, wantErr: ErrInvalidControl},
+		{name: "CIF con dígito de control incorrecto", id: "B12345675", wantKind: KindCIF, wantErr: ErrInvalidControl},
+		{name: "CIF con símbolo de control", id: "B1234567*", wantKind: KindCIF, wantErr: ErrInvalidFormat},
+		{name: "letra inicial no válida", id: "I12345674", wantErr: ErrInvalidFormat},
+		{name: "demasiado corto", id: "1234567Z", wantErr: ErrInvalidFormat},
+		{name: "vacío", id: "", wantErr: ErrInvalidFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kind, err := Validate(tt.id)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantKind, kind)
+			assert.Equal(t, tt.wantErr == nil, IsValid(tt.id))
+		})
+	}
+}
+
+// TestNormalize tests para la normalización de identificadores
+func TestNormalize(t *testing.T) {
+	assert.Equal(t, "B12345674", Normalize(" b-12.345.674 "))
+	assert.Equal(t, "B12345674", Normalize("ESB12345674"))
+	assert.Equal(t, "ES1234567", Normalize("es1234567"))
+}
@@ -4,13 +4,22 @@
 -- UUID extension para generar IDs únicos
 CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
 
--- PostGIS extension para funcionalidades de geolocalización
--- CREATE EXTENSION IF NOT EXISTS postgis;
--- CREATE EXTENSION IF NOT EXISTS postgis_topology;
+-- PostGIS para búsquedas por cercanía, si la imagen lo incluye (p. ej. postgis/postgis);
+-- sin él la aplicación usa un cálculo Haversine sobre latitude/longitude
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM pg_available_extensions WHERE name = 'postgis') THEN
+        CREATE EXTENSION IF NOT EXISTS postgis;
+    END IF;
+END
+$$;
 
 -- Full text search en español
 CREATE EXTENSION IF NOT EXISTS unaccent;
 
+-- Similitud por trigramas para sugerencias de búsqueda
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
 -- Configuración de texto completo en español
 DO $$
 BEGIN
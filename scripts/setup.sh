@@ -123,6 +123,26 @@ setup_environment() {
             JWT_SECRET=$(openssl rand -hex 32 2>/dev/null || head -c 32 /dev/urandom | base64)
             sed -i.bak "s/your_super_secret_jwt_key_minimum_32_characters_long/$JWT_SECRET/" .env
             
+            # Geocodificador con las ciudades incluidas para desarrollo
+            if ! grep -q "^GEOCODER_PROVIDER=" .env; then
+                echo "GEOCODER_PROVIDER=fixture" >> .env
+            fi
+
+            # Proveedor de pagos de pruebas y secreto de sus webhooks si no están definidos
+            if ! grep -q "^PAYMENTS_PROVIDER=" .env; then
+                echo "PAYMENTS_PROVIDER=fake" >> .env
+            fi
+            if ! grep -q "^PAYMENTS_WEBHOOK_SECRET=" .env; then
+                PAYMENTS_WEBHOOK_SECRET=$(openssl rand -hex 32 2>/dev/null || head -c 32 /dev/urandom | base64)
+                echo "PAYMENTS_WEBHOOK_SECRET=$PAYMENTS_WEBHOOK_SECRET" >> .env
+            fi
+            
+            # Generar clave de firma de las exportaciones de auditoría (semilla Ed25519)
+            if ! grep -q "^AUDIT_SIGNING_KEY=" .env; then
+                AUDIT_SIGNING_KEY=$(openssl rand -base64 32 2>/dev/null || head -c 32 /dev/urandom | base64)
+                echo "AUDIT_SIGNING_KEY=$AUDIT_SIGNING_KEY" >> .env
+            fi
+            
             # Generar password seguro para DB
             DB_PASSWORD=$(openssl rand -hex 16 2>/dev/null || head -c 16 /dev/urandom | base64)
             sed -i.bak "s/your_secure_password_here/$DB_PASSWORD/" .env